@@ -0,0 +1,62 @@
+package baseapp_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type testBankMsgServer struct{}
+
+var _ banktypes.MsgServer = testBankMsgServer{}
+
+func (testBankMsgServer) Send(goCtx context.Context, _ *banktypes.MsgSend) (*banktypes.MsgSendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.EventManager().EmitEvent(sdk.NewEvent("send"))
+
+	return &banktypes.MsgSendResponse{}, nil
+}
+
+func (testBankMsgServer) MultiSend(context.Context, *banktypes.MsgMultiSend) (*banktypes.MsgMultiSendResponse, error) {
+	return &banktypes.MsgMultiSendResponse{}, nil
+}
+
+func (testBankMsgServer) UpdateParams(context.Context, *banktypes.MsgUpdateParams) (*banktypes.MsgUpdateParamsResponse, error) {
+	return &banktypes.MsgUpdateParamsResponse{}, nil
+}
+
+func TestMsgServiceRouter(t *testing.T) {
+	router := baseapp.NewMsgServiceRouter()
+	banktypes.RegisterMsgServer(router, testBankMsgServer{})
+
+	msg := &banktypes.MsgSend{}
+	typeURL := sdk.MsgTypeURL(msg)
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", typeURL)
+	require.Equal(t, "/cosmos.bank.v1beta1.Msg/Send", router.MethodByTypeURL(typeURL))
+	require.Equal(t, "/cosmos.bank.v1beta1.Msg/MultiSend", router.MethodByTypeURL(sdk.MsgTypeURL(&banktypes.MsgMultiSend{})))
+	require.Nil(t, router.HandlerByTypeURL("/cosmos.bank.v1beta1.MsgUnknown"))
+
+	handler := router.Handler(msg)
+	require.NotNil(t, handler)
+
+	ctx := sdk.Context{}.WithContext(context.Background())
+	res, err := handler(ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Len(t, res.Events, 1)
+	require.Equal(t, "send", res.Events[0].Type)
+
+	var data banktypes.MsgSendResponse
+	require.NoError(t, proto.Unmarshal(res.Data, &data))
+
+	// registering the same service twice must panic
+	require.Panics(t, func() {
+		banktypes.RegisterMsgServer(router, testBankMsgServer{})
+	})
+}
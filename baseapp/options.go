@@ -6,6 +6,7 @@ import (
 
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -54,6 +55,24 @@ func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetSnapshotStore provides a BaseApp option function that sets the snapshot
+// store used to serve and restore state sync snapshots.
+func SetSnapshotStore(snapshotStore *snapshots.Store) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSnapshotStore(snapshotStore) }
+}
+
+// SetSnapshotInterval provides a BaseApp option function that sets the block
+// interval at which state sync snapshots are taken.
+func SetSnapshotInterval(interval uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.setSnapshotInterval(interval) }
+}
+
+// SetSnapshotKeepRecent provides a BaseApp option function that sets the number
+// of recent state sync snapshots to keep.
+func SetSnapshotKeepRecent(keepRecent uint32) func(*BaseApp) {
+	return func(app *BaseApp) { app.setSnapshotKeepRecent(keepRecent) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -167,6 +186,21 @@ func (app *BaseApp) SetStoreLoader(loader StoreLoader) {
 	app.storeLoader = loader
 }
 
+// SetSnapshotStore sets the snapshot store, creating a snapshot manager for the
+// app's CommitMultiStore. Passing a nil store disables state sync snapshots.
+func (app *BaseApp) SetSnapshotStore(snapshotStore *snapshots.Store) {
+	if app.sealed {
+		panic("SetSnapshotStore() on sealed BaseApp")
+	}
+
+	if snapshotStore == nil {
+		app.snapshotManager = nil
+		return
+	}
+
+	app.snapshotManager = snapshots.NewManager(snapshotStore, app.cms)
+}
+
 // SetRouter allows us to customize the router.
 func (app *BaseApp) SetRouter(router sdk.Router) {
 	if app.sealed {
@@ -0,0 +1,49 @@
+package baseapp
+
+import (
+	"io"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+// ABCIListener interface used to hook into the ABCI message processing of the BaseApp
+type ABCIListener interface {
+	// ListenBeginBlock updates the streaming service with the latest BeginBlock messages
+	ListenBeginBlock(ctx types.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error
+	// ListenEndBlock updates the streaming service with the latest EndBlock messages
+	ListenEndBlock(ctx types.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
+	// ListenDeliverTx updates the streaming service with the latest DeliverTx messages
+	ListenDeliverTx(ctx types.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+}
+
+// StreamingService interface for registering WriteListeners with the BaseApp and updating the service with the ABCI messages using the hooks
+type StreamingService interface {
+	// Listeners returns the streaming service's listeners for the BaseApp to register
+	Listeners() map[types.StoreKey][]store.WriteListener
+	// ABCIListener interface for hooking into the ABCI messages from inside the BaseApp
+	ABCIListener
+	// Closer interface
+	io.Closer
+}
+
+// SetStreamingService is used to set a streaming service into the BaseApp hooks
+// and load the listeners into the multistore of every block. The listeners
+// observe the writes made during BeginBlock, DeliverTx and EndBlock, which the
+// ABCIListener hooks are called after.
+//
+// NOTE: the streaming service is not sealed with the BaseApp, so it may be set
+// after the stores have been loaded.
+func (app *BaseApp) SetStreamingService(s StreamingService) {
+	if app.storeListeners == nil {
+		app.storeListeners = make(map[types.StoreKey][]store.WriteListener)
+	}
+
+	for key, lis := range s.Listeners() {
+		app.storeListeners[key] = append(app.storeListeners[key], lis...)
+	}
+
+	app.abciListeners = append(app.abciListeners, s)
+}
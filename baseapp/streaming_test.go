@@ -0,0 +1,141 @@
+package baseapp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ StreamingService = (*mockStreamingService)(nil)
+
+// mockStreamingService records the state changes streamed for every ABCI
+// phase.
+type mockStreamingService struct {
+	listener *storetypes.MemoryListener
+
+	beginBlock [][]*storetypes.StoreKVPair
+	deliverTx  [][]*storetypes.StoreKVPair
+	endBlock   [][]*storetypes.StoreKVPair
+}
+
+func newMockStreamingService() *mockStreamingService {
+	return &mockStreamingService{listener: storetypes.NewMemoryListener()}
+}
+
+func (s *mockStreamingService) Listeners() map[sdk.StoreKey][]storetypes.WriteListener {
+	return map[sdk.StoreKey][]storetypes.WriteListener{capKey1: {s.listener}}
+}
+
+func (s *mockStreamingService) ListenBeginBlock(_ sdk.Context, _ abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	s.beginBlock = append(s.beginBlock, s.listener.PopStateCache())
+	return nil
+}
+
+func (s *mockStreamingService) ListenDeliverTx(_ sdk.Context, _ abci.RequestDeliverTx, _ abci.ResponseDeliverTx) error {
+	s.deliverTx = append(s.deliverTx, s.listener.PopStateCache())
+	return nil
+}
+
+func (s *mockStreamingService) ListenEndBlock(_ sdk.Context, _ abci.RequestEndBlock, _ abci.ResponseEndBlock) error {
+	s.endBlock = append(s.endBlock, s.listener.PopStateCache())
+	return nil
+}
+
+func (s *mockStreamingService) Close() error { return nil }
+
+func TestStreamingService(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+		bapp.Router().AddRoute(r)
+	}
+
+	beginKey, endKey := []byte("begin-key"), []byte("end-key")
+	blockerOpt := func(bapp *BaseApp) {
+		bapp.SetBeginBlocker(func(ctx sdk.Context, _ abci.RequestBeginBlock) abci.ResponseBeginBlock {
+			ctx.KVStore(capKey1).Set(beginKey, []byte("begin"))
+			return abci.ResponseBeginBlock{}
+		})
+		bapp.SetEndBlocker(func(ctx sdk.Context, _ abci.RequestEndBlock) abci.ResponseEndBlock {
+			ctx.KVStore(capKey1).Delete(beginKey)
+			ctx.KVStore(capKey1).Set(endKey, []byte("end"))
+			return abci.ResponseEndBlock{}
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, blockerOpt)
+	service := newMockStreamingService()
+	app.SetStreamingService(service)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	header := tmproto.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	nTxs := 3
+	for i := 0; i < nTxs; i++ {
+		txBytes, err := codec.MarshalBinaryBare(newTxCounter(int64(i), int64(i)))
+		require.NoError(t, err)
+
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+		// CheckTx writes must not be streamed
+		checkTxBytes, err := codec.MarshalBinaryBare(newTxCounter(int64(i), 0))
+		require.NoError(t, err)
+		require.True(t, app.CheckTx(abci.RequestCheckTx{Tx: checkTxBytes}).IsOK())
+	}
+
+	// only the ante handler writes of a tx failing in its handler are kept
+	failingTx := newTxCounter(int64(nTxs), int64(nTxs))
+	failingTx.setFailOnHandler(true)
+	txBytes, err := codec.MarshalBinaryBare(failingTx)
+	require.NoError(t, err)
+	require.False(t, app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes}).IsOK())
+
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	require.Equal(t, [][]*storetypes.StoreKVPair{{
+		{StoreKey: capKey1.Name(), Key: beginKey, Value: []byte("begin")},
+	}}, service.beginBlock)
+
+	require.Len(t, service.deliverTx, nTxs+1)
+	for i := 0; i < nTxs; i++ {
+		// the ante handler and the msg handler each write their counter
+		require.Equal(t, []*storetypes.StoreKVPair{
+			{StoreKey: capKey1.Name(), Key: anteKey, Value: encodeInt(int64(i + 1))},
+			{StoreKey: capKey1.Name(), Key: deliverKey, Value: encodeInt(int64(i + 1))},
+		}, service.deliverTx[i])
+	}
+	require.Equal(t, []*storetypes.StoreKVPair{
+		{StoreKey: capKey1.Name(), Key: anteKey, Value: encodeInt(int64(nTxs + 1))},
+	}, service.deliverTx[nTxs])
+
+	require.Equal(t, [][]*storetypes.StoreKVPair{{
+		{StoreKey: capKey1.Name(), Delete: true, Key: beginKey},
+		{StoreKey: capKey1.Name(), Key: endKey, Value: []byte("end")},
+	}}, service.endBlock)
+
+	// the committed writes are not streamed again
+	require.Empty(t, service.listener.PopStateCache())
+}
+
+func encodeInt(i int64) []byte {
+	bz := make([]byte, 8)
+	n := binary.PutVarint(bz, i)
+	return bz[:n]
+}
@@ -27,11 +27,13 @@ type Factory struct {
 	gasPrices          sdk.DecCoins
 	signMode           signing.SignMode
 	simulateAndExecute bool
+	feeGranter         sdk.AccAddress
 }
 
 const (
 	signModeDirect    = "direct"
 	signModeAminoJSON = "amino-json"
+	signModeTextual   = "textual"
 )
 
 func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
@@ -43,6 +45,8 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 		signMode = signing.SignMode_SIGN_MODE_DIRECT
 	case signModeAminoJSON:
 		signMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	case signModeTextual:
+		signMode = signing.SignMode_SIGN_MODE_TEXTUAL
 	}
 
 	accNum, _ := flagSet.GetUint64(flags.FlagAccountNumber)
@@ -67,6 +71,7 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 		gasAdjustment:      gasAdj,
 		memo:               memo,
 		signMode:           signMode,
+		feeGranter:         clientCtx.FeeGranter,
 	}
 
 	feesStr, _ := flagSet.GetString(flags.FlagFees)
@@ -89,6 +94,7 @@ func (f Factory) Fees() sdk.Coins                           { return f.fees }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
+func (f Factory) FeeGranter() sdk.AccAddress                { return f.feeGranter }
 
 // SimulateAndExecute returns the option to simulate and then execute the transaction
 // using the gas from the simulation results
@@ -193,3 +199,9 @@ func (f Factory) WithTimeoutHeight(height uint64) Factory {
 	f.timeoutHeight = height
 	return f
 }
+
+// WithFeeGranter returns a copy of the Factory with an updated fee granter.
+func (f Factory) WithFeeGranter(feeGranter sdk.AccAddress) Factory {
+	f.feeGranter = feeGranter
+	return f
+}
@@ -91,6 +91,54 @@ type GRPCConfig struct {
 	Address string `mapstructure:"address"`
 }
 
+// StateSyncConfig defines the state sync snapshot configuration.
+type StateSyncConfig struct {
+	// SnapshotInterval sets the interval at which state sync snapshots are taken.
+	// 0 disables snapshots. Must be a multiple of PruningKeepEvery.
+	SnapshotInterval uint64 `mapstructure:"snapshot-interval"`
+
+	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
+	// 0 keeps all snapshots.
+	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+}
+
+// StoreConfig defines application configuration for state streaming and other
+// storage related operations.
+type StoreConfig struct {
+	// Streamers defines the state streaming services to enable, among "file"
+	// and "grpc".
+	Streamers []string `mapstructure:"streamers"`
+}
+
+// StreamersConfig defines the configuration of the state streaming services.
+type StreamersConfig struct {
+	File FileStreamerConfig `mapstructure:"file"`
+	GRPC GRPCStreamerConfig `mapstructure:"grpc"`
+}
+
+// FileStreamerConfig defines the configuration of the file streaming service.
+type FileStreamerConfig struct {
+	// Keys defines the names of the stores to stream, "*" streams all of them.
+	Keys []string `mapstructure:"keys"`
+
+	// WriteDir defines the directory the files are written to. A relative path
+	// is resolved against the node's home directory.
+	WriteDir string `mapstructure:"write-dir"`
+
+	// Prefix defines an optional prefix of the written files.
+	Prefix string `mapstructure:"prefix"`
+}
+
+// GRPCStreamerConfig defines the configuration of the gRPC plugin streaming
+// service.
+type GRPCStreamerConfig struct {
+	// Keys defines the names of the stores to stream, "*" streams all of them.
+	Keys []string `mapstructure:"keys"`
+
+	// Address defines the address of the plugin's ABCIListenerService.
+	Address string `mapstructure:"address"`
+}
+
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
@@ -99,6 +147,9 @@ type Config struct {
 	Telemetry telemetry.Config `mapstructure:"telemetry"`
 	API       APIConfig        `mapstructure:"api"`
 	GRPC      GRPCConfig       `mapstructure:"grpc"`
+	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+	Store     StoreConfig      `mapstructure:"store"`
+	Streamers StreamersConfig  `mapstructure:"streamers"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -156,6 +207,22 @@ func DefaultConfig() *Config {
 			Enable:  true,
 			Address: DefaultGRPCAddress,
 		},
+		StateSync: StateSyncConfig{
+			SnapshotInterval:   0,
+			SnapshotKeepRecent: 2,
+		},
+		Store: StoreConfig{
+			Streamers: []string{},
+		},
+		Streamers: StreamersConfig{
+			File: FileStreamerConfig{
+				Keys:     []string{"*"},
+				WriteDir: "data/streaming",
+			},
+			GRPC: GRPCStreamerConfig{
+				Keys: []string{"*"},
+			},
+		},
 	}
 }
 
@@ -204,5 +271,23 @@ func GetConfig(v *viper.Viper) Config {
 			Enable:  v.GetBool("grpc.enable"),
 			Address: v.GetString("grpc.address"),
 		},
+		StateSync: StateSyncConfig{
+			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
+			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
+		},
+		Store: StoreConfig{
+			Streamers: v.GetStringSlice("store.streamers"),
+		},
+		Streamers: StreamersConfig{
+			File: FileStreamerConfig{
+				Keys:     v.GetStringSlice("streamers.file.keys"),
+				WriteDir: v.GetString("streamers.file.write-dir"),
+				Prefix:   v.GetString("streamers.file.prefix"),
+			},
+			GRPC: GRPCStreamerConfig{
+				Keys:    v.GetStringSlice("streamers.grpc.keys"),
+				Address: v.GetString("streamers.grpc.address"),
+			},
+		},
 	}
 }
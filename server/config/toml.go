@@ -130,6 +130,59 @@ enable = {{ .GRPC.Enable }}
 
 # Address defines the gRPC server address to bind to.
 address = "{{ .GRPC.Address }}"
+
+###############################################################################
+###                        State Sync Configuration                         ###
+###############################################################################
+
+# State sync snapshots allow other nodes to rapidly join the network without replaying historical
+# blocks, instead downloading and applying a snapshot of the application state at a given height.
+[state-sync]
+
+# snapshot-interval specifies the block interval at which local state sync snapshots are
+# taken (0 to disable). Must be a multiple of pruning-keep-every.
+snapshot-interval = {{ .StateSync.SnapshotInterval }}
+
+# snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
+snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+###############################################################################
+###                         Store / State Streaming                         ###
+###############################################################################
+
+[store]
+
+# streamers defines the state streaming services to enable, which write the
+# state changes of every BeginBlock, DeliverTx and EndBlock along with the
+# corresponding ABCI request and response. The available services are "file"
+# and "grpc".
+#
+# Example:
+# ["file"]
+streamers = [{{ range .Store.Streamers }}"{{ . }}", {{ end }}]
+
+[streamers]
+
+[streamers.file]
+
+# keys defines the names of the stores to stream ("*" streams all of them).
+keys = [{{ range .Streamers.File.Keys }}"{{ . }}", {{ end }}]
+
+# write-dir defines the directory the files are written to, relative to the
+# home directory unless absolute.
+write-dir = "{{ .Streamers.File.WriteDir }}"
+
+# prefix defines an optional prefix of the written files.
+prefix = "{{ .Streamers.File.Prefix }}"
+
+[streamers.grpc]
+
+# keys defines the names of the stores to stream ("*" streams all of them).
+keys = [{{ range .Streamers.GRPC.Keys }}"{{ . }}", {{ end }}]
+
+# address defines the address of the plugin implementing the
+# cosmos.base.streaming.v1beta1.ABCIListenerService gRPC service.
+address = "{{ .Streamers.GRPC.Address }}"
 `
 
 var configTemplate *template.Template
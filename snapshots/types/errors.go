@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SnapshotCodespace is the codespace for snapshot errors.
+const SnapshotCodespace = "snapshot"
+
+// snapshot sentinel errors
+var (
+	ErrUnknownFormat     = sdkerrors.Register(SnapshotCodespace, 2, "unknown snapshot format")
+	ErrChunkHashMismatch = sdkerrors.Register(SnapshotCodespace, 3, "chunk hash verification failed")
+	ErrInvalidMetadata   = sdkerrors.Register(SnapshotCodespace, 4, "invalid snapshot metadata")
+	ErrAppHashMismatch   = sdkerrors.Register(SnapshotCodespace, 5, "restored app hash does not match snapshot")
+)
@@ -6,7 +6,6 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
-	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 // ----------------------------------------------------------------------------
@@ -25,13 +24,12 @@ func MakeCodec(bm module.BasicManager) *codec.LegacyAmino {
 }
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
-	vesting.RegisterCodec(cdc)
 	sdk.RegisterCodec(cdc)
 	cryptocodec.RegisterCrypto(cdc)
 }
 
-// RegisterInterfaces registers Interfaces from sdk/types and vesting
+// RegisterInterfaces registers Interfaces from sdk/types. The vesting account
+// types are registered by the vesting module's AppModuleBasic.
 func RegisterInterfaces(interfaceRegistry types.InterfaceRegistry) {
 	sdk.RegisterInterfaces(interfaceRegistry)
-	vesting.RegisterInterfaces(interfaceRegistry)
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
 	"github.com/cosmos/cosmos-sdk/store/types"
 )
 
@@ -25,6 +26,8 @@ type Store struct {
 
 	traceWriter  io.Writer
 	traceContext types.TraceContext
+
+	listeners map[types.StoreKey][]types.WriteListener
 }
 
 var _ types.CacheMultiStore = Store{}
@@ -42,6 +45,7 @@ func NewFromKVStore(
 		keys:         keys,
 		traceWriter:  traceWriter,
 		traceContext: traceContext,
+		listeners:    make(map[types.StoreKey][]types.WriteListener),
 	}
 
 	for key, store := range stores {
@@ -68,6 +72,12 @@ func NewStore(
 func newCacheMultiStoreFromCMS(cms Store) Store {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range cms.stores {
+		// the listeners observe the writes of the nested cache once it is written
+		if cms.ListeningEnabled(k) {
+			stores[k] = listenkv.NewStore(v.(types.KVStore), k, cms.listeners[k])
+			continue
+		}
+
 		stores[k] = v
 	}
 
@@ -102,6 +112,23 @@ func (cms Store) TracingEnabled() bool {
 	return cms.traceWriter != nil
 }
 
+// AddListeners adds listeners for a specific KVStore
+func (cms Store) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	if ls, ok := cms.listeners[key]; ok {
+		cms.listeners[key] = append(ls, listeners...)
+	} else {
+		cms.listeners[key] = listeners
+	}
+}
+
+// ListeningEnabled returns if listening is enabled for a specific KVStore
+func (cms Store) ListeningEnabled(key types.StoreKey) bool {
+	if ls, ok := cms.listeners[key]; ok {
+		return len(ls) != 0
+	}
+	return false
+}
+
 // GetStoreType returns the type of the store.
 func (cms Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -144,11 +171,17 @@ func (cms Store) GetStore(key types.StoreKey) types.Store {
 	return cms.stores[key].(types.Store)
 }
 
-// GetKVStore returns an underlying KVStore by key.
+// GetKVStore returns an underlying KVStore by key. If listening is enabled for
+// the KVStore, it is wrapped in a listenkv.Store.
 func (cms Store) GetKVStore(key types.StoreKey) types.KVStore {
 	store := cms.stores[key]
 	if key == nil {
 		panic(fmt.Sprintf("kv store with key %v has not been registered in stores", key))
 	}
+
+	if cms.ListeningEnabled(key) {
+		return listenkv.NewStore(store.(types.KVStore), key, cms.listeners[key])
+	}
+
 	return store.(types.KVStore)
 }
@@ -0,0 +1,81 @@
+package listenkv_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var testStoreKey = types.NewKVStoreKey("listen_test")
+
+func newEmptyListenKVStore(w *bytes.Buffer) *listenkv.Store {
+	listener := types.NewStoreKVPairWriteListener(w)
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+
+	return listenkv.NewStore(memDB, testStoreKey, []types.WriteListener{listener})
+}
+
+// readKVPairs decodes the length-prefixed StoreKVPairs written to buf.
+func readKVPairs(t *testing.T, buf *bytes.Buffer) []types.StoreKVPair {
+	var pairs []types.StoreKVPair
+
+	for buf.Len() > 0 {
+		size, err := binary.ReadUvarint(buf)
+		require.NoError(t, err)
+
+		var pair types.StoreKVPair
+		require.NoError(t, pair.Unmarshal(buf.Next(int(size))))
+		pairs = append(pairs, pair)
+	}
+
+	return pairs
+}
+
+func TestListenKVStoreSetDelete(t *testing.T) {
+	var buf bytes.Buffer
+	store := newEmptyListenKVStore(&buf)
+
+	store.Set([]byte("key1"), []byte("value1"))
+	require.Equal(t, []byte("value1"), store.Get([]byte("key1")))
+	require.True(t, store.Has([]byte("key1")))
+
+	store.Delete([]byte("key1"))
+	require.False(t, store.Has([]byte("key1")))
+
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: []byte("key1"), Value: []byte("value1")},
+		{StoreKey: testStoreKey.Name(), Delete: true, Key: []byte("key1")},
+	}, readKVPairs(t, &buf))
+
+	require.Panics(t, func() { store.Set(nil, []byte("value")) })
+}
+
+func TestListenKVStoreCacheWrap(t *testing.T) {
+	var buf bytes.Buffer
+	store := newEmptyListenKVStore(&buf)
+
+	cache := store.CacheWrap().(types.CacheKVStore)
+	cache.Set([]byte("key2"), []byte("value2"))
+	cache.Set([]byte("key1"), []byte("value1"))
+	require.Zero(t, buf.Len())
+
+	// the cache writes its entries in key order
+	cache.Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: []byte("key1"), Value: []byte("value1")},
+		{StoreKey: testStoreKey.Name(), Key: []byte("key2"), Value: []byte("value2")},
+	}, readKVPairs(t, &buf))
+}
+
+func TestListenKVStoreGetStoreType(t *testing.T) {
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+	store := newEmptyListenKVStore(new(bytes.Buffer))
+	require.Equal(t, memDB.GetStoreType(), store.GetStoreType())
+}
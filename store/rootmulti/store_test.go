@@ -8,6 +8,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tm-db"
 
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
 	sdkmaps "github.com/cosmos/cosmos-sdk/store/internal/maps"
 	"github.com/cosmos/cosmos-sdk/store/types"
@@ -505,6 +506,59 @@ func TestMultiStore_PruningRestart(t *testing.T) {
 	}
 }
 
+func TestMultiStore_Listening(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	key1 := ms.keysByName["store1"]
+	key2 := ms.keysByName["store2"]
+
+	listener := types.NewMemoryListener()
+	require.False(t, ms.ListeningEnabled(key1))
+	ms.AddListeners(key1, []types.WriteListener{listener})
+	require.True(t, ms.ListeningEnabled(key1))
+	require.False(t, ms.ListeningEnabled(key2))
+
+	// direct writes are observed
+	ms.GetKVStore(key1).Set([]byte("k1"), []byte("v1"))
+	ms.GetKVStore(key2).Set([]byte("k2"), []byte("v2"))
+	require.Equal(t, []*types.StoreKVPair{
+		{StoreKey: "store1", Key: []byte("k1"), Value: []byte("v1")},
+	}, listener.PopStateCache())
+
+	// cached writes are observed once written
+	cacheMulti := ms.CacheMultiStore()
+	cacheMulti.GetKVStore(key1).Set([]byte("k3"), []byte("v3"))
+	cacheMulti.GetKVStore(key1).Delete([]byte("k1"))
+	require.Empty(t, listener.PopStateCache())
+
+	cacheMulti.Write()
+	require.Equal(t, []*types.StoreKVPair{
+		{StoreKey: "store1", Delete: true, Key: []byte("k1")},
+		{StoreKey: "store1", Key: []byte("k3"), Value: []byte("v3")},
+	}, listener.PopStateCache())
+
+	// listeners registered on a cache-wrap observe its writes and those of
+	// its own cache-wraps exactly once
+	cacheListener := types.NewMemoryListener()
+	cacheMulti = ms.CacheMultiStore()
+	cacheMulti.AddListeners(key2, []types.WriteListener{cacheListener})
+	cacheMulti.GetKVStore(key2).Set([]byte("k4"), []byte("v4"))
+
+	nested := cacheMulti.CacheMultiStore()
+	nested.GetKVStore(key2).Set([]byte("k5"), []byte("v5"))
+	nested.Write()
+	require.Equal(t, []*types.StoreKVPair{
+		{StoreKey: "store2", Key: []byte("k4"), Value: []byte("v4")},
+		{StoreKey: "store2", Key: []byte("k5"), Value: []byte("v5")},
+	}, cacheListener.PopStateCache())
+
+	cacheMulti.Write()
+	require.Empty(t, cacheListener.PopStateCache())
+	require.Empty(t, listener.PopStateCache())
+}
+
 //-----------------------------------------------------------------------
 // utils
 
@@ -579,3 +633,50 @@ func hashStores(stores map[types.StoreKey]types.CommitKVStore) []byte {
 	}
 	return sdkmaps.HashFromMap(m)
 }
+
+func TestMultistoreSnapshotRestore(t *testing.T) {
+	source := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, source.LoadLatestVersion())
+	for v := 1; v <= 3; v++ {
+		for _, name := range []string{"store1", "store2", "store3"} {
+			kv := source.getStoreByName(name).(types.KVStore)
+			for i := 0; i < 10; i++ {
+				kv.Set([]byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("%s-%d-%d", name, v, i)))
+			}
+		}
+		source.Commit()
+	}
+
+	target := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, target.LoadLatestVersion())
+
+	// invalid formats and heights should be rejected
+	_, err := source.Snapshot(2, 9)
+	require.Error(t, err)
+	_, err = source.Snapshot(0, snapshottypes.CurrentFormat)
+	require.Error(t, err)
+	_, err = source.Snapshot(9, snapshottypes.CurrentFormat)
+	require.Error(t, err)
+
+	chunks, err := source.Snapshot(2, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+	ready := make(chan struct{})
+	err = target.Restore(2, snapshottypes.CurrentFormat, chunks, ready)
+	require.NoError(t, err)
+
+	require.Equal(t, int64(2), target.LastCommitID().Version)
+	cInfo, err := getCommitInfo(source.db, 2)
+	require.NoError(t, err)
+	require.Equal(t, cInfo.CommitID(), target.LastCommitID())
+
+	for _, name := range []string{"store1", "store2", "store3"} {
+		sourceStore, err := source.getStoreByName(name).(*iavl.Store).GetImmutable(2)
+		require.NoError(t, err)
+		targetStore := target.getStoreByName(name).(types.KVStore)
+		iter := sourceStore.Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			require.Equal(t, iter.Value(), targetStore.Get(iter.Key()))
+		}
+		iter.Close()
+	}
+}
@@ -0,0 +1,176 @@
+package streaming
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/grpc"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// ServiceType enum for specifying the type of StreamingService
+type ServiceType int
+
+const (
+	Unknown ServiceType = iota
+	File
+	GRPC
+)
+
+// Streaming option keys
+const (
+	OptStoreStreamers = "store.streamers"
+
+	OptStreamersFileKeys     = "streamers.file.keys"
+	OptStreamersFileWriteDir = "streamers.file.write-dir"
+	OptStreamersFilePrefix   = "streamers.file.prefix"
+
+	OptStreamersGRPCKeys    = "streamers.grpc.keys"
+	OptStreamersGRPCAddress = "streamers.grpc.address"
+)
+
+// ServiceTypeFromString returns the streaming.ServiceType corresponding to the
+// provided name
+func ServiceTypeFromString(name string) ServiceType {
+	switch strings.ToLower(name) {
+	case "file", "f":
+		return File
+	case "grpc":
+		return GRPC
+	default:
+		return Unknown
+	}
+}
+
+// String returns the string name of a streaming.ServiceType
+func (sst ServiceType) String() string {
+	switch sst {
+	case File:
+		return "file"
+	case GRPC:
+		return "grpc"
+	default:
+		return "unknown"
+	}
+}
+
+// ServiceConstructor is used to construct a streaming service
+type ServiceConstructor func(opts serverTypes.AppOptions, keys []types.StoreKey) (baseapp.StreamingService, error)
+
+// serviceConstructorLookupTable is a mapping of streaming.ServiceTypes to
+// streaming.ServiceConstructors
+var serviceConstructorLookupTable = map[ServiceType]ServiceConstructor{
+	File: NewFileStreamingService,
+	GRPC: NewGRPCStreamingService,
+}
+
+// NewServiceConstructor returns the streaming.ServiceConstructor corresponding
+// to the provided name
+func NewServiceConstructor(name string) (ServiceConstructor, error) {
+	ssType := ServiceTypeFromString(name)
+	if ssType == Unknown {
+		return nil, fmt.Errorf("unrecognized streaming service name %s", name)
+	}
+
+	if constructor, ok := serviceConstructorLookupTable[ssType]; ok && constructor != nil {
+		return constructor, nil
+	}
+
+	return nil, fmt.Errorf("streaming service constructor of type %s not found", ssType.String())
+}
+
+// NewFileStreamingService is the streaming.ServiceConstructor function for
+// creating a FileStreamingService
+func NewFileStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey) (baseapp.StreamingService, error) {
+	filePrefix := cast.ToString(opts.Get(OptStreamersFilePrefix))
+
+	fileDir := cast.ToString(opts.Get(OptStreamersFileWriteDir))
+	if fileDir == "" {
+		return nil, fmt.Errorf("%s must be set to use the file streaming service", OptStreamersFileWriteDir)
+	}
+	if !filepath.IsAbs(fileDir) {
+		fileDir = filepath.Join(cast.ToString(opts.Get(flags.FlagHome)), fileDir)
+	}
+
+	return file.NewStreamingService(fileDir, filePrefix, keys)
+}
+
+// NewGRPCStreamingService is the streaming.ServiceConstructor function for
+// creating a gRPC plugin StreamingService
+func NewGRPCStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey) (baseapp.StreamingService, error) {
+	address := cast.ToString(opts.Get(OptStreamersGRPCAddress))
+	if address == "" {
+		return nil, fmt.Errorf("%s must be set to use the grpc streaming service", OptStreamersGRPCAddress)
+	}
+
+	return grpc.NewStreamingService(address, keys)
+}
+
+// LoadStreamingServices is a function for loading StreamingServices onto the
+// BaseApp using the provided AppOptions and the app's KVStoreKeys. The
+// services are enabled by the "store.streamers" option and each of them
+// streams the stores listed in its "streamers.{name}.keys" option, where "*"
+// stands for all of the stores.
+func LoadStreamingServices(bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions, keys map[string]*types.KVStoreKey) ([]baseapp.StreamingService, error) {
+	streamers := cast.ToStringSlice(appOpts.Get(OptStoreStreamers))
+	activeStreamers := make([]baseapp.StreamingService, 0, len(streamers))
+
+	for _, streamerName := range streamers {
+		exposeKeys, err := exposeStoreKeys(cast.ToStringSlice(appOpts.Get(fmt.Sprintf("streamers.%s.keys", streamerName))), keys)
+		if err != nil {
+			return nil, err
+		}
+
+		constructor, err := NewServiceConstructor(streamerName)
+		if err != nil {
+			return nil, err
+		}
+
+		streamingService, err := constructor(appOpts, exposeKeys)
+		if err != nil {
+			return nil, err
+		}
+
+		bApp.SetStreamingService(streamingService)
+		activeStreamers = append(activeStreamers, streamingService)
+	}
+
+	return activeStreamers, nil
+}
+
+// exposeStoreKeys returns the store keys named by keysStr, sorted by name.
+func exposeStoreKeys(keysStr []string, keys map[string]*types.KVStoreKey) ([]types.StoreKey, error) {
+	var exposeStoreKeys []types.StoreKey
+
+	for _, keyStr := range keysStr {
+		if keyStr == "*" {
+			exposeStoreKeys = make([]types.StoreKey, 0, len(keys))
+			for _, storeKey := range keys {
+				exposeStoreKeys = append(exposeStoreKeys, storeKey)
+			}
+
+			break
+		}
+
+		storeKey, ok := keys[keyStr]
+		if !ok {
+			return nil, fmt.Errorf("store key %s to stream does not exist", keyStr)
+		}
+
+		exposeStoreKeys = append(exposeStoreKeys, storeKey)
+	}
+
+	sort.SliceStable(exposeStoreKeys, func(i, j int) bool {
+		return exposeStoreKeys[i].Name() < exposeStoreKeys[j].Name()
+	})
+
+	return exposeStoreKeys, nil
+}
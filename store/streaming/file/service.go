@@ -0,0 +1,132 @@
+package file
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// StreamingService is a concrete implementation of baseapp.StreamingService
+// that writes the state changes out to files.
+//
+// A file is written after BeginBlock, every DeliverTx and EndBlock, named
+// {prefix}-block-{height}-begin, {prefix}-block-{height}-tx-{index} and
+// {prefix}-block-{height}-end respectively. Each file holds the ABCI request,
+// followed by the StoreKVPairs written during the phase, followed by the ABCI
+// response, all protobuf encoded and length-prefixed with a uvarint.
+type StreamingService struct {
+	listeners   map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
+	memListener *types.MemoryListener                    // the listener accumulating the state changes of the current phase
+	filePrefix  string                                   // optional prefix for each of the generated files
+	writeDir    string                                   // directory to write files into
+
+	currentTxIndex int64
+}
+
+// NewStreamingService creates a new StreamingService for the provided
+// writeDir, (optional) filePrefix and storeKeys.
+func NewStreamingService(writeDir, filePrefix string, storeKeys []types.StoreKey) (*StreamingService, error) {
+	if err := os.MkdirAll(writeDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create streaming directory %s: %w", writeDir, err)
+	}
+
+	memListener := types.NewMemoryListener()
+
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	for _, key := range storeKeys {
+		listeners[key] = []types.WriteListener{memListener}
+	}
+
+	return &StreamingService{
+		listeners:   listeners,
+		memListener: memListener,
+		filePrefix:  filePrefix,
+		writeDir:    writeDir,
+	}, nil
+}
+
+// Listeners satisfies the baseapp.StreamingService interface.
+func (fss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return fss.listeners
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface. It writes
+// the BeginBlock request, the state changes and the response to the
+// {prefix}-block-{height}-begin file.
+func (fss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	fss.currentTxIndex = 0
+
+	return fss.writeFile(fmt.Sprintf("block-%d-begin", ctx.BlockHeight()), &req, &res)
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface. It writes
+// the DeliverTx request, the state changes and the response to the
+// {prefix}-block-{height}-tx-{index} file.
+func (fss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	name := fmt.Sprintf("block-%d-tx-%d", ctx.BlockHeight(), fss.currentTxIndex)
+	fss.currentTxIndex++
+
+	return fss.writeFile(name, &req, &res)
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface. It writes
+// the EndBlock request, the state changes and the response to the
+// {prefix}-block-{height}-end file.
+func (fss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return fss.writeFile(fmt.Sprintf("block-%d-end", ctx.BlockHeight()), &req, &res)
+}
+
+// Close satisfies the io.Closer interface. The files are written as a whole
+// by every hook, so there is nothing to release.
+func (fss *StreamingService) Close() error {
+	return nil
+}
+
+type marshaler interface {
+	Marshal() ([]byte, error)
+}
+
+// writeFile writes the request, the accumulated state changes and the
+// response to the named file. The state changes are drained even if the write
+// fails, so they are never attributed to the following phase.
+func (fss *StreamingService) writeFile(name string, req, res marshaler) error {
+	kvPairs := fss.memListener.PopStateCache()
+
+	buf := new(bytes.Buffer)
+	if err := writeMessage(buf, req); err != nil {
+		return err
+	}
+	for _, kvPair := range kvPairs {
+		if err := writeMessage(buf, kvPair); err != nil {
+			return err
+		}
+	}
+	if err := writeMessage(buf, res); err != nil {
+		return err
+	}
+
+	if fss.filePrefix != "" {
+		name = fmt.Sprintf("%s-%s", fss.filePrefix, name)
+	}
+
+	return ioutil.WriteFile(filepath.Join(fss.writeDir, name), buf.Bytes(), 0600)
+}
+
+func writeMessage(buf *bytes.Buffer, msg marshaler) error {
+	bz, err := msg.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return types.WriteLengthPrefixed(buf, bz)
+}
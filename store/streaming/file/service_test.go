@@ -0,0 +1,96 @@
+package file
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	mockStoreKey1 = sdk.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = sdk.NewKVStoreKey("mockStore2")
+)
+
+// readMessages returns the length-prefixed messages of the named file.
+func readMessages(t *testing.T, path string) [][]byte {
+	bz, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+
+	buf := bytes.NewBuffer(bz)
+	var msgs [][]byte
+	for buf.Len() > 0 {
+		size, err := binary.ReadUvarint(buf)
+		require.NoError(t, err)
+		msgs = append(msgs, buf.Next(int(size)))
+	}
+
+	return msgs
+}
+
+func TestFileStreamingService(t *testing.T) {
+	testDir, err := ioutil.TempDir("", "streaming_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(testDir)
+
+	service, err := NewStreamingService(testDir, "pre", []types.StoreKey{mockStoreKey1, mockStoreKey2})
+	require.NoError(t, err)
+	require.Len(t, service.Listeners(), 2)
+
+	ctx := sdk.NewContext(nil, tmproto.Header{Height: 2}, false, log.NewNopLogger())
+	listener := service.Listeners()[mockStoreKey1][0]
+
+	// begin block
+	require.NoError(t, listener.OnWrite(mockStoreKey1, []byte("k1"), []byte("v1"), false))
+	beginReq := abci.RequestBeginBlock{Header: tmproto.Header{Height: 2}}
+	beginRes := abci.ResponseBeginBlock{Events: []abci.Event{{Type: "begin"}}}
+	require.NoError(t, service.ListenBeginBlock(ctx, beginReq, beginRes))
+
+	// two txs, the second one not writing any state
+	require.NoError(t, listener.OnWrite(mockStoreKey2, []byte("k2"), nil, true))
+	txReq := abci.RequestDeliverTx{Tx: []byte("tx")}
+	txRes := abci.ResponseDeliverTx{Code: 1}
+	require.NoError(t, service.ListenDeliverTx(ctx, txReq, txRes))
+	require.NoError(t, service.ListenDeliverTx(ctx, txReq, txRes))
+
+	// end block
+	endReq := abci.RequestEndBlock{Height: 2}
+	endRes := abci.ResponseEndBlock{}
+	require.NoError(t, service.ListenEndBlock(ctx, endReq, endRes))
+
+	msgs := readMessages(t, filepath.Join(testDir, "pre-block-2-begin"))
+	require.Len(t, msgs, 3)
+	var gotBeginReq abci.RequestBeginBlock
+	require.NoError(t, gotBeginReq.Unmarshal(msgs[0]))
+	require.Equal(t, beginReq.Header.Height, gotBeginReq.Header.Height)
+	var kvPair types.StoreKVPair
+	require.NoError(t, kvPair.Unmarshal(msgs[1]))
+	require.Equal(t, types.StoreKVPair{StoreKey: "mockStore1", Key: []byte("k1"), Value: []byte("v1")}, kvPair)
+	var gotBeginRes abci.ResponseBeginBlock
+	require.NoError(t, gotBeginRes.Unmarshal(msgs[2]))
+	require.Equal(t, beginRes, gotBeginRes)
+
+	msgs = readMessages(t, filepath.Join(testDir, "pre-block-2-tx-0"))
+	require.Len(t, msgs, 3)
+	kvPair = types.StoreKVPair{}
+	require.NoError(t, kvPair.Unmarshal(msgs[1]))
+	require.Equal(t, types.StoreKVPair{StoreKey: "mockStore2", Delete: true, Key: []byte("k2")}, kvPair)
+
+	require.Len(t, readMessages(t, filepath.Join(testDir, "pre-block-2-tx-1")), 2)
+	require.Len(t, readMessages(t, filepath.Join(testDir, "pre-block-2-end")), 2)
+
+	// the tx index restarts with every block
+	require.NoError(t, service.ListenBeginBlock(ctx.WithBlockHeight(3), beginReq, beginRes))
+	require.NoError(t, service.ListenDeliverTx(ctx.WithBlockHeight(3), txReq, txRes))
+	require.FileExists(t, filepath.Join(testDir, "pre-block-3-tx-0"))
+}
@@ -0,0 +1,1693 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/streaming/v1beta1/grpc.proto
+
+package grpc
+
+import (
+	context "context"
+	fmt "fmt"
+	types1 "github.com/cosmos/cosmos-sdk/store/types"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/tendermint/tendermint/abci/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ListenBeginBlockRequest is the request type for the ListenBeginBlock RPC method.
+type ListenBeginBlockRequest struct {
+	Req       *types.RequestBeginBlock  `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
+	Res       *types.ResponseBeginBlock `protobuf:"bytes,2,opt,name=res,proto3" json:"res,omitempty"`
+	ChangeSet []*types1.StoreKVPair     `protobuf:"bytes,3,rep,name=change_set,json=changeSet,proto3" json:"change_set,omitempty"`
+}
+
+func (m *ListenBeginBlockRequest) Reset()         { *m = ListenBeginBlockRequest{} }
+func (m *ListenBeginBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*ListenBeginBlockRequest) ProtoMessage()    {}
+func (*ListenBeginBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_094c5f8f388ea6fc, []int{0}
+}
+func (m *ListenBeginBlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListenBeginBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListenBeginBlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListenBeginBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListenBeginBlockRequest.Merge(m, src)
+}
+func (m *ListenBeginBlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListenBeginBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListenBeginBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListenBeginBlockRequest proto.InternalMessageInfo
+
+func (m *ListenBeginBlockRequest) GetReq() *types.RequestBeginBlock {
+	if m != nil {
+		return m.Req
+	}
+	return nil
+}
+
+func (m *ListenBeginBlockRequest) GetRes() *types.ResponseBeginBlock {
+	if m != nil {
+		return m.Res
+	}
+	return nil
+}
+
+func (m *ListenBeginBlockRequest) GetChangeSet() []*types1.StoreKVPair {
+	if m != nil {
+		return m.ChangeSet
+	}
+	return nil
+}
+
+// ListenBeginBlockResponse is the response type for the ListenBeginBlock RPC method.
+type ListenBeginBlockResponse struct {
+}
+
+func (m *ListenBeginBlockResponse) Reset()         { *m = ListenBeginBlockResponse{} }
+func (m *ListenBeginBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*ListenBeginBlockResponse) ProtoMessage()    {}
+func (*ListenBeginBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_094c5f8f388ea6fc, []int{1}
+}
+func (m *ListenBeginBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListenBeginBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListenBeginBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListenBeginBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListenBeginBlockResponse.Merge(m, src)
+}
+func (m *ListenBeginBlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListenBeginBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListenBeginBlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListenBeginBlockResponse proto.InternalMessageInfo
+
+// ListenDeliverTxRequest is the request type for the ListenDeliverTx RPC method.
+type ListenDeliverTxRequest struct {
+	BlockHeight int64                    `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Req         *types.RequestDeliverTx  `protobuf:"bytes,2,opt,name=req,proto3" json:"req,omitempty"`
+	Res         *types.ResponseDeliverTx `protobuf:"bytes,3,opt,name=res,proto3" json:"res,omitempty"`
+	ChangeSet   []*types1.StoreKVPair    `protobuf:"bytes,4,rep,name=change_set,json=changeSet,proto3" json:"change_set,omitempty"`
+}
+
+func (m *ListenDeliverTxRequest) Reset()         { *m = ListenDeliverTxRequest{} }
+func (m *ListenDeliverTxRequest) String() string { return proto.CompactTextString(m) }
+func (*ListenDeliverTxRequest) ProtoMessage()    {}
+func (*ListenDeliverTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_094c5f8f388ea6fc, []int{2}
+}
+func (m *ListenDeliverTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListenDeliverTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListenDeliverTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListenDeliverTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListenDeliverTxRequest.Merge(m, src)
+}
+func (m *ListenDeliverTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListenDeliverTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListenDeliverTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListenDeliverTxRequest proto.InternalMessageInfo
+
+func (m *ListenDeliverTxRequest) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ListenDeliverTxRequest) GetReq() *types.RequestDeliverTx {
+	if m != nil {
+		return m.Req
+	}
+	return nil
+}
+
+func (m *ListenDeliverTxRequest) GetRes() *types.ResponseDeliverTx {
+	if m != nil {
+		return m.Res
+	}
+	return nil
+}
+
+func (m *ListenDeliverTxRequest) GetChangeSet() []*types1.StoreKVPair {
+	if m != nil {
+		return m.ChangeSet
+	}
+	return nil
+}
+
+// ListenDeliverTxResponse is the response type for the ListenDeliverTx RPC method.
+type ListenDeliverTxResponse struct {
+}
+
+func (m *ListenDeliverTxResponse) Reset()         { *m = ListenDeliverTxResponse{} }
+func (m *ListenDeliverTxResponse) String() string { return proto.CompactTextString(m) }
+func (*ListenDeliverTxResponse) ProtoMessage()    {}
+func (*ListenDeliverTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_094c5f8f388ea6fc, []int{3}
+}
+func (m *ListenDeliverTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListenDeliverTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListenDeliverTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListenDeliverTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListenDeliverTxResponse.Merge(m, src)
+}
+func (m *ListenDeliverTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListenDeliverTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListenDeliverTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListenDeliverTxResponse proto.InternalMessageInfo
+
+// ListenEndBlockRequest is the request type for the ListenEndBlock RPC method.
+type ListenEndBlockRequest struct {
+	BlockHeight int64                   `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Req         *types.RequestEndBlock  `protobuf:"bytes,2,opt,name=req,proto3" json:"req,omitempty"`
+	Res         *types.ResponseEndBlock `protobuf:"bytes,3,opt,name=res,proto3" json:"res,omitempty"`
+	ChangeSet   []*types1.StoreKVPair   `protobuf:"bytes,4,rep,name=change_set,json=changeSet,proto3" json:"change_set,omitempty"`
+}
+
+func (m *ListenEndBlockRequest) Reset()         { *m = ListenEndBlockRequest{} }
+func (m *ListenEndBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*ListenEndBlockRequest) ProtoMessage()    {}
+func (*ListenEndBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_094c5f8f388ea6fc, []int{4}
+}
+func (m *ListenEndBlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListenEndBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListenEndBlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListenEndBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListenEndBlockRequest.Merge(m, src)
+}
+func (m *ListenEndBlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListenEndBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListenEndBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListenEndBlockRequest proto.InternalMessageInfo
+
+func (m *ListenEndBlockRequest) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ListenEndBlockRequest) GetReq() *types.RequestEndBlock {
+	if m != nil {
+		return m.Req
+	}
+	return nil
+}
+
+func (m *ListenEndBlockRequest) GetRes() *types.ResponseEndBlock {
+	if m != nil {
+		return m.Res
+	}
+	return nil
+}
+
+func (m *ListenEndBlockRequest) GetChangeSet() []*types1.StoreKVPair {
+	if m != nil {
+		return m.ChangeSet
+	}
+	return nil
+}
+
+// ListenEndBlockResponse is the response type for the ListenEndBlock RPC method.
+type ListenEndBlockResponse struct {
+}
+
+func (m *ListenEndBlockResponse) Reset()         { *m = ListenEndBlockResponse{} }
+func (m *ListenEndBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*ListenEndBlockResponse) ProtoMessage()    {}
+func (*ListenEndBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_094c5f8f388ea6fc, []int{5}
+}
+func (m *ListenEndBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListenEndBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListenEndBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListenEndBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListenEndBlockResponse.Merge(m, src)
+}
+func (m *ListenEndBlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListenEndBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListenEndBlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListenEndBlockResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ListenBeginBlockRequest)(nil), "cosmos.base.streaming.v1beta1.ListenBeginBlockRequest")
+	proto.RegisterType((*ListenBeginBlockResponse)(nil), "cosmos.base.streaming.v1beta1.ListenBeginBlockResponse")
+	proto.RegisterType((*ListenDeliverTxRequest)(nil), "cosmos.base.streaming.v1beta1.ListenDeliverTxRequest")
+	proto.RegisterType((*ListenDeliverTxResponse)(nil), "cosmos.base.streaming.v1beta1.ListenDeliverTxResponse")
+	proto.RegisterType((*ListenEndBlockRequest)(nil), "cosmos.base.streaming.v1beta1.ListenEndBlockRequest")
+	proto.RegisterType((*ListenEndBlockResponse)(nil), "cosmos.base.streaming.v1beta1.ListenEndBlockResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/streaming/v1beta1/grpc.proto", fileDescriptor_094c5f8f388ea6fc)
+}
+
+var fileDescriptor_094c5f8f388ea6fc = []byte{
+	// 510 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0xaa, 0xd3, 0x40,
+	0x14, 0xc6, 0x3b, 0x46, 0x04, 0xa7, 0xa2, 0x32, 0xa2, 0xc6, 0x88, 0xa1, 0xad, 0x20, 0x75, 0xe1,
+	0x84, 0xfe, 0xbb, 0xae, 0xad, 0x5e, 0x50, 0xae, 0x0b, 0x69, 0xc5, 0x85, 0x9b, 0x4b, 0x92, 0x1e,
+	0xd2, 0xe1, 0xb6, 0x99, 0xde, 0x99, 0xb9, 0x45, 0x17, 0x82, 0xe0, 0x0b, 0xf8, 0x02, 0xbe, 0x8f,
+	0x1b, 0xa1, 0x4b, 0x97, 0xd2, 0xee, 0x04, 0xdf, 0x41, 0x32, 0x93, 0xb6, 0x49, 0x34, 0xd2, 0xca,
+	0x5d, 0x85, 0xcc, 0x7c, 0xdf, 0x39, 0x73, 0x7e, 0x67, 0xe6, 0xe0, 0x66, 0xc8, 0xe5, 0x94, 0x4b,
+	0x2f, 0xf0, 0x25, 0x78, 0x52, 0x09, 0xf0, 0xa7, 0x2c, 0x8e, 0xbc, 0x79, 0x2b, 0x00, 0xe5, 0xb7,
+	0xbc, 0x48, 0xcc, 0x42, 0x3a, 0x13, 0x5c, 0x71, 0x72, 0xcf, 0x28, 0x69, 0xa2, 0xa4, 0x1b, 0x25,
+	0x4d, 0x95, 0xce, 0x5d, 0x05, 0xf1, 0x08, 0xc4, 0x94, 0xc5, 0xca, 0xf3, 0x83, 0x90, 0x79, 0xea,
+	0xfd, 0x0c, 0xa4, 0xf1, 0x3a, 0x0f, 0xf3, 0x59, 0xb8, 0x80, 0x4d, 0x86, 0x09, 0x93, 0x0a, 0xe2,
+	0x24, 0x92, 0x96, 0x36, 0xbe, 0x21, 0x7c, 0xfb, 0xa5, 0x5e, 0xeb, 0x43, 0xc4, 0xe2, 0xfe, 0x84,
+	0x87, 0x27, 0x03, 0x38, 0x3d, 0x03, 0xa9, 0x48, 0x17, 0x5b, 0x02, 0x4e, 0x6d, 0x54, 0x43, 0xcd,
+	0x6a, 0xbb, 0x41, 0xb7, 0x19, 0x69, 0x92, 0x91, 0xa6, 0xb2, 0x8c, 0x2f, 0x91, 0x93, 0x5e, 0xe2,
+	0x92, 0xf6, 0x05, 0xed, 0xba, 0xff, 0x17, 0x97, 0x9c, 0xf1, 0x58, 0x42, 0xde, 0x26, 0xc9, 0x21,
+	0xc6, 0xe1, 0xd8, 0x8f, 0x23, 0x38, 0x96, 0xa0, 0x6c, 0xab, 0x66, 0x35, 0xab, 0xed, 0x07, 0x34,
+	0x0f, 0x81, 0x0b, 0x58, 0x03, 0xa0, 0xc3, 0xe4, 0xef, 0xe8, 0xcd, 0x2b, 0x9f, 0x89, 0xc1, 0x65,
+	0xe3, 0x1c, 0x82, 0x6a, 0x38, 0xd8, 0xfe, 0xb3, 0x1c, 0x93, 0xb1, 0xf1, 0x0b, 0xe1, 0x5b, 0x66,
+	0xf3, 0x19, 0x4c, 0xd8, 0x1c, 0xc4, 0xeb, 0x77, 0xeb, 0x52, 0xeb, 0xf8, 0x4a, 0x90, 0x68, 0x8f,
+	0xc7, 0xc0, 0xa2, 0xb1, 0xd2, 0x35, 0x5b, 0x83, 0xaa, 0x5e, 0x7b, 0xae, 0x97, 0x48, 0xc7, 0xd0,
+	0x30, 0x75, 0xd5, 0xcb, 0x68, 0x6c, 0x23, 0x6b, 0x18, 0x5d, 0x03, 0xc3, 0x2a, 0x45, 0x68, 0x8e,
+	0x96, 0x73, 0x15, 0x59, 0x5c, 0xfc, 0x5f, 0x16, 0x77, 0xd6, 0xad, 0xcd, 0x94, 0x9b, 0xa2, 0xf8,
+	0x89, 0xf0, 0x4d, 0xb3, 0x77, 0x18, 0x8f, 0x72, 0x4d, 0xdf, 0x81, 0x44, 0x3b, 0x4b, 0xa2, 0x56,
+	0x46, 0x62, 0x13, 0x58, 0x83, 0xe8, 0x64, 0x41, 0xd4, 0x4b, 0x41, 0x64, 0x4d, 0xe7, 0xc6, 0xc1,
+	0x5e, 0xb7, 0x7d, 0x5b, 0xab, 0xc9, 0xd6, 0xfe, 0x62, 0xe1, 0x1b, 0x4f, 0xfa, 0x4f, 0x5f, 0x98,
+	0x6d, 0x10, 0x43, 0x10, 0x73, 0x16, 0x02, 0xf9, 0x84, 0xf0, 0xf5, 0xe2, 0x35, 0x22, 0x07, 0xf4,
+	0x9f, 0x4f, 0x92, 0x96, 0x3c, 0x23, 0xe7, 0xf1, 0xde, 0x3e, 0x73, 0x3a, 0xf2, 0x11, 0xe1, 0x6b,
+	0x85, 0x06, 0x92, 0xde, 0x4e, 0xc1, 0x8a, 0xf7, 0xdb, 0x39, 0xd8, 0xd7, 0x96, 0x1e, 0xe1, 0x03,
+	0xbe, 0x9a, 0x47, 0x47, 0xba, 0x3b, 0x45, 0x2a, 0xdc, 0x2a, 0xa7, 0xb7, 0xa7, 0x2b, 0x9d, 0x11,
+	0x47, 0x5f, 0x97, 0x2e, 0x5a, 0x2c, 0x5d, 0xf4, 0x63, 0xe9, 0xa2, 0xcf, 0x2b, 0xb7, 0xb2, 0x58,
+	0xb9, 0x95, 0xef, 0x2b, 0xb7, 0xf2, 0xb6, 0x15, 0x31, 0x35, 0x3e, 0x0b, 0x68, 0xc8, 0xa7, 0x5e,
+	0x3a, 0xed, 0xcc, 0xe7, 0x91, 0x1c, 0x9d, 0xa4, 0x33, 0x6f, 0x3b, 0x5f, 0x93, 0xb9, 0x1a, 0x5c,
+	0xd2, 0x13, 0xaf, 0xf3, 0x7b, 0x00, 0x81, 0xb4, 0xbf, 0x8e, 0x84, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ABCIListenerServiceClient is the client API for ABCIListenerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ABCIListenerServiceClient interface {
+	// ListenBeginBlock receives the BeginBlock request and response along with
+	// the state changes written during BeginBlock.
+	ListenBeginBlock(ctx context.Context, in *ListenBeginBlockRequest, opts ...grpc.CallOption) (*ListenBeginBlockResponse, error)
+	// ListenDeliverTx receives a DeliverTx request and response along with the
+	// state changes written by the transaction.
+	ListenDeliverTx(ctx context.Context, in *ListenDeliverTxRequest, opts ...grpc.CallOption) (*ListenDeliverTxResponse, error)
+	// ListenEndBlock receives the EndBlock request and response along with the
+	// state changes written during EndBlock.
+	ListenEndBlock(ctx context.Context, in *ListenEndBlockRequest, opts ...grpc.CallOption) (*ListenEndBlockResponse, error)
+}
+
+type aBCIListenerServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewABCIListenerServiceClient(cc grpc1.ClientConn) ABCIListenerServiceClient {
+	return &aBCIListenerServiceClient{cc}
+}
+
+func (c *aBCIListenerServiceClient) ListenBeginBlock(ctx context.Context, in *ListenBeginBlockRequest, opts ...grpc.CallOption) (*ListenBeginBlockResponse, error) {
+	out := new(ListenBeginBlockResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.streaming.v1beta1.ABCIListenerService/ListenBeginBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aBCIListenerServiceClient) ListenDeliverTx(ctx context.Context, in *ListenDeliverTxRequest, opts ...grpc.CallOption) (*ListenDeliverTxResponse, error) {
+	out := new(ListenDeliverTxResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.streaming.v1beta1.ABCIListenerService/ListenDeliverTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aBCIListenerServiceClient) ListenEndBlock(ctx context.Context, in *ListenEndBlockRequest, opts ...grpc.CallOption) (*ListenEndBlockResponse, error) {
+	out := new(ListenEndBlockResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.streaming.v1beta1.ABCIListenerService/ListenEndBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ABCIListenerServiceServer is the server API for ABCIListenerService service.
+type ABCIListenerServiceServer interface {
+	// ListenBeginBlock receives the BeginBlock request and response along with
+	// the state changes written during BeginBlock.
+	ListenBeginBlock(context.Context, *ListenBeginBlockRequest) (*ListenBeginBlockResponse, error)
+	// ListenDeliverTx receives a DeliverTx request and response along with the
+	// state changes written by the transaction.
+	ListenDeliverTx(context.Context, *ListenDeliverTxRequest) (*ListenDeliverTxResponse, error)
+	// ListenEndBlock receives the EndBlock request and response along with the
+	// state changes written during EndBlock.
+	ListenEndBlock(context.Context, *ListenEndBlockRequest) (*ListenEndBlockResponse, error)
+}
+
+// UnimplementedABCIListenerServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedABCIListenerServiceServer struct {
+}
+
+func (*UnimplementedABCIListenerServiceServer) ListenBeginBlock(ctx context.Context, req *ListenBeginBlockRequest) (*ListenBeginBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListenBeginBlock not implemented")
+}
+func (*UnimplementedABCIListenerServiceServer) ListenDeliverTx(ctx context.Context, req *ListenDeliverTxRequest) (*ListenDeliverTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListenDeliverTx not implemented")
+}
+func (*UnimplementedABCIListenerServiceServer) ListenEndBlock(ctx context.Context, req *ListenEndBlockRequest) (*ListenEndBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListenEndBlock not implemented")
+}
+
+func RegisterABCIListenerServiceServer(s grpc1.Server, srv ABCIListenerServiceServer) {
+	s.RegisterService(&_ABCIListenerService_serviceDesc, srv)
+}
+
+func _ABCIListenerService_ListenBeginBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListenBeginBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ABCIListenerServiceServer).ListenBeginBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.streaming.v1beta1.ABCIListenerService/ListenBeginBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIListenerServiceServer).ListenBeginBlock(ctx, req.(*ListenBeginBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ABCIListenerService_ListenDeliverTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListenDeliverTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ABCIListenerServiceServer).ListenDeliverTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.streaming.v1beta1.ABCIListenerService/ListenDeliverTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIListenerServiceServer).ListenDeliverTx(ctx, req.(*ListenDeliverTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ABCIListenerService_ListenEndBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListenEndBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ABCIListenerServiceServer).ListenEndBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.streaming.v1beta1.ABCIListenerService/ListenEndBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIListenerServiceServer).ListenEndBlock(ctx, req.(*ListenEndBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ABCIListenerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.streaming.v1beta1.ABCIListenerService",
+	HandlerType: (*ABCIListenerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListenBeginBlock",
+			Handler:    _ABCIListenerService_ListenBeginBlock_Handler,
+		},
+		{
+			MethodName: "ListenDeliverTx",
+			Handler:    _ABCIListenerService_ListenDeliverTx_Handler,
+		},
+		{
+			MethodName: "ListenEndBlock",
+			Handler:    _ABCIListenerService_ListenEndBlock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/streaming/v1beta1/grpc.proto",
+}
+
+func (m *ListenBeginBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListenBeginBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListenBeginBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChangeSet) > 0 {
+		for iNdEx := len(m.ChangeSet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChangeSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Res != nil {
+		{
+			size, err := m.Res.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Req != nil {
+		{
+			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListenBeginBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListenBeginBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListenBeginBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListenDeliverTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListenDeliverTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListenDeliverTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChangeSet) > 0 {
+		for iNdEx := len(m.ChangeSet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChangeSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Res != nil {
+		{
+			size, err := m.Res.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Req != nil {
+		{
+			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGrpc(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListenDeliverTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListenDeliverTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListenDeliverTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListenEndBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListenEndBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListenEndBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChangeSet) > 0 {
+		for iNdEx := len(m.ChangeSet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChangeSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Res != nil {
+		{
+			size, err := m.Res.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Req != nil {
+		{
+			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGrpc(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListenEndBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListenEndBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListenEndBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGrpc(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGrpc(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ListenBeginBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Req != nil {
+		l = m.Req.Size()
+		n += 1 + l + sovGrpc(uint64(l))
+	}
+	if m.Res != nil {
+		l = m.Res.Size()
+		n += 1 + l + sovGrpc(uint64(l))
+	}
+	if len(m.ChangeSet) > 0 {
+		for _, e := range m.ChangeSet {
+			l = e.Size()
+			n += 1 + l + sovGrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListenBeginBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListenDeliverTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovGrpc(uint64(m.BlockHeight))
+	}
+	if m.Req != nil {
+		l = m.Req.Size()
+		n += 1 + l + sovGrpc(uint64(l))
+	}
+	if m.Res != nil {
+		l = m.Res.Size()
+		n += 1 + l + sovGrpc(uint64(l))
+	}
+	if len(m.ChangeSet) > 0 {
+		for _, e := range m.ChangeSet {
+			l = e.Size()
+			n += 1 + l + sovGrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListenDeliverTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListenEndBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovGrpc(uint64(m.BlockHeight))
+	}
+	if m.Req != nil {
+		l = m.Req.Size()
+		n += 1 + l + sovGrpc(uint64(l))
+	}
+	if m.Res != nil {
+		l = m.Res.Size()
+		n += 1 + l + sovGrpc(uint64(l))
+	}
+	if len(m.ChangeSet) > 0 {
+		for _, e := range m.ChangeSet {
+			l = e.Size()
+			n += 1 + l + sovGrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListenEndBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovGrpc(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGrpc(x uint64) (n int) {
+	return sovGrpc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ListenBeginBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListenBeginBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListenBeginBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Req == nil {
+				m.Req = &types.RequestBeginBlock{}
+			}
+			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Res", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Res == nil {
+				m.Res = &types.ResponseBeginBlock{}
+			}
+			if err := m.Res.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangeSet = append(m.ChangeSet, &types1.StoreKVPair{})
+			if err := m.ChangeSet[len(m.ChangeSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListenBeginBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListenBeginBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListenBeginBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListenDeliverTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListenDeliverTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListenDeliverTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Req == nil {
+				m.Req = &types.RequestDeliverTx{}
+			}
+			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Res", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Res == nil {
+				m.Res = &types.ResponseDeliverTx{}
+			}
+			if err := m.Res.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangeSet = append(m.ChangeSet, &types1.StoreKVPair{})
+			if err := m.ChangeSet[len(m.ChangeSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListenDeliverTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListenDeliverTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListenDeliverTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListenEndBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListenEndBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListenEndBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Req == nil {
+				m.Req = &types.RequestEndBlock{}
+			}
+			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Res", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Res == nil {
+				m.Res = &types.ResponseEndBlock{}
+			}
+			if err := m.Res.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangeSet = append(m.ChangeSet, &types1.StoreKVPair{})
+			if err := m.ChangeSet[len(m.ChangeSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListenEndBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListenEndBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListenEndBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGrpc(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGrpc
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGrpc
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGrpc
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGrpc
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGrpc        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGrpc          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGrpc = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,96 @@
+package grpc
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// StreamingService is a concrete implementation of baseapp.StreamingService
+// that forwards the state changes to an external plugin implementing the
+// ABCIListenerService gRPC service. The node acts as the gRPC client and
+// blocks on every call, so a plugin receives the phases of each block in
+// order.
+type StreamingService struct {
+	listeners   map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
+	memListener *types.MemoryListener                    // the listener accumulating the state changes of the current phase
+
+	conn   *grpc.ClientConn
+	client ABCIListenerServiceClient
+}
+
+// NewStreamingService creates a new StreamingService streaming the writes of
+// the provided storeKeys to the plugin listening at address.
+func NewStreamingService(address string, storeKeys []types.StoreKey) (*StreamingService, error) {
+	conn, err := grpc.Dial(address, grpc.WithInsecure())
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial streaming plugin at %s: %w", address, err)
+	}
+
+	memListener := types.NewMemoryListener()
+
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	for _, key := range storeKeys {
+		listeners[key] = []types.WriteListener{memListener}
+	}
+
+	return &StreamingService{
+		listeners:   listeners,
+		memListener: memListener,
+		conn:        conn,
+		client:      NewABCIListenerServiceClient(conn),
+	}, nil
+}
+
+// Listeners satisfies the baseapp.StreamingService interface.
+func (gss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return gss.listeners
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface.
+func (gss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	_, err := gss.client.ListenBeginBlock(ctx.Context(), &ListenBeginBlockRequest{
+		Req:       &req,
+		Res:       &res,
+		ChangeSet: gss.memListener.PopStateCache(),
+	})
+
+	return err
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface.
+func (gss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	_, err := gss.client.ListenDeliverTx(ctx.Context(), &ListenDeliverTxRequest{
+		BlockHeight: ctx.BlockHeight(),
+		Req:         &req,
+		Res:         &res,
+		ChangeSet:   gss.memListener.PopStateCache(),
+	})
+
+	return err
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface.
+func (gss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	_, err := gss.client.ListenEndBlock(ctx.Context(), &ListenEndBlockRequest{
+		BlockHeight: ctx.BlockHeight(),
+		Req:         &req,
+		Res:         &res,
+		ChangeSet:   gss.memListener.PopStateCache(),
+	})
+
+	return err
+}
+
+// Close satisfies the io.Closer interface, closing the connection to the
+// plugin.
+func (gss *StreamingService) Close() error {
+	return gss.conn.Close()
+}
@@ -0,0 +1,81 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var mockStoreKey = sdk.NewKVStoreKey("mockStore")
+
+// mockPlugin records the requests received by the ABCIListenerService.
+type mockPlugin struct {
+	beginBlocks []*ListenBeginBlockRequest
+	deliverTxs  []*ListenDeliverTxRequest
+	endBlocks   []*ListenEndBlockRequest
+}
+
+func (p *mockPlugin) ListenBeginBlock(_ context.Context, req *ListenBeginBlockRequest) (*ListenBeginBlockResponse, error) {
+	p.beginBlocks = append(p.beginBlocks, req)
+	return &ListenBeginBlockResponse{}, nil
+}
+
+func (p *mockPlugin) ListenDeliverTx(_ context.Context, req *ListenDeliverTxRequest) (*ListenDeliverTxResponse, error) {
+	p.deliverTxs = append(p.deliverTxs, req)
+	return &ListenDeliverTxResponse{}, nil
+}
+
+func (p *mockPlugin) ListenEndBlock(_ context.Context, req *ListenEndBlockRequest) (*ListenEndBlockResponse, error) {
+	p.endBlocks = append(p.endBlocks, req)
+	return &ListenEndBlockResponse{}, nil
+}
+
+func TestGRPCStreamingService(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	plugin := &mockPlugin{}
+	server := grpc.NewServer()
+	RegisterABCIListenerServiceServer(server, plugin)
+	go server.Serve(lis)
+	defer server.Stop()
+
+	service, err := NewStreamingService(lis.Addr().String(), []types.StoreKey{mockStoreKey})
+	require.NoError(t, err)
+	defer service.Close()
+
+	ctx := sdk.NewContext(nil, tmproto.Header{Height: 5}, false, log.NewNopLogger())
+	listener := service.Listeners()[mockStoreKey][0]
+
+	require.NoError(t, listener.OnWrite(mockStoreKey, []byte("k1"), []byte("v1"), false))
+	require.NoError(t, service.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: 5}}, abci.ResponseBeginBlock{}))
+
+	require.NoError(t, listener.OnWrite(mockStoreKey, []byte("k1"), nil, true))
+	require.NoError(t, service.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: []byte("tx")}, abci.ResponseDeliverTx{}))
+	require.NoError(t, service.ListenEndBlock(ctx, abci.RequestEndBlock{Height: 5}, abci.ResponseEndBlock{}))
+
+	require.Len(t, plugin.beginBlocks, 1)
+	require.Equal(t, int64(5), plugin.beginBlocks[0].Req.Header.Height)
+	require.Equal(t, []*types.StoreKVPair{
+		{StoreKey: "mockStore", Key: []byte("k1"), Value: []byte("v1")},
+	}, plugin.beginBlocks[0].ChangeSet)
+
+	require.Len(t, plugin.deliverTxs, 1)
+	require.Equal(t, int64(5), plugin.deliverTxs[0].BlockHeight)
+	require.Equal(t, []byte("tx"), plugin.deliverTxs[0].Req.Tx)
+	require.Equal(t, []*types.StoreKVPair{
+		{StoreKey: "mockStore", Delete: true, Key: []byte("k1")},
+	}, plugin.deliverTxs[0].ChangeSet)
+
+	require.Len(t, plugin.endBlocks, 1)
+	require.Empty(t, plugin.endBlocks[0].ChangeSet)
+}
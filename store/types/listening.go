@@ -0,0 +1,90 @@
+package types
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// WriteListener interface for streaming data out from a listenkv.Store
+type WriteListener interface {
+	// if value is nil then it was deleted
+	// storeKey indicates the source KVStore, to facilitate using the same WriteListener across separate KVStores
+	// delete bool indicates if it was a delete; true: delete, false: set
+	OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error
+}
+
+// StoreKVPairWriteListener is used to configure listening to a KVStore by
+// writing out length-prefixed protobuf encoded StoreKVPairs to an underlying
+// io.Writer object.
+type StoreKVPairWriteListener struct {
+	writer io.Writer
+}
+
+// NewStoreKVPairWriteListener creates a StoreKVPairWriteListener with a
+// provided io.Writer.
+func NewStoreKVPairWriteListener(w io.Writer) *StoreKVPairWriteListener {
+	return &StoreKVPairWriteListener{writer: w}
+}
+
+// OnWrite satisfies the WriteListener interface by writing length-prefixed
+// protobuf encoded StoreKVPairs.
+func (wl *StoreKVPairWriteListener) OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error {
+	kvPair := &StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	}
+
+	bz, err := kvPair.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return WriteLengthPrefixed(wl.writer, bz)
+}
+
+// WriteLengthPrefixed writes bz to w prefixed with its uvarint encoded length.
+func WriteLengthPrefixed(w io.Writer, bz []byte) error {
+	prefix := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(prefix, uint64(len(bz)))
+
+	if _, err := w.Write(prefix[:n]); err != nil {
+		return err
+	}
+
+	_, err := w.Write(bz)
+	return err
+}
+
+// MemoryListener listens to the state writes and accumulates the records in
+// memory, so they can be drained by a streaming service at the end of each
+// ABCI phase.
+type MemoryListener struct {
+	stateCache []*StoreKVPair
+}
+
+// NewMemoryListener creates a listener that accumulates the state writes in
+// memory.
+func NewMemoryListener() *MemoryListener {
+	return &MemoryListener{}
+}
+
+// OnWrite implements the WriteListener interface.
+func (fl *MemoryListener) OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error {
+	fl.stateCache = append(fl.stateCache, &StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	})
+
+	return nil
+}
+
+// PopStateCache returns the current state cache and resets it.
+func (fl *MemoryListener) PopStateCache() []*StoreKVPair {
+	res := fl.stateCache
+	fl.stateCache = nil
+	return res
+}
@@ -30,8 +30,7 @@ package module
 
 import (
 	"encoding/json"
-
-	"github.com/gogo/protobuf/grpc"
+	"fmt"
 
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
@@ -41,6 +40,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 //__________________________________________________________________________________________
@@ -157,12 +157,19 @@ type AppModule interface {
 
 	// routes
 	Route() sdk.Route
-	// Deprecated: use RegisterQueryService
+	// Deprecated: use RegisterServices
 	QuerierRoute() string
-	// Deprecated: use RegisterQueryService
+	// Deprecated: use RegisterServices
 	LegacyQuerierHandler(codec.JSONMarshaler) sdk.Querier
-	// RegisterQueryService allows a module to register a gRPC query service
-	RegisterQueryService(grpc.Server)
+	// RegisterServices allows a module to register its Msg and gRPC query
+	// services
+	RegisterServices(Configurator)
+
+	// ConsensusVersion is a sequence number for state-breaking changes of the
+	// module. It should be incremented on each consensus-breaking change
+	// introduced by the module, along with the registration of the matching
+	// in-place store migration. The initial version is 1.
+	ConsensusVersion() uint64
 
 	// ABCI
 	BeginBlock(sdk.Context, abci.RequestBeginBlock)
@@ -195,8 +202,11 @@ func (GenesisOnlyAppModule) QuerierRoute() string { return "" }
 // LegacyQuerierHandler returns an empty module querier
 func (gam GenesisOnlyAppModule) LegacyQuerierHandler(codec.JSONMarshaler) sdk.Querier { return nil }
 
-// RegisterQueryService registers all gRPC query services.
-func (gam GenesisOnlyAppModule) RegisterQueryService(grpc.Server) {}
+// RegisterServices registers all services.
+func (gam GenesisOnlyAppModule) RegisterServices(Configurator) {}
+
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (gam GenesisOnlyAppModule) ConsensusVersion() uint64 { return 1 }
 
 // BeginBlock returns an empty module begin-block
 func (gam GenesisOnlyAppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {}
@@ -216,6 +226,7 @@ type Manager struct {
 	OrderExportGenesis []string
 	OrderBeginBlockers []string
 	OrderEndBlockers   []string
+	OrderMigrations    []string
 }
 
 // NewManager creates a new Manager object
@@ -234,6 +245,7 @@ func NewManager(modules ...AppModule) *Manager {
 		OrderExportGenesis: modulesStr,
 		OrderBeginBlockers: modulesStr,
 		OrderEndBlockers:   modulesStr,
+		OrderMigrations:    modulesStr,
 	}
 }
 
@@ -257,6 +269,23 @@ func (m *Manager) SetOrderEndBlockers(moduleNames ...string) {
 	m.OrderEndBlockers = moduleNames
 }
 
+// SetOrderMigrations sets the order of in-place store migrations and of the
+// initialization of the modules added by an upgrade. It must contain all the
+// modules of the manager.
+func (m *Manager) SetOrderMigrations(moduleNames ...string) {
+	if len(moduleNames) != len(m.Modules) {
+		panic(fmt.Sprintf("the migrations order must contain all %d modules, got %d", len(m.Modules), len(moduleNames)))
+	}
+
+	for _, moduleName := range moduleNames {
+		if _, ok := m.Modules[moduleName]; !ok {
+			panic(fmt.Sprintf("unknown module in the migrations order: %s", moduleName))
+		}
+	}
+
+	m.OrderMigrations = moduleNames
+}
+
 // RegisterInvariants registers all module routes and module querier routes
 func (m *Manager) RegisterInvariants(ir sdk.InvariantRegistry) {
 	for _, module := range m.Modules {
@@ -276,10 +305,10 @@ func (m *Manager) RegisterRoutes(router sdk.Router, queryRouter sdk.QueryRouter,
 	}
 }
 
-// RegisterQueryServices registers all module query services
-func (m *Manager) RegisterQueryServices(grpcRouter grpc.Server) {
+// RegisterServices registers all module Msg and query services
+func (m *Manager) RegisterServices(cfg Configurator) {
 	for _, module := range m.Modules {
-		module.RegisterQueryService(grpcRouter)
+		module.RegisterServices(cfg)
 	}
 }
 
@@ -359,3 +388,79 @@ func (m *Manager) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) abci.Respo
 		Events:           ctx.EventManager().ABCIEvents(),
 	}
 }
+
+// MigrationHandler is the migration function that each module registers.
+type MigrationHandler func(sdk.Context) error
+
+// VersionMap is a map of moduleName -> version, where version denotes the
+// version from which we should perform the migration for each module.
+type VersionMap map[string]uint64
+
+// RunMigrations performs in-place store migrations for all modules. This
+// function MUST be called inside an x/upgrade UpgradeHandler.
+//
+// Recall that in an upgrade handler, the `fromVM` VersionMap is retrieved from
+// x/upgrade's store, and the function needs to return the target VersionMap
+// that will in turn be persisted to the x/upgrade's store. In general,
+// returning RunMigrations should be enough:
+//
+// Example:
+//
+//	cfg := module.NewConfigurator(...)
+//	app.UpgradeKeeper.SetUpgradeHandler("my-plan", func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+//		return app.mm.RunMigrations(ctx, cfg, fromVM)
+//	})
+//
+// Internally, RunMigrations will perform the following steps, for each module
+// in OrderMigrations:
+//   - if the module's `fromVM` version is less than its update `toVM` version,
+//     then run in-place store migrations for that module between those versions.
+//   - if the module does not exist in the `fromVM` (which means that it's a new
+//     module, because it was not in the previous x/upgrade's store), then run
+//     `InitGenesis` on that module with its default genesis state.
+//
+// The x/upgrade store of a chain started before module versions were
+// introduced holds no version map. The first upgrade handler of such a chain
+// must therefore build `fromVM` itself, setting the version of its existing
+// modules to 1 and leaving out the modules added by the upgrade.
+func (m Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM VersionMap) (VersionMap, error) {
+	c, ok := cfg.(configurator)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", configurator{}, cfg)
+	}
+
+	updatedVM := make(VersionMap)
+	for _, moduleName := range m.OrderMigrations {
+		module := m.Modules[moduleName]
+		fromVersion, exists := fromVM[moduleName]
+		toVersion := module.ConsensusVersion()
+
+		if exists {
+			if err := c.runModuleMigrations(ctx, moduleName, fromVersion, toVersion); err != nil {
+				return nil, err
+			}
+		} else {
+			ctx.Logger().Info(fmt.Sprintf("adding a new module: %s", moduleName))
+			moduleValUpdates := module.InitGenesis(ctx, c.cdc, module.DefaultGenesis(c.cdc))
+			// The module manager assumes only one module will update the
+			// validator set, and that it will not be by a new module.
+			if len(moduleValUpdates) > 0 {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "validator InitGenesis updates of new module %s are not supported", moduleName)
+			}
+		}
+
+		updatedVM[moduleName] = toVersion
+	}
+
+	return updatedVM, nil
+}
+
+// GetVersionMap gets consensus version from all modules
+func (m *Manager) GetVersionMap() VersionMap {
+	vermap := make(VersionMap)
+	for _, v := range m.Modules {
+		vermap[v.Name()] = v.ConsensusVersion()
+	}
+
+	return vermap
+}
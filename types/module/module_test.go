@@ -12,6 +12,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -168,7 +169,7 @@ func TestManager_RegisterRoutes(t *testing.T) {
 	mm.RegisterRoutes(router, queryRouter, jsonCdc)
 }
 
-func TestManager_RegisterQueryServices(t *testing.T) {
+func TestManager_RegisterServices(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
 
@@ -180,11 +181,72 @@ func TestManager_RegisterQueryServices(t *testing.T) {
 	require.NotNil(t, mm)
 	require.Equal(t, 2, len(mm.Modules))
 
+	msgRouter := mocks.NewMockServer(mockCtrl)
 	queryRouter := mocks.NewMockServer(mockCtrl)
-	mockAppModule1.EXPECT().RegisterQueryService(queryRouter).Times(1)
-	mockAppModule2.EXPECT().RegisterQueryService(queryRouter).Times(1)
+	cfg := module.NewConfigurator(codec.NewAminoCodec(codec.New()), msgRouter, queryRouter)
+	mockAppModule1.EXPECT().RegisterServices(cfg).Times(1)
+	mockAppModule2.EXPECT().RegisterServices(cfg).Times(1)
 
-	mm.RegisterQueryServices(queryRouter)
+	mm.RegisterServices(cfg)
+}
+
+func TestConfigurator_RegisterMigration(t *testing.T) {
+	cfg := module.NewConfigurator(codec.NewAminoCodec(codec.New()), nil, nil)
+	noop := func(sdk.Context) error { return nil }
+
+	require.Error(t, cfg.RegisterMigration("module1", 0, noop))
+	require.NoError(t, cfg.RegisterMigration("module1", 1, noop))
+	require.Error(t, cfg.RegisterMigration("module1", 1, noop))
+	require.NoError(t, cfg.RegisterMigration("module2", 1, noop))
+}
+
+func TestManager_RunMigrations(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+	require.NotNil(t, mm)
+
+	cdc := codec.NewAminoCodec(codec.New())
+	ctx := sdk.Context{}.WithLogger(log.NewNopLogger())
+	cfg := module.NewConfigurator(cdc, nil, nil)
+
+	var migrated []uint64
+	for _, version := range []uint64{1, 2} {
+		version := version
+		require.NoError(t, cfg.RegisterMigration("module1", version, func(sdk.Context) error {
+			migrated = append(migrated, version)
+			return nil
+		}))
+	}
+
+	// module1 is migrated from version 1 to 3, module2 is a new module
+	mockAppModule1.EXPECT().ConsensusVersion().Times(1).Return(uint64(3))
+	mockAppModule2.EXPECT().ConsensusVersion().Times(1).Return(uint64(1))
+	mockAppModule2.EXPECT().DefaultGenesis(gomock.Eq(cdc)).Times(1).Return(json.RawMessage(`{}`))
+	mockAppModule2.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(json.RawMessage(`{}`))).Times(1).Return(nil)
+
+	vm, err := mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 1})
+	require.NoError(t, err)
+	require.Equal(t, module.VersionMap{"module1": 3, "module2": 1}, vm)
+	require.Equal(t, []uint64{1, 2}, migrated)
+
+	// a missing migration is an error
+	mockAppModule1.EXPECT().ConsensusVersion().Times(1).Return(uint64(4))
+	_, err = mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 3, "module2": 1})
+	require.Error(t, err)
+
+	// a new module updating the validator set is an error
+	mockAppModule1.EXPECT().ConsensusVersion().Times(1).Return(uint64(3))
+	mockAppModule2.EXPECT().ConsensusVersion().Times(1).Return(uint64(1))
+	mockAppModule2.EXPECT().DefaultGenesis(gomock.Eq(cdc)).Times(1).Return(json.RawMessage(`{}`))
+	mockAppModule2.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(json.RawMessage(`{}`))).Times(1).Return([]abci.ValidatorUpdate{{}})
+	_, err = mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 3})
+	require.Error(t, err)
 }
 
 func TestManager_InitGenesis(t *testing.T) {
@@ -20,6 +20,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 const (
@@ -200,11 +201,11 @@ func setupTest() (*simapp.SimApp, sdk.Context, codec.Marshaler) {
 	maccPerms[randomPerm] = []string{"random"}
 	app.AccountKeeper = authkeeper.NewAccountKeeper(
 		appCodec, app.GetKey(authtypes.StoreKey), app.GetSubspace(authtypes.ModuleName),
-		authtypes.ProtoBaseAccount, maccPerms,
+		authtypes.ProtoBaseAccount, maccPerms, authtypes.NewModuleAddress(govtypes.ModuleName),
 	)
 	app.BankKeeper = bankkeeper.NewBaseKeeper(
 		appCodec, app.GetKey(authtypes.StoreKey), app.AccountKeeper,
-		app.GetSubspace(types.ModuleName), make(map[string]bool),
+		app.GetSubspace(types.ModuleName), make(map[string]bool), authtypes.NewModuleAddress(govtypes.ModuleName),
 	)
 
 	return app, ctx, appCodec
@@ -7,8 +7,10 @@ import (
 	"math"
 	"strings"
 
+	"github.com/gogo/protobuf/proto"
 	yaml "gopkg.in/yaml.v2"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -36,6 +38,33 @@ func (r Result) GetEvents() Events {
 	return events
 }
 
+// WrapServiceResult wraps a result from a protobuf Msg service method call into
+// a Result, proto marshaling the response into the Result data and collecting
+// the events emitted on the context's event manager.
+func WrapServiceResult(ctx Context, res proto.Message, err error) (*Result, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	if res != nil {
+		data, err = proto.Marshal(res)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var events []abci.Event
+	if evtMgr := ctx.EventManager(); evtMgr != nil {
+		events = evtMgr.ABCIEvents()
+	}
+
+	return &Result{
+		Data:   data,
+		Events: events,
+	}, nil
+}
+
 // ABCIMessageLogs represents a slice of ABCIMessageLog.
 type ABCIMessageLogs []ABCIMessageLog
 
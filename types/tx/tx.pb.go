@@ -658,6 +658,10 @@ type Fee struct {
 	// gas_limit is the maximum gas that can be used in transaction processing
 	// before an out of gas error occurs
 	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// granter is the address of the account paying the fees through a fee
+	// allowance granted in x/feegrant. If empty, the fees are paid by the first
+	// signer.
+	Granter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=granter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"granter,omitempty"`
 }
 
 func (m *Fee) Reset()         { *m = Fee{} }
@@ -707,6 +711,13 @@ func (m *Fee) GetGasLimit() uint64 {
 	return 0
 }
 
+func (m *Fee) GetGranter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Granter
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Tx)(nil), "cosmos.tx.v1beta1.Tx")
 	proto.RegisterType((*TxRaw)(nil), "cosmos.tx.v1beta1.TxRaw")
@@ -723,59 +734,61 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/tx.proto", fileDescriptor_96d1575ffde80842) }
 
 var fileDescriptor_96d1575ffde80842 = []byte{
-	// 830 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0x5f, 0xef, 0xbf, 0xec, 0xbe, 0x24, 0x4d, 0x3b, 0xaa, 0xd0, 0x66, 0xa3, 0xba, 0xc1, 0x28,
-	0xb0, 0x08, 0xd5, 0x6e, 0xc3, 0x81, 0x3f, 0x42, 0x82, 0x6c, 0x4b, 0x49, 0x05, 0x05, 0x34, 0xc9,
-	0xa9, 0x17, 0x6b, 0xec, 0x9d, 0x78, 0x47, 0x5d, 0xcf, 0x2c, 0x9e, 0x71, 0xb1, 0x3f, 0x01, 0x97,
-	0x22, 0x71, 0xe1, 0x4b, 0xf0, 0x1d, 0xb8, 0xf7, 0xd8, 0x23, 0x07, 0x04, 0x28, 0xf9, 0x20, 0xa0,
-	0x19, 0x8f, 0xdd, 0x50, 0xa2, 0xdd, 0x0b, 0xa7, 0x7d, 0xf3, 0xe6, 0xf7, 0xfb, 0xbd, 0xdf, 0xbe,
-	0x37, 0xcf, 0x30, 0x8e, 0x85, 0x4c, 0x85, 0x0c, 0x54, 0x11, 0x3c, 0xbb, 0x17, 0x51, 0x45, 0xee,
-	0x05, 0xaa, 0xf0, 0x97, 0x99, 0x50, 0x02, 0xdd, 0xa8, 0xee, 0x7c, 0x55, 0xf8, 0xf6, 0x6e, 0x7c,
-	0x33, 0x11, 0x89, 0x30, 0xb7, 0x81, 0x8e, 0x2a, 0xe0, 0xf8, 0x1d, 0x2b, 0x12, 0x11, 0x49, 0x83,
-	0x38, 0x2b, 0x97, 0x4a, 0x34, 0x6a, 0xd5, 0xd1, 0x02, 0xdd, 0xcb, 0xc0, 0x06, 0x21, 0x18, 0x7f,
-	0x4d, 0x48, 0x15, 0x81, 0x64, 0x09, 0x67, 0x3c, 0x69, 0x50, 0xf6, 0x6c, 0x81, 0xbb, 0x89, 0x10,
-	0xc9, 0x82, 0x06, 0xe6, 0x14, 0xe5, 0x67, 0x01, 0xe1, 0x65, 0x75, 0xe5, 0xfd, 0xe8, 0x40, 0xfb,
-	0xb4, 0x40, 0x77, 0xa0, 0x1b, 0x89, 0x59, 0x39, 0x72, 0xf6, 0x9d, 0xc9, 0xe6, 0xe1, 0xae, 0xff,
-	0x9f, 0xff, 0xe2, 0x9f, 0x16, 0x53, 0x31, 0x2b, 0xb1, 0x81, 0xa1, 0x0f, 0x61, 0x48, 0x72, 0x35,
-	0x0f, 0x19, 0x3f, 0x13, 0xa3, 0xb6, 0xe1, 0xec, 0x5d, 0xc1, 0x39, 0xca, 0xd5, 0xfc, 0x11, 0x3f,
-	0x13, 0x78, 0x40, 0x6c, 0x84, 0x5c, 0x00, 0xed, 0x8d, 0xa8, 0x3c, 0xa3, 0x72, 0xd4, 0xd9, 0xef,
-	0x4c, 0xb6, 0xf0, 0xa5, 0x8c, 0xc7, 0xa1, 0x77, 0x5a, 0x60, 0xf2, 0x3d, 0xba, 0x05, 0xa0, 0x4b,
-	0x85, 0x51, 0xa9, 0xa8, 0x34, 0xbe, 0xb6, 0xf0, 0x50, 0x67, 0xa6, 0x3a, 0x81, 0xde, 0x86, 0x9d,
-	0xc6, 0x81, 0xc5, 0xb4, 0x0d, 0x66, 0xbb, 0x2e, 0x55, 0xe1, 0xd6, 0xd5, 0xfb, 0xd5, 0x81, 0x8d,
-	0x13, 0x96, 0xf0, 0x07, 0x22, 0xfe, 0xbf, 0x4a, 0xee, 0xc2, 0x20, 0x9e, 0x13, 0xc6, 0x43, 0x36,
-	0x1b, 0x75, 0xf6, 0x9d, 0xc9, 0x10, 0x6f, 0x98, 0xf3, 0xa3, 0x19, 0x3a, 0x80, 0x6b, 0x24, 0x8e,
-	0x45, 0xce, 0x55, 0xc8, 0xf3, 0x34, 0xa2, 0xd9, 0xa8, 0xbb, 0xef, 0x4c, 0xba, 0x78, 0xdb, 0x66,
-	0xbf, 0x36, 0x49, 0xf4, 0x2e, 0x5c, 0xaf, 0x61, 0x92, 0x7e, 0x97, 0x53, 0x1e, 0xd3, 0x51, 0xcf,
-	0x00, 0x77, 0x6c, 0xfe, 0xc4, 0xa6, 0xbd, 0x9f, 0xdb, 0xd0, 0xaf, 0x46, 0x83, 0xee, 0xc2, 0x20,
-	0xa5, 0x52, 0x92, 0xc4, 0x98, 0xef, 0x4c, 0x36, 0x0f, 0x6f, 0xfa, 0xd5, 0xe0, 0xfd, 0x7a, 0xf0,
-	0xfe, 0x11, 0x2f, 0x71, 0x83, 0x42, 0x08, 0xba, 0x29, 0x4d, 0xab, 0x09, 0x0e, 0xb1, 0x89, 0xb5,
-	0x45, 0xc5, 0x52, 0x2a, 0x72, 0x15, 0xce, 0x29, 0x4b, 0xe6, 0xca, 0xfc, 0x87, 0x2e, 0xde, 0xb6,
-	0xd9, 0x63, 0x93, 0x44, 0x53, 0xb8, 0x41, 0x0b, 0x45, 0xb9, 0x64, 0x82, 0x87, 0x62, 0xa9, 0x98,
-	0xe0, 0x72, 0xf4, 0xf7, 0xc6, 0x8a, 0xb2, 0xd7, 0x1b, 0xfc, 0x37, 0x15, 0x1c, 0x3d, 0x01, 0x97,
-	0x0b, 0x1e, 0xc6, 0x19, 0x53, 0x2c, 0x26, 0x8b, 0xf0, 0x0a, 0xc1, 0x9d, 0x15, 0x82, 0x7b, 0x5c,
-	0xf0, 0xfb, 0x96, 0xfb, 0xf9, 0x6b, 0xda, 0xde, 0x33, 0x18, 0xd4, 0xaf, 0x0f, 0x7d, 0x06, 0x5b,
-	0x7a, 0xe2, 0x34, 0x33, 0xa3, 0xab, 0x9b, 0x73, 0xeb, 0x8a, 0x07, 0x7b, 0x62, 0x60, 0xe6, 0xc9,
-	0x6e, 0xca, 0x26, 0x96, 0x68, 0x02, 0x9d, 0x33, 0x4a, 0xed, 0x4b, 0x7f, 0xe3, 0x0a, 0xe2, 0x43,
-	0x4a, 0xb1, 0x86, 0x78, 0xcf, 0x1d, 0x80, 0x57, 0x2a, 0xe8, 0x01, 0xc0, 0x32, 0x8f, 0x16, 0x2c,
-	0x0e, 0x9f, 0xd2, 0x7a, 0xbb, 0x0e, 0x6a, 0xbe, 0xde, 0x6b, 0xdf, 0x6e, 0x7c, 0x2d, 0xf4, 0xad,
-	0x41, 0x7f, 0x49, 0x4b, 0x3c, 0x5c, 0xd6, 0xa1, 0x5e, 0xb7, 0x54, 0xcc, 0xe8, 0xba, 0x75, 0x7b,
-	0x2c, 0x66, 0xb4, 0x5a, 0xb7, 0xd4, 0x46, 0xde, 0xef, 0x6d, 0x18, 0xd4, 0x69, 0xf4, 0x09, 0xf4,
-	0x25, 0xe3, 0xc9, 0x82, 0x5a, 0x23, 0xde, 0x0a, 0x0d, 0xff, 0xc4, 0x20, 0x8f, 0x5b, 0xd8, 0x72,
-	0xd0, 0x47, 0xd0, 0x4b, 0xf3, 0x85, 0x62, 0xd6, 0xc0, 0x9b, 0xab, 0xc8, 0x8f, 0x35, 0xf0, 0xb8,
-	0x85, 0x2b, 0xc6, 0xf8, 0x08, 0xfa, 0x95, 0x1c, 0xfa, 0x00, 0xba, 0xda, 0x9b, 0x31, 0x70, 0xed,
-	0xf0, 0xad, 0x4b, 0x1a, 0xf5, 0x17, 0xeb, 0xf2, 0x28, 0xb4, 0x1e, 0x36, 0x84, 0xf1, 0x73, 0x07,
-	0x7a, 0x46, 0x15, 0x7d, 0x01, 0x83, 0x88, 0x29, 0x92, 0x65, 0xa4, 0x6e, 0xe8, 0x7b, 0xab, 0x1a,
-	0x7a, 0x5f, 0xa4, 0x4b, 0x12, 0xab, 0x29, 0x53, 0x47, 0x9a, 0x82, 0x1b, 0x32, 0xfa, 0x18, 0xa0,
-	0xe9, 0xaa, 0x5e, 0xe5, 0xce, 0xba, 0xb6, 0x0e, 0xeb, 0xb6, 0xca, 0x69, 0x0f, 0x3a, 0x32, 0x4f,
-	0xbd, 0x1f, 0x1c, 0xe8, 0x3c, 0xa4, 0x14, 0xc5, 0xd0, 0x27, 0xa9, 0xde, 0x4b, 0xfb, 0xb6, 0x76,
-	0xff, 0xe5, 0xe8, 0x95, 0x15, 0xc6, 0xa7, 0x77, 0x5f, 0xfc, 0x71, 0xbb, 0xf5, 0xcb, 0x9f, 0xb7,
-	0x27, 0x09, 0x53, 0xf3, 0x3c, 0xf2, 0x63, 0x91, 0x06, 0xf6, 0x3b, 0x5e, 0xfd, 0xdc, 0x91, 0xb3,
-	0xa7, 0x81, 0x2a, 0x97, 0x54, 0x1a, 0x82, 0xc4, 0x56, 0x1a, 0xed, 0xc1, 0x30, 0x21, 0x32, 0x5c,
-	0xb0, 0x94, 0x29, 0x33, 0x84, 0x2e, 0x1e, 0x24, 0x44, 0x7e, 0xa5, 0xcf, 0xd3, 0x4f, 0x5f, 0x9c,
-	0xbb, 0xce, 0xcb, 0x73, 0xd7, 0xf9, 0xeb, 0xdc, 0x75, 0x7e, 0xba, 0x70, 0x5b, 0x2f, 0x2f, 0xdc,
-	0xd6, 0x6f, 0x17, 0x6e, 0xeb, 0xc9, 0xc1, 0xfa, 0x42, 0x81, 0x2a, 0xa2, 0xbe, 0xd9, 0xad, 0xf7,
-	0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x43, 0xc7, 0xe9, 0x47, 0xe3, 0x06, 0x00, 0x00,
+	// 859 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xfa, 0x5f, 0xec, 0xd7, 0xa4, 0x69, 0x47, 0x15, 0x72, 0x1c, 0x75, 0x13, 0x8c, 0x02,
+	0x46, 0x28, 0xbb, 0x4d, 0x38, 0xf0, 0x47, 0x48, 0x60, 0xb7, 0x94, 0x54, 0xa5, 0x80, 0x26, 0x39,
+	0xf5, 0xb2, 0x9a, 0xdd, 0x9d, 0xac, 0x47, 0xf5, 0xce, 0x98, 0x9d, 0xd9, 0xb2, 0xfe, 0x0e, 0x45,
+	0xe2, 0xc2, 0x97, 0xe0, 0x3b, 0x70, 0xef, 0xb1, 0x07, 0x0e, 0x1c, 0x50, 0x41, 0xc9, 0xb7, 0xe0,
+	0x02, 0x9a, 0xd9, 0xd9, 0x6d, 0x28, 0x91, 0xc3, 0x81, 0x93, 0xdf, 0xbc, 0xf9, 0xfd, 0x7e, 0xef,
+	0xe7, 0xf7, 0xe6, 0x2d, 0x0c, 0x23, 0x21, 0x53, 0x21, 0x7d, 0x55, 0xf8, 0x4f, 0x0f, 0x42, 0xaa,
+	0xc8, 0x81, 0xaf, 0x0a, 0x6f, 0x91, 0x09, 0x25, 0xd0, 0xcd, 0xf2, 0xce, 0x53, 0x85, 0x67, 0xef,
+	0x86, 0xb7, 0x12, 0x91, 0x08, 0x73, 0xeb, 0xeb, 0xa8, 0x04, 0x0e, 0xdf, 0xb1, 0x22, 0x21, 0x91,
+	0xd4, 0x8f, 0xb2, 0xe5, 0x42, 0x89, 0x5a, 0xad, 0x3c, 0x5a, 0xa0, 0x7b, 0x11, 0x58, 0x23, 0x04,
+	0xe3, 0xaf, 0x09, 0xa9, 0xc2, 0x97, 0x2c, 0xe1, 0x8c, 0x27, 0x35, 0xca, 0x9e, 0x2d, 0x70, 0x2b,
+	0x11, 0x22, 0x99, 0x53, 0xdf, 0x9c, 0xc2, 0xfc, 0xd4, 0x27, 0x7c, 0x59, 0x5e, 0x8d, 0xbe, 0x77,
+	0xa0, 0x79, 0x52, 0xa0, 0x7d, 0x68, 0x87, 0x22, 0x5e, 0x0e, 0x9c, 0x5d, 0x67, 0x7c, 0xed, 0x70,
+	0xcb, 0xfb, 0xd7, 0x7f, 0xf1, 0x4e, 0x8a, 0xa9, 0x88, 0x97, 0xd8, 0xc0, 0xd0, 0x87, 0xd0, 0x27,
+	0xb9, 0x9a, 0x05, 0x8c, 0x9f, 0x8a, 0x41, 0xd3, 0x70, 0xb6, 0x2f, 0xe1, 0x4c, 0x72, 0x35, 0x7b,
+	0xc0, 0x4f, 0x05, 0xee, 0x11, 0x1b, 0x21, 0x17, 0x40, 0x7b, 0x23, 0x2a, 0xcf, 0xa8, 0x1c, 0xb4,
+	0x76, 0x5b, 0xe3, 0x75, 0x7c, 0x21, 0x33, 0xe2, 0xd0, 0x39, 0x29, 0x30, 0xf9, 0x0e, 0xdd, 0x06,
+	0xd0, 0xa5, 0x82, 0x70, 0xa9, 0xa8, 0x34, 0xbe, 0xd6, 0x71, 0x5f, 0x67, 0xa6, 0x3a, 0x81, 0xde,
+	0x86, 0xcd, 0xda, 0x81, 0xc5, 0x34, 0x0d, 0x66, 0xa3, 0x2a, 0x55, 0xe2, 0xae, 0xaa, 0xf7, 0xb3,
+	0x03, 0x6b, 0xc7, 0x2c, 0xe1, 0xf7, 0x44, 0xf4, 0x7f, 0x95, 0xdc, 0x82, 0x5e, 0x34, 0x23, 0x8c,
+	0x07, 0x2c, 0x1e, 0xb4, 0x76, 0x9d, 0x71, 0x1f, 0xaf, 0x99, 0xf3, 0x83, 0x18, 0xed, 0xc1, 0x75,
+	0x12, 0x45, 0x22, 0xe7, 0x2a, 0xe0, 0x79, 0x1a, 0xd2, 0x6c, 0xd0, 0xde, 0x75, 0xc6, 0x6d, 0xbc,
+	0x61, 0xb3, 0x5f, 0x99, 0x24, 0x7a, 0x17, 0x6e, 0x54, 0x30, 0x49, 0xbf, 0xcd, 0x29, 0x8f, 0xe8,
+	0xa0, 0x63, 0x80, 0x9b, 0x36, 0x7f, 0x6c, 0xd3, 0xa3, 0x1f, 0x9b, 0xd0, 0x2d, 0x47, 0x83, 0xee,
+	0x40, 0x2f, 0xa5, 0x52, 0x92, 0xc4, 0x98, 0x6f, 0x8d, 0xaf, 0x1d, 0xde, 0xf2, 0xca, 0xc1, 0x7b,
+	0xd5, 0xe0, 0xbd, 0x09, 0x5f, 0xe2, 0x1a, 0x85, 0x10, 0xb4, 0x53, 0x9a, 0x96, 0x13, 0xec, 0x63,
+	0x13, 0x6b, 0x8b, 0x8a, 0xa5, 0x54, 0xe4, 0x2a, 0x98, 0x51, 0x96, 0xcc, 0x94, 0xf9, 0x0f, 0x6d,
+	0xbc, 0x61, 0xb3, 0x47, 0x26, 0x89, 0xa6, 0x70, 0x93, 0x16, 0x8a, 0x72, 0xc9, 0x04, 0x0f, 0xc4,
+	0x42, 0x31, 0xc1, 0xe5, 0xe0, 0xaf, 0xb5, 0x15, 0x65, 0x6f, 0xd4, 0xf8, 0xaf, 0x4b, 0x38, 0x7a,
+	0x0c, 0x2e, 0x17, 0x3c, 0x88, 0x32, 0xa6, 0x58, 0x44, 0xe6, 0xc1, 0x25, 0x82, 0x9b, 0x2b, 0x04,
+	0xb7, 0xb9, 0xe0, 0x77, 0x2d, 0xf7, 0xf3, 0xd7, 0xb4, 0x47, 0x4f, 0xa1, 0x57, 0xbd, 0x3e, 0xf4,
+	0x19, 0xac, 0xeb, 0x89, 0xd3, 0xcc, 0x8c, 0xae, 0x6a, 0xce, 0xed, 0x4b, 0x1e, 0xec, 0xb1, 0x81,
+	0x99, 0x27, 0x7b, 0x4d, 0xd6, 0xb1, 0x44, 0x63, 0x68, 0x9d, 0x52, 0x6a, 0x5f, 0xfa, 0x1b, 0x97,
+	0x10, 0xef, 0x53, 0x8a, 0x35, 0x64, 0xf4, 0xcc, 0x01, 0x78, 0xa5, 0x82, 0xee, 0x01, 0x2c, 0xf2,
+	0x70, 0xce, 0xa2, 0xe0, 0x09, 0xad, 0xb6, 0x6b, 0xaf, 0xe2, 0xeb, 0xbd, 0xf6, 0xec, 0xc6, 0x57,
+	0x42, 0xdf, 0x18, 0xf4, 0x43, 0xba, 0xc4, 0xfd, 0x45, 0x15, 0xea, 0x75, 0x4b, 0x45, 0x4c, 0xaf,
+	0x5a, 0xb7, 0x47, 0x22, 0xa6, 0xe5, 0xba, 0xa5, 0x36, 0x1a, 0xfd, 0xd6, 0x84, 0x5e, 0x95, 0x46,
+	0x9f, 0x40, 0x57, 0x32, 0x9e, 0xcc, 0xa9, 0x35, 0x32, 0x5a, 0xa1, 0xe1, 0x1d, 0x1b, 0xe4, 0x51,
+	0x03, 0x5b, 0x0e, 0xfa, 0x08, 0x3a, 0x69, 0x3e, 0x57, 0xcc, 0x1a, 0x78, 0x73, 0x15, 0xf9, 0x91,
+	0x06, 0x1e, 0x35, 0x70, 0xc9, 0x18, 0x4e, 0xa0, 0x5b, 0xca, 0xa1, 0x0f, 0xa0, 0xad, 0xbd, 0x19,
+	0x03, 0xd7, 0x0f, 0xdf, 0xba, 0xa0, 0x51, 0x7d, 0xb1, 0x2e, 0x8e, 0x42, 0xeb, 0x61, 0x43, 0x18,
+	0x3e, 0x73, 0xa0, 0x63, 0x54, 0xd1, 0x17, 0xd0, 0x0b, 0x99, 0x22, 0x59, 0x46, 0xaa, 0x86, 0xbe,
+	0xb7, 0xaa, 0xa1, 0x77, 0x45, 0xba, 0x20, 0x91, 0x9a, 0x32, 0x35, 0xd1, 0x14, 0x5c, 0x93, 0xd1,
+	0xc7, 0x00, 0x75, 0x57, 0xf5, 0x2a, 0xb7, 0xae, 0x6a, 0x6b, 0xbf, 0x6a, 0xab, 0x9c, 0x76, 0xa0,
+	0x25, 0xf3, 0x74, 0xf4, 0x8b, 0x03, 0xad, 0xfb, 0x94, 0xa2, 0x08, 0xba, 0x24, 0xd5, 0x7b, 0x69,
+	0xdf, 0xd6, 0xd6, 0x3f, 0x1c, 0xbd, 0xb2, 0xc2, 0xf8, 0xf4, 0xce, 0xf3, 0x97, 0x3b, 0x8d, 0x9f,
+	0x7e, 0xdf, 0x19, 0x27, 0x4c, 0xcd, 0xf2, 0xd0, 0x8b, 0x44, 0xea, 0xdb, 0xef, 0x78, 0xf9, 0xb3,
+	0x2f, 0xe3, 0x27, 0xbe, 0x5a, 0x2e, 0xa8, 0x34, 0x04, 0x89, 0xad, 0x34, 0xda, 0x86, 0x7e, 0x42,
+	0x64, 0x30, 0x67, 0x29, 0x53, 0x66, 0x08, 0x6d, 0xdc, 0x4b, 0x88, 0xfc, 0x52, 0x9f, 0xd1, 0x43,
+	0x58, 0x4b, 0x32, 0xc2, 0x15, 0xcd, 0xcc, 0xbe, 0xae, 0x4f, 0x0f, 0xfe, 0x7c, 0xb9, 0xb3, 0xff,
+	0x1f, 0x6a, 0x4c, 0xa2, 0x68, 0x12, 0xc7, 0x19, 0x95, 0x12, 0x57, 0x0a, 0xd3, 0x4f, 0x9f, 0x9f,
+	0xb9, 0xce, 0x8b, 0x33, 0xd7, 0xf9, 0xe3, 0xcc, 0x75, 0x7e, 0x38, 0x77, 0x1b, 0x2f, 0xce, 0xdd,
+	0xc6, 0xaf, 0xe7, 0x6e, 0xe3, 0xf1, 0xde, 0xd5, 0x8a, 0xbe, 0x2a, 0xc2, 0xae, 0x59, 0xd4, 0xf7,
+	0xff, 0x1e, 0x00, 0x95, 0x44, 0x5b, 0x63, 0x30, 0x07, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -1282,6 +1295,13 @@ func (m *Fee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.GasLimit != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
 		i--
@@ -1540,6 +1560,10 @@ func (m *Fee) Size() (n int) {
 	if m.GasLimit != 0 {
 		n += 1 + sovTx(uint64(m.GasLimit))
 	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2904,6 +2928,40 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = append(m.Granter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Granter == nil {
+				m.Granter = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
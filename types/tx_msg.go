@@ -62,6 +62,7 @@ type (
 		GetGas() uint64
 		GetFee() Coins
 		FeePayer() AccAddress
+		FeeGranter() AccAddress
 	}
 
 	// Tx must have GetMemo() method to use ValidateMemoDecorator
@@ -84,3 +85,9 @@ type TxDecoder func(txBytes []byte) (Tx, error)
 
 // TxEncoder marshals transaction to bytes
 type TxEncoder func(tx Tx) ([]byte, error)
+
+// MsgTypeURL returns the protobuf type URL of a Msg, e.g.
+// "/cosmos.bank.v1beta1.MsgSend".
+func MsgTypeURL(msg Msg) string {
+	return "/" + proto.MessageName(msg)
+}
@@ -122,7 +122,7 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) error {
 			}
 
 			for _, sig := range sigs {
-				err = signing.VerifySignature(sig.PubKey, signingData, sig.Data, txCfg.SignModeHandler(), txBuilder.GetTx())
+				err = signing.VerifySignature(cmd.Context(), sig.PubKey, signingData, sig.Data, txCfg.SignModeHandler(), txBuilder.GetTx())
 				if err != nil {
 					return fmt.Errorf("couldn't verify signature")
 				}
@@ -112,7 +112,7 @@ func printAndValidateSigs(
 				AccountNumber:   accNum,
 				AccountSequence: accSeq,
 			}
-			err = authsigning.VerifySignature(pubKey, signingData, sig.Data, signModeHandler, sigTx)
+			err = authsigning.VerifySignature(cmd.Context(), pubKey, signingData, sig.Data, signModeHandler, sigTx)
 			if err != nil {
 				return false
 			}
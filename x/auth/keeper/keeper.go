@@ -24,13 +24,18 @@ type AccountKeeper struct {
 
 	// The prototypical AccountI constructor.
 	proto func() types.AccountI
+
+	// the address capable of executing a MsgUpdateParams message, typically
+	// the x/gov module account
+	authority sdk.AccAddress
 }
 
 // NewAccountKeeper returns a new sdk.AccountKeeper that uses go-amino to
-// (binary) encode and decode concrete sdk.Accounts.
+// (binary) encode and decode concrete sdk.Accounts. The param subspace is only
+// used by the store migration moving the auth parameters out of it.
 func NewAccountKeeper(
 	cdc codec.BinaryMarshaler, key sdk.StoreKey, paramstore paramtypes.Subspace, proto func() types.AccountI,
-	maccPerms map[string][]string,
+	maccPerms map[string][]string, authority sdk.AccAddress,
 ) AccountKeeper {
 
 	// set KeyTable if it has not already been set
@@ -49,9 +54,15 @@ func NewAccountKeeper(
 		cdc:           cdc,
 		paramSubspace: paramstore,
 		permAddrs:     permAddrs,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the x/auth module's authority.
+func (ak AccountKeeper) GetAuthority() sdk.AccAddress {
+	return ak.authority
+}
+
 // Logger returns a module-specific logger.
 func (ak AccountKeeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 const (
@@ -132,7 +133,7 @@ func TestSupply_ValidatePermissions(t *testing.T) {
 	cdc, _ := simapp.MakeCodecs()
 	keeper := keeper.NewAccountKeeper(
 		cdc, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
-		types.ProtoBaseAccount, maccPerms,
+		types.ProtoBaseAccount, maccPerms, types.NewModuleAddress(govtypes.ModuleName),
 	)
 
 	err := keeper.ValidatePermissions(multiPermAcc)
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v041auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v0_41"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper AccountKeeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper AccountKeeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v041auth.MigrateStore(ctx, m.keeper.key, m.keeper.cdc, m.keeper.paramSubspace)
+}
@@ -7,11 +7,16 @@ import (
 
 // SetParams sets the auth module's parameters.
 func (ak AccountKeeper) SetParams(ctx sdk.Context, params types.Params) {
-	ak.paramSubspace.SetParamSet(ctx, &params)
+	ctx.KVStore(ak.key).Set(types.ParamsKey, ak.cdc.MustMarshalBinaryBare(&params))
 }
 
 // GetParams gets the auth module's parameters.
 func (ak AccountKeeper) GetParams(ctx sdk.Context) (params types.Params) {
-	ak.paramSubspace.GetParamSet(ctx, &params)
+	bz := ctx.KVStore(ak.key).Get(types.ParamsKey)
+	if bz == nil {
+		panic("stored auth params should not have been nil")
+	}
+
+	ak.cdc.MustUnmarshalBinaryBare(bz, &params)
 	return
 }
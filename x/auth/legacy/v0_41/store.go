@@ -0,0 +1,31 @@
+package v041
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.40 to v0.41. The
+// migration includes:
+//
+// - Move the auth parameters from the x/params subspace to the auth store
+// under types.ParamsKey.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, paramSpace paramtypes.Subspace) error {
+	var params types.Params
+	paramSpace.GetParamSet(ctx, &params)
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.MarshalBinaryBare(&params)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(storeKey).Set(types.ParamsKey, bz)
+
+	return nil
+}
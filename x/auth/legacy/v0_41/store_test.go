@@ -0,0 +1,26 @@
+package v041_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	v041auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v0_41"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	storeKey := app.GetKey(types.StoreKey)
+	paramSpace := app.GetSubspace(types.ModuleName)
+
+	params := types.DefaultParams()
+	params.MaxMemoCharacters = 1000
+	paramSpace.SetParamSet(ctx, &params)
+
+	require.NoError(t, v041auth.MigrateStore(ctx, storeKey, app.AppCodec(), paramSpace))
+	require.Equal(t, params, app.AccountKeeper.GetParams(ctx))
+}
@@ -1,6 +1,8 @@
 package signing
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
@@ -20,6 +22,27 @@ type SignModeHandler interface {
 	GetSignBytes(mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error)
 }
 
+// SignModeHandlerWithContext is a SignModeHandler whose sign bytes may depend on
+// state read through the provided context, e.g. the bank metadata used by
+// SIGN_MODE_TEXTUAL to display coins
+type SignModeHandlerWithContext interface {
+	SignModeHandler
+
+	// GetSignBytesWithContext returns the sign bytes for the provided SignMode,
+	// SignerData and Tx, or an error
+	GetSignBytesWithContext(ctx context.Context, mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error)
+}
+
+// GetSignBytesWithContext returns the sign bytes of the provided handler, passing
+// it the context if it implements SignModeHandlerWithContext
+func GetSignBytesWithContext(ctx context.Context, h SignModeHandler, mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error) {
+	if hc, ok := h.(SignModeHandlerWithContext); ok {
+		return hc.GetSignBytesWithContext(ctx, mode, data, tx)
+	}
+
+	return h.GetSignBytes(mode, data, tx)
+}
+
 // SignerData is the specific information needed to sign a transaction that generally
 // isn't included in the transaction body itself
 type SignerData struct {
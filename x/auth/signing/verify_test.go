@@ -1,6 +1,7 @@
 package signing_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -60,7 +61,7 @@ func TestVerifySignature(t *testing.T) {
 	handler := MakeTestHandlerMap()
 	stdTx := types.NewStdTx(msgs, fee, []types.StdSignature{stdSig}, memo)
 	stdTx.TimeoutHeight = 10
-	err = signing.VerifySignature(pubKey, signerData, sigV2.Data, handler, stdTx)
+	err = signing.VerifySignature(context.Background(), pubKey, signerData, sigV2.Data, handler, stdTx)
 	require.NoError(t, err)
 
 	pkSet := []crypto.PubKey{pubKey, pubKey1}
@@ -86,7 +87,7 @@ func TestVerifySignature(t *testing.T) {
 	err = multisig.AddSignatureFromPubKey(multisignature, sig2V2.Data, pkSet[1], pkSet)
 	require.NoError(t, err)
 
-	err = signing.VerifySignature(multisigKey, signerData, multisignature, handler, stdTx)
+	err = signing.VerifySignature(context.Background(), multisigKey, signerData, multisignature, handler, stdTx)
 	require.NoError(t, err)
 }
 
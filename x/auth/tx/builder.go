@@ -214,6 +214,12 @@ func (t *builder) FeePayer() sdk.AccAddress {
 	return t.GetSigners()[0]
 }
 
+// FeeGranter returns the address of the account paying the fees through a fee
+// allowance, or nil if the fees are paid by the fee payer.
+func (t *builder) FeeGranter() sdk.AccAddress {
+	return t.tx.AuthInfo.Fee.Granter
+}
+
 func (t *builder) GetMemo() string {
 	return t.tx.Body.Memo
 }
@@ -305,6 +311,19 @@ func (t *builder) SetFeeAmount(coins sdk.Coins) {
 	t.authInfoBz = nil
 }
 
+// SetFeeGranter sets the address of the account paying the fees through a fee
+// allowance.
+func (t *builder) SetFeeGranter(feeGranter sdk.AccAddress) {
+	if t.tx.AuthInfo.Fee == nil {
+		t.tx.AuthInfo.Fee = &tx.Fee{}
+	}
+
+	t.tx.AuthInfo.Fee.Granter = feeGranter
+
+	// set authInfoBz to nil because the cached authInfoBz no longer matches tx.AuthInfo
+	t.authInfoBz = nil
+}
+
 func (t *builder) SetSignatures(signatures ...signing.SignatureV2) error {
 	n := len(signatures)
 	signerInfos := make([]*tx.SignerInfo, n)
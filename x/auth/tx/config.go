@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
 )
 
 type config struct {
@@ -24,11 +25,21 @@ type config struct {
 }
 
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec, PublicKeyCodec and sign modes. The
-// first enabled sign mode will become the default sign mode.
+// first enabled sign mode will become the default sign mode. SIGN_MODE_TEXTUAL displays coins in their base
+// denomination; use NewTxConfigWithTextual to display them using their bank metadata.
 func NewTxConfig(protoCodec *codec.ProtoCodec, pubkeyCodec types.PublicKeyCodec, enabledSignModes []signingtypes.SignMode) client.TxConfig {
+	return NewTxConfigWithTextual(protoCodec, pubkeyCodec, enabledSignModes, nil)
+}
+
+// NewTxConfigWithTextual returns a new protobuf TxConfig like NewTxConfig, whose SIGN_MODE_TEXTUAL handler queries
+// coin metadata using the provided CoinMetadataQueryFn.
+func NewTxConfigWithTextual(
+	protoCodec *codec.ProtoCodec, pubkeyCodec types.PublicKeyCodec, enabledSignModes []signingtypes.SignMode,
+	coinMetadataQuerier textual.CoinMetadataQueryFn,
+) client.TxConfig {
 	return &config{
 		pubkeyCodec: pubkeyCodec,
-		handler:     makeSignModeHandler(enabledSignModes),
+		handler:     NewSignModeHandler(enabledSignModes, coinMetadataQuerier),
 		decoder:     DefaultTxDecoder(protoCodec, pubkeyCodec),
 		encoder:     DefaultTxEncoder(),
 		jsonDecoder: DefaultJSONTxDecoder(protoCodec, pubkeyCodec),
@@ -47,6 +47,12 @@ func (s signModeLegacyAminoJSONHandler) GetSignBytes(mode signingtypes.SignMode,
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "SIGN_MODE_LEGACY_AMINO_JSON does not support protobuf extension options.")
 	}
 
+	// the fee granter is not part of the StdSignDoc, so it would not be
+	// covered by the signature
+	if !protoTx.FeeGranter().Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "SIGN_MODE_LEGACY_AMINO_JSON does not support fee granters.")
+	}
+
 	// nolint: staticcheck
 	return types.StdSignBytes(
 		data.ChainID, data.AccountNumber, data.AccountSequence, protoTx.GetTimeoutHeight(),
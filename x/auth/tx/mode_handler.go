@@ -5,17 +5,22 @@ import (
 
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
 )
 
 // DefaultSignModes are the default sign modes enabled for protobuf transactions.
 var DefaultSignModes = []signingtypes.SignMode{
 	signingtypes.SignMode_SIGN_MODE_DIRECT,
 	signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+	signingtypes.SignMode_SIGN_MODE_TEXTUAL,
 }
 
-// makeSignModeHandler returns the default protobuf SignModeHandler supporting
-// SIGN_MODE_DIRECT and SIGN_MODE_LEGACY_AMINO_JSON.
-func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler {
+// NewSignModeHandler returns the default protobuf SignModeHandler supporting
+// SIGN_MODE_DIRECT, SIGN_MODE_LEGACY_AMINO_JSON and SIGN_MODE_TEXTUAL. The
+// provided CoinMetadataQueryFn is used by SIGN_MODE_TEXTUAL to display coins
+// in their display denomination; if it is nil, coins are displayed in their
+// base denomination.
+func NewSignModeHandler(modes []signingtypes.SignMode, coinMetadataQuerier textual.CoinMetadataQueryFn) signing.SignModeHandler {
 	if len(modes) < 1 {
 		panic(fmt.Errorf("no sign modes enabled"))
 	}
@@ -28,6 +33,8 @@ func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler
 			handlers[i] = signModeDirectHandler{}
 		case signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
 			handlers[i] = signModeLegacyAminoJSONHandler{}
+		case signingtypes.SignMode_SIGN_MODE_TEXTUAL:
+			handlers[i] = signModeTextualHandler{renderer: textual.NewRenderer(coinMetadataQuerier)}
 		default:
 			panic(fmt.Errorf("unsupported sign mode %+v", mode))
 		}
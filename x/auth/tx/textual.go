@@ -0,0 +1,121 @@
+package tx
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
+)
+
+var _ signing.SignModeHandlerWithContext = signModeTextualHandler{}
+
+// signModeTextualHandler defines the SIGN_MODE_TEXTUAL SignModeHandler. Its
+// sign bytes are a deterministic, human-readable rendering of the transaction,
+// one line per field, which ends with a hash of the SIGN_MODE_DIRECT sign bytes
+// binding the rendering to the binary transaction.
+type signModeTextualHandler struct {
+	renderer textual.Renderer
+}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (signModeTextualHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_TEXTUAL
+}
+
+// Modes implements SignModeHandler.Modes
+func (signModeTextualHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes
+func (h signModeTextualHandler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	return h.GetSignBytesWithContext(context.Background(), mode, data, tx)
+}
+
+// GetSignBytesWithContext implements SignModeHandlerWithContext.GetSignBytesWithContext
+func (h signModeTextualHandler) GetSignBytesWithContext(ctx context.Context, mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_TEXTUAL {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_TEXTUAL, mode)
+	}
+
+	lines, err := TextualLines(ctx, h.renderer, tx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(strings.Join(lines, "\n")), nil
+}
+
+// TextualLines returns the SIGN_MODE_TEXTUAL rendering of the provided protobuf
+// transaction for the given signer, as displayed to the signer line by line.
+// Values are rendered using the provided Renderer.
+func TextualLines(ctx context.Context, renderer textual.Renderer, tx sdk.Tx, data signing.SignerData) ([]string, error) {
+	protoTx, ok := tx.(*builder)
+	if !ok {
+		return nil, fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	}
+
+	lines := []string{
+		textual.Field("Chain ID", data.ChainID),
+		textual.Field("Account number", textual.FormatInt(strconv.FormatUint(data.AccountNumber, 10))),
+		textual.Field("Sequence", textual.FormatInt(strconv.FormatUint(data.AccountSequence, 10))),
+	}
+
+	anyMsgs := protoTx.tx.Body.Messages
+	msgs := protoTx.GetMsgs()
+	if len(anyMsgs) == 1 {
+		lines = append(lines, "This transaction has 1 message")
+	} else {
+		lines = append(lines, fmt.Sprintf("This transaction has %d messages", len(anyMsgs)))
+	}
+
+	for i, msg := range msgs {
+		msgLines, err := renderer.RenderMessage(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+
+		lines = append(lines, textual.Field(fmt.Sprintf("Message (%d/%d)", i+1, len(msgs)), anyMsgs[i].TypeUrl))
+		lines = append(lines, textual.Nest(msgLines)...)
+	}
+
+	if memo := protoTx.GetMemo(); memo != "" {
+		lines = append(lines, textual.Field("Memo", memo))
+	}
+
+	fees, err := renderer.FormatCoins(ctx, protoTx.GetFee())
+	if err != nil {
+		return nil, err
+	}
+
+	lines = append(lines,
+		textual.Field("Fees", fees),
+		textual.Field("Gas limit", textual.FormatInt(strconv.FormatUint(protoTx.GetGas(), 10))),
+	)
+
+	if feeGranter := protoTx.FeeGranter(); !feeGranter.Empty() {
+		lines = append(lines, textual.Field("Fee granter", feeGranter.String()))
+	}
+
+	if timeoutHeight := protoTx.GetTimeoutHeight(); timeoutHeight != 0 {
+		lines = append(lines, textual.Field("Timeout height", textual.FormatInt(strconv.FormatUint(timeoutHeight, 10))))
+	}
+
+	directBz, err := DirectSignBytes(
+		protoTx.getBodyBytes(), protoTx.getAuthInfoBytes(), data.ChainID, data.AccountNumber, data.AccountSequence,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(directBz)
+	lines = append(lines, textual.Field("Hash of raw bytes", fmt.Sprintf("%X", hash)))
+
+	return lines, nil
+}
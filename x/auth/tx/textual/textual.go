@@ -0,0 +1,250 @@
+package textual
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Indent is the prefix of a line nested under the preceding line.
+const Indent = "> "
+
+// RenderMessage renders a protobuf message field by field, in field order,
+// omitting fields with default values. Each field is rendered as
+// "<Field name>: <value>", where known types (coins, decimals, integers,
+// timestamps, addresses, ...) are rendered using the value renderers of this
+// package and the Renderer, and nested messages are rendered on the following lines, prefixed
+// with Indent.
+func (r Renderer) RenderMessage(ctx context.Context, msg proto.Message) ([]string, error) {
+	v := reflect.ValueOf(msg)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot render non-struct message %T", msg)
+	}
+
+	return r.renderStruct(ctx, v)
+}
+
+// Field renders a single "<name>: <value>" line.
+func Field(name, value string) string {
+	return fmt.Sprintf("%s: %s", name, value)
+}
+
+// Nest prefixes each line with Indent.
+func Nest(lines []string) []string {
+	nested := make([]string, len(lines))
+	for i, line := range lines {
+		nested[i] = Indent + line
+	}
+
+	return nested
+}
+
+var (
+	coinType      = reflect.TypeOf(sdk.Coin{})
+	coinsType     = reflect.TypeOf(sdk.Coins{})
+	coinSliceType = reflect.TypeOf([]sdk.Coin{})
+	decCoinType   = reflect.TypeOf(sdk.DecCoin{})
+	decCoinsType  = reflect.TypeOf(sdk.DecCoins{})
+	decSliceType  = reflect.TypeOf([]sdk.DecCoin{})
+	intType       = reflect.TypeOf(sdk.Int{})
+	decType       = reflect.TypeOf(sdk.Dec{})
+	timeType      = reflect.TypeOf(time.Time{})
+	durationType  = reflect.TypeOf(time.Duration(0))
+	accAddrType   = reflect.TypeOf(sdk.AccAddress{})
+	valAddrType   = reflect.TypeOf(sdk.ValAddress{})
+	consAddrType  = reflect.TypeOf(sdk.ConsAddress{})
+	anyType       = reflect.TypeOf(codectypes.Any{})
+	stringerType  = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+// renderStruct renders the exported, non-default fields of a protobuf struct.
+func (r Renderer) renderStruct(ctx context.Context, v reflect.Value) ([]string, error) {
+	var lines []string
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" || strings.HasPrefix(sf.Name, "XXX_") {
+			continue
+		}
+
+		fv := v.Field(i)
+		if fv.IsZero() {
+			continue
+		}
+
+		// oneof fields hold a pointer to a wrapper struct with a single field
+		if _, ok := sf.Tag.Lookup("protobuf_oneof"); ok {
+			fieldLines, err := r.renderStruct(ctx, fv.Elem().Elem())
+			if err != nil {
+				return nil, err
+			}
+			lines = append(lines, fieldLines...)
+			continue
+		}
+
+		fieldLines, err := r.renderField(ctx, fieldLabel(sf), fv)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, fieldLines...)
+	}
+
+	return lines, nil
+}
+
+// renderField renders a single labeled value.
+func (r Renderer) renderField(ctx context.Context, label string, v reflect.Value) ([]string, error) {
+	switch v.Type() {
+	case coinType:
+		return renderValue(label)(r.FormatCoin(ctx, v.Interface().(sdk.Coin)))
+	case coinsType, coinSliceType:
+		return renderValue(label)(r.FormatCoins(ctx, v.Convert(coinsType).Interface().(sdk.Coins)))
+	case decCoinType:
+		return renderValue(label)(r.FormatDecCoin(ctx, v.Interface().(sdk.DecCoin)))
+	case decCoinsType, decSliceType:
+		return renderValue(label)(r.FormatDecCoins(ctx, v.Convert(decCoinsType).Interface().(sdk.DecCoins)))
+	case intType:
+		return []string{Field(label, FormatInt(v.Interface().(sdk.Int).String()))}, nil
+	case decType:
+		return []string{Field(label, FormatDec(v.Interface().(sdk.Dec)))}, nil
+	case timeType:
+		return []string{Field(label, FormatTime(v.Interface().(time.Time)))}, nil
+	case durationType:
+		return []string{Field(label, FormatDuration(v.Interface().(time.Duration)))}, nil
+	case accAddrType, valAddrType, consAddrType:
+		return []string{Field(label, v.Interface().(fmt.Stringer).String())}, nil
+	case anyType:
+		return r.renderAny(ctx, label, v.Addr().Interface().(*codectypes.Any))
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil, nil
+		}
+		return r.renderField(ctx, label, v.Elem())
+
+	case reflect.Struct:
+		lines, err := r.renderStruct(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		return append([]string{label + ":"}, Nest(lines)...), nil
+
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return []string{Field(label, strings.ToUpper(hex.EncodeToString(v.Bytes())))}, nil
+		}
+
+		var lines []string
+		for i := 0; i < v.Len(); i++ {
+			elemLines, err := r.renderField(ctx, fmt.Sprintf("%s (%d/%d)", label, i+1, v.Len()), v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			lines = append(lines, elemLines...)
+		}
+		return lines, nil
+
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+
+		var lines []string
+		for _, key := range keys {
+			elemLines, err := r.renderField(ctx, fmt.Sprintf("%s (%v)", label, key.Interface()), v.MapIndex(key))
+			if err != nil {
+				return nil, err
+			}
+			lines = append(lines, elemLines...)
+		}
+		return lines, nil
+
+	case reflect.String:
+		return []string{Field(label, v.String())}, nil
+
+	case reflect.Bool:
+		return []string{Field(label, strconv.FormatBool(v.Bool()))}, nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		// protobuf enums are rendered using their name
+		if v.Type().Implements(stringerType) {
+			return []string{Field(label, v.Interface().(fmt.Stringer).String())}, nil
+		}
+		return []string{Field(label, FormatInt(strconv.FormatInt(v.Int(), 10)))}, nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return []string{Field(label, FormatInt(strconv.FormatUint(v.Uint(), 10)))}, nil
+
+	default:
+		return nil, fmt.Errorf("cannot render field %s of type %s", label, v.Type())
+	}
+}
+
+// renderValue returns a function rendering a single labeled value, or the
+// error of the value's formatting.
+func renderValue(label string) func(string, error) ([]string, error) {
+	return func(value string, err error) ([]string, error) {
+		if err != nil {
+			return nil, err
+		}
+		return []string{Field(label, value)}, nil
+	}
+}
+
+// renderAny renders the type URL of an Any followed by its nested cached value,
+// or its raw value if it has not been unpacked.
+func (r Renderer) renderAny(ctx context.Context, label string, any *codectypes.Any) ([]string, error) {
+	lines := []string{Field(label, any.TypeUrl)}
+
+	msg, ok := any.GetCachedValue().(proto.Message)
+	if !ok {
+		return append(lines, Nest([]string{Field("Value", strings.ToUpper(hex.EncodeToString(any.Value)))})...), nil
+	}
+
+	valueLines, err := r.RenderMessage(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(lines, Nest(valueLines)...), nil
+}
+
+// fieldLabel returns a human-readable label for a protobuf struct field, e.g.
+// "from_address" becomes "From address".
+func fieldLabel(sf reflect.StructField) string {
+	name := sf.Name
+	for _, part := range strings.Split(sf.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(part, "name=") {
+			name = strings.TrimPrefix(part, "name=")
+			break
+		}
+	}
+
+	name = strings.ReplaceAll(name, "_", " ")
+	if name == "" {
+		return name
+	}
+
+	return strings.ToUpper(name[:1]) + name[1:]
+}
@@ -0,0 +1,188 @@
+package textual_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestFormatInt(t *testing.T) {
+	testCases := []struct {
+		in  string
+		out string
+	}{
+		{"0", "0"},
+		{"12", "12"},
+		{"123", "123"},
+		{"1234", "1'234"},
+		{"1234567", "1'234'567"},
+		{"-1234567", "-1'234'567"},
+		{"100000", "100'000"},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.out, textual.FormatInt(tc.in), tc.in)
+	}
+}
+
+func TestFormatDec(t *testing.T) {
+	require.Equal(t, "0", textual.FormatDec(sdk.ZeroDec()))
+	require.Equal(t, "1'234.5", textual.FormatDec(sdk.MustNewDecFromStr("1234.500")))
+	require.Equal(t, "-0.000001", textual.FormatDec(sdk.MustNewDecFromStr("-0.000001")))
+	require.Equal(t, "1'000", textual.FormatDec(sdk.NewDec(1000)))
+}
+
+// coinMetadataQuerier returns a CoinMetadataQueryFn serving the provided
+// metadata by base denomination.
+func coinMetadataQuerier(metadatas ...banktypes.Metadata) textual.CoinMetadataQueryFn {
+	return func(_ context.Context, denom string) (*banktypes.Metadata, error) {
+		for i := range metadatas {
+			if metadatas[i].Base == denom {
+				return &metadatas[i], nil
+			}
+		}
+		return nil, nil
+	}
+}
+
+func newMetadata(base, display string, exponent uint32) banktypes.Metadata {
+	return banktypes.Metadata{
+		Base:    base,
+		Display: display,
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: base, Exponent: 0},
+			{Denom: display, Exponent: exponent},
+		},
+	}
+}
+
+func TestFormatCoins(t *testing.T) {
+	ctx := context.Background()
+	r := textual.NewRenderer(coinMetadataQuerier(
+		newMetadata("utxtl", "txtl", 6),
+		newMetadata("ubad", "bad", 19),
+		banktypes.Metadata{Base: "unodisplay", DenomUnits: []*banktypes.DenomUnit{{Denom: "unodisplay"}}},
+	))
+
+	testCases := []struct {
+		coin sdk.Coin
+		out  string
+	}{
+		{sdk.NewInt64Coin("utxtl", 1500000), "1.5 txtl"},
+		{sdk.NewInt64Coin("utxtl", 1), "0.000001 txtl"},
+		{sdk.NewInt64Coin("stake", 1000), "1'000 stake"},
+		// metadata without a usable display unit falls back to the base denom
+		{sdk.NewInt64Coin("ubad", 1000), "1'000 ubad"},
+		{sdk.NewInt64Coin("unodisplay", 1000), "1'000 unodisplay"},
+	}
+	for _, tc := range testCases {
+		out, err := r.FormatCoin(ctx, tc.coin)
+		require.NoError(t, err)
+		require.Equal(t, tc.out, out, tc.coin.String())
+	}
+
+	out, err := r.FormatCoins(ctx, sdk.NewCoins())
+	require.NoError(t, err)
+	require.Equal(t, "zero", out)
+
+	out, err = r.FormatCoins(ctx, sdk.NewCoins(sdk.NewInt64Coin("utxtl", 2000000), sdk.NewInt64Coin("stake", 1000)))
+	require.NoError(t, err)
+	require.Equal(t, "1'000 stake, 2 txtl", out)
+
+	out, err = r.FormatDecCoin(ctx, sdk.NewDecCoinFromDec("utxtl", sdk.NewDec(250000)))
+	require.NoError(t, err)
+	require.Equal(t, "0.25 txtl", out)
+
+	// without a querier, coins are rendered using their base denom
+	out, err = textual.NewRenderer(nil).FormatCoin(ctx, sdk.NewInt64Coin("utxtl", 1500000))
+	require.NoError(t, err)
+	require.Equal(t, "1'500'000 utxtl", out)
+
+	// query errors are returned
+	failing := textual.NewRenderer(func(context.Context, string) (*banktypes.Metadata, error) {
+		return nil, errors.New("query failed")
+	})
+	_, err = failing.FormatCoins(ctx, sdk.NewCoins(sdk.NewInt64Coin("utxtl", 1)))
+	require.EqualError(t, err, "query failed")
+}
+
+func TestBankKeeperCoinMetadataQueryFn(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.BankKeeper.SetDenomMetaData(ctx, newMetadata("utxtl", "txtl", 6))
+
+	r := textual.NewRenderer(textual.NewBankKeeperCoinMetadataQueryFn(app.BankKeeper))
+	out, err := r.FormatCoins(
+		sdk.WrapSDKContext(ctx), sdk.NewCoins(sdk.NewInt64Coin("utxtl", 1500000), sdk.NewInt64Coin("stake", 1000)),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "1'000 stake, 1.5 txtl", out)
+
+	// the querier requires an sdk.Context
+	_, err = r.FormatCoin(context.Background(), sdk.NewInt64Coin("utxtl", 1))
+	require.Error(t, err)
+}
+
+func TestFormatTime(t *testing.T) {
+	ts := time.Date(2020, 9, 30, 12, 30, 0, 0, time.FixedZone("CET", 3600))
+	require.Equal(t, "2020-09-30T11:30:00Z", textual.FormatTime(ts))
+	require.Equal(t, "1h30m0s", textual.FormatDuration(90*time.Minute))
+}
+
+func TestRenderMessage(t *testing.T) {
+	ctx := context.Background()
+	r := textual.NewRenderer(coinMetadataQuerier(newMetadata("utxtl", "txtl", 6)))
+	from := sdk.AccAddress([]byte("from________________"))
+	to := sdk.AccAddress([]byte("to__________________"))
+	msg := banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("stake", 1234), sdk.NewInt64Coin("utxtl", 1)))
+
+	lines, err := r.RenderMessage(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"From address: " + from.String(),
+		"To address: " + to.String(),
+		"Amount: 1'234 stake, 0.000001 txtl",
+	}, lines)
+
+	// nested and repeated messages are indented and numbered
+	multiSend := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(from, sdk.NewCoins(sdk.NewInt64Coin("stake", 2)))},
+		[]banktypes.Output{
+			banktypes.NewOutput(to, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))),
+			banktypes.NewOutput(from, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))),
+		},
+	)
+	lines, err = r.RenderMessage(ctx, multiSend)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"Inputs (1/1):",
+		"> Address: " + from.String(),
+		"> Coins: 2 stake",
+		"Outputs (1/2):",
+		"> Address: " + to.String(),
+		"> Coins: 1 stake",
+		"Outputs (2/2):",
+		"> Address: " + from.String(),
+		"> Coins: 1 stake",
+	}, lines)
+
+	// enums are rendered by name and default values are omitted
+	vote := govtypes.NewMsgVote(from, 1, govtypes.OptionYes)
+	lines, err = r.RenderMessage(ctx, vote)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"Proposal id: 1",
+		"Voter: " + from.String(),
+		"Option: VOTE_OPTION_YES",
+	}, lines)
+}
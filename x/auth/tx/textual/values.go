@@ -0,0 +1,222 @@
+package textual
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// CoinMetadataQueryFn returns the bank metadata of a base denomination, or nil
+// if the denomination has no metadata.
+type CoinMetadataQueryFn func(ctx context.Context, denom string) (*banktypes.Metadata, error)
+
+// BankKeeper defines the bank keeper method used to query coin metadata on
+// chain.
+type BankKeeper interface {
+	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+}
+
+// NewBankKeeperCoinMetadataQueryFn returns a CoinMetadataQueryFn reading coin
+// metadata from the bank keeper. The provided context must wrap an sdk.Context.
+func NewBankKeeperCoinMetadataQueryFn(bk BankKeeper) CoinMetadataQueryFn {
+	return func(ctx context.Context, denom string) (*banktypes.Metadata, error) {
+		sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context)
+		if !ok {
+			return nil, fmt.Errorf("cannot query the metadata of denom %s without an sdk.Context", denom)
+		}
+
+		metadata, found := bk.GetDenomMetaData(sdkCtx, denom)
+		if !found {
+			return nil, nil
+		}
+
+		return &metadata, nil
+	}
+}
+
+// NewGRPCCoinMetadataQueryFn returns a CoinMetadataQueryFn querying coin
+// metadata from the bank module over the provided gRPC connection, e.g. a
+// client.Context.
+func NewGRPCCoinMetadataQueryFn(conn gogogrpc.ClientConn) CoinMetadataQueryFn {
+	queryClient := banktypes.NewQueryClient(conn)
+
+	return func(ctx context.Context, denom string) (*banktypes.Metadata, error) {
+		// the metadata of all denominations is paginated through, as a missing
+		// denomination can't be told apart from a failed DenomMetadata query once
+		// relayed through the node's ABCI query
+		req := &banktypes.QueryDenomsMetadataRequest{Pagination: &query.PageRequest{}}
+		for {
+			res, err := queryClient.DenomsMetadata(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+
+			for i := range res.Metadatas {
+				if res.Metadatas[i].Base == denom {
+					return &res.Metadatas[i], nil
+				}
+			}
+
+			if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+				return nil, nil
+			}
+			req.Pagination.Key = res.Pagination.NextKey
+		}
+	}
+}
+
+// Renderer renders values and messages into human-readable lines, using the
+// bank metadata of coin denominations to display coins.
+type Renderer struct {
+	coinMetadataQuerier CoinMetadataQueryFn
+}
+
+// NewRenderer returns a Renderer using the provided CoinMetadataQueryFn. If it
+// is nil, coins are always rendered using their base denomination.
+func NewRenderer(coinMetadataQuerier CoinMetadataQueryFn) Renderer {
+	return Renderer{coinMetadataQuerier: coinMetadataQuerier}
+}
+
+// displayUnit returns the display denomination of a base denomination and its
+// exponent, such that 1 display unit equals 10^exponent base units. The base
+// denomination itself is returned if it has no usable metadata.
+func (r Renderer) displayUnit(ctx context.Context, base string) (string, uint32, error) {
+	if r.coinMetadataQuerier == nil {
+		return base, 0, nil
+	}
+
+	metadata, err := r.coinMetadataQuerier(ctx, base)
+	if err != nil {
+		return "", 0, err
+	}
+	if metadata == nil || metadata.Display == "" {
+		return base, 0, nil
+	}
+
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display && unit.Exponent <= sdk.Precision {
+			return unit.Denom, unit.Exponent, nil
+		}
+	}
+
+	return base, 0, nil
+}
+
+// FormatInt formats an integer string with a thousands separator, e.g.
+// "1234567" becomes "1'234'567".
+func FormatInt(v string) string {
+	sign := ""
+	if strings.HasPrefix(v, "-") {
+		sign, v = "-", v[1:]
+	}
+
+	var sb strings.Builder
+	for i, c := range v {
+		if i > 0 && (len(v)-i)%3 == 0 {
+			sb.WriteByte('\'')
+		}
+		sb.WriteRune(c)
+	}
+
+	return sign + sb.String()
+}
+
+// FormatDec formats a decimal with a thousands separator in its integral part
+// and without trailing zeros in its fractional part, e.g. 1234.500 becomes
+// "1'234.5".
+func FormatDec(d sdk.Dec) string {
+	parts := strings.SplitN(d.String(), ".", 2)
+	intPart := FormatInt(parts[0])
+	if len(parts) == 1 {
+		return intPart
+	}
+
+	fracPart := strings.TrimRight(parts[1], "0")
+	if fracPart == "" {
+		return intPart
+	}
+
+	return intPart + "." + fracPart
+}
+
+// FormatCoin formats a coin using its display denomination if its denomination
+// has bank metadata, or using its base denomination otherwise.
+func (r Renderer) FormatCoin(ctx context.Context, coin sdk.Coin) (string, error) {
+	display, exponent, err := r.displayUnit(ctx, coin.Denom)
+	if err != nil {
+		return "", err
+	}
+	if exponent == 0 {
+		return fmt.Sprintf("%s %s", FormatInt(coin.Amount.String()), display), nil
+	}
+
+	amount := coin.Amount.ToDec().QuoInt(sdk.NewIntWithDecimal(1, int(exponent)))
+	return fmt.Sprintf("%s %s", FormatDec(amount), display), nil
+}
+
+// FormatCoins formats a set of coins as a comma-separated list. Empty coins
+// are rendered as "zero".
+func (r Renderer) FormatCoins(ctx context.Context, coins sdk.Coins) (string, error) {
+	if len(coins) == 0 {
+		return "zero", nil
+	}
+
+	formatted := make([]string, len(coins))
+	for i, coin := range coins {
+		s, err := r.FormatCoin(ctx, coin)
+		if err != nil {
+			return "", err
+		}
+		formatted[i] = s
+	}
+
+	return strings.Join(formatted, ", "), nil
+}
+
+// FormatDecCoin formats a decimal coin using its display denomination if its
+// denomination has bank metadata, or using its base denomination otherwise.
+func (r Renderer) FormatDecCoin(ctx context.Context, coin sdk.DecCoin) (string, error) {
+	display, exponent, err := r.displayUnit(ctx, coin.Denom)
+	if err != nil {
+		return "", err
+	}
+
+	amount := coin.Amount.QuoInt(sdk.NewIntWithDecimal(1, int(exponent)))
+	return fmt.Sprintf("%s %s", FormatDec(amount), display), nil
+}
+
+// FormatDecCoins formats a set of decimal coins as a comma-separated list.
+// Empty coins are rendered as "zero".
+func (r Renderer) FormatDecCoins(ctx context.Context, coins sdk.DecCoins) (string, error) {
+	if len(coins) == 0 {
+		return "zero", nil
+	}
+
+	formatted := make([]string, len(coins))
+	for i, coin := range coins {
+		s, err := r.FormatDecCoin(ctx, coin)
+		if err != nil {
+			return "", err
+		}
+		formatted[i] = s
+	}
+
+	return strings.Join(formatted, ", "), nil
+}
+
+// FormatTime formats a timestamp in UTC using RFC 3339.
+func FormatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// FormatDuration formats a duration, e.g. "1h30m0s".
+func FormatDuration(d time.Duration) string {
+	return d.String()
+}
@@ -0,0 +1,136 @@
+package tx
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestTextualModeHandler(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	txConfig := NewTxConfig(marshaler, std.DefaultPublicKeyCodec{}, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL})
+	txBuilder := txConfig.NewTxBuilder()
+
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	txBuilder.SetMemo("sometestmemo")
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 1500)))
+	txBuilder.SetGasLimit(200000)
+	txBuilder.SetTimeoutHeight(10)
+
+	modeHandler := txConfig.SignModeHandler()
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_TEXTUAL, modeHandler.DefaultMode())
+	require.Len(t, modeHandler.Modes(), 1)
+
+	signingData := signing.SignerData{
+		ChainID:         "test-chain",
+		AccountNumber:   1,
+		AccountSequence: 1234,
+	}
+
+	signBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	directBytes, err := signModeDirectHandler{}.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	expected := []string{
+		"Chain ID: test-chain",
+		"Account number: 1",
+		"Sequence: 1'234",
+		"This transaction has 1 message",
+		"Message (1/1): /testdata.TestMsg",
+		"> Signers (1/1): " + addr.String(),
+		"Memo: sometestmemo",
+		"Fees: 1'500 atom",
+		"Gas limit: 200'000",
+		"Timeout height: 10",
+		fmt.Sprintf("Hash of raw bytes: %X", sha256.Sum256(directBytes)),
+	}
+	require.Equal(t, strings.Join(expected, "\n"), string(signBytes))
+
+	t.Log("verify sign bytes change with the signer data")
+	signingData.AccountSequence = 1235
+	otherSignBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.NotEqual(t, signBytes, otherSignBytes)
+}
+
+func TestTextualModeHandler_coinMetadata(t *testing.T) {
+	type ctxKey struct{}
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	// the querier only knows the metadata of uatom through the signing context
+	querier := func(ctx context.Context, denom string) (*banktypes.Metadata, error) {
+		if ctx.Value(ctxKey{}) == nil || denom != "uatom" {
+			return nil, nil
+		}
+		return &banktypes.Metadata{
+			Base:       "uatom",
+			Display:    "atom",
+			DenomUnits: []*banktypes.DenomUnit{{Denom: "uatom"}, {Denom: "atom", Exponent: 6}},
+		}, nil
+	}
+	txConfig := NewTxConfigWithTextual(
+		marshaler, std.DefaultPublicKeyCodec{}, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}, querier,
+	)
+	txBuilder := txConfig.NewTxBuilder()
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500)))
+
+	var signingData signing.SignerData
+	ctx := context.WithValue(context.Background(), ctxKey{}, true)
+	signBytes, err := signing.GetSignBytesWithContext(
+		ctx, txConfig.SignModeHandler(), signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx(),
+	)
+	require.NoError(t, err)
+	require.Contains(t, strings.Split(string(signBytes), "\n"), "Fees: 0.0015 atom")
+
+	signBytes, err = txConfig.SignModeHandler().GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Contains(t, strings.Split(string(signBytes), "\n"), "Fees: 1'500 uatom")
+}
+
+func TestTextualModeHandler_nonTEXTUAL_MODE(t *testing.T) {
+	invalidModes := []signingtypes.SignMode{
+		signingtypes.SignMode_SIGN_MODE_DIRECT,
+		signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		signingtypes.SignMode_SIGN_MODE_UNSPECIFIED,
+	}
+	for _, invalidMode := range invalidModes {
+		t.Run(invalidMode.String(), func(t *testing.T) {
+			var th signModeTextualHandler
+			var signingData signing.SignerData
+			_, err := th.GetSignBytes(invalidMode, signingData, nil)
+			require.Error(t, err)
+			wantErr := fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_TEXTUAL, invalidMode)
+			require.Equal(t, err, wantErr)
+		})
+	}
+}
+
+func TestTextualModeHandler_nonProtoTx(t *testing.T) {
+	var th signModeTextualHandler
+	var signingData signing.SignerData
+	tx := new(nonProtoTx)
+	_, err := th.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, tx)
+	require.Error(t, err)
+	wantErr := fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	require.Equal(t, err, wantErr)
+}
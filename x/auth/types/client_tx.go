@@ -75,6 +75,10 @@ func (s *StdTxBuilder) SetTimeoutHeight(height uint64) {
 	s.TimeoutHeight = height
 }
 
+// SetFeeGranter implements TxBuilder.SetFeeGranter. It is a no-op, as fee
+// grants are not supported by StdTx.
+func (s *StdTxBuilder) SetFeeGranter(_ sdk.AccAddress) {}
+
 // StdTxConfig is a context.TxConfig for StdTx
 type StdTxConfig struct {
 	Cdc *codec.LegacyAmino
@@ -14,11 +14,17 @@ const (
 	// FeeCollectorName the root string for the fee collector account address
 	FeeCollectorName = "fee_collector"
 
+	// RouterKey is the message route for auth
+	RouterKey = ModuleName
+
 	// QuerierRoute is the querier route for auth
 	QuerierRoute = ModuleName
 )
 
 var (
+	// ParamsKey is the key for the auth module parameters
+	ParamsKey = []byte{0x00}
+
 	// AddressStoreKeyPrefix prefix for account-by-address store
 	AddressStoreKeyPrefix = []byte{0x01}
 
@@ -276,6 +276,11 @@ func (tx StdTx) FeePayer() sdk.AccAddress {
 	return sdk.AccAddress{}
 }
 
+// FeeGranter always returns nil, as fee grants are not supported by StdTx.
+func (tx StdTx) FeeGranter() sdk.AccAddress {
+	return nil
+}
+
 // StdSignDoc is replay-prevention structure.
 // It includes the result of msg.GetSignBytes(),
 // as well as the ChainID (prevent cross chain replay)
@@ -0,0 +1,313 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// Transaction command flags
+const (
+	FlagDelayed = "delayed"
+	FlagLockup  = "lockup"
+	FlagVesting = "vesting"
+	FlagDest    = "dest"
+)
+
+// GetTxCmd returns vesting module's transaction commands.
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Vesting transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewMsgCreateVestingAccountCmd(),
+		NewMsgCreatePeriodicVestingAccountCmd(),
+		NewMsgCreateClawbackVestingAccountCmd(),
+		NewMsgClawbackCmd(),
+	)
+
+	return txCmd
+}
+
+// NewMsgCreateVestingAccountCmd returns a CLI command handler for creating a
+// MsgCreateVestingAccount transaction.
+func NewMsgCreateVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vesting-account [to_address] [amount] [end_time]",
+		Short: "Create a new vesting account funded with an allocation of tokens.",
+		Long: `Create a new vesting account funded with an allocation of tokens. The
+account can either be a delayed or continuous vesting account, which is determined
+by the '--delayed' flag. All vesting accounts created will have their start time
+set by the committed block's time. The end_time must be provided as a UNIX epoch
+timestamp.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			endTime, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			delayed, _ := cmd.Flags().GetBool(FlagDelayed)
+
+			msg := types.NewMsgCreateVestingAccount(clientCtx.GetFromAddress(), toAddr, amount, endTime, delayed)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagDelayed, false, "Create a delayed vesting account if true")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// VestingData defines the schedule read from the periods files of the
+// create-periodic-vesting-account and create-clawback-vesting-account commands.
+type VestingData struct {
+	StartTime int64         `json:"start_time"`
+	Periods   []InputPeriod `json:"periods"`
+}
+
+// InputPeriod defines a single vesting period of a VestingData.
+type InputPeriod struct {
+	Coins  string `json:"coins"`
+	Length int64  `json:"length_seconds"`
+}
+
+// NewMsgCreatePeriodicVestingAccountCmd returns a CLI command handler for
+// creating a MsgCreatePeriodicVestingAccount transaction.
+func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-periodic-vesting-account [to_address] [periods_json_file]",
+		Short: "Create a new periodic vesting account funded with an allocation of tokens.",
+		Long: `Create a new periodic vesting account funded with the sum of the
+amounts of its vesting periods. The periods are read from a JSON file giving the
+start time as a UNIX epoch timestamp and, for each period, its length in seconds
+and the coins vesting at its end:
+
+{
+  "start_time": 1625204910,
+  "periods": [
+    {
+      "coins": "10test",
+      "length_seconds": 2592000
+    },
+    {
+      "coins": "10test",
+      "length_seconds": 2592000
+    }
+  ]
+}`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			startTime, periods, err := readVestingData(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, startTime, periods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgCreateClawbackVestingAccountCmd returns a CLI command handler for
+// creating a MsgCreateClawbackVestingAccount transaction.
+func NewMsgCreateClawbackVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address]",
+		Short: "Create a new vesting account funded with an allocation of tokens, subject to clawback.",
+		Long: `Create a new vesting account funded with an allocation of tokens, which the
+sender, as the funder, may later claw back while they are unvested. The lockup and
+vesting schedules are read from the JSON files given by the '--lockup' and
+'--vesting' flags, in the same format as for create-periodic-vesting-account. At
+least one of them must be given; if only one is given, the coins are unlocked or
+vested, respectively, from the start time. Both schedules must share the same
+start time and total amount.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			lockupFile, _ := cmd.Flags().GetString(FlagLockup)
+			vestingFile, _ := cmd.Flags().GetString(FlagVesting)
+			if lockupFile == "" && vestingFile == "" {
+				return fmt.Errorf("must specify at least one of --%s or --%s", FlagLockup, FlagVesting)
+			}
+
+			var (
+				lockupStart, vestingStart     int64
+				lockupPeriods, vestingPeriods []types.Period
+			)
+
+			if lockupFile != "" {
+				lockupStart, lockupPeriods, err = readVestingData(lockupFile)
+				if err != nil {
+					return err
+				}
+			}
+
+			if vestingFile != "" {
+				vestingStart, vestingPeriods, err = readVestingData(vestingFile)
+				if err != nil {
+					return err
+				}
+			}
+
+			startTime := lockupStart
+			switch {
+			case lockupFile == "":
+				startTime = vestingStart
+			case vestingFile != "" && lockupStart != vestingStart:
+				return fmt.Errorf("lockup start time %d does not match vesting start time %d", lockupStart, vestingStart)
+			}
+
+			msg := types.NewMsgCreateClawbackVestingAccount(clientCtx.GetFromAddress(), toAddr, startTime, lockupPeriods, vestingPeriods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagLockup, "", "Path to a JSON file with the lockup schedule")
+	cmd.Flags().String(FlagVesting, "", "Path to a JSON file with the vesting schedule")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgClawbackCmd returns a CLI command handler for creating a MsgClawback
+// transaction.
+func NewMsgClawbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Short: "Transfer the unvested tokens of a clawback vesting account.",
+		Long: `Transfer the unvested tokens of a clawback vesting account, including
+delegated and unbonding ones, to the address given by the '--dest' flag, or back
+to the funder if it is not given. Must be sent by the funder of the account.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var dest sdk.AccAddress
+			if destString, _ := cmd.Flags().GetString(FlagDest); destString != "" {
+				dest, err = sdk.AccAddressFromBech32(destString)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), addr, dest)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDest, "", "Address of the destination of the unvested tokens, defaults to the funder")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readVestingData reads a VestingData JSON file and returns its start time and
+// periods.
+func readVestingData(path string) (int64, []types.Period, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var vestingData VestingData
+	if err := json.Unmarshal(contents, &vestingData); err != nil {
+		return 0, nil, err
+	}
+
+	periods := make([]types.Period, len(vestingData.Periods))
+	for i, p := range vestingData.Periods {
+		amount, err := sdk.ParseCoins(p.Coins)
+		if err != nil {
+			return 0, nil, err
+		}
+		if p.Length < 1 {
+			return 0, nil, fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
+		}
+
+		periods[i] = types.Period{Length: p.Length, Amount: amount}
+	}
+
+	return vestingData.StartTime, periods, nil
+}
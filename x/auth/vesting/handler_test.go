@@ -0,0 +1,285 @@
+package vesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+
+	app     *simapp.SimApp
+	ctx     sdk.Context
+	handler sdk.Handler
+	addrs   []sdk.AccAddress
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1000, 0)})
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.handler = vesting.NewHandler(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	suite.addrs = simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(10000))
+}
+
+func (suite *HandlerTestSuite) TestMsgCreateVestingAccount() {
+	ctx := suite.ctx
+	from := suite.addrs[0]
+	to1 := sdk.AccAddress([]byte("to1_________________"))
+	to2 := sdk.AccAddress([]byte("to2_________________"))
+	to3 := sdk.AccAddress([]byte("to3_________________"))
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgCreateVestingAccount
+		expectErr bool
+	}{
+		{
+			name:      "create continuous vesting account",
+			msg:       types.NewMsgCreateVestingAccount(from, to1, amount, ctx.BlockTime().Unix()+10000, false),
+			expectErr: false,
+		},
+		{
+			name:      "create delayed vesting account",
+			msg:       types.NewMsgCreateVestingAccount(from, to2, amount, ctx.BlockTime().Unix()+10000, true),
+			expectErr: false,
+		},
+		{
+			name:      "account already exists",
+			msg:       types.NewMsgCreateVestingAccount(from, suite.addrs[1], amount, ctx.BlockTime().Unix()+10000, false),
+			expectErr: true,
+		},
+		{
+			name:      "end time before block time",
+			msg:       types.NewMsgCreateVestingAccount(from, to3, amount, ctx.BlockTime().Unix()-1, false),
+			expectErr: true,
+		},
+		{
+			name:      "insufficient funds",
+			msg:       types.NewMsgCreateVestingAccount(from, to3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)), ctx.BlockTime().Unix()+10000, false),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			cacheCtx, _ := ctx.CacheContext()
+			res, err := suite.handler(cacheCtx, tc.msg)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().NotNil(res)
+
+			acc := suite.app.AccountKeeper.GetAccount(cacheCtx, tc.msg.ToAddress)
+			suite.Require().NotNil(acc)
+
+			if tc.msg.Delayed {
+				suite.Require().IsType(&types.DelayedVestingAccount{}, acc)
+			} else {
+				suite.Require().IsType(&types.ContinuousVestingAccount{}, acc)
+				suite.Require().Equal(ctx.BlockTime().Unix(), acc.(*types.ContinuousVestingAccount).StartTime)
+			}
+
+			suite.Require().Equal(tc.msg.Amount, suite.app.BankKeeper.GetAllBalances(cacheCtx, tc.msg.ToAddress))
+			suite.Require().Equal(tc.msg.Amount, suite.app.BankKeeper.LockedCoins(cacheCtx, tc.msg.ToAddress))
+		})
+	}
+}
+
+func (suite *HandlerTestSuite) TestMsgCreatePeriodicVestingAccount() {
+	ctx := suite.ctx
+	from := suite.addrs[0]
+	to1 := sdk.AccAddress([]byte("to1_________________"))
+	periods := []types.Period{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30))},
+		{Length: 200, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 70))},
+	}
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgCreatePeriodicVestingAccount
+		expectErr bool
+	}{
+		{
+			name:      "create periodic vesting account",
+			msg:       types.NewMsgCreatePeriodicVestingAccount(from, to1, ctx.BlockTime().Unix(), periods),
+			expectErr: false,
+		},
+		{
+			name:      "account already exists",
+			msg:       types.NewMsgCreatePeriodicVestingAccount(from, suite.addrs[1], ctx.BlockTime().Unix(), periods),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			cacheCtx, _ := ctx.CacheContext()
+			res, err := suite.handler(cacheCtx, tc.msg)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().NotNil(res)
+
+			acc := suite.app.AccountKeeper.GetAccount(cacheCtx, tc.msg.ToAddress)
+			suite.Require().IsType(&types.PeriodicVestingAccount{}, acc)
+
+			pva := acc.(*types.PeriodicVestingAccount)
+			total := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+			suite.Require().Equal(total, pva.OriginalVesting)
+			suite.Require().Equal(tc.msg.StartTime+300, pva.EndTime)
+			suite.Require().Equal(total, suite.app.BankKeeper.GetAllBalances(cacheCtx, tc.msg.ToAddress))
+		})
+	}
+}
+
+func (suite *HandlerTestSuite) TestMsgCreateClawbackVestingAccount() {
+	ctx := suite.ctx
+	from := suite.addrs[0]
+	to1 := sdk.AccAddress([]byte("to1_________________"))
+	to2 := sdk.AccAddress([]byte("to2_________________"))
+	lockupPeriods := []types.Period{
+		{Length: 400, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))},
+	}
+	vestingPeriods := []types.Period{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30))},
+		{Length: 200, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 70))},
+	}
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgCreateClawbackVestingAccount
+		expEnd    int64
+		expectErr bool
+	}{
+		{
+			name:      "create clawback vesting account",
+			msg:       types.NewMsgCreateClawbackVestingAccount(from, to1, ctx.BlockTime().Unix(), lockupPeriods, vestingPeriods),
+			expEnd:    ctx.BlockTime().Unix() + 400,
+			expectErr: false,
+		},
+		{
+			name:      "create clawback vesting account without lockup",
+			msg:       types.NewMsgCreateClawbackVestingAccount(from, to2, ctx.BlockTime().Unix(), nil, vestingPeriods),
+			expEnd:    ctx.BlockTime().Unix() + 300,
+			expectErr: false,
+		},
+		{
+			name:      "account already exists",
+			msg:       types.NewMsgCreateClawbackVestingAccount(from, suite.addrs[1], ctx.BlockTime().Unix(), lockupPeriods, vestingPeriods),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			cacheCtx, _ := ctx.CacheContext()
+			res, err := suite.handler(cacheCtx, tc.msg)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().NotNil(res)
+
+			acc := suite.app.AccountKeeper.GetAccount(cacheCtx, tc.msg.ToAddress)
+			suite.Require().IsType(&types.ClawbackVestingAccount{}, acc)
+
+			va := acc.(*types.ClawbackVestingAccount)
+			total := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+			suite.Require().Equal(from, va.FunderAddress)
+			suite.Require().Equal(total, va.OriginalVesting)
+			suite.Require().Equal(tc.expEnd, va.EndTime)
+			suite.Require().Equal(total, suite.app.BankKeeper.GetAllBalances(cacheCtx, tc.msg.ToAddress))
+			suite.Require().Equal(total, suite.app.BankKeeper.LockedCoins(cacheCtx, tc.msg.ToAddress))
+		})
+	}
+}
+
+func (suite *HandlerTestSuite) TestMsgClawback() {
+	ctx := suite.ctx
+	funder := suite.addrs[0]
+	valAddr := sdk.ValAddress(suite.addrs[1])
+	addr := sdk.AccAddress([]byte("vesting_____________"))
+	dest := sdk.AccAddress([]byte("dest________________"))
+	stakingHandler := staking.NewHandler(suite.app.StakingKeeper)
+
+	msgCreateValidator := stakingtypes.NewMsgCreateValidator(
+		valAddr, ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+		stakingtypes.Description{}, stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	_, err := stakingHandler(ctx, msgCreateValidator)
+	suite.Require().NoError(err)
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+
+	// vest 1000 tokens in four periods without any lockup and delegate 800
+	period := types.Period{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 250))}
+	vestingPeriods := []types.Period{period, period, period, period}
+	_, err = suite.handler(ctx, types.NewMsgCreateClawbackVestingAccount(funder, addr, ctx.BlockTime().Unix(), nil, vestingPeriods))
+	suite.Require().NoError(err)
+
+	_, err = stakingHandler(ctx, stakingtypes.NewMsgDelegate(addr, valAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 800)))
+	suite.Require().NoError(err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(150 * time.Second))
+
+	// only the funder may claw back
+	_, err = suite.handler(ctx, types.NewMsgClawback(dest, addr, nil))
+	suite.Require().Error(err)
+
+	// only clawback vesting accounts are subject to clawback
+	_, err = suite.handler(ctx, types.NewMsgClawback(funder, suite.addrs[1], nil))
+	suite.Require().Error(err)
+
+	res, err := suite.handler(ctx, types.NewMsgClawback(funder, addr, dest))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	// the 750 unvested tokens are taken from the 200 unbonded tokens first and
+	// then from the delegation
+	va := suite.app.AccountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	vested := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 250))
+	suite.Require().Equal(vested, va.OriginalVesting)
+	suite.Require().Equal(vested, va.DelegatedFree)
+	suite.Require().True(va.DelegatedVesting.IsZero())
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(ctx, addr).IsZero())
+
+	delegation, found := suite.app.StakingKeeper.GetDelegation(ctx, addr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(250), delegation.Shares)
+
+	delegation, found = suite.app.StakingKeeper.GetDelegation(ctx, dest, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(550), delegation.Shares)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200)), suite.app.BankKeeper.GetAllBalances(ctx, dest))
+
+	// nothing is left to claw back
+	_, err = suite.handler(ctx, types.NewMsgClawback(funder, addr, dest))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200)), suite.app.BankKeeper.GetAllBalances(ctx, dest))
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
@@ -0,0 +1,285 @@
+package vesting
+
+import (
+	"context"
+	"math"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+type msgServer struct {
+	keeper.AccountKeeper
+	types.BankKeeper
+	types.StakingKeeper
+}
+
+// NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
+// wrapping the corresponding AccountKeeper, BankKeeper and StakingKeeper.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, StakingKeeper: sk}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// CreateVestingAccount implements the Msg/CreateVestingAccount method. It
+// creates a continuous or delayed vesting account at the recipient address
+// and funds it from the sender's balance.
+func (s msgServer) CreateVestingAccount(goCtx context.Context, msg *types.MsgCreateVestingAccount) (*types.MsgCreateVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	baseAccount, err := s.newBaseAccount(ctx, msg.ToAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	baseVestingAccount := types.NewBaseVestingAccount(baseAccount, msg.Amount.Sort(), msg.EndTime)
+
+	var acc authtypes.GenesisAccount
+	if msg.Delayed {
+		acc = types.NewDelayedVestingAccountRaw(baseVestingAccount)
+	} else {
+		acc = types.NewContinuousVestingAccountRaw(baseVestingAccount, ctx.BlockTime().Unix())
+	}
+
+	if err := s.fundVestingAccount(ctx, acc, msg.FromAddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateVestingAccountResponse{}, nil
+}
+
+// CreatePeriodicVestingAccount implements the Msg/CreatePeriodicVestingAccount
+// method. It creates a periodic vesting account at the recipient address and
+// funds it from the sender's balance with the sum of the periods' amounts.
+func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *types.MsgCreatePeriodicVestingAccount) (*types.MsgCreatePeriodicVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var totalCoins sdk.Coins
+	for _, period := range msg.VestingPeriods {
+		totalCoins = totalCoins.Add(period.Amount...)
+	}
+
+	baseAccount, err := s.newBaseAccount(ctx, msg.ToAddress, totalCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	acc := types.NewPeriodicVestingAccount(baseAccount, totalCoins.Sort(), msg.StartTime, msg.VestingPeriods)
+	if err := s.fundVestingAccount(ctx, acc, msg.FromAddress, totalCoins); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
+}
+
+// CreateClawbackVestingAccount implements the
+// Msg/CreateClawbackVestingAccount method. It creates a clawback vesting
+// account at the recipient address, with the sender as its funder, and funds
+// it from the sender's balance. An empty lockup or vesting schedule leaves the
+// coins unlocked or vested, respectively, from the start time.
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	lockupPeriods := types.Periods(msg.LockupPeriods)
+	vestingPeriods := types.Periods(msg.VestingPeriods)
+
+	totalCoins := lockupPeriods.TotalAmount()
+	if len(lockupPeriods) == 0 {
+		totalCoins = vestingPeriods.TotalAmount()
+		lockupPeriods = types.Periods{{Length: 0, Amount: totalCoins}}
+	}
+	if len(vestingPeriods) == 0 {
+		vestingPeriods = types.Periods{{Length: 0, Amount: totalCoins}}
+	}
+
+	baseAccount, err := s.newBaseAccount(ctx, msg.ToAddress, totalCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	acc := types.NewClawbackVestingAccount(baseAccount, msg.FromAddress, totalCoins, msg.StartTime, lockupPeriods, vestingPeriods)
+	if err := s.fundVestingAccount(ctx, acc, msg.FromAddress, totalCoins); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+// Clawback implements the Msg/Clawback method. It removes the unvested coins
+// from a clawback vesting account and transfers them to the destination
+// address, or back to the funder if no destination is given.
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	dest := msg.DestAddress
+	if dest.Empty() {
+		dest = msg.FunderAddress
+	}
+
+	if s.BlockedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", dest)
+	}
+
+	acc := s.GetAccount(ctx, msg.Address)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", msg.Address)
+	}
+
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not subject to clawback", msg.Address)
+	}
+
+	if !va.FunderAddress.Equals(msg.FunderAddress) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "clawback can only be requested by the original funder %s", va.FunderAddress)
+	}
+
+	if err := s.clawback(ctx, va, dest); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FunderAddress.String()),
+		),
+	)
+
+	return &types.MsgClawbackResponse{}, nil
+}
+
+// clawback truncates the vesting schedule of the account at the current block
+// time and transfers the unvested coins to dest. Unbonded coins are taken
+// first, then unbonding delegation entries and finally delegations, so that
+// staked coins move with their bookkeeping intact. Coins lost to slashing
+// cannot be clawed back.
+func (s msgServer) clawback(ctx sdk.Context, va *types.ClawbackVestingAccount, dest sdk.AccAddress) error {
+	toClawBack := va.ComputeClawback(ctx.BlockTime().Unix())
+	if toClawBack.IsZero() {
+		return nil
+	}
+
+	addr := va.GetAddress()
+	bondDenom := s.BondDenom(ctx)
+
+	encumbered := va.GetVestingCoins(ctx.BlockTime())
+	bonded := sdk.NewCoins(sdk.NewCoin(bondDenom, s.GetDelegatorBonded(ctx, addr)))
+	unbonding := sdk.NewCoins(sdk.NewCoin(bondDenom, s.GetDelegatorUnbonding(ctx, addr)))
+	unbonded := s.GetAllBalances(ctx, addr)
+	toClawBack = va.UpdateDelegation(encumbered, toClawBack, bonded, unbonding, unbonded)
+
+	// store the truncated schedule first so the unvested coins are no longer
+	// locked when sending them
+	s.SetAccount(ctx, va)
+
+	spendable := s.SpendableCoins(ctx, addr)
+	toXfer := sdk.NewCoins()
+	for _, coin := range toClawBack {
+		toXfer = toXfer.Add(sdk.NewCoin(coin.Denom, sdk.MinInt(coin.Amount, spendable.AmountOf(coin.Denom))))
+	}
+
+	if err := s.SendCoins(ctx, addr, dest, toXfer); err != nil {
+		return err
+	}
+
+	want := toClawBack.Sub(toXfer).AmountOf(bondDenom)
+	for _, ubd := range s.GetUnbondingDelegations(ctx, addr, math.MaxUint16) {
+		if !want.IsPositive() {
+			return nil
+		}
+
+		want = want.Sub(s.TransferUnbonding(ctx, addr, dest, ubd.ValidatorAddress, want))
+	}
+
+	for _, delegation := range s.GetDelegatorDelegations(ctx, addr, math.MaxUint16) {
+		if !want.IsPositive() {
+			return nil
+		}
+
+		validator, found := s.GetValidator(ctx, delegation.ValidatorAddress)
+		if !found {
+			continue
+		}
+
+		wantShares, err := validator.SharesFromTokensTruncated(want)
+		if err != nil {
+			// the validator has no tokens left to claw back
+			continue
+		}
+
+		// round up so that we never claw back less than was transferred
+		transferred := s.TransferDelegation(ctx, addr, dest, delegation.ValidatorAddress, wantShares)
+		want = want.Sub(validator.TokensFromSharesRoundUp(transferred).RoundInt())
+	}
+
+	return nil
+}
+
+// newBaseAccount checks that the amount can be sent to the given address and
+// that no account exists there yet, and returns a new base account for it.
+func (s msgServer) newBaseAccount(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Coins) (*authtypes.BaseAccount, error) {
+	if err := s.SendEnabledCoins(ctx, amount...); err != nil {
+		return nil, err
+	}
+
+	if s.BlockedAddr(addr) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", addr)
+	}
+
+	if acc := s.GetAccount(ctx, addr); acc != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", addr)
+	}
+
+	baseAccount, ok := s.NewAccountWithAddress(ctx, addr).(*authtypes.BaseAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount, got: %T", baseAccount)
+	}
+
+	return baseAccount, nil
+}
+
+// fundVestingAccount validates and stores the vesting account and sends it the
+// amount from the sender.
+func (s msgServer) fundVestingAccount(ctx sdk.Context, acc authtypes.GenesisAccount, from sdk.AccAddress, amount sdk.Coins) error {
+	if err := acc.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	s.SetAccount(ctx, acc)
+
+	defer func() {
+		telemetry.IncrCounter(1, "new", "account")
+
+		for _, a := range amount {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	if err := s.SendCoins(ctx, from, acc.GetAddress(), amount); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, from.String()),
+		),
+	)
+
+	return nil
+}
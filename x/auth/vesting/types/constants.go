@@ -0,0 +1,12 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "vesting"
+
+	// AttributeValueCategory is an alias for the message event value.
+	AttributeValueCategory = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+)
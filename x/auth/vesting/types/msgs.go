@@ -0,0 +1,245 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// vesting message types
+const (
+	TypeMsgCreateVestingAccount         = "msg_create_vesting_account"
+	TypeMsgCreatePeriodicVestingAccount = "msg_create_periodic_vesting_account"
+	TypeMsgCreateClawbackVestingAccount = "msg_create_clawback_vesting_account"
+	TypeMsgClawback                     = "msg_clawback"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateVestingAccount{}
+	_ sdk.Msg = &MsgCreatePeriodicVestingAccount{}
+	_ sdk.Msg = &MsgCreateClawbackVestingAccount{}
+	_ sdk.Msg = &MsgClawback{}
+)
+
+// NewMsgCreateVestingAccount returns a reference to a new MsgCreateVestingAccount.
+//nolint:interfacer
+func NewMsgCreateVestingAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, endTime int64, delayed bool) *MsgCreateVestingAccount {
+	return &MsgCreateVestingAccount{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      amount,
+		EndTime:     endTime,
+		Delayed:     delayed,
+	}
+}
+
+// Route returns the message route for a MsgCreateVestingAccount.
+func (msg MsgCreateVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateVestingAccount.
+func (msg MsgCreateVestingAccount) Type() string { return TypeMsgCreateVestingAccount }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateVestingAccount) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing from address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing to address")
+	}
+	if !msg.Amount.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	if !msg.Amount.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	if msg.EndTime <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid end time")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreateVestingAccount.
+func (msg MsgCreateVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the expected signers for a MsgCreateVestingAccount.
+func (msg MsgCreateVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// NewMsgCreatePeriodicVestingAccount returns a reference to a new
+// MsgCreatePeriodicVestingAccount.
+//nolint:interfacer
+func NewMsgCreatePeriodicVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, periods []Period) *MsgCreatePeriodicVestingAccount {
+	return &MsgCreatePeriodicVestingAccount{
+		FromAddress:    fromAddr,
+		ToAddress:      toAddr,
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// Route returns the message route for a MsgCreatePeriodicVestingAccount.
+func (msg MsgCreatePeriodicVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreatePeriodicVestingAccount.
+func (msg MsgCreatePeriodicVestingAccount) Type() string {
+	return TypeMsgCreatePeriodicVestingAccount
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreatePeriodicVestingAccount) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing from address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing to address")
+	}
+	if msg.StartTime < 1 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid start time of %d, length must be greater than 0", msg.StartTime)
+	}
+	if len(msg.VestingPeriods) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing vesting periods")
+	}
+
+	for i, period := range msg.VestingPeriods {
+		if period.Length < 1 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid period length of %d in period %d, length must be greater than 0", period.Length, i)
+		}
+		if !period.Amount.IsValid() || !period.Amount.IsAllPositive() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s in period %d", period.Amount, i)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreatePeriodicVestingAccount.
+func (msg MsgCreatePeriodicVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the expected signers for a MsgCreatePeriodicVestingAccount.
+func (msg MsgCreatePeriodicVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// NewMsgCreateClawbackVestingAccount returns a reference to a new
+// MsgCreateClawbackVestingAccount.
+//nolint:interfacer
+func NewMsgCreateClawbackVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, lockupPeriods, vestingPeriods []Period) *MsgCreateClawbackVestingAccount {
+	return &MsgCreateClawbackVestingAccount{
+		FromAddress:    fromAddr,
+		ToAddress:      toAddr,
+		StartTime:      startTime,
+		LockupPeriods:  lockupPeriods,
+		VestingPeriods: vestingPeriods,
+	}
+}
+
+// Route returns the message route for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Type() string {
+	return TypeMsgCreateClawbackVestingAccount
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateClawbackVestingAccount) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing from address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing to address")
+	}
+	if msg.StartTime < 1 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid start time of %d, length must be greater than 0", msg.StartTime)
+	}
+	if len(msg.LockupPeriods) == 0 && len(msg.VestingPeriods) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing lockup and vesting periods")
+	}
+
+	for i, period := range msg.LockupPeriods {
+		if period.Length < 1 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid period length of %d in lockup period %d, length must be greater than 0", period.Length, i)
+		}
+		if !period.Amount.IsValid() || !period.Amount.IsAllPositive() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s in lockup period %d", period.Amount, i)
+		}
+	}
+
+	for i, period := range msg.VestingPeriods {
+		if period.Length < 1 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid period length of %d in vesting period %d, length must be greater than 0", period.Length, i)
+		}
+		if !period.Amount.IsValid() || !period.Amount.IsAllPositive() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s in vesting period %d", period.Amount, i)
+		}
+	}
+
+	if len(msg.LockupPeriods) > 0 && len(msg.VestingPeriods) > 0 {
+		lockupTotal := Periods(msg.LockupPeriods).TotalAmount()
+		vestingTotal := Periods(msg.VestingPeriods).TotalAmount()
+		if !lockupTotal.IsEqual(vestingTotal) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "lockup total %s does not match vesting total %s", lockupTotal, vestingTotal)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the expected signers for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// NewMsgClawback returns a reference to a new MsgClawback. An empty dest
+// address returns the clawed-back coins to the funder.
+//nolint:interfacer
+func NewMsgClawback(funder, addr, dest sdk.AccAddress) *MsgClawback {
+	return &MsgClawback{
+		FunderAddress: funder,
+		Address:       addr,
+		DestAddress:   dest,
+	}
+}
+
+// Route returns the message route for a MsgClawback.
+func (msg MsgClawback) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgClawback.
+func (msg MsgClawback) Type() string { return TypeMsgClawback }
+
+// ValidateBasic Implements Msg.
+func (msg MsgClawback) ValidateBasic() error {
+	if msg.FunderAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing funder address")
+	}
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing account address")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgClawback.
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the expected signers for a MsgClawback.
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FunderAddress}
+}
@@ -0,0 +1,141 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func TestMsgCreateVestingAccountValidateBasic(t *testing.T) {
+	from := sdk.AccAddress([]byte("from________________"))
+	to := sdk.AccAddress([]byte("to__________________"))
+	amount := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100))
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgCreateVestingAccount
+		expectErr bool
+	}{
+		{"valid", types.NewMsgCreateVestingAccount(from, to, amount, 100, false), false},
+		{"valid delayed", types.NewMsgCreateVestingAccount(from, to, amount, 100, true), false},
+		{"empty from", types.NewMsgCreateVestingAccount(nil, to, amount, 100, false), true},
+		{"empty to", types.NewMsgCreateVestingAccount(from, nil, amount, 100, false), true},
+		{"empty amount", types.NewMsgCreateVestingAccount(from, to, sdk.Coins{}, 100, false), true},
+		{"invalid amount", types.NewMsgCreateVestingAccount(from, to, sdk.Coins{sdk.Coin{Denom: stakeDenom, Amount: sdk.NewInt(-1)}}, 100, false), true},
+		{"invalid end time", types.NewMsgCreateVestingAccount(from, to, amount, 0, false), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgCreatePeriodicVestingAccountValidateBasic(t *testing.T) {
+	from := sdk.AccAddress([]byte("from________________"))
+	to := sdk.AccAddress([]byte("to__________________"))
+	periods := []types.Period{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 50))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 50))},
+	}
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgCreatePeriodicVestingAccount
+		expectErr bool
+	}{
+		{"valid", types.NewMsgCreatePeriodicVestingAccount(from, to, 1, periods), false},
+		{"empty from", types.NewMsgCreatePeriodicVestingAccount(nil, to, 1, periods), true},
+		{"empty to", types.NewMsgCreatePeriodicVestingAccount(from, nil, 1, periods), true},
+		{"invalid start time", types.NewMsgCreatePeriodicVestingAccount(from, to, 0, periods), true},
+		{"no periods", types.NewMsgCreatePeriodicVestingAccount(from, to, 1, nil), true},
+		{"invalid period length", types.NewMsgCreatePeriodicVestingAccount(from, to, 1, []types.Period{
+			{Length: 0, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 50))},
+		}), true},
+		{"empty period amount", types.NewMsgCreatePeriodicVestingAccount(from, to, 1, []types.Period{
+			{Length: 100, Amount: sdk.Coins{}},
+		}), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgCreateClawbackVestingAccountValidateBasic(t *testing.T) {
+	from := sdk.AccAddress([]byte("from________________"))
+	to := sdk.AccAddress([]byte("to__________________"))
+	lockupPeriods := []types.Period{
+		{Length: 200, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100))},
+	}
+	vestingPeriods := []types.Period{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 50))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 50))},
+	}
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgCreateClawbackVestingAccount
+		expectErr bool
+	}{
+		{"valid", types.NewMsgCreateClawbackVestingAccount(from, to, 1, lockupPeriods, vestingPeriods), false},
+		{"valid without lockup", types.NewMsgCreateClawbackVestingAccount(from, to, 1, nil, vestingPeriods), false},
+		{"valid without vesting", types.NewMsgCreateClawbackVestingAccount(from, to, 1, lockupPeriods, nil), false},
+		{"empty from", types.NewMsgCreateClawbackVestingAccount(nil, to, 1, lockupPeriods, vestingPeriods), true},
+		{"empty to", types.NewMsgCreateClawbackVestingAccount(from, nil, 1, lockupPeriods, vestingPeriods), true},
+		{"invalid start time", types.NewMsgCreateClawbackVestingAccount(from, to, 0, lockupPeriods, vestingPeriods), true},
+		{"no periods", types.NewMsgCreateClawbackVestingAccount(from, to, 1, nil, nil), true},
+		{"invalid period length", types.NewMsgCreateClawbackVestingAccount(from, to, 1, []types.Period{
+			{Length: 0, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100))},
+		}, vestingPeriods), true},
+		{"mismatched totals", types.NewMsgCreateClawbackVestingAccount(from, to, 1, []types.Period{
+			{Length: 200, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 99))},
+		}, vestingPeriods), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgClawbackValidateBasic(t *testing.T) {
+	funder := sdk.AccAddress([]byte("funder______________"))
+	addr := sdk.AccAddress([]byte("addr________________"))
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgClawback
+		expectErr bool
+	}{
+		{"valid", types.NewMsgClawback(funder, addr, nil), false},
+		{"valid with dest", types.NewMsgClawback(funder, addr, funder), false},
+		{"empty funder", types.NewMsgClawback(nil, addr, nil), true},
+		{"empty address", types.NewMsgClawback(funder, nil, nil), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
@@ -17,6 +17,7 @@ var (
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
 )
 
 //-----------------------------------------------------------------------------
@@ -172,8 +173,10 @@ type vestingAccountYAML struct {
 	EndTime          int64          `json:"end_time" yaml:"end_time"`
 
 	// custom fields based on concrete vesting type which can be omitted
-	StartTime      int64   `json:"start_time,omitempty" yaml:"start_time,omitempty"`
-	VestingPeriods Periods `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	StartTime      int64          `json:"start_time,omitempty" yaml:"start_time,omitempty"`
+	VestingPeriods Periods        `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	FunderAddress  sdk.AccAddress `json:"funder_address,omitempty" yaml:"funder_address,omitempty"`
+	LockupPeriods  Periods        `json:"lockup_periods,omitempty" yaml:"lockup_periods,omitempty"`
 }
 
 func (bva BaseVestingAccount) String() string {
@@ -555,3 +558,267 @@ func (dva DelayedVestingAccount) String() string {
 	out, _ := dva.MarshalYAML()
 	return out.(string)
 }
+
+//-----------------------------------------------------------------------------
+// Clawback Vesting Account
+
+var _ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+var _ authtypes.GenesisAccount = (*ClawbackVestingAccount)(nil)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount. Both the
+// lockup and the vesting schedule start at startTime and must each total the
+// original vesting amount.
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, originalVesting sdk.Coins, startTime int64, lockupPeriods, vestingPeriods Periods) *ClawbackVestingAccount {
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         clawbackEndTime(startTime, lockupPeriods, vestingPeriods),
+	}
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder,
+		StartTime:          startTime,
+		LockupPeriods:      lockupPeriods,
+		VestingPeriods:     vestingPeriods,
+	}
+}
+
+// GetVestedCoins returns the total number of vested coins. Coins are only
+// considered vested, and hence spendable, once they are both unlocked and
+// vested. If no coins are vested, nil is returned.
+func (va ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	return coinsMin(va.GetUnlockedOnly(blockTime), va.GetVestedOnly(blockTime))
+}
+
+// GetVestingCoins returns the total number of vesting coins, i.e. the coins
+// which are still locked or unvested.
+func (va ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return va.OriginalVesting.Sub(va.GetVestedCoins(blockTime))
+}
+
+// GetUnlockedOnly returns the coins unlocked by the lockup schedule alone.
+func (va ClawbackVestingAccount) GetUnlockedOnly(blockTime time.Time) sdk.Coins {
+	return readSchedule(va.StartTime, va.LockupPeriods, blockTime.Unix())
+}
+
+// GetVestedOnly returns the coins vested by the vesting schedule alone. These
+// are the coins no longer subject to clawback.
+func (va ClawbackVestingAccount) GetVestedOnly(blockTime time.Time) sdk.Coins {
+	return readSchedule(va.StartTime, va.VestingPeriods, blockTime.Unix())
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked).
+func (va ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return va.BaseVestingAccount.LockedCoinsFromVesting(va.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (va *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	va.BaseVestingAccount.TrackDelegation(balance, va.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting and lockup start for a clawback
+// vesting account.
+func (va ClawbackVestingAccount) GetStartTime() int64 {
+	return va.StartTime
+}
+
+// GetFunder returns the address allowed to claw back the unvested coins.
+func (va ClawbackVestingAccount) GetFunder() sdk.AccAddress {
+	return va.FunderAddress
+}
+
+// GetLockupPeriods returns the lockup periods of the account.
+func (va ClawbackVestingAccount) GetLockupPeriods() Periods {
+	return va.LockupPeriods
+}
+
+// GetVestingPeriods returns the vesting periods of the account.
+func (va ClawbackVestingAccount) GetVestingPeriods() Periods {
+	return va.VestingPeriods
+}
+
+// Validate checks for errors on the account fields
+func (va ClawbackVestingAccount) Validate() error {
+	if va.GetStartTime() > va.GetEndTime() {
+		return errors.New("vesting start-time cannot be after end-time")
+	}
+	if va.FunderAddress.Empty() {
+		return errors.New("funder address cannot be empty")
+	}
+
+	if clawbackEndTime(va.StartTime, va.LockupPeriods, va.VestingPeriods) != va.EndTime {
+		return errors.New("vesting end time does not match length of all lockup and vesting periods")
+	}
+	if !Periods(va.LockupPeriods).TotalAmount().IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in lockup periods")
+	}
+	if !Periods(va.VestingPeriods).TotalAmount().IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+
+	return va.BaseVestingAccount.Validate()
+}
+
+// ComputeClawback removes all future vesting events from the account and
+// returns the coins that are no longer owed to it. Vesting events at or
+// before clawbackTime are kept, the lockup schedule is capped to the new
+// original vesting amount, and the end time is adjusted accordingly. The
+// delegation bookkeeping is left to UpdateDelegation.
+func (va *ClawbackVestingAccount) ComputeClawback(clawbackTime int64) sdk.Coins {
+	totalVested := sdk.NewCoins()
+	totalUnvested := sdk.NewCoins()
+
+	vestTime := va.StartTime
+	unvestedIdx := len(va.VestingPeriods)
+	for i, period := range va.VestingPeriods {
+		vestTime += period.Length
+		if vestTime > clawbackTime && unvestedIdx == len(va.VestingPeriods) {
+			unvestedIdx = i
+		}
+
+		if i < unvestedIdx {
+			totalVested = totalVested.Add(period.Amount...)
+		} else {
+			totalUnvested = totalUnvested.Add(period.Amount...)
+		}
+	}
+
+	va.VestingPeriods = va.VestingPeriods[:unvestedIdx]
+	va.LockupPeriods = capPeriods(va.LockupPeriods, totalVested)
+	va.OriginalVesting = totalVested
+
+	va.EndTime = clawbackEndTime(va.StartTime, va.LockupPeriods, va.VestingPeriods)
+
+	return totalUnvested
+}
+
+// UpdateDelegation adjusts the delegation bookkeeping of the account for a
+// clawback of toClawBack, given the coins still encumbered by the schedules
+// and the bonded, unbonding and unbonded coins the account actually holds.
+// Clawback takes unbonded coins first, so delegated coins are only reduced
+// once those are exhausted. Coins lost to slashing remain accounted as
+// delegated. It returns the amount to claw back, capped to what the account
+// holds.
+func (va *ClawbackVestingAccount) UpdateDelegation(encumbered, toClawBack, bonded, unbonding, unbonded sdk.Coins) sdk.Coins {
+	delegated := bonded.Add(unbonding...)
+	oldDelegated := va.DelegatedVesting.Add(va.DelegatedFree...)
+	slashed := oldDelegated.Sub(coinsMin(delegated, oldDelegated))
+
+	total := delegated.Add(unbonded...)
+	toClawBack = coinsMin(toClawBack, total)
+
+	newDelegated := coinsMin(delegated, total.Sub(toClawBack)).Add(slashed...)
+	va.DelegatedVesting = coinsMin(encumbered, newDelegated)
+	va.DelegatedFree = newDelegated.Sub(va.DelegatedVesting)
+
+	return toClawBack
+}
+
+func (va ClawbackVestingAccount) String() string {
+	out, _ := va.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (va ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	alias := vestingAccountYAML{
+		Address:          va.Address,
+		AccountNumber:    va.AccountNumber,
+		Sequence:         va.Sequence,
+		OriginalVesting:  va.OriginalVesting,
+		DelegatedFree:    va.DelegatedFree,
+		DelegatedVesting: va.DelegatedVesting,
+		EndTime:          va.EndTime,
+		StartTime:        va.StartTime,
+		VestingPeriods:   va.VestingPeriods,
+		FunderAddress:    va.FunderAddress,
+		LockupPeriods:    va.LockupPeriods,
+	}
+
+	pk := va.GetPubKey()
+	if pk != nil {
+		pks, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pk)
+		if err != nil {
+			return nil, err
+		}
+
+		alias.PubKey = pks
+	}
+
+	bz, err := yaml.Marshal(alias)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(bz), err
+}
+
+// clawbackEndTime returns the time at which both the lockup and the vesting
+// schedules starting at startTime have ended.
+func clawbackEndTime(startTime int64, lockupPeriods, vestingPeriods Periods) int64 {
+	endTime := startTime + lockupPeriods.TotalLength()
+	if vestingEnd := startTime + vestingPeriods.TotalLength(); vestingEnd > endTime {
+		return vestingEnd
+	}
+
+	return endTime
+}
+
+// readSchedule returns the sum of the amounts of the periods, starting at
+// startTime, which have ended by readTime.
+func readSchedule(startTime int64, periods Periods, readTime int64) sdk.Coins {
+	var coins sdk.Coins
+
+	if readTime < startTime {
+		return coins
+	}
+
+	endTime := startTime
+	for _, period := range periods {
+		endTime += period.Length
+		if endTime > readTime {
+			break
+		}
+
+		coins = coins.Add(period.Amount...)
+	}
+
+	return coins
+}
+
+// capPeriods returns a copy of the periods whose cumulative amounts never
+// exceed the given cap, dropping trailing periods left without any coins.
+func capPeriods(periods Periods, cap sdk.Coins) Periods {
+	capped := make(Periods, 0, len(periods))
+	remaining := cap
+
+	for _, period := range periods {
+		amount := coinsMin(period.Amount, remaining)
+		remaining = remaining.Sub(amount)
+		capped = append(capped, Period{Length: period.Length, Amount: amount})
+	}
+
+	for len(capped) > 0 && capped[len(capped)-1].Amount.IsZero() {
+		capped = capped[:len(capped)-1]
+	}
+
+	return capped
+}
+
+// coinsMin returns the denomination-wise minimum of the two coin sets.
+func coinsMin(a, b sdk.Coins) sdk.Coins {
+	var min sdk.Coins
+
+	for _, coin := range a {
+		amount := sdk.MinInt(coin.Amount, b.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			min = min.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return min
+}
@@ -578,6 +578,115 @@ func TestTrackUndelegationPeriodicVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pva.DelegatedVesting)
 }
 
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, funder := testdata.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	require.Equal(t, now.Add(18*time.Hour).Unix(), va.EndTime)
+
+	// require no coins vested at the beginning of the schedules
+	require.Nil(t, va.GetVestedCoins(now))
+	require.Equal(t, origCoins, va.GetVestingCoins(now))
+
+	// require coins vested but still locked not to be considered vested
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestedOnly(now.Add(6*time.Hour)))
+	require.Nil(t, va.GetVestedCoins(now.Add(6*time.Hour)))
+	require.Equal(t, origCoins, va.LockedCoins(now.Add(6*time.Hour)))
+
+	// require unlocked coins to be limited by the vesting schedule
+	require.Equal(t, origCoins, va.GetUnlockedOnly(now.Add(12*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestedCoins(now.Add(12*time.Hour)))
+
+	// require all coins vested at the end of both schedules
+	require.Equal(t, origCoins, va.GetVestedCoins(now.Add(18*time.Hour)))
+	require.True(t, va.LockedCoins(now.Add(18*time.Hour)).IsZero())
+}
+
+func TestComputeClawback(t *testing.T) {
+	now := tmtime.Now()
+	c := func(fee, stake int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(feeDenom, fee), sdk.NewInt64Coin(stakeDenom, stake))
+	}
+	lockupPeriods := types.Periods{
+		types.Period{Length: 100, Amount: c(600, 60)},
+		types.Period{Length: 100, Amount: c(400, 40)},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: 50, Amount: c(200, 20)},
+		types.Period{Length: 50, Amount: c(300, 30)},
+		types.Period{Length: 200, Amount: c(500, 50)},
+	}
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, funder := testdata.KeyTestPubAddr()
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, funder, c(1000, 100), now.Unix(), lockupPeriods, vestingPeriods)
+
+	// claw back at the end of the second vesting period
+	clawedBack := va.ComputeClawback(now.Unix() + 100)
+	require.Equal(t, c(500, 50), clawedBack)
+	require.Equal(t, c(500, 50), va.OriginalVesting)
+	require.Equal(t, []types.Period{{Length: 50, Amount: c(200, 20)}, {Length: 50, Amount: c(300, 30)}}, va.VestingPeriods)
+	require.Equal(t, []types.Period{{Length: 100, Amount: c(500, 50)}}, va.LockupPeriods)
+	require.Equal(t, now.Unix()+100, va.EndTime)
+	require.NoError(t, va.Validate())
+
+	// nothing left to claw back
+	require.True(t, va.ComputeClawback(now.Unix()+100).IsZero())
+}
+
+func TestUpdateDelegationClawback(t *testing.T) {
+	c := func(amt int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, amt))
+	}
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, funder := testdata.KeyTestPubAddr()
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	periods := types.Periods{types.Period{Length: 100, Amount: c(100)}}
+
+	testCases := []struct {
+		name                string
+		delegatedVesting    sdk.Coins
+		delegatedFree       sdk.Coins
+		bonded, unbonded    sdk.Coins
+		toClawBack          sdk.Coins
+		expClawBack         sdk.Coins
+		expDelegatedVesting sdk.Coins
+		expDelegatedFree    sdk.Coins
+	}{
+		{"unbonded covers clawback", c(40), nil, c(40), c(60), c(50), c(50), c(10), c(30)},
+		{"clawback takes delegations", c(80), nil, c(80), c(20), c(50), c(50), c(10), c(40)},
+		{"slashed delegations", c(80), nil, c(40), c(20), c(100), c(60), c(10), c(30)},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			va := types.NewClawbackVestingAccount(bacc, funder, c(100), 0, periods, periods)
+			va.DelegatedVesting = tc.delegatedVesting
+			va.DelegatedFree = tc.delegatedFree
+
+			clawBack := va.UpdateDelegation(c(10), tc.toClawBack, tc.bonded, nil, tc.unbonded)
+			require.Equal(t, tc.expClawBack, clawBack)
+			require.Equal(t, tc.expDelegatedVesting, va.DelegatedVesting)
+			require.Equal(t, tc.expDelegatedFree, va.DelegatedFree)
+		})
+	}
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -633,6 +742,27 @@ func TestGenesisAccountValidate(t *testing.T) {
 				0, types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}}),
 			true,
 		},
+		{
+			"valid clawback vesting account",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0,
+				types.Periods{types.Period{Length: int64(50), Amount: initialVesting}},
+				types.Periods{types.Period{Length: int64(100), Amount: initialVesting}}),
+			false,
+		},
+		{
+			"invalid clawback lockup amounts",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0,
+				types.Periods{types.Period{Length: int64(50), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}},
+				types.Periods{types.Period{Length: int64(100), Amount: initialVesting}}),
+			true,
+		},
+		{
+			"clawback vesting account without funder",
+			types.NewClawbackVestingAccount(baseAcc, nil, initialVesting, 0,
+				types.Periods{types.Period{Length: int64(50), Amount: initialVesting}},
+				types.Periods{types.Period{Length: int64(100), Amount: initialVesting}}),
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -707,3 +837,24 @@ func TestDelayedVestingAccountMarshal(t *testing.T) {
 	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
 	require.NotNil(t, err)
 }
+
+func TestClawbackVestingAccountMarshal(t *testing.T) {
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 5))
+	baseAcc := authtypes.NewBaseAccount(addr, pubkey, 10, 50)
+
+	acc := types.NewClawbackVestingAccount(baseAcc, addr, coins, time.Now().Unix(), types.Periods{types.Period{3600, coins}}, types.Periods{types.Period{7200, coins}})
+
+	bz, err := app.AccountKeeper.MarshalAccount(acc)
+	require.Nil(t, err)
+
+	acc2, err := app.AccountKeeper.UnmarshalAccount(bz)
+	require.Nil(t, err)
+	require.IsType(t, &types.ClawbackVestingAccount{}, acc2)
+	require.Equal(t, acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(t, err)
+}
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	authorizationQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the authz module",
+		Long:                       "",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	authorizationQueryCmd.AddCommand(
+		GetCmdQueryGrants(),
+	)
+
+	return authorizationQueryCmd
+}
+
+// GetCmdQueryGrants implements the query authorization command.
+func GetCmdQueryGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grants [granter-addr] [grantee-addr] [msg-type-url]?",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "query grants for a granter-grantee pair and optionally a msg-type-url",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query authorization grants for a granter-grantee pair. If msg-type-url
+is set, it will select grants only for that msg type.
+Examples:
+$ %s query %s grants cosmos1skj.. cosmos1skjwj..
+$ %s query %s grants cosmos1skjw.. cosmos1skjwj.. /cosmos.bank.v1beta1.MsgSend
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msgTypeURL := ""
+			if len(args) > 2 {
+				msgTypeURL = args[2]
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Grants(
+				context.Background(),
+				&types.QueryGrantsRequest{
+					Granter:    granter,
+					Grantee:    grantee,
+					MsgTypeUrl: msgTypeURL,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "grants")
+
+	return cmd
+}
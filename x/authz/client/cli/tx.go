@@ -0,0 +1,296 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Flags for the authz tx commands
+const (
+	FlagSpendLimit        = "spend-limit"
+	FlagMsgType           = "msg-type"
+	FlagExpiration        = "expiration"
+	FlagAllowedValidators = "allowed-validators"
+	FlagDenyValidators    = "deny-validators"
+	FlagMaxTokens         = "max-tokens"
+
+	delegate   = "delegate"
+	redelegate = "redelegate"
+	unbond     = "unbond"
+)
+
+// GetTxCmd returns the transaction commands for the authz module
+func GetTxCmd() *cobra.Command {
+	authzTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Authorization transactions subcommands",
+		Long:                       "Authorize and revoke access to execute transactions on behalf of your address",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	authzTxCmd.AddCommand(
+		NewCmdGrantAuthorization(),
+		NewCmdRevokeAuthorization(),
+		NewCmdExecAuthorization(),
+	)
+
+	return authzTxCmd
+}
+
+// NewCmdGrantAuthorization returns a CLI command handler for creating a MsgGrant transaction.
+func NewCmdGrantAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from [granter]",
+		Short: "Grant authorization to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant authorization to an address to execute a transaction on your behalf:
+
+Examples:
+ $ %s tx %s grant cosmos1skjw.. send --spend-limit=1000stake --from=cosmos1skl..
+ $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1beta1.MsgVote --from=cosmos1sk..
+ $ %s tx %s grant cosmos1skjw.. delegate --allowed-validators=cosmosvaloper1.. --from=cosmos1sk..
+`, version.AppName, types.ModuleName, version.AppName, types.ModuleName, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var authorization exported.Authorization
+			switch args[1] {
+			case "send":
+				limit, err := cmd.Flags().GetString(FlagSpendLimit)
+				if err != nil {
+					return err
+				}
+
+				spendLimit, err := sdk.ParseCoins(limit)
+				if err != nil {
+					return err
+				}
+
+				if !spendLimit.IsAllPositive() {
+					return fmt.Errorf("spend-limit should be greater than zero")
+				}
+
+				authorization = banktypes.NewSendAuthorization(spendLimit)
+
+			case "generic":
+				msgType, err := cmd.Flags().GetString(FlagMsgType)
+				if err != nil {
+					return err
+				}
+
+				authorization = types.NewGenericAuthorization(msgType)
+
+			case delegate, unbond, redelegate:
+				authorization, err = stakeAuthorizationFromFlags(cmd, args[1])
+				if err != nil {
+					return err
+				}
+
+			default:
+				return fmt.Errorf("invalid authorization type, %s", args[1])
+			}
+
+			expiration, err := cmd.Flags().GetInt64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, time.Unix(expiration, 0))
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagMsgType, "", "The Msg type URL for generic authorization")
+	cmd.Flags().String(FlagSpendLimit, "", "SpendLimit for Send Authorization, an array of Coins allowed spend")
+	cmd.Flags().String(FlagMaxTokens, "", "The maximum amount of tokens for staking authorizations, unlimited if empty")
+	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
+	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
+	cmd.Flags().Int64(FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "The Unix timestamp. Default is one year.")
+
+	return cmd
+}
+
+func stakeAuthorizationFromFlags(cmd *cobra.Command, authzType string) (exported.Authorization, error) {
+	limit, err := cmd.Flags().GetString(FlagMaxTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	allowValidators, err := cmd.Flags().GetStringSlice(FlagAllowedValidators)
+	if err != nil {
+		return nil, err
+	}
+
+	denyValidators, err := cmd.Flags().GetStringSlice(FlagDenyValidators)
+	if err != nil {
+		return nil, err
+	}
+
+	var maxTokens *sdk.Coin
+	if limit != "" {
+		coin, err := sdk.ParseCoin(limit)
+		if err != nil {
+			return nil, err
+		}
+
+		maxTokens = &coin
+	}
+
+	allowed, err := bech32toValidatorAddresses(allowValidators)
+	if err != nil {
+		return nil, err
+	}
+
+	denied, err := bech32toValidatorAddresses(denyValidators)
+	if err != nil {
+		return nil, err
+	}
+
+	var authorizationType stakingtypes.AuthorizationType
+	switch authzType {
+	case delegate:
+		authorizationType = stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE
+	case unbond:
+		authorizationType = stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE
+	default:
+		authorizationType = stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE
+	}
+
+	return stakingtypes.NewStakeAuthorization(allowed, denied, authorizationType, maxTokens)
+}
+
+// NewCmdRevokeAuthorization returns a CLI command handler for creating a MsgRevoke transaction.
+func NewCmdRevokeAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [grantee] [msg_type_url] --from=[granter]",
+		Short: "revoke authorization",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`revoke authorization from a granter to a grantee:
+Example:
+ $ %s tx %s revoke cosmos1skj.. /cosmos.bank.v1beta1.MsgSend --from=cosmos1skj..
+			`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevoke(clientCtx.GetFromAddress(), grantee, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdExecAuthorization returns a CLI command handler for creating a MsgExec transaction.
+func NewCmdExecAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [msg_tx_json_file] --from [grantee]",
+		Short: "execute tx on behalf of granter account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`execute tx on behalf of granter account:
+Example:
+ $ %s tx %s exec tx.json --from grantee
+ $ %s tx bank send <granter> <recipient> --from <granter> --chain-id <chain-id> --generate-only > tx.json && %s tx %s exec tx.json --from grantee
+			`, version.AppName, types.ModuleName, version.AppName, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			msgs := theTx.GetMsgs()
+			if len(msgs) == 0 {
+				return errors.New("no messages found in the transaction file")
+			}
+
+			msg, err := types.NewMsgExec(clientCtx.GetFromAddress(), msgs)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func bech32toValidatorAddresses(validators []string) ([]sdk.ValAddress, error) {
+	vals := make([]sdk.ValAddress, len(validators))
+	for i, validator := range validators {
+		addr, err := sdk.ValAddressFromBech32(validator)
+		if err != nil {
+			return nil, err
+		}
+		vals[i] = addr
+	}
+
+	return vals, nil
+}
@@ -0,0 +1,39 @@
+package exported
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Authorization represents the interface of various Authorization types
+// implemented by other modules.
+type Authorization interface {
+	proto.Message
+
+	// MsgTypeURL returns the fully-qualified Msg type URL, e.g.
+	// "/cosmos.bank.v1beta1.MsgSend", of the Msg which this authorization
+	// allows to be executed.
+	MsgTypeURL() string
+
+	// Accept determines whether this grant permits the provided sdk.Msg to be
+	// performed, and if so provides an updated authorization instance.
+	Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error)
+
+	// ValidateBasic does a simple validation check that doesn't require access
+	// to any other information.
+	ValidateBasic() error
+}
+
+// AcceptResponse instructs the authz keeper whether a request is accepted and
+// whether the authorization should be updated or deleted afterwards.
+type AcceptResponse struct {
+	// If Accept=true, the controller can accept and authorization and handle the update.
+	Accept bool
+	// If Delete=true, the controller must delete the authorization object and release
+	// storage resources.
+	Delete bool
+	// Controller, who is calling Authorization.Accept must check if `Updated != nil`. If yes,
+	// it must use the updated version and handle the update on the storage level.
+	Updated Authorization
+}
@@ -0,0 +1,39 @@
+package authz
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// InitGenesis initializes the authz module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs *types.GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
+	}
+
+	for _, entry := range gs.Authorization {
+		grant := entry.GetGrant()
+		if grant.IsExpired(ctx.BlockHeader().Time) {
+			continue
+		}
+
+		if err := k.SaveGrant(ctx, entry.Grantee, entry.Granter, entry.GetAuthorization(), grant.Expiration); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the authz module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	var entries []types.GrantAuthorization
+	k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.Grant) bool {
+		entries = append(entries, types.NewGrantAuthorization(granter, grantee, grant))
+		return false
+	})
+
+	return types.NewGenesisState(entries)
+}
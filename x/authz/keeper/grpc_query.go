@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Grants implements the Query/Grants gRPC method.
+func (k Keeper) Grants(c context.Context, req *types.QueryGrantsRequest) (*types.QueryGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Granter.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "empty granter address")
+	}
+
+	if req.Grantee.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "empty grantee address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.MsgTypeUrl != "" {
+		grant, found := k.getGrant(ctx, types.GetGrantStoreKey(req.Granter, req.Grantee, req.MsgTypeUrl))
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "no authorization found for %s type", req.MsgTypeUrl)
+		}
+
+		return &types.QueryGrantsResponse{
+			Grants: []*types.Grant{&grant},
+		}, nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	grantsStore := prefix.NewStore(store, types.GetGrantsPrefix(req.Granter, req.Grantee))
+
+	var grants []*types.Grant
+	pageRes, err := query.Paginate(grantsStore, req.Pagination, func(key []byte, value []byte) error {
+		var grant types.Grant
+		if err := k.cdc.UnmarshalBinaryBare(value, &grant); err != nil {
+			return err
+		}
+
+		grants = append(grants, &grant)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGrantsResponse{
+		Grants:     grants,
+		Pagination: pageRes,
+	}, nil
+}
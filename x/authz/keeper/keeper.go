@@ -0,0 +1,236 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// Keeper defines the authz module's keeper. It stores the grants issued by
+// granters to grantees and dispatches the messages executed on their behalf.
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryMarshaler
+	router   *baseapp.MsgServiceRouter
+}
+
+// NewKeeper constructs a message authorization Keeper
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, router *baseapp.MsgServiceRouter) Keeper {
+	return Keeper{
+		storeKey: storeKey,
+		cdc:      cdc,
+		router:   router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// getGrant returns the grant stored under the given key, if any.
+func (k Keeper) getGrant(ctx sdk.Context, skey []byte) (grant types.Grant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(skey)
+	if bz == nil {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+// update replaces the authorization of an existing grant, keeping its
+// expiration time.
+func (k Keeper) update(ctx sdk.Context, granter, grantee sdk.AccAddress, updated exported.Authorization) error {
+	skey := types.GetGrantStoreKey(granter, grantee, updated.MsgTypeURL())
+	grant, found := k.getGrant(ctx, skey)
+	if !found {
+		return types.ErrNoAuthorizationFound
+	}
+
+	newGrant, err := types.NewGrant(updated, grant.Expiration)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(skey, k.cdc.MustMarshalBinaryBare(&newGrant))
+
+	return nil
+}
+
+// DispatchActions attempts to execute the provided messages via authorization
+// grants from the message signer to the grantee. It returns the result data of
+// each executed message.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
+	results := make([][]byte, len(msgs))
+
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			return nil, types.ErrAuthorizationNumOfSigners
+		}
+
+		granter := signers[0]
+
+		// if the granter is the grantee, the message is executed as if it was
+		// signed directly, without requiring any authorization
+		if !granter.Equals(grantee) {
+			authorization, _ := k.GetCleanAuthorization(ctx, grantee, granter, sdk.MsgTypeURL(msg))
+			if authorization == nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "authorization not found")
+			}
+
+			resp, err := authorization.Accept(ctx, msg)
+			if err != nil {
+				return nil, err
+			}
+
+			if resp.Delete {
+				err = k.DeleteGrant(ctx, grantee, granter, sdk.MsgTypeURL(msg))
+			} else if resp.Updated != nil {
+				err = k.update(ctx, granter, grantee, resp.Updated)
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			if !resp.Accept {
+				return nil, sdkerrors.ErrUnauthorized
+			}
+		}
+
+		handler := k.router.Handler(msg)
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		}
+
+		msgResp, err := handler(ctx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute message; message %v", msg)
+		}
+
+		results[i] = msgResp.Data
+
+		// emit the events from the dispatched actions
+		ctx.EventManager().EmitEvents(msgResp.GetEvents())
+	}
+
+	return results, nil
+}
+
+// SaveGrant method grants the provided authorization to the grantee on the
+// granter's account with the provided expiration time. If there is an existing
+// authorization grant for the same (granter, grantee, Msg type URL) triple, it
+// is overwritten.
+func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization exported.Authorization, expiration time.Time) error {
+	store := ctx.KVStore(k.storeKey)
+
+	grant, err := types.NewGrant(authorization, expiration)
+	if err != nil {
+		return err
+	}
+
+	skey := types.GetGrantStoreKey(granter, grantee, authorization.MsgTypeURL())
+	store.Set(skey, k.cdc.MustMarshalBinaryBare(&grant))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrant,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, authorization.MsgTypeURL()),
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// DeleteGrant revokes any authorization for the provided Msg type URL granted
+// to the grantee by the granter.
+func (k Keeper) DeleteGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, msgTypeURL string) error {
+	store := ctx.KVStore(k.storeKey)
+
+	skey := types.GetGrantStoreKey(granter, grantee, msgTypeURL)
+	if _, found := k.getGrant(ctx, skey); !found {
+		return sdkerrors.Wrapf(types.ErrNoAuthorizationFound, "failed to delete grant with key %X", skey)
+	}
+
+	store.Delete(skey)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevoke,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgTypeURL),
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetAuthorizations returns all the authorizations granted to the grantee by
+// the granter, including expired ones.
+func (k Keeper) GetAuthorizations(ctx sdk.Context, grantee, granter sdk.AccAddress) []exported.Authorization {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.GetGrantsPrefix(granter, grantee))
+	defer iter.Close()
+
+	var authorizations []exported.Authorization
+	for ; iter.Valid(); iter.Next() {
+		var grant types.Grant
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+
+		authorizations = append(authorizations, grant.GetAuthorization())
+	}
+
+	return authorizations
+}
+
+// GetCleanAuthorization returns an authorization for the Msg type URL granted
+// to the grantee by the granter along with its expiration time. If the grant
+// has expired, it is deleted and nil is returned.
+func (k Keeper) GetCleanAuthorization(ctx sdk.Context, grantee, granter sdk.AccAddress, msgTypeURL string) (exported.Authorization, time.Time) {
+	grant, found := k.getGrant(ctx, types.GetGrantStoreKey(granter, grantee, msgTypeURL))
+	if !found {
+		return nil, time.Time{}
+	}
+
+	if grant.IsExpired(ctx.BlockHeader().Time) {
+		// the error can be ignored since the grant is known to exist
+		_ = k.DeleteGrant(ctx, grantee, granter, msgTypeURL)
+		return nil, time.Time{}
+	}
+
+	return grant.GetAuthorization(), grant.Expiration
+}
+
+// IterateGrants iterates over all authorization grants. The iteration stops
+// when the handler function returns true.
+func (k Keeper) IterateGrants(ctx sdk.Context, handler func(granter, grantee sdk.AccAddress, grant types.Grant) bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.GrantKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var grant types.Grant
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+
+		granter, grantee, _ := types.ParseGrantStoreKey(iter.Key())
+		if handler(granter, grantee, grant) {
+			break
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var bankSendAuthMsgType = banktypes.SendAuthorization{}.MsgTypeURL()
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	queryClient types.QueryClient
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.AuthzKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+}
+
+func (s *KeeperTestSuite) TestKeeper() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	granterAddr, granteeAddr := addrs[0], addrs[1]
+	now := ctx.BlockHeader().Time
+
+	s.T().Log("verify that no authorization returns nil")
+	authorization, expiration := app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+	s.Require().Zero(expiration)
+
+	s.T().Log("verify that an expired authorization is rejected")
+	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+	x := &banktypes.SendAuthorization{SpendLimit: newCoins}
+	err := app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, x, now.Add(-1*time.Hour))
+	s.Require().NoError(err)
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+
+	s.T().Log("verify that a valid authorization can be fetched")
+	err = app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, x, now.Add(time.Hour))
+	s.Require().NoError(err)
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().NotNil(authorization)
+	s.Require().Equal(bankSendAuthMsgType, authorization.MsgTypeURL())
+
+	s.T().Log("verify fetching an authorization with the wrong msg type fails")
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, sdk.MsgTypeURL(&banktypes.MsgMultiSend{}))
+	s.Require().Nil(authorization)
+
+	s.T().Log("verify fetching an authorization with the wrong grantee fails")
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, addrs[2], granterAddr, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+
+	s.T().Log("verify revoke works")
+	s.Require().NoError(app.AuthzKeeper.DeleteGrant(ctx, granteeAddr, granterAddr, bankSendAuthMsgType))
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+
+	s.T().Log("verify revoking a missing authorization fails")
+	s.Require().Error(app.AuthzKeeper.DeleteGrant(ctx, granteeAddr, granterAddr, bankSendAuthMsgType))
+}
+
+func (s *KeeperTestSuite) TestKeeperIter() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	granterAddr, granteeAddr := addrs[0], addrs[1]
+
+	x := &banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 100))}
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, x, ctx.BlockHeader().Time.Add(time.Hour)))
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, types.NewGenericAuthorization("/cosmos.gov.v1beta1.MsgVote"), ctx.BlockHeader().Time.Add(time.Hour)))
+
+	count := 0
+	app.AuthzKeeper.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.Grant) bool {
+		s.Require().Equal(granterAddr, granter)
+		s.Require().Equal(granteeAddr, grantee)
+		count++
+		return false
+	})
+	s.Require().Equal(2, count)
+	s.Require().Len(app.AuthzKeeper.GetAuthorizations(ctx, granteeAddr, granterAddr), 2)
+}
+
+func (s *KeeperTestSuite) TestKeeperFees() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	granterAddr, granteeAddr, recipientAddr := addrs[0], addrs[1], addrs[2]
+	now := ctx.BlockHeader().Time
+
+	smallCoin := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20))
+	someCoin := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 123))
+
+	msgs := []sdk.Msg{banktypes.NewMsgSend(granterAddr, recipientAddr, someCoin)}
+
+	s.T().Log("verify dispatch fails with no authorization")
+	_, err := app.AuthzKeeper.DispatchActions(ctx, granteeAddr, msgs)
+	s.Require().Error(err)
+
+	s.T().Log("verify dispatch executes a message within the spend limit")
+	err = app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, banktypes.NewSendAuthorization(someCoin.Add(smallCoin...)), now.Add(time.Hour))
+	s.Require().NoError(err)
+
+	results, err := app.AuthzKeeper.DispatchActions(ctx, granteeAddr, msgs)
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+	s.Require().Equal(someCoin, app.BankKeeper.GetAllBalances(ctx, recipientAddr).Sub(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(30000000)))))
+
+	authorization, _ := app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().NotNil(authorization)
+	s.Require().Equal(smallCoin, authorization.(*banktypes.SendAuthorization).SpendLimit)
+
+	s.T().Log("verify dispatch fails when exceeding the spend limit")
+	_, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, msgs)
+	s.Require().Error(err)
+
+	s.T().Log("verify the authorization is deleted once fully spent")
+	_, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, []sdk.Msg{banktypes.NewMsgSend(granterAddr, recipientAddr, smallCoin)})
+	s.Require().NoError(err)
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryGrants() {
+	app, ctx, queryClient, addrs := s.app, s.ctx, s.queryClient, s.addrs
+	granterAddr, granteeAddr := addrs[0], addrs[1]
+
+	_, err := queryClient.Grants(gocontext.Background(), &types.QueryGrantsRequest{})
+	s.Require().Error(err)
+
+	_, err = queryClient.Grants(gocontext.Background(), &types.QueryGrantsRequest{
+		Granter: granterAddr, Grantee: granteeAddr, MsgTypeUrl: bankSendAuthMsgType,
+	})
+	s.Require().Error(err)
+
+	var authorizations []exported.Authorization
+	authorizations = append(authorizations,
+		banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("steak", 100))),
+		types.NewGenericAuthorization("/cosmos.gov.v1beta1.MsgVote"),
+	)
+	for _, a := range authorizations {
+		s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, a, ctx.BlockHeader().Time.Add(time.Hour)))
+	}
+
+	res, err := queryClient.Grants(gocontext.Background(), &types.QueryGrantsRequest{
+		Granter: granterAddr, Grantee: granteeAddr, MsgTypeUrl: bankSendAuthMsgType,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Grants, 1)
+	s.Require().Equal(authorizations[0], res.Grants[0].GetAuthorization())
+
+	res, err = queryClient.Grants(gocontext.Background(), &types.QueryGrantsRequest{
+		Granter: granterAddr, Grantee: granteeAddr,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Grants, 2)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// Grant implements the Msg/Grant method.
+func (k Keeper) Grant(goCtx context.Context, msg *types.MsgGrant) (*types.MsgGrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorization := msg.GetAuthorization()
+	if authorization == nil {
+		return nil, sdkerrors.Wrap(types.ErrUnknownAuthorizationType, "authorization is nil")
+	}
+
+	if !msg.Grant.Expiration.After(ctx.BlockHeader().Time) {
+		return nil, types.ErrInvalidExpirationTime
+	}
+
+	// the authorized Msg must be routable
+	t := authorization.MsgTypeURL()
+	if k.router.HandlerByTypeURL(t) == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "%s doesn't exist", t)
+	}
+
+	if err := k.SaveGrant(ctx, msg.Grantee, msg.Granter, authorization, msg.Grant.Expiration); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+	)
+
+	return &types.MsgGrantResponse{}, nil
+}
+
+// Revoke implements the Msg/Revoke method.
+func (k Keeper) Revoke(goCtx context.Context, msg *types.MsgRevoke) (*types.MsgRevokeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.DeleteGrant(ctx, msg.Grantee, msg.Granter, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+	)
+
+	return &types.MsgRevokeResponse{}, nil
+}
+
+// Exec implements the Msg/Exec method.
+func (k Keeper) Exec(goCtx context.Context, msg *types.MsgExec) (*types.MsgExecResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := k.DispatchActions(ctx, msg.Grantee, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeExec,
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Grantee.String()),
+		),
+	})
+
+	return &types.MsgExecResponse{Results: results}, nil
+}
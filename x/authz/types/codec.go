@@ -0,0 +1,64 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+// RegisterCodec registers the necessary x/authz interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterInterface((*exported.Authorization)(nil), nil)
+	cdc.RegisterConcrete(&MsgGrant{}, "cosmos-sdk/MsgGrant", nil)
+	cdc.RegisterConcrete(&MsgRevoke{}, "cosmos-sdk/MsgRevoke", nil)
+	cdc.RegisterConcrete(&MsgExec{}, "cosmos-sdk/MsgExec", nil)
+	cdc.RegisterConcrete(&GenericAuthorization{}, "cosmos-sdk/GenericAuthorization", nil)
+}
+
+// RegisterInterfaces registers the interfaces types with the interface registry
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgGrant{},
+		&MsgRevoke{},
+		&MsgExec{},
+	)
+	registry.RegisterInterface(
+		"cosmos.authz.v1beta1.Authorization",
+		(*exported.Authorization)(nil),
+		&GenericAuthorization{},
+	)
+}
+
+// RegisterAuthorizationTypeCodec registers an external authorization type
+// defined in another module for the internal ModuleCdc. This allows the MsgGrant
+// to be correctly Amino encoded and decoded.
+func RegisterAuthorizationTypeCodec(o interface{}, name string) {
+	amino.RegisterConcrete(o, name, nil)
+}
+
+// RegisterAuthorizationInterfaceCodec registers an interface used by the
+// fields of an external authorization type, e.g. a protobuf oneof, for the
+// internal ModuleCdc.
+func RegisterAuthorizationInterfaceCodec(ptr interface{}) {
+	amino.RegisterInterface(ptr, nil)
+}
+
+var (
+	amino = codec.New()
+
+	// ModuleCdc references the global x/authz module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding as Amino is
+	// still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/authz and
+	// defined at the application level.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+}
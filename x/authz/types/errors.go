@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/authz module sentinel errors
+var (
+	ErrNoAuthorizationFound      = sdkerrors.Register(ModuleName, 2, "authorization not found")
+	ErrInvalidExpirationTime     = sdkerrors.Register(ModuleName, 3, "expiration time of authorization should be more than current time")
+	ErrUnknownAuthorizationType  = sdkerrors.Register(ModuleName, 4, "unknown authorization type")
+	ErrAuthorizationExpired      = sdkerrors.Register(ModuleName, 5, "authorization expired")
+	ErrGranteeIsGranter          = sdkerrors.Register(ModuleName, 6, "grantee and granter should be different")
+	ErrAuthorizationNumOfSigners = sdkerrors.Register(ModuleName, 7, "authorization can be given to msg with only one signer")
+)
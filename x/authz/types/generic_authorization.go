@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+var _ exported.Authorization = &GenericAuthorization{}
+
+// NewGenericAuthorization creates a new GenericAuthorization object.
+func NewGenericAuthorization(msgTypeURL string) *GenericAuthorization {
+	return &GenericAuthorization{
+		MsgTypeUrl: msgTypeURL,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a GenericAuthorization) MsgTypeURL() string {
+	return a.MsgTypeUrl
+}
+
+// Accept implements Authorization.Accept.
+func (a GenericAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (exported.AcceptResponse, error) {
+	return exported.AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a GenericAuthorization) ValidateBasic() error {
+	if a.MsgTypeUrl == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "msg type URL cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = GenesisState{}
+	_ codectypes.UnpackInterfacesMessage = GrantAuthorization{}
+)
+
+// NewGenesisState creates new GenesisState object
+func NewGenesisState(entries []GrantAuthorization) *GenesisState {
+	return &GenesisState{
+		Authorization: entries,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	for _, a := range gs.Authorization {
+		if a.Granter.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+		}
+		if a.Grantee.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+		}
+		if a.Granter.Equals(a.Grantee) {
+			return ErrGranteeIsGranter
+		}
+
+		auth := a.GetAuthorization()
+		if auth == nil {
+			return sdkerrors.Wrap(ErrUnknownAuthorizationType, "authorization is nil")
+		}
+		if err := auth.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, a := range gs.Authorization {
+		if err := a.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewGrantAuthorization creates a new GrantAuthorization genesis entry.
+func NewGrantAuthorization(granter, grantee sdk.AccAddress, g Grant) GrantAuthorization {
+	return GrantAuthorization{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: g.Authorization,
+		Expiration:    g.Expiration,
+	}
+}
+
+// GetAuthorization returns the cached authorization of the entry, or nil if
+// it is not set or cannot be unpacked.
+func (a GrantAuthorization) GetAuthorization() exported.Authorization {
+	return Grant{Authorization: a.Authorization}.GetAuthorization()
+}
+
+// GetGrant returns the Grant of the genesis entry.
+func (a GrantAuthorization) GetGrant() Grant {
+	return Grant{
+		Authorization: a.Authorization,
+		Expiration:    a.Expiration,
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a GrantAuthorization) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var auth exported.Authorization
+	return unpacker.UnpackAny(a.Authorization, &auth)
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+var _ codectypes.UnpackInterfacesMessage = &Grant{}
+
+// NewGrant returns a new Grant of the given authorization which expires at the
+// given time.
+func NewGrant(a exported.Authorization, expiration time.Time) (Grant, error) {
+	g := Grant{
+		Expiration: expiration,
+	}
+
+	msg, ok := a.(proto.Message)
+	if !ok {
+		return Grant{}, fmt.Errorf("cannot proto marshal %T", a)
+	}
+
+	any, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return Grant{}, err
+	}
+	g.Authorization = any
+
+	return g, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g Grant) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var a exported.Authorization
+	return unpacker.UnpackAny(g.Authorization, &a)
+}
+
+// GetAuthorization returns the cached value from the Grant.Authorization if present.
+func (g Grant) GetAuthorization() exported.Authorization {
+	if g.Authorization == nil {
+		return nil
+	}
+
+	a, ok := g.Authorization.GetCachedValue().(exported.Authorization)
+	if !ok {
+		return nil
+	}
+
+	return a
+}
+
+// ValidateBasic performs a stateless validation of the Grant.
+func (g Grant) ValidateBasic() error {
+	a := g.GetAuthorization()
+	if a == nil {
+		return sdkerrors.Wrap(ErrUnknownAuthorizationType, "authorization is nil")
+	}
+
+	if g.Expiration.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidExpirationTime, "expiration cannot be empty")
+	}
+
+	return a.ValidateBasic()
+}
+
+// IsExpired returns whether the given grant expired at the given time.
+func (g Grant) IsExpired(now time.Time) bool {
+	return !g.Expiration.After(now)
+}
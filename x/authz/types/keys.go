@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "authz"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	GrantKey = []byte{0x01} // prefix for each key to a grant
+)
+
+// GetGrantStoreKey returns the store key of the grant issued by granter to
+// grantee for the given Msg type URL:
+// 0x01 | len(granter) | granter | len(grantee) | grantee | msgTypeURL
+func GetGrantStoreKey(granter, grantee sdk.AccAddress, msgTypeURL string) []byte {
+	return append(GetGrantsPrefix(granter, grantee), []byte(msgTypeURL)...)
+}
+
+// GetGrantsPrefix returns the key prefix of all the grants issued by granter to
+// grantee. Addresses are length prefixed to keep the key unambiguous.
+func GetGrantsPrefix(granter, grantee sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(GrantKey)+2+len(granter)+len(grantee))
+	key = append(key, GrantKey...)
+	key = append(key, byte(len(granter)))
+	key = append(key, granter...)
+	key = append(key, byte(len(grantee)))
+	key = append(key, grantee...)
+
+	return key
+}
+
+// ParseGrantStoreKey splits a grant store key into its granter, grantee and
+// Msg type URL.
+func ParseGrantStoreKey(key []byte) (granter, grantee sdk.AccAddress, msgTypeURL string) {
+	key = key[len(GrantKey):]
+
+	granterLen := int(key[0])
+	granter = sdk.AccAddress(key[1 : 1+granterLen])
+	key = key[1+granterLen:]
+
+	granteeLen := int(key[0])
+	grantee = sdk.AccAddress(key[1 : 1+granteeLen])
+
+	return granter, grantee, string(key[1+granteeLen:])
+}
@@ -0,0 +1,241 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+// authz message types
+const (
+	TypeMsgGrant  = "grant"
+	TypeMsgRevoke = "revoke"
+	TypeMsgExec   = "exec"
+)
+
+var (
+	_ sdk.Msg = &MsgGrant{}
+	_ sdk.Msg = &MsgRevoke{}
+	_ sdk.Msg = &MsgExec{}
+
+	_ codectypes.UnpackInterfacesMessage = &MsgGrant{}
+	_ codectypes.UnpackInterfacesMessage = &MsgExec{}
+)
+
+// NewMsgGrant creates a new MsgGrant
+func NewMsgGrant(granter, grantee sdk.AccAddress, a exported.Authorization, expiration time.Time) (*MsgGrant, error) {
+	g, err := NewGrant(a, expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgGrant{
+		Granter: granter,
+		Grantee: grantee,
+		Grant:   g,
+	}, nil
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgGrant) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgGrant) Type() string { return TypeMsgGrant }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgGrant) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgGrant) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if msg.Granter.Equals(msg.Grantee) {
+		return ErrGranteeIsGranter
+	}
+
+	return msg.Grant.ValidateBasic()
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetAuthorization returns the authorization of the grant, or nil if it is
+// not set or cannot be unpacked.
+func (msg MsgGrant) GetAuthorization() exported.Authorization {
+	return msg.Grant.GetAuthorization()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrant) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return msg.Grant.UnpackInterfaces(unpacker)
+}
+
+// NewMsgRevoke creates a new MsgRevoke
+func NewMsgRevoke(granter, grantee sdk.AccAddress, msgTypeURL string) MsgRevoke {
+	return MsgRevoke{
+		Granter:    granter,
+		Grantee:    grantee,
+		MsgTypeUrl: msgTypeURL,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRevoke) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRevoke) Type() string { return TypeMsgRevoke }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRevoke) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRevoke) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if msg.Granter.Equals(msg.Grantee) {
+		return ErrGranteeIsGranter
+	}
+	if msg.MsgTypeUrl == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing msg type URL")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRevoke) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// NewMsgExec creates a new MsgExec
+func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) (MsgExec, error) {
+	msgsAny := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		pb, ok := msg.(proto.Message)
+		if !ok {
+			return MsgExec{}, fmt.Errorf("cannot proto marshal %T", msg)
+		}
+
+		any, err := codectypes.NewAnyWithValue(pb)
+		if err != nil {
+			return MsgExec{}, err
+		}
+
+		msgsAny[i] = any
+	}
+
+	return MsgExec{
+		Grantee: grantee,
+		Msgs:    msgsAny,
+	}, nil
+}
+
+// GetMessages returns the cached sdk.Msgs from the MsgExec.
+func (msg MsgExec) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, msgAny := range msg.Msgs {
+		m, ok := msgAny.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "messages contains %T which is not a sdk.Msg", msgAny)
+		}
+		msgs[i] = m
+	}
+
+	return msgs, nil
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgExec) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgExec) Type() string { return TypeMsgExec }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Grantee}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgExec) ValidateBasic() error {
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+
+	if len(msg.Msgs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "messages cannot be empty")
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	for _, m := range msgs {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. The executed messages are
+// embedded using their own sign bytes, so that MsgExec does not need to know
+// the Amino names of every Msg type it may carry.
+func (msg MsgExec) GetSignBytes() []byte {
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		panic(err)
+	}
+
+	msgsBz := make([]json.RawMessage, len(msgs))
+	for i, m := range msgs {
+		msgsBz[i] = m.GetSignBytes()
+	}
+
+	bz, err := json.Marshal(map[string]interface{}{
+		"type": "cosmos-sdk/MsgExec",
+		"value": map[string]interface{}{
+			"grantee": msg.Grantee.String(),
+			"msgs":    msgsBz,
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(bz)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgExec) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, m := range msg.Msgs {
+		var msgExecAuthorized sdk.Msg
+		if err := unpacker.UnpackAny(m, &msgExecAuthorized); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
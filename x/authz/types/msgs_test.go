@@ -0,0 +1,130 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	coinsPos = sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+	granter  = sdk.AccAddress("_______granter______")
+	grantee  = sdk.AccAddress("_______grantee______")
+)
+
+func TestMsgGrantAuthorization(t *testing.T) {
+	tests := []struct {
+		title            string
+		granter, grantee sdk.AccAddress
+		authorization    exported.Authorization
+		expiration       time.Time
+		expectErr        bool
+		expectPass       bool
+	}{
+		{"nil granter address", nil, grantee, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now(), false, false},
+		{"nil grantee address", granter, nil, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now(), false, false},
+		{"nil granter and grantee address", nil, nil, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now(), false, false},
+		{"granter is grantee", granter, granter, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now(), false, false},
+		{"nil authorization", granter, grantee, nil, time.Now(), true, false},
+		{"valid test case", granter, grantee, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now().AddDate(0, 1, 0), false, true},
+		{"zero expiration", granter, grantee, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Time{}, false, false},
+		{"invalid authorization", granter, grantee, &banktypes.SendAuthorization{}, time.Now().AddDate(0, 1, 0), false, false},
+	}
+
+	for _, tc := range tests {
+		msg, err := types.NewMsgGrant(tc.granter, tc.grantee, tc.authorization, tc.expiration)
+		if tc.expectErr {
+			require.Error(t, err, tc.title)
+			continue
+		}
+		require.NoError(t, err, tc.title)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.title)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.title)
+		}
+	}
+}
+
+func TestMsgRevokeAuthorization(t *testing.T) {
+	tests := []struct {
+		title            string
+		granter, grantee sdk.AccAddress
+		msgTypeURL       string
+		expectPass       bool
+	}{
+		{"nil granter address", nil, grantee, "hello", false},
+		{"nil grantee address", granter, nil, "hello", false},
+		{"nil granter and grantee address", nil, nil, "hello", false},
+		{"granter is grantee", granter, granter, "hello", false},
+		{"empty msg type URL", granter, grantee, "", false},
+		{"valid test case", granter, grantee, "hello", true},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgRevoke(tc.granter, tc.grantee, tc.msgTypeURL)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.title)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.title)
+		}
+	}
+}
+
+func TestMsgExecAuthorized(t *testing.T) {
+	tests := []struct {
+		title      string
+		grantee    sdk.AccAddress
+		msgs       []sdk.Msg
+		expectPass bool
+	}{
+		{"nil grantee address", nil, []sdk.Msg{}, false},
+		{"zero-messages test", grantee, []sdk.Msg{}, false},
+		{"invalid inner message", grantee, []sdk.Msg{banktypes.NewMsgSend(granter, grantee, sdk.Coins{})}, false},
+		{"valid test", grantee, []sdk.Msg{banktypes.NewMsgSend(granter, grantee, coinsPos)}, true},
+	}
+
+	for _, tc := range tests {
+		msg, err := types.NewMsgExec(tc.grantee, tc.msgs)
+		require.NoError(t, err)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.title)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.title)
+		}
+	}
+}
+
+func TestMsgGrantGetSignBytes(t *testing.T) {
+	expiration := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	sendAuth := banktypes.NewSendAuthorization(coinsPos)
+	msg, err := types.NewMsgGrant(granter, grantee, sendAuth, expiration)
+	require.NoError(t, err)
+	require.Contains(t, string(msg.GetSignBytes()), `"type":"cosmos-sdk/SendAuthorization"`)
+
+	stakeAuth, err := stakingtypes.NewStakeAuthorization(
+		[]sdk.ValAddress{sdk.ValAddress(granter)}, nil, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, nil,
+	)
+	require.NoError(t, err)
+	msg, err = types.NewMsgGrant(granter, grantee, stakeAuth, expiration)
+	require.NoError(t, err)
+	require.Contains(t, string(msg.GetSignBytes()), `"type":"cosmos-sdk/StakeAuthorization"`)
+}
+
+func TestMsgExecGetSignBytes(t *testing.T) {
+	send := banktypes.NewMsgSend(granter, grantee, coinsPos)
+	msg, err := types.NewMsgExec(grantee, []sdk.Msg{send})
+	require.NoError(t, err)
+
+	expected := `{"type":"cosmos-sdk/MsgExec","value":{"grantee":"` + grantee.String() + `","msgs":[` + string(send.GetSignBytes()) + `]}}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+}
@@ -0,0 +1,16 @@
+package types
+
+import codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryGrantsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, grant := range m.Grants {
+		if err := grant.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var _ codectypes.UnpackInterfacesMessage = &QueryGrantsResponse{}
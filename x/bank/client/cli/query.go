@@ -33,6 +33,8 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetBalancesCmd(),
 		GetCmdQueryTotalSupply(),
+		GetCmdDenomsMetadata(),
+		GetCmdDenomOwners(),
 	)
 
 	return cmd
@@ -132,10 +134,15 @@ To query for the total supply of a specific coin denomination use:
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			if denom == "" {
-				res, err := queryClient.TotalSupply(context.Background(), &types.QueryTotalSupplyRequest{})
+				res, err := queryClient.TotalSupply(context.Background(), &types.QueryTotalSupplyRequest{Pagination: pageReq})
 				if err != nil {
 					return err
 				}
@@ -154,6 +161,116 @@ To query for the total supply of a specific coin denomination use:
 
 	cmd.Flags().String(FlagDenom, "", "The specific balance denomination to query for")
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all supply totals")
+
+	return cmd
+}
+
+// GetCmdDenomsMetadata defines the cobra command to query client denomination metadata.
+func GetCmdDenomsMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-metadata",
+		Short: "Query the client metadata for coin denominations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the client metadata for all the registered coin denominations
+
+Example:
+  To query for the client metadata of all coin denominations use:
+  $ %s query %s denom-metadata
+
+To query for the client metadata of a specific coin denomination use:
+  $ %s query %s denom-metadata --denom=[denom]
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if denom == "" {
+				res, err := queryClient.DenomsMetadata(context.Background(), &types.QueryDenomsMetadataRequest{Pagination: pageReq})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintOutput(res)
+			}
+
+			res, err := queryClient.DenomMetadata(context.Background(), &types.QueryDenomMetadataRequest{Denom: denom})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "The specific denomination to query client metadata for")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denominations metadata")
+
+	return cmd
+}
+
+// GetCmdDenomOwners defines the cobra command to query the holders of a denomination.
+func GetCmdDenomOwners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-owners [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for all account addresses that own a particular token denomination",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for all account addresses that own a particular token denomination.
+
+Example:
+  $ %s query %s denom-owners uatom
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomOwners(context.Background(), &types.QueryDenomOwnersRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denom owners")
 
 	return cmd
 }
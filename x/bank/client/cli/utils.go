@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type (
+	// SetDenomMetadataProposalJSON defines a SetDenomMetadataProposal with a deposit
+	SetDenomMetadataProposalJSON struct {
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Metadata    types.Metadata `json:"metadata" yaml:"metadata"`
+		Deposit     string         `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// ParseSetDenomMetadataProposalJSON reads and parses a SetDenomMetadataProposalJSON from a file.
+func ParseSetDenomMetadataProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (SetDenomMetadataProposalJSON, error) {
+	proposal := SetDenomMetadataProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
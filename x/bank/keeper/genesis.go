@@ -31,6 +31,10 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	}
 
 	k.SetSupply(ctx, types.NewSupply(genState.Supply))
+
+	for _, meta := range genState.DenomMetadata {
+		k.SetDenomMetaData(ctx, meta)
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -29,6 +29,16 @@ func (suite *IntegrationTestSuite) TestExportGenesis() {
 	suite.Require().Equal(expectedMetadata, exportGenesis.DenomMetadata)
 }
 
+func (suite *IntegrationTestSuite) TestInitGenesis() {
+	app, ctx := suite.app, suite.ctx
+
+	genesis := types.DefaultGenesisState()
+	genesis.DenomMetadata = suite.getTestMetadata()
+	app.BankKeeper.InitGenesis(ctx, *genesis)
+
+	suite.Require().Equal(genesis.DenomMetadata, app.BankKeeper.GetAllDenomMetaData(ctx))
+}
+
 func (suite *IntegrationTestSuite) getTestBalances() []types.Balance {
 	addr2, _ := sdk.AccAddressFromBech32("cosmos1f9xjhxm0plzrh9cskf4qee4pc2xwp0n0556gh0")
 	addr1, _ := sdk.AccAddressFromBech32("cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh")
@@ -70,11 +70,18 @@ func (k BaseKeeper) AllBalances(ctx context.Context, req *types.QueryAllBalances
 }
 
 // TotalSupply implements the Query/TotalSupply gRPC method
-func (k BaseKeeper) TotalSupply(ctx context.Context, _ *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
+func (k BaseKeeper) TotalSupply(ctx context.Context, req *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	totalSupply := k.GetSupply(sdkCtx).GetTotal()
+	totalSupply, pageRes, err := k.GetPaginatedTotalSupply(sdkCtx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	return &types.QueryTotalSupplyResponse{Supply: totalSupply}, nil
+	return &types.QueryTotalSupplyResponse{Supply: totalSupply, Pagination: pageRes}, nil
 }
 
 // SupplyOf implements the Query/SupplyOf gRPC method
@@ -88,9 +95,9 @@ func (k BaseKeeper) SupplyOf(c context.Context, req *types.QuerySupplyOfRequest)
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	supply := k.GetSupply(ctx).GetTotal().AmountOf(req.Denom)
+	supply := k.GetSupplyOf(ctx, req.Denom)
 
-	return &types.QuerySupplyOfResponse{Amount: sdk.NewCoin(req.Denom, supply)}, nil
+	return &types.QuerySupplyOfResponse{Amount: supply}, nil
 }
 
 // Params implements the gRPC service handler for querying x/bank parameters.
@@ -104,3 +111,79 @@ func (k BaseKeeper) Params(ctx context.Context, req *types.QueryParamsRequest) (
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// DenomMetadata implements the Query/DenomMetadata gRPC method
+func (k BaseKeeper) DenomMetadata(ctx context.Context, req *types.QueryDenomMetadataRequest) (*types.QueryDenomMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	metadata, found := k.GetDenomMetaData(sdkCtx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "client metadata for denom %s", req.Denom)
+	}
+
+	return &types.QueryDenomMetadataResponse{Metadata: metadata}, nil
+}
+
+// DenomsMetadata implements the Query/DenomsMetadata gRPC method
+func (k BaseKeeper) DenomsMetadata(ctx context.Context, req *types.QueryDenomsMetadataRequest) (*types.QueryDenomsMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := prefix.NewStore(sdkCtx.KVStore(k.storeKey), types.DenomMetadataPrefix)
+
+	metadatas := []types.Metadata{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var metadata types.Metadata
+		if err := k.cdc.UnmarshalBinaryBare(value, &metadata); err != nil {
+			return err
+		}
+
+		metadatas = append(metadatas, metadata)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomsMetadataResponse{Metadatas: metadatas, Pagination: pageRes}, nil
+}
+
+// DenomOwners implements the Query/DenomOwners gRPC method
+func (k BaseKeeper) DenomOwners(ctx context.Context, req *types.QueryDenomOwnersRequest) (*types.QueryDenomOwnersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	denomAddrStore := prefix.NewStore(sdkCtx.KVStore(k.storeKey), types.CreateDenomAddressPrefix(req.Denom))
+
+	var denomOwners []*types.DenomOwner
+	pageRes, err := query.Paginate(denomAddrStore, req.Pagination, func(key, _ []byte) error {
+		addr := sdk.AccAddress(key)
+
+		denomOwners = append(denomOwners, &types.DenomOwner{
+			Address: addr,
+			Balance: k.GetBalance(sdkCtx, addr, req.Denom),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomOwnersResponse{DenomOwners: denomOwners, Pagination: pageRes}, nil
+}
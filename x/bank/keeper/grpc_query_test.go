@@ -91,6 +91,26 @@ func (suite *IntegrationTestSuite) TestQueryTotalSupply() {
 	suite.Require().NotNil(res)
 
 	suite.Require().Equal(expectedTotalSupply.Total, res.Supply)
+
+	multiSupply := types.NewSupply(sdk.NewCoins(
+		sdk.NewInt64Coin("test1", 100), sdk.NewInt64Coin("test2", 200), sdk.NewInt64Coin("test3", 300),
+	))
+	app.BankKeeper.SetSupply(ctx, multiSupply)
+
+	res, err = queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(multiSupply.Total[:2], res.Supply)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	res, err = queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(multiSupply.Total[2:], res.Supply)
+	suite.Require().Nil(res.Pagination.NextKey)
 }
 
 func (suite *IntegrationTestSuite) TestQueryTotalSupplyOf() {
@@ -117,3 +137,95 @@ func (suite *IntegrationTestSuite) TestQueryParams() {
 	suite.Require().NotNil(res)
 	suite.Require().Equal(suite.app.BankKeeper.GetParams(suite.ctx), res.GetParams())
 }
+
+func (suite *IntegrationTestSuite) TestQueryDenomMetadata() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	metadata := suite.getTestMetadata()
+
+	_, err := queryClient.DenomMetadata(gocontext.Background(), &types.QueryDenomMetadataRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.DenomMetadata(gocontext.Background(), &types.QueryDenomMetadataRequest{Denom: metadata[0].Base})
+	suite.Require().Error(err)
+
+	app.BankKeeper.SetDenomMetaData(ctx, metadata[0])
+
+	res, err := queryClient.DenomMetadata(gocontext.Background(), &types.QueryDenomMetadataRequest{Denom: metadata[0].Base})
+	suite.Require().NoError(err)
+	suite.Require().Equal(metadata[0], res.Metadata)
+}
+
+func (suite *IntegrationTestSuite) TestQueryDenomsMetadata() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	metadata := suite.getTestMetadata()
+
+	res, err := queryClient.DenomsMetadata(gocontext.Background(), &types.QueryDenomsMetadataRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Metadatas)
+
+	for _, m := range metadata {
+		app.BankKeeper.SetDenomMetaData(ctx, m)
+	}
+
+	res, err = queryClient.DenomsMetadata(gocontext.Background(), &types.QueryDenomsMetadataRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(metadata[:1], res.Metadatas)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	res, err = queryClient.DenomsMetadata(gocontext.Background(), &types.QueryDenomsMetadataRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(metadata[1:], res.Metadatas)
+	suite.Require().Nil(res.Pagination.NextKey)
+}
+
+func (suite *IntegrationTestSuite) TestQueryDenomOwners() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+
+	_, err := queryClient.DenomOwners(gocontext.Background(), &types.QueryDenomOwnersRequest{})
+	suite.Require().Error(err)
+
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(newFooCoin(10), newBarCoin(5))))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr2, sdk.NewCoins(newFooCoin(20))))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr3, sdk.NewCoins(newBarCoin(30))))
+
+	res, err := queryClient.DenomOwners(gocontext.Background(), &types.QueryDenomOwnersRequest{
+		Denom:      fooDenom,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.DenomOwner{{Address: addr1, Balance: newFooCoin(10)}}, res.DenomOwners)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	res, err = queryClient.DenomOwners(gocontext.Background(), &types.QueryDenomOwnersRequest{
+		Denom:      fooDenom,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.DenomOwner{{Address: addr2, Balance: newFooCoin(20)}}, res.DenomOwners)
+	suite.Require().Nil(res.Pagination.NextKey)
+
+	// spending the whole balance removes the holder
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr2, addr3, sdk.NewCoins(newFooCoin(20))))
+
+	res, err = queryClient.DenomOwners(gocontext.Background(), &types.QueryDenomOwnersRequest{Denom: fooDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.DenomOwner{
+		{Address: addr1, Balance: newFooCoin(10)},
+		{Address: addr3, Balance: newFooCoin(20)},
+	}, res.DenomOwners)
+
+	// clearing the balances removes the holder from every denomination
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr3, sdk.NewCoins()))
+
+	res, err = queryClient.DenomOwners(gocontext.Background(), &types.QueryDenomOwnersRequest{Denom: barDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.DenomOwner{{Address: addr1, Balance: newBarCoin(5)}}, res.DenomOwners)
+}
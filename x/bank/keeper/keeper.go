@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/cosmos/cosmos-sdk/x/bank/exported"
@@ -27,8 +28,12 @@ type Keeper interface {
 
 	GetSupply(ctx sdk.Context) exported.SupplyI
 	SetSupply(ctx sdk.Context, supply exported.SupplyI)
+	GetSupplyOf(ctx sdk.Context, denom string) sdk.Coin
+	GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
+	IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) bool)
 
-	GetDenomMetaData(ctx sdk.Context, denom string) types.Metadata
+	GetDenomMetaData(ctx sdk.Context, denom string) (types.Metadata, bool)
+	GetAllDenomMetaData(ctx sdk.Context) []types.Metadata
 	SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metadata)
 	IterateAllDenomMetaData(ctx sdk.Context, cb func(types.Metadata) bool)
 
@@ -45,6 +50,8 @@ type Keeper interface {
 	MarshalSupply(supplyI exported.SupplyI) ([]byte, error)
 	UnmarshalSupply(bz []byte) (exported.SupplyI, error)
 
+	GetAuthority() sdk.AccAddress
+
 	types.QueryServer
 }
 
@@ -56,11 +63,17 @@ type BaseKeeper struct {
 	cdc        codec.BinaryMarshaler
 	storeKey   sdk.StoreKey
 	paramSpace paramtypes.Subspace
+
+	// the address capable of executing a MsgUpdateParams message, typically
+	// the x/gov module account
+	authority sdk.AccAddress
 }
 
+// NewBaseKeeper returns a new BaseKeeper. The paramSpace is only used by the
+// store migration moving the bank parameters out of the x/params subspace.
 func NewBaseKeeper(
 	cdc codec.BinaryMarshaler, storeKey sdk.StoreKey, ak types.AccountKeeper, paramSpace paramtypes.Subspace,
-	blockedAddrs map[string]bool,
+	blockedAddrs map[string]bool, authority sdk.AccAddress,
 ) BaseKeeper {
 
 	// set KeyTable if it has not already been set
@@ -69,14 +82,20 @@ func NewBaseKeeper(
 	}
 
 	return BaseKeeper{
-		BaseSendKeeper: NewBaseSendKeeper(cdc, storeKey, ak, paramSpace, blockedAddrs),
+		BaseSendKeeper: NewBaseSendKeeper(cdc, storeKey, ak, blockedAddrs),
 		ak:             ak,
 		cdc:            cdc,
 		storeKey:       storeKey,
 		paramSpace:     paramSpace,
+		authority:      authority,
 	}
 }
 
+// GetAuthority returns the x/bank module's authority.
+func (k BaseKeeper) GetAuthority() sdk.AccAddress {
+	return k.authority
+}
+
 // DelegateCoins performs delegation by deducting amt coins from an account with
 // address addr. For vesting accounts, delegations amounts are tracked for both
 // vesting and vested coins. The coins are then transferred from the delegator
@@ -153,44 +172,133 @@ func (k BaseKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAdd
 	return nil
 }
 
-// GetSupply retrieves the Supply from store
+// GetSupply retrieves the total supply of all denominations from store
 func (k BaseKeeper) GetSupply(ctx sdk.Context) exported.SupplyI {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.SupplyKey)
+	total := sdk.NewCoins()
+	k.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		// the store iterates in denomination order, so the coins stay sorted
+		total = append(total, coin)
+		return false
+	})
+
+	return types.NewSupply(total)
+}
+
+// SetSupply sets the total supply of every denomination in the given Supply to
+// store, removing the supply of all other denominations.
+func (k BaseKeeper) SetSupply(ctx sdk.Context, supply exported.SupplyI) {
+	supplyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SupplyKey)
+
+	iterator := supplyStore.Iterator(nil, nil)
+	var denoms [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, denom := range denoms {
+		supplyStore.Delete(denom)
+	}
+
+	for _, coin := range supply.GetTotal() {
+		k.setSupply(ctx, coin)
+	}
+}
+
+// GetSupplyOf retrieves the total supply of the given denomination from store
+func (k BaseKeeper) GetSupplyOf(ctx sdk.Context, denom string) sdk.Coin {
+	supplyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SupplyKey)
+
+	bz := supplyStore.Get([]byte(denom))
 	if bz == nil {
-		panic("stored supply should not have been nil")
+		return sdk.NewCoin(denom, sdk.ZeroInt())
 	}
 
-	supply, err := k.UnmarshalSupply(bz)
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(fmt.Errorf("unable to unmarshal supply value %v", err))
+	}
+
+	return sdk.NewCoin(denom, amount)
+}
+
+// GetPaginatedTotalSupply queries for the supply of the denominations,
+// ignoring the supply of the others, given a pagination request.
+func (k BaseKeeper) GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error) {
+	supplyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SupplyKey)
+
+	supply := sdk.NewCoins()
+	pageRes, err := query.Paginate(supplyStore, pagination, func(key, value []byte) error {
+		var amount sdk.Int
+		if err := amount.Unmarshal(value); err != nil {
+			return fmt.Errorf("unable to convert amount string to Int %v", err)
+		}
+
+		// the store iterates in denomination order, so the coins stay sorted
+		supply = append(supply, sdk.NewCoin(string(key), amount))
+		return nil
+	})
 	if err != nil {
-		panic(err)
+		return nil, nil, err
 	}
 
-	return supply
+	return supply, pageRes, nil
 }
 
-// SetSupply sets the Supply to store
-func (k BaseKeeper) SetSupply(ctx sdk.Context, supply exported.SupplyI) {
-	store := ctx.KVStore(k.storeKey)
-	bz, err := k.MarshalSupply(supply)
+// IterateTotalSupply iterates over the total supply of every denomination,
+// in denomination order, and provides it to a callback. If true is returned
+// from the callback, iteration is halted.
+func (k BaseKeeper) IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) bool) {
+	supplyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SupplyKey)
+
+	iterator := supplyStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(fmt.Errorf("unable to unmarshal supply value %v", err))
+		}
+
+		if cb(sdk.NewCoin(string(iterator.Key()), amount)) {
+			break
+		}
+	}
+}
+
+// setSupply sets the supply of a single denomination to store, removing it
+// once it reaches zero.
+func (k BaseKeeper) setSupply(ctx sdk.Context, coin sdk.Coin) {
+	supplyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SupplyKey)
+
+	if coin.IsZero() {
+		supplyStore.Delete([]byte(coin.Denom))
+		return
+	}
+
+	bz, err := coin.Amount.Marshal()
 	if err != nil {
 		panic(err)
 	}
 
-	store.Set(types.SupplyKey, bz)
+	supplyStore.Set([]byte(coin.Denom), bz)
 }
 
-// GetDenomMetaData retrieves the denomination metadata
-func (k BaseKeeper) GetDenomMetaData(ctx sdk.Context, denom string) types.Metadata {
+// GetDenomMetaData retrieves the denomination metadata. A boolean is returned
+// indicating whether metadata is registered for the denomination.
+func (k BaseKeeper) GetDenomMetaData(ctx sdk.Context, denom string) (types.Metadata, bool) {
 	store := ctx.KVStore(k.storeKey)
 	store = prefix.NewStore(store, types.DenomMetadataKey(denom))
 
 	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return types.Metadata{}, false
+	}
 
 	var metadata types.Metadata
 	k.cdc.MustUnmarshalBinaryBare(bz, &metadata)
 
-	return metadata
+	return metadata, true
 }
 
 // GetAllDenomMetaData retrieves all denominations metadata
@@ -234,6 +342,7 @@ func (k BaseKeeper) SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metada
 }
 
 // SendCoinsFromModuleToAccount transfers coins from a ModuleAccount to an AccAddress.
+// The send restriction sees the sender module as the origin module.
 // It will panic if the module account does not exist.
 func (k BaseKeeper) SendCoinsFromModuleToAccount(
 	ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
@@ -244,10 +353,11 @@ func (k BaseKeeper) SendCoinsFromModuleToAccount(
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", senderModule))
 	}
 
-	return k.SendCoins(ctx, senderAddr, recipientAddr, amt)
+	return k.SendCoins(types.WithOriginModule(ctx, senderModule), senderAddr, recipientAddr, amt)
 }
 
 // SendCoinsFromModuleToModule transfers coins from a ModuleAccount to another.
+// The send restriction sees the sender module as the origin module.
 // It will panic if either module account does not exist.
 func (k BaseKeeper) SendCoinsFromModuleToModule(
 	ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins,
@@ -263,10 +373,11 @@ func (k BaseKeeper) SendCoinsFromModuleToModule(
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
 	}
 
-	return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+	return k.SendCoins(types.WithOriginModule(ctx, senderModule), senderAddr, recipientAcc.GetAddress(), amt)
 }
 
 // SendCoinsFromAccountToModule transfers coins from an AccAddress to a ModuleAccount.
+// The send restriction sees the recipient module as the origin module.
 // It will panic if the module account does not exist.
 func (k BaseKeeper) SendCoinsFromAccountToModule(
 	ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
@@ -277,7 +388,7 @@ func (k BaseKeeper) SendCoinsFromAccountToModule(
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
 	}
 
-	return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+	return k.SendCoins(types.WithOriginModule(ctx, recipientModule), senderAddr, recipientAcc.GetAddress(), amt)
 }
 
 // DelegateCoinsFromAccountToModule delegates coins and transfers them from a
@@ -335,11 +446,11 @@ func (k BaseKeeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins)
 		return err
 	}
 
-	// update total supply
-	supply := k.GetSupply(ctx)
-	supply.Inflate(amt)
-
-	k.SetSupply(ctx, supply)
+	// update the total supply of the minted denominations only
+	for _, coin := range amt {
+		supply := k.GetSupplyOf(ctx, coin.Denom)
+		k.setSupply(ctx, supply.Add(coin))
+	}
 
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("minted %s from %s module account", amt.String(), moduleName))
@@ -364,10 +475,11 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins)
 		return err
 	}
 
-	// update total supply
-	supply := k.GetSupply(ctx)
-	supply.Deflate(amt)
-	k.SetSupply(ctx, supply)
+	// update the total supply of the burned denominations only
+	for _, coin := range amt {
+		supply := k.GetSupplyOf(ctx, coin.Denom)
+		k.setSupply(ctx, supply.Sub(coin))
+	}
 
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("burned %s from %s module account", amt.String(), moduleName))
@@ -12,11 +12,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
 const (
@@ -95,6 +99,23 @@ func (suite *IntegrationTestSuite) TestSupply() {
 	suite.Require().Equal(totalSupply, total)
 }
 
+func (suite *IntegrationTestSuite) TestSupplyOf() {
+	app, ctx := suite.app, suite.ctx
+
+	fooCoin := sdk.NewInt64Coin("foo", 100)
+	barCoin := sdk.NewInt64Coin("bar", 200)
+	app.BankKeeper.SetSupply(ctx, types.NewSupply(sdk.NewCoins(fooCoin, barCoin)))
+
+	suite.Require().Equal(fooCoin, app.BankKeeper.GetSupplyOf(ctx, "foo"))
+	suite.Require().Equal(barCoin, app.BankKeeper.GetSupplyOf(ctx, "bar"))
+	suite.Require().Equal(sdk.NewInt64Coin("baz", 0), app.BankKeeper.GetSupplyOf(ctx, "baz"))
+
+	// overwriting the supply removes denominations that are no longer present
+	app.BankKeeper.SetSupply(ctx, types.NewSupply(sdk.NewCoins(fooCoin)))
+	suite.Require().Equal(sdk.NewInt64Coin("bar", 0), app.BankKeeper.GetSupplyOf(ctx, "bar"))
+	suite.Require().Equal(sdk.NewCoins(fooCoin), app.BankKeeper.GetSupply(ctx).GetTotal())
+}
+
 func (suite *IntegrationTestSuite) TestSupply_SendCoins() {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
@@ -110,11 +131,11 @@ func (suite *IntegrationTestSuite) TestSupply_SendCoins() {
 
 	authKeeper := authkeeper.NewAccountKeeper(
 		appCodec, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
-		authtypes.ProtoBaseAccount, maccPerms,
+		authtypes.ProtoBaseAccount, maccPerms, authtypes.NewModuleAddress(govtypes.ModuleName),
 	)
 	keeper := keeper.NewBaseKeeper(
 		appCodec, app.GetKey(types.StoreKey), authKeeper,
-		app.GetSubspace(types.ModuleName), make(map[string]bool),
+		app.GetSubspace(types.ModuleName), make(map[string]bool), authtypes.NewModuleAddress(govtypes.ModuleName),
 	)
 
 	baseAcc := authKeeper.NewAccountWithAddress(ctx, authtypes.NewModuleAddress("baseAcc"))
@@ -173,11 +194,11 @@ func (suite *IntegrationTestSuite) TestSupply_MintCoins() {
 
 	authKeeper := authkeeper.NewAccountKeeper(
 		appCodec, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
-		authtypes.ProtoBaseAccount, maccPerms,
+		authtypes.ProtoBaseAccount, maccPerms, authtypes.NewModuleAddress(govtypes.ModuleName),
 	)
 	keeper := keeper.NewBaseKeeper(
 		appCodec, app.GetKey(types.StoreKey), authKeeper,
-		app.GetSubspace(types.ModuleName), make(map[string]bool),
+		app.GetSubspace(types.ModuleName), make(map[string]bool), authtypes.NewModuleAddress(govtypes.ModuleName),
 	)
 
 	authKeeper.SetModuleAccount(ctx, burnerAcc)
@@ -227,11 +248,11 @@ func (suite *IntegrationTestSuite) TestSupply_BurnCoins() {
 
 	authKeeper := authkeeper.NewAccountKeeper(
 		appCodec, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
-		authtypes.ProtoBaseAccount, maccPerms,
+		authtypes.ProtoBaseAccount, maccPerms, authtypes.NewModuleAddress(govtypes.ModuleName),
 	)
 	keeper := keeper.NewBaseKeeper(
 		appCodec, app.GetKey(types.StoreKey), authKeeper,
-		app.GetSubspace(types.ModuleName), make(map[string]bool),
+		app.GetSubspace(types.ModuleName), make(map[string]bool), authtypes.NewModuleAddress(govtypes.ModuleName),
 	)
 
 	suite.Require().NoError(keeper.SetBalances(ctx, burnerAcc.GetAddress(), initCoins))
@@ -405,6 +426,103 @@ func (suite *IntegrationTestSuite) TestSendCoins() {
 	suite.Require().Equal(expected, acc2Balances)
 }
 
+func (suite *IntegrationTestSuite) TestSendRestriction() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, balances))
+
+	defer app.BankKeeper.ClearSendRestriction()
+
+	var calls []string
+	frozen := func(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "frozen")
+		if fromAddr.Equals(addr3) || toAddr.Equals(addr3) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is frozen", addr3)
+		}
+		return toAddr, nil
+	}
+	redirect := func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "redirect")
+		if toAddr.Equals(addr2) {
+			return addr3, nil
+		}
+		return toAddr, nil
+	}
+
+	// the redirect runs first, so the frozen check sees the new recipient
+	app.BankKeeper.AppendSendRestriction(frozen)
+	app.BankKeeper.PrependSendRestriction(redirect)
+
+	sendAmt := sdk.NewCoins(newFooCoin(10))
+	suite.Require().Error(app.BankKeeper.SendCoins(ctx, addr1, addr2, sendAmt))
+	suite.Require().Equal([]string{"redirect", "frozen"}, calls)
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	inputs := []types.Input{{Address: addr1, Coins: sendAmt}}
+	outputs := []types.Output{{Address: addr2, Coins: sendAmt}}
+	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	// redirected sends credit the new recipient
+	app.BankKeeper.ClearSendRestriction()
+	app.BankKeeper.AppendSendRestriction(redirect)
+
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sendAmt))
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr2).Empty())
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(20)), app.BankKeeper.GetAllBalances(ctx, addr3))
+
+	// several inputs can't be attributed to the outputs, so they are rejected
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr3, balances))
+	multiInputs := []types.Input{{Address: addr1, Coins: sendAmt}, {Address: addr3, Coins: sendAmt}}
+	multiOutputs := []types.Output{{Address: addr2, Coins: sendAmt.Add(sendAmt...)}}
+	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, multiInputs, multiOutputs))
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr3))
+
+	// a restriction can't redirect coins to a blocked address
+	app.BankKeeper.ClearSendRestriction()
+	blockedAddr := authtypes.NewModuleAddress(minttypes.ModuleName)
+	suite.Require().True(app.BankKeeper.BlockedAddr(blockedAddr))
+	app.BankKeeper.AppendSendRestriction(func(sdk.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) (sdk.AccAddress, error) {
+		return blockedAddr, nil
+	})
+
+	suite.Require().Error(app.BankKeeper.SendCoins(ctx, addr1, addr2, sendAmt))
+	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, blockedAddr).Empty())
+
+	// without a restriction several inputs are allowed
+	app.BankKeeper.ClearSendRestriction()
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, multiInputs, multiOutputs))
+
+	// an empty recipient is rejected
+	app.BankKeeper.ClearSendRestriction()
+	app.BankKeeper.AppendSendRestriction(func(sdk.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) (sdk.AccAddress, error) {
+		return nil, nil
+	})
+	suite.Require().Error(app.BankKeeper.SendCoins(ctx, addr1, addr2, sendAmt))
+
+	// module initiated sends expose the origin module
+	app.BankKeeper.ClearSendRestriction()
+
+	var originModule string
+	app.BankKeeper.AppendSendRestriction(func(ctx sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		originModule = types.GetOriginModule(ctx)
+		return toAddr, nil
+	})
+
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sendAmt))
+	suite.Require().Empty(originModule)
+
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sendAmt))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr1, sendAmt))
+	suite.Require().Equal(minttypes.ModuleName, originModule)
+}
+
 func (suite *IntegrationTestSuite) TestValidateBalance() {
 	app, ctx := suite.app, suite.ctx
 	now := tmtime.Now()
@@ -981,7 +1099,8 @@ func (suite *IntegrationTestSuite) TestSetDenomMetaData() {
 		app.BankKeeper.SetDenomMetaData(ctx, metadata[i])
 	}
 
-	actualMetadata := app.BankKeeper.GetDenomMetaData(ctx, metadata[1].Base)
+	actualMetadata, found := app.BankKeeper.GetDenomMetaData(ctx, metadata[1].Base)
+	suite.Require().True(found)
 
 	suite.Require().Equal(metadata[1].GetBase(), actualMetadata.GetBase())
 	suite.Require().Equal(metadata[1].GetDisplay(), actualMetadata.GetDisplay())
@@ -989,6 +1108,51 @@ func (suite *IntegrationTestSuite) TestSetDenomMetaData() {
 	suite.Require().Equal(metadata[1].GetDenomUnits()[1].GetDenom(), actualMetadata.GetDenomUnits()[1].GetDenom())
 	suite.Require().Equal(metadata[1].GetDenomUnits()[1].GetExponent(), actualMetadata.GetDenomUnits()[1].GetExponent())
 	suite.Require().Equal(metadata[1].GetDenomUnits()[1].GetAliases(), actualMetadata.GetDenomUnits()[1].GetAliases())
+
+	_, found = app.BankKeeper.GetDenomMetaData(ctx, "unknown")
+	suite.Require().False(found)
+}
+
+func (suite *IntegrationTestSuite) TestSetDenomMetadataProposal() {
+	app, ctx := suite.app, suite.ctx
+	handler := bank.NewSetDenomMetadataProposalHandler(app.BankKeeper)
+
+	metadata := suite.getTestMetadata()[0]
+	suite.Require().NoError(handler(ctx, types.NewSetDenomMetadataProposal("title", "description", metadata)))
+
+	actualMetadata, found := app.BankKeeper.GetDenomMetaData(ctx, metadata.Base)
+	suite.Require().True(found)
+	suite.Require().Equal(metadata, actualMetadata)
+
+	// updating the metadata of an existing denomination replaces it
+	metadata.Description = "updated"
+	suite.Require().NoError(handler(ctx, types.NewSetDenomMetadataProposal("title", "description", metadata)))
+
+	actualMetadata, _ = app.BankKeeper.GetDenomMetaData(ctx, metadata.Base)
+	suite.Require().Equal("updated", actualMetadata.Description)
+
+	// invalid metadata is rejected
+	invalid := suite.getTestMetadata()[1]
+	suite.Require().Error(handler(ctx, types.NewSetDenomMetadataProposal("title", "description", invalid)))
+
+	_, found = app.BankKeeper.GetDenomMetaData(ctx, invalid.Base)
+	suite.Require().False(found)
+}
+
+func (suite *IntegrationTestSuite) TestMsgUpdateParams() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+
+	params := types.NewParams(false, types.SendEnabledParams{types.NewSendEnabled("atom", true)})
+
+	// only the authority may update the params
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(authtypes.NewModuleAddress("other"), params))
+	suite.Require().Error(err)
+	suite.Require().True(app.BankKeeper.GetParams(ctx).DefaultSendEnabled)
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(app.BankKeeper.GetAuthority(), params))
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, app.BankKeeper.GetParams(ctx))
 }
 
 func (suite *IntegrationTestSuite) TestIterateAllDenomMetaData() {
@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// HandleSetDenomMetadataProposal is a handler for executing a passed set denom
+// metadata proposal. It registers the metadata, replacing any existing metadata
+// for the same base denomination.
+func HandleSetDenomMetadataProposal(ctx sdk.Context, k Keeper, p *types.SetDenomMetadataProposal) error {
+	if err := p.Metadata.Validate(); err != nil {
+		return err
+	}
+
+	k.SetDenomMetaData(ctx, p.Metadata)
+	return nil
+}
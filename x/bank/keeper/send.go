@@ -7,7 +7,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // SendKeeper defines a module interface that facilitates the transfer of coins
@@ -31,6 +30,10 @@ type SendKeeper interface {
 	SendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 
 	BlockedAddr(addr sdk.AccAddress) bool
+
+	AppendSendRestriction(restriction types.SendRestrictionFn)
+	PrependSendRestriction(restriction types.SendRestrictionFn)
+	ClearSendRestriction()
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -40,43 +43,74 @@ var _ SendKeeper = (*BaseSendKeeper)(nil)
 type BaseSendKeeper struct {
 	BaseViewKeeper
 
-	cdc        codec.BinaryMarshaler
-	ak         types.AccountKeeper
-	storeKey   sdk.StoreKey
-	paramSpace paramtypes.Subspace
+	cdc      codec.BinaryMarshaler
+	ak       types.AccountKeeper
+	storeKey sdk.StoreKey
 
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
+
+	// sendRestriction is shared by all copies of the keeper so that
+	// restrictions registered after the keeper is passed to other modules
+	// still apply to them.
+	sendRestriction *sendRestriction
 }
 
 func NewBaseSendKeeper(
-	cdc codec.BinaryMarshaler, storeKey sdk.StoreKey, ak types.AccountKeeper, paramSpace paramtypes.Subspace, blockedAddrs map[string]bool,
+	cdc codec.BinaryMarshaler, storeKey sdk.StoreKey, ak types.AccountKeeper, blockedAddrs map[string]bool,
 ) BaseSendKeeper {
 
 	return BaseSendKeeper{
-		BaseViewKeeper: NewBaseViewKeeper(cdc, storeKey, ak),
-		cdc:            cdc,
-		ak:             ak,
-		storeKey:       storeKey,
-		paramSpace:     paramSpace,
-		blockedAddrs:   blockedAddrs,
+		BaseViewKeeper:  NewBaseViewKeeper(cdc, storeKey, ak),
+		cdc:             cdc,
+		ak:              ak,
+		storeKey:        storeKey,
+		blockedAddrs:    blockedAddrs,
+		sendRestriction: newSendRestriction(),
 	}
 }
 
+// AppendSendRestriction adds the provided SendRestrictionFn to run after
+// previously provided restrictions.
+func (k BaseSendKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.append(restriction)
+}
+
+// PrependSendRestriction adds the provided SendRestrictionFn to run before
+// previously provided restrictions.
+func (k BaseSendKeeper) PrependSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.prepend(restriction)
+}
+
+// ClearSendRestriction removes the send restriction (if there is one).
+func (k BaseSendKeeper) ClearSendRestriction() {
+	k.sendRestriction.clear()
+}
+
 // GetParams returns the total set of bank parameters.
 func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		panic("stored bank params should not have been nil")
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &params)
 	return params
 }
 
 // SetParams sets the total set of bank parameters.
 func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, k.cdc.MustMarshalBinaryBare(&params))
 }
 
 // InputOutputCoins performs multi-send functionality. It accepts a series of
 // inputs that correspond to a series of outputs. It returns an error if the
 // inputs and outputs don't lineup or if any single transfer of tokens fails.
+//
+// The send restriction is consulted for every output with the address of the
+// input as the sender. As an output can not be attributed to one of several
+// inputs, sends with more than one input are rejected while a send restriction
+// is set.
 func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
 	// Safety check ensuring that when sending coins the keeper must maintain the
 	// Check supply invariant and validity of Coins.
@@ -84,6 +118,26 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
+	if len(inputs) != 1 && k.sendRestriction.isSet() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "multiple inputs are not supported while a send restriction is set")
+	}
+
+	var fromAddr sdk.AccAddress
+	if len(inputs) == 1 {
+		fromAddr = inputs[0].Address
+	}
+
+	// apply the send restriction to all outputs before moving any coins
+	restrictedOutputs := make([]types.Output, len(outputs))
+	for i, out := range outputs {
+		toAddr, err := k.applySendRestriction(ctx, fromAddr, out.Address, out.Coins)
+		if err != nil {
+			return err
+		}
+
+		restrictedOutputs[i] = types.NewOutput(toAddr, out.Coins)
+	}
+
 	for _, in := range inputs {
 		_, err := k.SubtractCoins(ctx, in.Address, in.Coins)
 		if err != nil {
@@ -98,7 +152,7 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		)
 	}
 
-	for _, out := range outputs {
+	for _, out := range restrictedOutputs {
 		_, err := k.AddCoins(ctx, out.Address, out.Coins)
 		if err != nil {
 			return err
@@ -127,8 +181,14 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 }
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure.
+// The send restriction may reject the transfer or redirect it to another
+// receiving account. An error is returned upon failure.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	toAddr, err := k.applySendRestriction(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTransfer,
@@ -142,7 +202,7 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 		),
 	})
 
-	_, err := k.SubtractCoins(ctx, fromAddr, amt)
+	_, err = k.SubtractCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
 	}
@@ -235,6 +295,7 @@ func (k BaseSendKeeper) ClearBalances(ctx sdk.Context, addr sdk.AccAddress) {
 
 	for _, key := range keys {
 		accountStore.Delete(key)
+		store.Delete(types.CreateDenomAddressKey(string(key), addr))
 	}
 }
 
@@ -267,6 +328,15 @@ func (k BaseSendKeeper) SetBalance(ctx sdk.Context, addr sdk.AccAddress, balance
 	bz := k.cdc.MustMarshalBinaryBare(&balance)
 	accountStore.Set([]byte(balance.Denom), bz)
 
+	// keep the denom to holder address index in sync; only non-zero balances
+	// make an address a holder of the denomination
+	denomAddrKey := types.CreateDenomAddressKey(balance.Denom, addr)
+	if balance.IsZero() {
+		store.Delete(denomAddrKey)
+	} else {
+		store.Set(denomAddrKey, []byte{0})
+	}
+
 	return nil
 }
 
@@ -292,3 +362,71 @@ func (k BaseSendKeeper) SendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
 func (k BaseSendKeeper) BlockedAddr(addr sdk.AccAddress) bool {
 	return k.blockedAddrs[addr.String()]
 }
+
+// applySendRestriction runs the send restriction and makes sure that it does
+// not redirect the coins to an address which is blocked from receiving funds.
+func (k BaseSendKeeper) applySendRestriction(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins,
+) (sdk.AccAddress, error) {
+	newToAddr, err := k.sendRestriction.apply(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return nil, err
+	}
+
+	if !newToAddr.Equals(toAddr) && k.BlockedAddr(newToAddr) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", newToAddr)
+	}
+
+	return newToAddr, nil
+}
+
+// sendRestriction is a struct that houses a SendRestrictionFn. It exists so
+// that the SendRestrictionFn can be updated through a pointer shared by all
+// copies of the keeper.
+type sendRestriction struct {
+	fn types.SendRestrictionFn
+}
+
+// newSendRestriction creates a new sendRestriction with nil send restriction.
+func newSendRestriction() *sendRestriction {
+	return &sendRestriction{}
+}
+
+// append adds the provided restriction to this, to be run after the existing function.
+func (r *sendRestriction) append(restriction types.SendRestrictionFn) {
+	r.fn = types.ComposeSendRestrictions(r.fn, restriction)
+}
+
+// prepend adds the provided restriction to this, to be run before the existing function.
+func (r *sendRestriction) prepend(restriction types.SendRestrictionFn) {
+	r.fn = types.ComposeSendRestrictions(restriction, r.fn)
+}
+
+// clear removes the send restriction (sets it to nil).
+func (r *sendRestriction) clear() {
+	r.fn = nil
+}
+
+// isSet returns true if there is a send restriction.
+func (r *sendRestriction) isSet() bool {
+	return r != nil && r.fn != nil
+}
+
+// apply runs the send restriction, if any, returning the address the coins
+// must be sent to.
+func (r *sendRestriction) apply(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if !r.isSet() {
+		return toAddr, nil
+	}
+
+	newToAddr, err := r.fn(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return nil, err
+	}
+
+	if newToAddr.Empty() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "send restriction returned an empty recipient for %s", toAddr)
+	}
+
+	return newToAddr, nil
+}
@@ -0,0 +1,7 @@
+package v040
+
+// DONTCOVER
+
+// SupplyKey is the key under which the total supply of all denominations is
+// stored as a single Supply.
+var SupplyKey = []byte{0x00}
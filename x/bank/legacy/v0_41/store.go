@@ -0,0 +1,111 @@
+package v041
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/exported"
+	v040bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_40"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.40 to v0.41. The
+// migration includes:
+//
+// - Change the total supply, stored as a single Supply, to be stored per
+// denomination under types.SupplyKey.
+// - Build the denom to holder address index from the existing balances.
+// - Move the bank parameters from the x/params subspace to the bank store
+// under types.ParamsKey.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, paramSpace paramtypes.Subspace) error {
+	store := ctx.KVStore(storeKey)
+
+	if err := migrateSupply(store, cdc); err != nil {
+		return err
+	}
+
+	if err := migrateDenomOwners(store, cdc); err != nil {
+		return err
+	}
+
+	return migrateParams(ctx, store, cdc, paramSpace)
+}
+
+// migrateSupply moves the total supply from a single Supply object to one
+// entry per denomination.
+func migrateSupply(store sdk.KVStore, cdc codec.BinaryMarshaler) error {
+	bz := store.Get(v040bank.SupplyKey)
+	if bz == nil {
+		return nil
+	}
+
+	var supply exported.SupplyI
+	if err := codec.UnmarshalAny(cdc, &supply, bz); err != nil {
+		return err
+	}
+
+	// the old key is the new prefix, so it must be removed first
+	store.Delete(v040bank.SupplyKey)
+
+	supplyStore := prefix.NewStore(store, types.SupplyKey)
+	for _, coin := range supply.GetTotal() {
+		if coin.IsZero() {
+			continue
+		}
+
+		amount, err := coin.Amount.Marshal()
+		if err != nil {
+			return err
+		}
+
+		supplyStore.Set([]byte(coin.Denom), amount)
+	}
+
+	return nil
+}
+
+// migrateDenomOwners indexes the holder address of every non-zero balance by
+// denomination.
+func migrateDenomOwners(store sdk.KVStore, cdc codec.BinaryMarshaler) error {
+	balancesStore := prefix.NewStore(store, types.BalancesPrefix)
+
+	iterator := balancesStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var balance sdk.Coin
+		if err := cdc.UnmarshalBinaryBare(iterator.Value(), &balance); err != nil {
+			return err
+		}
+
+		if balance.IsZero() {
+			continue
+		}
+
+		addr := types.AddressFromBalancesStore(iterator.Key())
+		store.Set(types.CreateDenomAddressKey(balance.Denom, addr), []byte{0})
+	}
+
+	return nil
+}
+
+// migrateParams copies the bank parameters from the x/params subspace to the
+// bank store.
+func migrateParams(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryMarshaler, paramSpace paramtypes.Subspace) error {
+	var params types.Params
+	paramSpace.GetParamSet(ctx, &params)
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.MarshalBinaryBare(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
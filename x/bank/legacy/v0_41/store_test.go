@@ -0,0 +1,98 @@
+package v041_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v040bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_40"
+	v041bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_41"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	storeKey := app.GetKey(types.StoreKey)
+	store := ctx.KVStore(storeKey)
+	paramSpace := legacyParamSpace(ctx, app)
+
+	// replace the supply with a v0.40 one
+	app.BankKeeper.SetSupply(ctx, types.NewSupply(sdk.NewCoins()))
+
+	total := sdk.NewCoins(sdk.NewInt64Coin("atom", 50), sdk.NewInt64Coin("stake", 100))
+	bz, err := codec.MarshalAny(app.AppCodec(), types.NewSupply(total))
+	require.NoError(t, err)
+	store.Set(v040bank.SupplyKey, bz)
+
+	require.NoError(t, v041bank.MigrateStore(ctx, storeKey, app.AppCodec(), paramSpace))
+
+	require.False(t, store.Has(v040bank.SupplyKey))
+	require.Equal(t, total, app.BankKeeper.GetSupply(ctx).GetTotal())
+	require.Equal(t, sdk.NewInt64Coin("atom", 50), app.BankKeeper.GetSupplyOf(ctx, "atom"))
+
+	// migrating again is a no-op
+	require.NoError(t, v041bank.MigrateStore(ctx, storeKey, app.AppCodec(), paramSpace))
+	require.Equal(t, total, app.BankKeeper.GetSupply(ctx).GetTotal())
+}
+
+func TestMigrateStoreDenomOwners(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	storeKey := app.GetKey(types.StoreKey)
+	store := ctx.KVStore(storeKey)
+	paramSpace := legacyParamSpace(ctx, app)
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+
+	// write v0.40 balances, which are not indexed
+	balancesStore := prefix.NewStore(store, types.BalancesPrefix)
+	for _, balance := range []struct {
+		addr sdk.AccAddress
+		coin sdk.Coin
+	}{
+		{addr1, sdk.NewInt64Coin("atom", 10)},
+		{addr1, sdk.NewInt64Coin("stake", 0)},
+		{addr2, sdk.NewInt64Coin("stake", 20)},
+	} {
+		bz, err := app.AppCodec().MarshalBinaryBare(&balance.coin)
+		require.NoError(t, err)
+		balancesStore.Set(append(balance.addr.Bytes(), balance.coin.Denom...), bz)
+	}
+
+	require.NoError(t, v041bank.MigrateStore(ctx, storeKey, app.AppCodec(), paramSpace))
+
+	require.True(t, store.Has(types.CreateDenomAddressKey("atom", addr1)))
+	require.False(t, store.Has(types.CreateDenomAddressKey("stake", addr1)))
+	require.True(t, store.Has(types.CreateDenomAddressKey("stake", addr2)))
+	require.False(t, store.Has(types.CreateDenomAddressKey("atom", addr2)))
+}
+
+func TestMigrateStoreParams(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	storeKey := app.GetKey(types.StoreKey)
+	paramSpace := legacyParamSpace(ctx, app)
+
+	// params are only read from the store after the migration
+	app.BankKeeper.SetParams(ctx, types.Params{})
+
+	require.NoError(t, v041bank.MigrateStore(ctx, storeKey, app.AppCodec(), paramSpace))
+	require.Equal(t, types.NewParams(false, types.SendEnabledParams{types.NewSendEnabled("atom", true)}), app.BankKeeper.GetParams(ctx))
+}
+
+// legacyParamSpace returns the bank subspace holding the v0.40 params.
+func legacyParamSpace(ctx sdk.Context, app *simapp.SimApp) paramtypes.Subspace {
+	paramSpace := app.GetSubspace(types.ModuleName)
+	params := types.NewParams(false, types.SendEnabledParams{types.NewSendEnabled("atom", true)})
+	paramSpace.SetParamSet(ctx, &params)
+
+	return paramSpace
+}
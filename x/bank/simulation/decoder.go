@@ -4,32 +4,45 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
-	"github.com/cosmos/cosmos-sdk/x/bank/exported"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
-type SupplyUnmarshaller interface {
-	UnmarshalSupply([]byte) (exported.SupplyI, error)
-}
-
 // NewDecodeStore returns a function closure that unmarshals the KVPair's values
 // to the corresponding types.
-func NewDecodeStore(cdc SupplyUnmarshaller) func(kvA, kvB kv.Pair) string {
+func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
 		case bytes.Equal(kvA.Key[:1], types.SupplyKey):
-			supplyA, err := cdc.UnmarshalSupply(kvA.Value)
-			if err != nil {
+			var amountA, amountB sdk.Int
+			if err := amountA.Unmarshal(kvA.Value); err != nil {
 				panic(err)
 			}
 
-			supplyB, err := cdc.UnmarshalSupply(kvB.Value)
-			if err != nil {
+			if err := amountB.Unmarshal(kvB.Value); err != nil {
 				panic(err)
 			}
 
-			return fmt.Sprintf("%v\n%v", supplyA, supplyB)
+			return fmt.Sprintf("%v\n%v", amountA, amountB)
+
+		case bytes.Equal(kvA.Key[:1], types.DenomMetadataPrefix):
+			var metadataA, metadataB types.Metadata
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &metadataA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &metadataB)
+			return fmt.Sprintf("%v\n%v", metadataA, metadataB)
+
+		case bytes.Equal(kvA.Key[:1], types.DenomAddressPrefix):
+			addrA := sdk.AccAddress(kvA.Key[len(kvA.Key)-sdk.AddrLen:])
+			addrB := sdk.AccAddress(kvB.Key[len(kvB.Key)-sdk.AddrLen:])
+			return fmt.Sprintf("%v\n%v", addrA, addrB)
+
+		case bytes.HasPrefix(kvA.Key, types.BalancesPrefix):
+			var balanceA, balanceB sdk.Coin
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &balanceA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &balanceB)
+			return fmt.Sprintf("%v\n%v", balanceA, balanceB)
 
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
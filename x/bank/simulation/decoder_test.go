@@ -14,17 +14,29 @@ import (
 )
 
 func TestDecodeStore(t *testing.T) {
-	app := simapp.Setup(false)
-	dec := simulation.NewDecodeStore(app.BankKeeper)
+	cdc, _ := simapp.MakeCodecs()
+	dec := simulation.NewDecodeStore(cdc)
 
-	totalSupply := types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	addr := sdk.AccAddress([]byte("addr1_______________"))
 
-	supplyBz, err := app.BankKeeper.MarshalSupply(totalSupply)
+	supply := sdk.NewInt(1000)
+	supplyBz, err := supply.Marshal()
 	require.NoError(t, err)
 
+	metadata := types.Metadata{
+		DenomUnits: []*types.DenomUnit{{Denom: "uatom"}, {Denom: "atom", Exponent: 6}},
+		Base:       "uatom",
+		Display:    "atom",
+	}
+
+	balance := sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)
+
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
-			{Key: types.SupplyKey, Value: supplyBz},
+			{Key: append(types.SupplyKey, sdk.DefaultBondDenom...), Value: supplyBz},
+			{Key: types.DenomMetadataKey(metadata.Base), Value: cdc.MustMarshalBinaryBare(&metadata)},
+			{Key: types.CreateDenomAddressKey(sdk.DefaultBondDenom, addr), Value: []byte{0}},
+			{Key: append(append(types.BalancesPrefix, addr...), sdk.DefaultBondDenom...), Value: cdc.MustMarshalBinaryBare(&balance)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -33,7 +45,10 @@ func TestDecodeStore(t *testing.T) {
 		name        string
 		expectedLog string
 	}{
-		{"Supply", fmt.Sprintf("%v\n%v", totalSupply, totalSupply)},
+		{"Supply", fmt.Sprintf("%v\n%v", supply, supply)},
+		{"DenomMetadata", fmt.Sprintf("%v\n%v", metadata, metadata)},
+		{"DenomAddress", fmt.Sprintf("%v\n%v", addr, addr)},
+		{"Balance", fmt.Sprintf("%v\n%v", balance, balance)},
 		{"other", ""},
 	}
 
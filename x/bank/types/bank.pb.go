@@ -418,6 +418,46 @@ func (m *Metadata) GetDisplay() string {
 	return ""
 }
 
+// SetDenomMetadataProposal details a proposal to register or update the
+// metadata of a denomination.
+type SetDenomMetadataProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Metadata    Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *SetDenomMetadataProposal) Reset()      { *m = SetDenomMetadataProposal{} }
+func (*SetDenomMetadataProposal) ProtoMessage() {}
+func (*SetDenomMetadataProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
+}
+func (m *SetDenomMetadataProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetDenomMetadataProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetDenomMetadataProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetDenomMetadataProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDenomMetadataProposal.Merge(m, src)
+}
+func (m *SetDenomMetadataProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetDenomMetadataProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDenomMetadataProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDenomMetadataProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
@@ -426,49 +466,53 @@ func init() {
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*SetDenomMetadataProposal)(nil), "cosmos.bank.v1beta1.SetDenomMetadataProposal")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 587 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbf, 0x6f, 0xd3, 0x4e,
-	0x14, 0xf7, 0x35, 0x69, 0x9a, 0x5e, 0xfa, 0x5d, 0xee, 0xdb, 0xc1, 0xad, 0x84, 0x6d, 0x2c, 0x21,
-	0xa5, 0x88, 0x38, 0x04, 0xc4, 0x92, 0x05, 0x35, 0xa5, 0x42, 0x15, 0x42, 0x20, 0x57, 0x08, 0x09,
-	0x86, 0xe8, 0xe2, 0xbb, 0x06, 0xab, 0xf6, 0x9d, 0x95, 0x3b, 0xa3, 0xe6, 0x3f, 0x60, 0x64, 0x44,
-	0x62, 0xc9, 0xcc, 0x88, 0x58, 0x61, 0xee, 0x58, 0xc1, 0xc2, 0x14, 0x50, 0xb2, 0x30, 0x77, 0x64,
-	0x42, 0xbe, 0xb3, 0x93, 0x54, 0x0a, 0xa8, 0x03, 0x0b, 0x53, 0xde, 0x8f, 0xcf, 0xfb, 0x7c, 0x5e,
-	0xde, 0x7b, 0x67, 0x68, 0x05, 0x5c, 0xc4, 0x5c, 0x34, 0x7b, 0x98, 0x1d, 0x37, 0x5f, 0xb6, 0x7a,
-	0x54, 0xe2, 0x96, 0x72, 0xbc, 0x64, 0xc0, 0x25, 0x47, 0xff, 0xeb, 0xbc, 0xa7, 0x42, 0x79, 0x7e,
-	0x7b, 0xb3, 0xcf, 0xfb, 0x5c, 0xe5, 0x9b, 0x99, 0xa5, 0xa1, 0xdb, 0x5b, 0x1a, 0xda, 0xd5, 0x89,
-	0xbc, 0x4e, 0xa7, 0xe6, 0x2a, 0x82, 0xce, 0x54, 0x02, 0x1e, 0x32, 0x9d, 0x77, 0xbf, 0x00, 0x58,
-	0x79, 0x8c, 0x07, 0x38, 0x16, 0xe8, 0x08, 0x6e, 0x08, 0xca, 0x48, 0x97, 0x32, 0xdc, 0x8b, 0x28,
-	0x31, 0x81, 0x53, 0xaa, 0xd7, 0x6e, 0x39, 0xde, 0x92, 0x3e, 0xbc, 0x43, 0xca, 0xc8, 0xbe, 0xc6,
-	0x75, 0xae, 0x9e, 0x8f, 0xed, 0x2b, 0x43, 0x1c, 0x47, 0x6d, 0x77, 0xb1, 0xfe, 0x06, 0x8f, 0x43,
-	0x49, 0xe3, 0x44, 0x0e, 0x5d, 0xbf, 0x26, 0xe6, 0x78, 0xf4, 0x1c, 0x6e, 0x12, 0x7a, 0x84, 0xd3,
-	0x48, 0x76, 0x2f, 0xe8, 0xad, 0x38, 0xa0, 0x5e, 0xed, 0xec, 0x9c, 0x8f, 0xed, 0x6b, 0x9a, 0x6d,
-	0x19, 0x6a, 0x91, 0x15, 0xe5, 0x80, 0x85, 0x66, 0xda, 0xe5, 0x37, 0x23, 0xdb, 0x70, 0xef, 0xc3,
-	0xda, 0x42, 0x10, 0x6d, 0xc2, 0x55, 0x42, 0x19, 0x8f, 0x4d, 0xe0, 0x80, 0xfa, 0xba, 0xaf, 0x1d,
-	0x64, 0xc2, 0xb5, 0x0b, 0xd2, 0x7e, 0xe1, 0xb6, 0xab, 0x19, 0xc9, 0x8f, 0x91, 0x0d, 0xdc, 0x8f,
-	0x00, 0xae, 0x1e, 0xb0, 0x24, 0x95, 0xe8, 0x01, 0x5c, 0xc3, 0x84, 0x0c, 0xa8, 0x10, 0x8a, 0x65,
-	0xa3, 0xd3, 0xfa, 0x39, 0xb6, 0x1b, 0xfd, 0x50, 0xbe, 0x48, 0x7b, 0x5e, 0xc0, 0xe3, 0x7c, 0xec,
-	0xf9, 0x4f, 0x43, 0x90, 0xe3, 0xa6, 0x1c, 0x26, 0x54, 0x78, 0xbb, 0x41, 0xb0, 0xab, 0x0b, 0xfd,
-	0x82, 0x01, 0x61, 0xb8, 0x9a, 0xed, 0x40, 0x98, 0x2b, 0x6a, 0xc6, 0x5b, 0xf3, 0x19, 0x0b, 0x3a,
-	0x9b, 0xf1, 0x1e, 0x0f, 0x59, 0xe7, 0xe6, 0xe9, 0xd8, 0x36, 0xde, 0x7d, 0xb3, 0xeb, 0x97, 0x50,
-	0xca, 0x0a, 0x84, 0xaf, 0x99, 0xdb, 0x65, 0xd5, 0xff, 0x27, 0x00, 0x2b, 0x8f, 0x52, 0xf9, 0xef,
-	0xfe, 0x81, 0xf7, 0x00, 0x56, 0x0e, 0xd3, 0x24, 0x89, 0x86, 0x99, 0xa6, 0xe4, 0x12, 0x47, 0xf9,
-	0x61, 0xfe, 0x5d, 0x4d, 0xc5, 0xdc, 0xde, 0x7f, 0x35, 0xb2, 0x8d, 0x62, 0xf9, 0x9f, 0x3f, 0x34,
-	0xee, 0x5c, 0xff, 0x23, 0xc3, 0x89, 0x7e, 0xbc, 0xf4, 0x24, 0xe1, 0x03, 0x49, 0x89, 0xa7, 0x1b,
-	0x3d, 0x70, 0x9f, 0xc2, 0xf5, 0x7b, 0xd9, 0x89, 0x3d, 0x61, 0xa1, 0xfc, 0xcd, 0xf1, 0x6d, 0xc3,
-	0x6a, 0x56, 0xc6, 0x28, 0x93, 0xea, 0xfa, 0xfe, 0xf3, 0x67, 0x7e, 0x76, 0x98, 0x38, 0x0a, 0xb1,
-	0xa0, 0xc2, 0x2c, 0x39, 0xa5, 0xfa, 0xba, 0x5f, 0xb8, 0xee, 0x5b, 0x00, 0xab, 0x0f, 0xa9, 0xc4,
-	0x04, 0x4b, 0x8c, 0x1c, 0x58, 0x23, 0x54, 0x04, 0x83, 0x30, 0x91, 0x21, 0x67, 0x39, 0xfd, 0x62,
-	0x08, 0xdd, 0xcd, 0x10, 0x8c, 0xc7, 0xdd, 0x94, 0x85, 0xb2, 0xd8, 0x95, 0xb5, 0xf4, 0x41, 0xcf,
-	0xfa, 0xf5, 0x21, 0x29, 0x4c, 0x81, 0x10, 0x2c, 0x67, 0xd3, 0x35, 0x4b, 0x8a, 0x5b, 0xd9, 0x59,
-	0x77, 0x24, 0x14, 0x49, 0x84, 0x87, 0x66, 0x59, 0x85, 0x0b, 0xb7, 0xb3, 0x77, 0x3a, 0xb1, 0xc0,
-	0xd9, 0xc4, 0x02, 0xdf, 0x27, 0x16, 0x78, 0x3d, 0xb5, 0x8c, 0xb3, 0xa9, 0x65, 0x7c, 0x9d, 0x5a,
-	0xc6, 0xb3, 0x9d, 0xcb, 0x8c, 0x51, 0xed, 0xa3, 0x57, 0x51, 0xdf, 0xa5, 0xdb, 0xbf, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0xeb, 0x92, 0xb2, 0x14, 0x1f, 0x05, 0x00, 0x00,
+	// 635 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbd, 0x6f, 0xd3, 0x40,
+	0x1c, 0xcd, 0x35, 0x69, 0x9a, 0x5e, 0xca, 0x72, 0x74, 0x70, 0x2b, 0xd5, 0x0e, 0x96, 0x90, 0x52,
+	0x44, 0x1d, 0x5a, 0xc4, 0x92, 0x05, 0xd5, 0xa5, 0x42, 0x15, 0x42, 0x54, 0xae, 0x10, 0x12, 0x0c,
+	0xd1, 0xc5, 0x77, 0x2d, 0x56, 0xed, 0x3b, 0xcb, 0x77, 0x46, 0xcd, 0x7f, 0xc0, 0xc8, 0x88, 0x84,
+	0x90, 0x3a, 0x33, 0x22, 0x56, 0x98, 0x3b, 0x56, 0xb0, 0x30, 0x05, 0xd4, 0x2e, 0xcc, 0x1d, 0x99,
+	0xd0, 0xdd, 0xd9, 0x69, 0x8a, 0x02, 0xea, 0xc2, 0xc0, 0x94, 0xfb, 0x7d, 0xbd, 0xf7, 0x7e, 0x1f,
+	0x0e, 0xb4, 0x43, 0x2e, 0x12, 0x2e, 0x3a, 0x7d, 0xcc, 0xf6, 0x3b, 0x2f, 0x56, 0xfb, 0x54, 0xe2,
+	0x55, 0x6d, 0x78, 0x69, 0xc6, 0x25, 0x47, 0x57, 0x4d, 0xdc, 0xd3, 0xae, 0x22, 0xbe, 0x38, 0xbf,
+	0xc7, 0xf7, 0xb8, 0x8e, 0x77, 0xd4, 0xcb, 0xa4, 0x2e, 0x2e, 0x98, 0xd4, 0x9e, 0x09, 0x14, 0x75,
+	0x26, 0x74, 0xce, 0x22, 0xe8, 0x88, 0x25, 0xe4, 0x11, 0x33, 0x71, 0xf7, 0x0b, 0x80, 0xf5, 0x6d,
+	0x9c, 0xe1, 0x44, 0xa0, 0x5d, 0x38, 0x27, 0x28, 0x23, 0x3d, 0xca, 0x70, 0x3f, 0xa6, 0xc4, 0x02,
+	0xad, 0x6a, 0xbb, 0xb9, 0xd6, 0xf2, 0x26, 0xe8, 0xf0, 0x76, 0x28, 0x23, 0x9b, 0x26, 0xcf, 0xbf,
+	0x76, 0x36, 0x74, 0x96, 0x06, 0x38, 0x89, 0xbb, 0xee, 0x78, 0xfd, 0x4d, 0x9e, 0x44, 0x92, 0x26,
+	0xa9, 0x1c, 0xb8, 0x41, 0x53, 0x9c, 0xe7, 0xa3, 0x67, 0x70, 0x9e, 0xd0, 0x5d, 0x9c, 0xc7, 0xb2,
+	0x77, 0x81, 0x6f, 0xaa, 0x05, 0xda, 0x0d, 0x7f, 0xf9, 0x6c, 0xe8, 0x5c, 0x37, 0x68, 0x93, 0xb2,
+	0xc6, 0x51, 0x51, 0x91, 0x30, 0x26, 0xa6, 0x5b, 0x7b, 0x7d, 0xe8, 0x54, 0xdc, 0xfb, 0xb0, 0x39,
+	0xe6, 0x44, 0xf3, 0x70, 0x9a, 0x50, 0xc6, 0x13, 0x0b, 0xb4, 0x40, 0x7b, 0x36, 0x30, 0x06, 0xb2,
+	0xe0, 0xcc, 0x05, 0xea, 0xa0, 0x34, 0xbb, 0x0d, 0x05, 0xf2, 0xe3, 0xd0, 0x01, 0xee, 0x47, 0x00,
+	0xa7, 0xb7, 0x58, 0x9a, 0x4b, 0xf4, 0x00, 0xce, 0x60, 0x42, 0x32, 0x2a, 0x84, 0x46, 0x99, 0xf3,
+	0x57, 0x7f, 0x0e, 0x9d, 0x95, 0xbd, 0x48, 0x3e, 0xcf, 0xfb, 0x5e, 0xc8, 0x93, 0x62, 0xec, 0xc5,
+	0xcf, 0x8a, 0x20, 0xfb, 0x1d, 0x39, 0x48, 0xa9, 0xf0, 0xd6, 0xc3, 0x70, 0xdd, 0x14, 0x06, 0x25,
+	0x02, 0xc2, 0x70, 0x5a, 0xed, 0x40, 0x58, 0x53, 0x7a, 0xc6, 0x0b, 0xe7, 0x33, 0x16, 0x74, 0x34,
+	0xe3, 0x0d, 0x1e, 0x31, 0xff, 0xd6, 0xd1, 0xd0, 0xa9, 0xbc, 0xfb, 0xe6, 0xb4, 0x2f, 0xc1, 0xa4,
+	0x0a, 0x44, 0x60, 0x90, 0xbb, 0x35, 0xad, 0xff, 0x13, 0x80, 0xf5, 0x47, 0xb9, 0xfc, 0x7f, 0x1b,
+	0x78, 0x0f, 0x60, 0x7d, 0x27, 0x4f, 0xd3, 0x78, 0xa0, 0x38, 0x25, 0x97, 0x38, 0xb6, 0xc0, 0x3f,
+	0xe0, 0xd4, 0xc8, 0xdd, 0xcd, 0x97, 0x87, 0x4e, 0xa5, 0x5c, 0xfe, 0xe7, 0x0f, 0x2b, 0x77, 0x6e,
+	0xfc, 0x15, 0xe1, 0xc0, 0x7c, 0xbc, 0xf4, 0x20, 0xe5, 0x99, 0xa4, 0xc4, 0x33, 0x42, 0xb7, 0xdc,
+	0x27, 0x70, 0xf6, 0x9e, 0x3a, 0xb1, 0xc7, 0x2c, 0x92, 0x7f, 0x38, 0xbe, 0x45, 0xd8, 0x50, 0x65,
+	0x8c, 0x32, 0xa9, 0xaf, 0xef, 0x4a, 0x30, 0xb2, 0xd5, 0x61, 0xe2, 0x38, 0xc2, 0x82, 0x0a, 0xab,
+	0xda, 0xaa, 0xb6, 0x67, 0x83, 0xd2, 0x74, 0xdf, 0x00, 0xd8, 0x78, 0x48, 0x25, 0x26, 0x58, 0x62,
+	0xd4, 0x82, 0x4d, 0x42, 0x45, 0x98, 0x45, 0xa9, 0x8c, 0x38, 0x2b, 0xe0, 0xc7, 0x5d, 0xe8, 0xae,
+	0xca, 0x60, 0x3c, 0xe9, 0xe5, 0x2c, 0x92, 0xe5, 0xae, 0xec, 0x89, 0x1f, 0xf4, 0x48, 0x6f, 0x00,
+	0x49, 0xf9, 0x14, 0x08, 0xc1, 0x9a, 0x9a, 0xae, 0x55, 0xd5, 0xd8, 0xfa, 0xad, 0xd4, 0x91, 0x48,
+	0xa4, 0x31, 0x1e, 0x58, 0x35, 0xed, 0x2e, 0x4d, 0xf7, 0x2d, 0x80, 0xd6, 0x0e, 0x95, 0x1a, 0xaa,
+	0x54, 0xb9, 0x9d, 0xf1, 0x94, 0x0b, 0x1c, 0xab, 0x31, 0xc8, 0x48, 0xc6, 0xb4, 0x1c, 0x83, 0x36,
+	0x7e, 0xef, 0x61, 0x6a, 0x52, 0x0f, 0x8d, 0xa4, 0xc0, 0xd2, 0x32, 0x9a, 0x6b, 0x4b, 0x13, 0x1b,
+	0x28, 0x09, 0xfd, 0x9a, 0x5a, 0x7e, 0x30, 0x2a, 0xea, 0x36, 0xca, 0x9d, 0xfa, 0x1b, 0x47, 0x27,
+	0x36, 0x38, 0x3e, 0xb1, 0xc1, 0xf7, 0x13, 0x1b, 0xbc, 0x3a, 0xb5, 0x2b, 0xc7, 0xa7, 0x76, 0xe5,
+	0xeb, 0xa9, 0x5d, 0x79, 0xba, 0x7c, 0x99, 0x35, 0xeb, 0x7b, 0xe9, 0xd7, 0xf5, 0xff, 0xe6, 0xed,
+	0x5f, 0x03, 0x00, 0xae, 0xa9, 0xb5, 0x4d, 0xbf, 0x05, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -905,6 +949,53 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SetDenomMetadataProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDenomMetadataProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetDenomMetadataProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBank(v)
 	base := offset
@@ -1052,6 +1143,25 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *SetDenomMetadataProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovBank(uint64(l))
+	return n
+}
+
 func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1918,6 +2028,156 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetDenomMetadataProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetDenomMetadataProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetDenomMetadataProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBank(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,7 +5,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authzexported "github.com/cosmos/cosmos-sdk/x/authz/exported"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/exported"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterCodec registers the necessary x/bank interfaces and concrete types
@@ -15,12 +18,16 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&Supply{}, "cosmos-sdk/Supply", nil)
 	cdc.RegisterConcrete(&MsgSend{}, "cosmos-sdk/MsgSend", nil)
 	cdc.RegisterConcrete(&MsgMultiSend{}, "cosmos-sdk/MsgMultiSend", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "cosmos-sdk/x/bank/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&SendAuthorization{}, "cosmos-sdk/SendAuthorization", nil)
+	cdc.RegisterConcrete(&SetDenomMetadataProposal{}, "cosmos-sdk/SetDenomMetadataProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSend{},
 		&MsgMultiSend{},
+		&MsgUpdateParams{},
 	)
 
 	registry.RegisterInterface(
@@ -28,6 +35,16 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*exported.SupplyI)(nil),
 		&Supply{},
 	)
+
+	registry.RegisterImplementations(
+		(*authzexported.Authorization)(nil),
+		&SendAuthorization{},
+	)
+
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&SetDenomMetadataProposal{},
+	)
 }
 
 var (
@@ -46,4 +63,8 @@ func init() {
 	RegisterCodec(amino)
 	cryptocodec.RegisterCrypto(amino)
 	amino.Seal()
+
+	// SendAuthorization is carried inside authz messages, so it must be
+	// known to the authz amino codec as well.
+	authztypes.RegisterAuthorizationTypeCodec(&SendAuthorization{}, "cosmos-sdk/SendAuthorization")
 }
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"sort"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -42,6 +43,19 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	seenMetadata := make(map[string]bool)
+	for _, metadata := range data.DenomMetadata {
+		if seenMetadata[metadata.Base] {
+			return fmt.Errorf("duplicate client metadata for denom %s", metadata.Base)
+		}
+
+		if err := metadata.Validate(); err != nil {
+			return err
+		}
+
+		seenMetadata[metadata.Base] = true
+	}
+
 	return NewSupply(data.Supply).ValidateBasic()
 }
 
@@ -25,6 +25,8 @@ var (
 	BalancesPrefix      = []byte("balances")
 	SupplyKey           = []byte{0x00}
 	DenomMetadataPrefix = []byte{0x1}
+	DenomAddressPrefix  = []byte{0x03}
+	ParamsKey           = []byte{0x05}
 )
 
 // DenomMetadataKey returns the denomination metadata key.
@@ -33,6 +35,22 @@ func DenomMetadataKey(denom string) []byte {
 	return append(DenomMetadataPrefix, d...)
 }
 
+// CreateDenomAddressPrefix creates a prefix for the denom to holder address
+// index of the given denomination. The denomination is length prefixed so that
+// no denomination is a prefix of another one.
+func CreateDenomAddressPrefix(denom string) []byte {
+	key := make([]byte, 0, len(DenomAddressPrefix)+1+len(denom))
+	key = append(key, DenomAddressPrefix...)
+	key = append(key, byte(len(denom)))
+	return append(key, denom...)
+}
+
+// CreateDenomAddressKey creates the denom to holder address index key of the
+// given denomination and address.
+func CreateDenomAddressKey(denom string, addr sdk.AccAddress) []byte {
+	return append(CreateDenomAddressPrefix(denom), addr.Bytes()...)
+}
+
 // AddressFromBalancesStore returns an account address from a balances prefix
 // store. The key must not contain the perfix BalancesPrefix as the prefix store
 // iterator discards the actual prefix.
@@ -24,3 +24,15 @@ func TestAddressFromBalancesStore(t *testing.T) {
 	res := types.AddressFromBalancesStore(key)
 	require.Equal(t, res, addr)
 }
+
+func TestCreateDenomAddressKey(t *testing.T) {
+	addr, err := sdk.AccAddressFromBech32("cosmos1n88uc38xhjgxzw9nwre4ep2c8ga4fjxcar6mn7")
+	require.NoError(t, err)
+
+	key := types.CreateDenomAddressKey("stake", addr)
+	require.Equal(t, cloneAppend(types.CreateDenomAddressPrefix("stake"), addr.Bytes()), key)
+	require.Equal(t, append(append(types.DenomAddressPrefix, byte(5)), "stake"...), types.CreateDenomAddressPrefix("stake"))
+
+	// a denomination is never a prefix of another one
+	require.NotEqual(t, types.CreateDenomAddressPrefix("stake"), types.CreateDenomAddressPrefix("stakes")[:len(types.CreateDenomAddressPrefix("stake"))])
+}
@@ -0,0 +1,160 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate performs a basic validation of the coin metadata fields. It checks:
+//   - Base and Display denominations are valid coin denominations
+//   - Base and Display denominations are present in the DenomUnit slice
+//   - Base denomination has exponent 0
+//   - Denomination units are sorted in ascending order by exponent
+//   - Denomination units do not contain duplicate denominations or aliases
+func (m Metadata) Validate() error {
+	if err := sdk.ValidateDenom(m.Base); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "invalid base denomination: %s", err)
+	}
+
+	if err := sdk.ValidateDenom(m.Display); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "invalid display denomination: %s", err)
+	}
+
+	if len(m.DenomUnits) == 0 {
+		return sdkerrors.Wrap(ErrInvalidDenomMetadata, "denomination units cannot be empty")
+	}
+
+	var hasDisplay bool
+	seenUnits := make(map[string]bool)
+
+	for i, unit := range m.DenomUnits {
+		if unit == nil {
+			return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "denomination unit %d cannot be nil", i)
+		}
+
+		if i == 0 {
+			// the first denomination unit MUST be the base
+			if unit.Denom != m.Base {
+				return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "first denomination unit %s must be the base denomination %s", unit.Denom, m.Base)
+			}
+
+			if unit.Exponent != 0 {
+				return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "the exponent for base denomination unit %s must be 0", m.Base)
+			}
+		} else if m.DenomUnits[i-1].Exponent >= unit.Exponent {
+			return sdkerrors.Wrap(ErrInvalidDenomMetadata, "denomination units must be sorted in strictly ascending order by exponent")
+		}
+
+		if err := unit.Validate(); err != nil {
+			return err
+		}
+
+		if unit.Denom == m.Display {
+			hasDisplay = true
+		}
+
+		for _, denom := range append([]string{unit.Denom}, unit.Aliases...) {
+			if seenUnits[denom] {
+				return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "duplicate denomination unit or alias %s", denom)
+			}
+
+			seenUnits[denom] = true
+		}
+	}
+
+	if !hasDisplay {
+		return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "display denomination %s is not a denomination unit", m.Display)
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the denomination unit fields.
+func (du DenomUnit) Validate() error {
+	if err := sdk.ValidateDenom(du.Denom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "invalid denomination unit: %s", err)
+	}
+
+	for _, alias := range du.Aliases {
+		if strings.TrimSpace(alias) == "" {
+			return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "alias of denomination unit %s cannot be blank", du.Denom)
+		}
+	}
+
+	return nil
+}
+
+// GetDenomUnit returns the denomination unit whose denomination or one of its
+// aliases matches the given denomination. A boolean is returned indicating
+// whether such unit exists.
+func (m Metadata) GetDenomUnit(denom string) (DenomUnit, bool) {
+	for _, unit := range m.DenomUnits {
+		if unit == nil {
+			continue
+		}
+
+		if unit.Denom == denom {
+			return *unit, true
+		}
+
+		for _, alias := range unit.Aliases {
+			if alias == denom {
+				return *unit, true
+			}
+		}
+	}
+
+	return DenomUnit{}, false
+}
+
+// ConvertDecCoin converts a coin denominated in one of the metadata's
+// denomination units (or aliases) to the given target denomination unit (or
+// alias). The returned coin is denominated in the target unit's denomination.
+func (m Metadata) ConvertDecCoin(coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+	srcUnit, ok := m.GetDenomUnit(coin.Denom)
+	if !ok {
+		return sdk.DecCoin{}, sdkerrors.Wrapf(ErrInvalidDenomMetadata, "%s is not a denomination unit of %s", coin.Denom, m.Base)
+	}
+
+	dstUnit, ok := m.GetDenomUnit(denom)
+	if !ok {
+		return sdk.DecCoin{}, sdkerrors.Wrapf(ErrInvalidDenomMetadata, "%s is not a denomination unit of %s", denom, m.Base)
+	}
+
+	amount := coin.Amount
+	ten := sdk.NewDec(10)
+
+	switch {
+	case srcUnit.Exponent > dstUnit.Exponent:
+		amount = amount.Mul(ten.Power(uint64(srcUnit.Exponent - dstUnit.Exponent)))
+
+	case srcUnit.Exponent < dstUnit.Exponent:
+		amount = amount.Quo(ten.Power(uint64(dstUnit.Exponent - srcUnit.Exponent)))
+	}
+
+	return sdk.DecCoin{Denom: dstUnit.Denom, Amount: amount}, nil
+}
+
+// ToDisplayCoin converts a coin denominated in any of the metadata's
+// denomination units to the display denomination.
+func (m Metadata) ToDisplayCoin(coin sdk.Coin) (sdk.DecCoin, error) {
+	return m.ConvertDecCoin(sdk.NewDecCoinFromCoin(coin), m.Display)
+}
+
+// ToBaseCoin converts a coin denominated in any of the metadata's denomination
+// units to the base denomination. An error is returned if the resulting amount
+// is not integral.
+func (m Metadata) ToBaseCoin(coin sdk.DecCoin) (sdk.Coin, error) {
+	baseCoin, err := m.ConvertDecCoin(coin, m.Base)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if !baseCoin.Amount.Equal(baseCoin.Amount.TruncateDec()) {
+		return sdk.Coin{}, sdkerrors.Wrapf(ErrInvalidDenomMetadata, "%s cannot be represented in the base denomination %s", coin, m.Base)
+	}
+
+	return sdk.Coin{Denom: baseCoin.Denom, Amount: baseCoin.Amount.TruncateInt()}, nil
+}
@@ -0,0 +1,109 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func atomMetadata() types.Metadata {
+	return types.Metadata{
+		Description: "The native staking token of the Cosmos Hub.",
+		DenomUnits: []*types.DenomUnit{
+			{"uatom", uint32(0), []string{"microatom"}},
+			{"matom", uint32(3), []string{"milliatom"}},
+			{"atom", uint32(6), nil},
+		},
+		Base:    "uatom",
+		Display: "atom",
+	}
+}
+
+func TestMetadataValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(*types.Metadata)
+		expErr   bool
+	}{
+		{"valid metadata", func(*types.Metadata) {}, false},
+		{"invalid base denom", func(m *types.Metadata) { m.Base = "" }, true},
+		{"invalid display denom", func(m *types.Metadata) { m.Display = "" }, true},
+		{"no denom units", func(m *types.Metadata) { m.DenomUnits = nil }, true},
+		{"nil denom unit", func(m *types.Metadata) { m.DenomUnits[1] = nil }, true},
+		{"base is not the first unit", func(m *types.Metadata) { m.Base = "matom" }, true},
+		{"base exponent is not zero", func(m *types.Metadata) { m.DenomUnits[0].Exponent = 1 }, true},
+		{"units not sorted by exponent", func(m *types.Metadata) { m.DenomUnits[2].Exponent = 2 }, true},
+		{"duplicate exponent", func(m *types.Metadata) { m.DenomUnits[2].Exponent = 3 }, true},
+		{"display is not a unit", func(m *types.Metadata) { m.Display = "katom" }, true},
+		{"duplicate unit", func(m *types.Metadata) { m.DenomUnits[2].Denom = "matom"; m.Display = "matom" }, true},
+		{"duplicate alias", func(m *types.Metadata) { m.DenomUnits[1].Aliases = []string{"microatom"} }, true},
+		{"alias equals unit", func(m *types.Metadata) { m.DenomUnits[1].Aliases = []string{"atom"} }, true},
+		{"blank alias", func(m *types.Metadata) { m.DenomUnits[1].Aliases = []string{" "} }, true},
+		{"invalid unit denom", func(m *types.Metadata) { m.DenomUnits[1].Denom = "1atom" }, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			metadata := atomMetadata()
+			tc.malleate(&metadata)
+
+			err := metadata.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMetadataConvertDecCoin(t *testing.T) {
+	metadata := atomMetadata()
+
+	unit, found := metadata.GetDenomUnit("milliatom")
+	require.True(t, found)
+	require.Equal(t, "matom", unit.Denom)
+
+	_, found = metadata.GetDenomUnit("katom")
+	require.False(t, found)
+
+	displayCoin, err := metadata.ToDisplayCoin(sdk.NewInt64Coin("uatom", 1500000))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(15, 1)), displayCoin)
+
+	milliCoin, err := metadata.ConvertDecCoin(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(25, 1)), "milliatom")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64DecCoin("matom", 2500), milliCoin)
+
+	baseCoin, err := metadata.ToBaseCoin(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(15, 1)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uatom", 1500000), baseCoin)
+
+	_, err = metadata.ToBaseCoin(sdk.NewDecCoinFromDec("matom", sdk.NewDecWithPrec(15, 4)))
+	require.Error(t, err)
+
+	_, err = metadata.ConvertDecCoin(sdk.NewInt64DecCoin("stake", 1), "atom")
+	require.Error(t, err)
+
+	_, err = metadata.ConvertDecCoin(sdk.NewInt64DecCoin("atom", 1), "stake")
+	require.Error(t, err)
+}
+
+func TestSetDenomMetadataProposalValidateBasic(t *testing.T) {
+	proposal := types.NewSetDenomMetadataProposal("title", "description", atomMetadata())
+	require.NoError(t, proposal.ValidateBasic())
+	require.Equal(t, types.RouterKey, proposal.ProposalRoute())
+	require.Equal(t, types.ProposalTypeSetDenomMetadata, proposal.ProposalType())
+
+	proposal = types.NewSetDenomMetadataProposal("", "description", atomMetadata())
+	require.Error(t, proposal.ValidateBasic())
+
+	invalid := atomMetadata()
+	invalid.Display = "katom"
+	proposal = types.NewSetDenomMetadataProposal("title", "description", invalid)
+	require.Error(t, proposal.ValidateBasic())
+}
@@ -7,8 +7,9 @@ import (
 
 // bank message types
 const (
-	TypeMsgSend      = "send"
-	TypeMsgMultiSend = "multisend"
+	TypeMsgSend         = "send"
+	TypeMsgMultiSend    = "multisend"
+	TypeMsgUpdateParams = "update_params"
 )
 
 var _ sdk.Msg = &MsgSend{}
@@ -98,6 +99,38 @@ func (msg MsgMultiSend) GetSigners() []sdk.AccAddress {
 	return addrs
 }
 
+var _ sdk.Msg = &MsgUpdateParams{}
+
+// NewMsgUpdateParams - construct a msg to update the bank module parameters.
+func NewMsgUpdateParams(authority sdk.AccAddress, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{Authority: authority, Params: params}
+}
+
+// Route Implements Msg
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// ValidateBasic Implements Msg.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+
+	return msg.Params.Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
+
 // ValidateBasic - validate transaction input
 func (in Input) ValidateBasic() error {
 	if err := sdk.VerifyAddressFormat(in.Address); err != nil {
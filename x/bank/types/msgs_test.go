@@ -269,3 +269,26 @@ func TestMsgSendSigners(t *testing.T) {
 	require.Equal(t, signers, tx.Signers())
 }
 */
+
+func TestMsgUpdateParamsValidation(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________"))
+
+	cases := []struct {
+		name       string
+		msg        *MsgUpdateParams
+		expectPass bool
+	}{
+		{"valid", NewMsgUpdateParams(authority, DefaultParams()), true},
+		{"empty authority", NewMsgUpdateParams(sdk.AccAddress{}, DefaultParams()), false},
+		{"invalid send enabled denom", NewMsgUpdateParams(authority, NewParams(true, SendEnabledParams{NewSendEnabled("", true)})), false},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeSetDenomMetadata defines the type for a SetDenomMetadataProposal
+	ProposalTypeSetDenomMetadata = "SetDenomMetadata"
+)
+
+// Assert SetDenomMetadataProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &SetDenomMetadataProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetDenomMetadata)
+	govtypes.RegisterProposalTypeCodec(&SetDenomMetadataProposal{}, "cosmos-sdk/SetDenomMetadataProposal")
+}
+
+// NewSetDenomMetadataProposal creates a new set denomination metadata proposal.
+func NewSetDenomMetadataProposal(title, description string, metadata Metadata) *SetDenomMetadataProposal {
+	return &SetDenomMetadataProposal{title, description, metadata}
+}
+
+// GetTitle returns the title of a set denomination metadata proposal.
+func (sdp *SetDenomMetadataProposal) GetTitle() string { return sdp.Title }
+
+// GetDescription returns the description of a set denomination metadata proposal.
+func (sdp *SetDenomMetadataProposal) GetDescription() string { return sdp.Description }
+
+// ProposalRoute returns the routing key of a set denomination metadata proposal.
+func (sdp *SetDenomMetadataProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a set denomination metadata proposal.
+func (sdp *SetDenomMetadataProposal) ProposalType() string { return ProposalTypeSetDenomMetadata }
+
+// ValidateBasic runs basic stateless validity checks
+func (sdp *SetDenomMetadataProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(sdp); err != nil {
+		return err
+	}
+
+	return sdp.Metadata.Validate()
+}
+
+// String implements the Stringer interface.
+func (sdp SetDenomMetadataProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Denom Metadata Proposal:
+  Title:       %s
+  Description: %s
+  Base:        %s
+  Display:     %s
+`, sdp.Title, sdp.Description, sdp.Metadata.Base, sdp.Metadata.Display))
+	return b.String()
+}
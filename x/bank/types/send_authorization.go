@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authzexported "github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+var _ authzexported.Authorization = &SendAuthorization{}
+
+// NewSendAuthorization creates a new SendAuthorization object.
+func NewSendAuthorization(spendLimit sdk.Coins) *SendAuthorization {
+	return &SendAuthorization{
+		SpendLimit: spendLimit,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a SendAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSend{})
+}
+
+// Accept implements Authorization.Accept. The spend limit is reduced by the
+// amount sent and the authorization is deleted once it is fully spent.
+func (a SendAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authzexported.AcceptResponse, error) {
+	mSend, ok := msg.(*MsgSend)
+	if !ok {
+		return authzexported.AcceptResponse{}, sdkerrors.Wrap(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+
+	limitLeft, isNegative := a.SpendLimit.SafeSub(mSend.Amount)
+	if isNegative {
+		return authzexported.AcceptResponse{}, sdkerrors.ErrInsufficientFunds
+	}
+
+	if limitLeft.IsZero() {
+		return authzexported.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authzexported.AcceptResponse{Accept: true, Delete: false, Updated: &SendAuthorization{SpendLimit: limitLeft}}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a SendAuthorization) ValidateBasic() error {
+	if a.SpendLimit == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "spend limit cannot be nil")
+	}
+
+	if !a.SpendLimit.IsValid() || !a.SpendLimit.IsAllPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit must be positive: %s", a.SpendLimit)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSendAuthorization(t *testing.T) {
+	ctx := sdk.Context{}
+	fromAddr := sdk.AccAddress("_____from_address___")
+	toAddr := sdk.AccAddress("______to_address____")
+	coins1000 := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	coins500 := sdk.NewCoins(sdk.NewInt64Coin("stake", 500))
+
+	authorization := NewSendAuthorization(coins1000)
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", authorization.MsgTypeURL())
+	require.NoError(t, authorization.ValidateBasic())
+
+	t.Log("verify a partial spend updates the spend limit")
+	resp, err := authorization.Accept(ctx, NewMsgSend(fromAddr, toAddr, coins500))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Equal(t, NewSendAuthorization(coins500), resp.Updated)
+
+	t.Log("verify spending the whole limit deletes the authorization")
+	resp, err = authorization.Accept(ctx, NewMsgSend(fromAddr, toAddr, coins1000))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	t.Log("verify exceeding the limit is rejected")
+	_, err = NewSendAuthorization(coins500).Accept(ctx, NewMsgSend(fromAddr, toAddr, coins1000))
+	require.Error(t, err)
+
+	t.Log("verify other msg types are rejected")
+	_, err = authorization.Accept(ctx, NewMsgMultiSend(nil, nil))
+	require.Error(t, err)
+
+	t.Log("verify an empty spend limit is invalid")
+	require.Error(t, NewSendAuthorization(nil).ValidateBasic())
+	require.Error(t, NewSendAuthorization(sdk.Coins{sdk.NewInt64Coin("stake", 0)}).ValidateBasic())
+}
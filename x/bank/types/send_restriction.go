@@ -0,0 +1,72 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendRestrictionFn can restrict sends and/or provide a new receiver address.
+// It is consulted before coins are moved from fromAddr to toAddr. Returning an
+// error rejects the transfer, otherwise the coins are sent to the returned
+// address, which may differ from toAddr.
+type SendRestrictionFn func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (newToAddr sdk.AccAddress, err error)
+
+// NoOpSendRestrictionFn is a SendRestrictionFn that does not restrict or
+// redirect any send.
+func NoOpSendRestrictionFn(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+	return toAddr, nil
+}
+
+// Then creates a composite restriction that runs this one then the provided
+// second one. The second restriction receives the address returned by the
+// first one.
+func (r SendRestrictionFn) Then(second SendRestrictionFn) SendRestrictionFn {
+	return ComposeSendRestrictions(r, second)
+}
+
+// ComposeSendRestrictions combines multiple restrictions into one, applying
+// them in the order provided. Nil restrictions are ignored. Evaluation stops at
+// the first error.
+func ComposeSendRestrictions(restrictions ...SendRestrictionFn) SendRestrictionFn {
+	toRun := make([]SendRestrictionFn, 0, len(restrictions))
+	for _, r := range restrictions {
+		if r != nil {
+			toRun = append(toRun, r)
+		}
+	}
+
+	switch len(toRun) {
+	case 0:
+		return nil
+
+	case 1:
+		return toRun[0]
+	}
+
+	return func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		var err error
+		for _, r := range toRun {
+			toAddr, err = r(ctx, fromAddr, toAddr, amt)
+			if err != nil {
+				return toAddr, err
+			}
+		}
+
+		return toAddr, nil
+	}
+}
+
+type originModuleKey struct{}
+
+// WithOriginModule returns a new context recording that the sends performed
+// with it are initiated by the given module.
+func WithOriginModule(ctx sdk.Context, moduleName string) sdk.Context {
+	return ctx.WithValue(originModuleKey{}, moduleName)
+}
+
+// GetOriginModule returns the name of the module that initiated the sends
+// performed with the given context, or an empty string if the sends are not
+// initiated by a module.
+func GetOriginModule(ctx sdk.Context) string {
+	moduleName, _ := ctx.Value(originModuleKey{}).(string)
+	return moduleName
+}
@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestComposeSendRestrictions(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	addr3 := sdk.AccAddress([]byte("addr3"))
+	ctx := sdk.Context{}
+
+	require.Nil(t, types.ComposeSendRestrictions())
+	require.Nil(t, types.ComposeSendRestrictions(nil, nil))
+
+	var calls []string
+	redirect := func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "redirect")
+		return addr3, nil
+	}
+	reject := func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "reject")
+		if toAddr.Equals(addr3) {
+			return nil, errors.New("rejected")
+		}
+		return toAddr, nil
+	}
+
+	toAddr, err := types.SendRestrictionFn(types.NoOpSendRestrictionFn).Then(redirect)(ctx, addr1, addr2, nil)
+	require.NoError(t, err)
+	require.Equal(t, addr3, toAddr)
+	require.Equal(t, []string{"redirect"}, calls)
+
+	calls = nil
+	_, err = types.ComposeSendRestrictions(redirect, nil, reject)(ctx, addr1, addr2, nil)
+	require.Error(t, err)
+	require.Equal(t, []string{"redirect", "reject"}, calls)
+
+	calls = nil
+	toAddr, err = types.ComposeSendRestrictions(reject, redirect)(ctx, addr1, addr2, nil)
+	require.NoError(t, err)
+	require.Equal(t, addr3, toAddr)
+	require.Equal(t, []string{"reject", "redirect"}, calls)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -13,6 +14,8 @@ import (
 
 // Keeper - crisis keeper
 type Keeper struct {
+	cdc            codec.BinaryMarshaler
+	storeKey       sdk.StoreKey
 	routes         []types.InvarRoute
 	paramSpace     paramtypes.Subspace
 	invCheckPeriod uint
@@ -20,12 +23,18 @@ type Keeper struct {
 	supplyKeeper types.SupplyKeeper
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	// the address capable of executing a MsgUpdateParams message, typically
+	// the x/gov module account
+	authority sdk.AccAddress
 }
 
-// NewKeeper creates a new Keeper object
+// NewKeeper creates a new Keeper object. The param subspace is only used by the
+// store migration moving the constant fee out of it.
 func NewKeeper(
-	paramSpace paramtypes.Subspace, invCheckPeriod uint, supplyKeeper types.SupplyKeeper,
-	feeCollectorName string,
+	cdc codec.BinaryMarshaler, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace,
+	invCheckPeriod uint, supplyKeeper types.SupplyKeeper, feeCollectorName string,
+	authority sdk.AccAddress,
 ) Keeper {
 
 	// set KeyTable if it has not already been set
@@ -34,14 +43,22 @@ func NewKeeper(
 	}
 
 	return Keeper{
+		cdc:              cdc,
+		storeKey:         storeKey,
 		routes:           make([]types.InvarRoute, 0),
 		paramSpace:       paramSpace,
 		invCheckPeriod:   invCheckPeriod,
 		supplyKeeper:     supplyKeeper,
 		feeCollectorName: feeCollectorName,
+		authority:        authority,
 	}
 }
 
+// GetAuthority returns the x/crisis module's authority.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
 
 func TestLogger(t *testing.T) {
@@ -44,3 +45,19 @@ func TestAssertInvariants(t *testing.T) {
 	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "", true })
 	require.Panics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
 }
+
+func TestMsgUpdateParams(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	constantFee := sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000)
+
+	// only the authority may update the constant fee
+	_, err := app.CrisisKeeper.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(sdk.AccAddress("other"), constantFee))
+	require.Error(t, err)
+	require.NotEqual(t, constantFee, app.CrisisKeeper.GetConstantFee(ctx))
+
+	_, err = app.CrisisKeeper.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(app.CrisisKeeper.GetAuthority(), constantFee))
+	require.NoError(t, err)
+	require.Equal(t, constantFee, app.CrisisKeeper.GetConstantFee(ctx))
+}
@@ -5,13 +5,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
 
-// GetConstantFee get's the constant fee from the paramSpace
+// GetConstantFee get's the constant fee from the store
 func (k Keeper) GetConstantFee(ctx sdk.Context) (constantFee sdk.Coin) {
-	k.paramSpace.Get(ctx, types.ParamStoreKeyConstantFee, &constantFee)
+	bz := ctx.KVStore(k.storeKey).Get(types.ConstantFeeKey)
+	if bz == nil {
+		panic("stored constant fee should not have been nil")
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &constantFee)
 	return
 }
 
-// GetConstantFee set's the constant fee in the paramSpace
+// GetConstantFee set's the constant fee in the store
 func (k Keeper) SetConstantFee(ctx sdk.Context, constantFee sdk.Coin) {
-	k.paramSpace.Set(ctx, types.ParamStoreKeyConstantFee, constantFee)
+	ctx.KVStore(k.storeKey).Set(types.ConstantFeeKey, k.cdc.MustMarshalBinaryBare(&constantFee))
 }
@@ -0,0 +1,36 @@
+package v041
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.40 to v0.41. The
+// migration includes:
+//
+// - Move the constant fee from the x/params subspace to the crisis store under
+// types.ConstantFeeKey.
+//
+// NOTE: the crisis store did not exist before v0.41, so it must be added by the
+// upgrade's store loader before the migration runs.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, paramSpace paramtypes.Subspace) error {
+	var constantFee sdk.Coin
+	paramSpace.Get(ctx, types.ParamStoreKeyConstantFee, &constantFee)
+
+	if !constantFee.IsValid() {
+		return fmt.Errorf("invalid constant fee: %s", constantFee)
+	}
+
+	bz, err := cdc.MarshalBinaryBare(&constantFee)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(storeKey).Set(types.ConstantFeeKey, bz)
+
+	return nil
+}
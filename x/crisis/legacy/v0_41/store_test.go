@@ -0,0 +1,26 @@
+package v041_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v041crisis "github.com/cosmos/cosmos-sdk/x/crisis/legacy/v0_41"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	storeKey := app.GetKey(types.StoreKey)
+	paramSpace := app.GetSubspace(types.ModuleName)
+
+	constantFee := sdk.NewInt64Coin("stake", 2000)
+	paramSpace.Set(ctx, types.ParamStoreKeyConstantFee, constantFee)
+
+	require.NoError(t, v041crisis.MigrateStore(ctx, storeKey, app.AppCodec(), paramSpace))
+	require.Equal(t, constantFee, app.CrisisKeeper.GetConstantFee(ctx))
+}
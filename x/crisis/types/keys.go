@@ -3,4 +3,12 @@ package types
 const (
 	// module name
 	ModuleName = "crisis"
+
+	// StoreKey is the default store key for crisis
+	StoreKey = ModuleName
+)
+
+var (
+	// ConstantFeeKey is the key for the constant fee parameter in the crisis store
+	ConstantFeeKey = []byte{0x01}
 )
@@ -2,10 +2,19 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// crisis message types
+const (
+	TypeMsgUpdateParams = "update_params"
 )
 
 // ensure Msg interface compliance at compile time
-var _ sdk.Msg = &MsgVerifyInvariant{}
+var (
+	_ sdk.Msg = &MsgVerifyInvariant{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
 
 // NewMsgVerifyInvariant creates a new MsgVerifyInvariant object
 func NewMsgVerifyInvariant(sender sdk.AccAddress, invModeName, invRoute string) *MsgVerifyInvariant {
@@ -40,3 +49,32 @@ func (msg MsgVerifyInvariant) ValidateBasic() error {
 func (msg MsgVerifyInvariant) FullInvariantRoute() string {
 	return msg.InvariantModuleName + "/" + msg.InvariantRoute
 }
+
+// NewMsgUpdateParams creates a new MsgUpdateParams object
+func NewMsgUpdateParams(authority sdk.AccAddress, constantFee sdk.Coin) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority:   authority,
+		ConstantFee: constantFee,
+	}
+}
+
+func (msg MsgUpdateParams) Route() string { return ModuleName }
+func (msg MsgUpdateParams) Type() string  { return TypeMsgUpdateParams }
+
+// GetSigners returns the authority as the only signer
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Authority} }
+
+// GetSignBytes gets the sign bytes for the msg MsgUpdateParams
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if msg.Authority.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty authority address")
+	}
+
+	return validateConstantFee(msg.ConstantFee)
+}
@@ -35,4 +35,15 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	// record the proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
+
+	// prune the withdraw records past their retention
+	k.PruneWithdrawRecords(ctx)
+}
+
+// EndBlocker restakes the rewards of the delegations which opted into
+// auto-compounding
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.AutoCompoundRewards(ctx)
 }
@@ -15,6 +15,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
+// Query flags for the x/distribution module
+var (
+	FlagStartHeight = "start-height"
+	FlagEndHeight   = "end-height"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	distQueryCmd := &cobra.Command{
@@ -31,6 +37,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorCommission(),
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(),
+		GetCmdQueryDelegatorWithdrawRecords(),
+		GetCmdQueryDelegatorAutoCompoundValidators(),
 		GetCmdQueryCommunityPool(),
 	)
 
@@ -290,6 +298,125 @@ $ %s query distribution rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1ggh
 	return cmd
 }
 
+// GetCmdQueryDelegatorWithdrawRecords implements the query delegator withdraw
+// records command.
+func GetCmdQueryDelegatorWithdrawRecords() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "withdraw-records [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the rewards and commission withdrawals recorded for a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rewards and commission withdrawals recorded for a delegator,
+optionally within a block range. Withdrawals are only recorded when enabled by
+the distribution params.
+
+Example:
+$ %s query distribution withdraw-records %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9 --%s=100 --%s=200
+`,
+				version.AppName, bech32PrefixAccAddr, FlagStartHeight, FlagEndHeight,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			startHeight, err := cmd.Flags().GetInt64(FlagStartHeight)
+			if err != nil {
+				return err
+			}
+
+			endHeight, err := cmd.Flags().GetInt64(FlagEndHeight)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorWithdrawRecords(
+				context.Background(),
+				&types.QueryDelegatorWithdrawRecordsRequest{
+					DelegatorAddress: delegatorAddr,
+					StartingHeight:   startHeight,
+					EndingHeight:     endHeight,
+					Pagination:       pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagStartHeight, 0, "Height from which the withdrawals are queried")
+	cmd.Flags().Int64(FlagEndHeight, 0, "Height until which the withdrawals are queried, no limit if zero")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "withdraw records")
+	return cmd
+}
+
+// GetCmdQueryDelegatorAutoCompoundValidators implements the query delegator
+// auto-compound validators command.
+func GetCmdQueryDelegatorAutoCompoundValidators() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "auto-compound-validators [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the validators a delegator auto-compounds the rewards of",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validators a delegator auto-compounds the rewards of.
+
+Example:
+$ %s query distribution auto-compound-validators %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorAutoCompoundValidators(
+				context.Background(),
+				&types.QueryDelegatorAutoCompoundValidatorsRequest{DelegatorAddress: delegatorAddr},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryCommunityPool returns the command for fetching community pool info.
 func GetCmdQueryCommunityPool() *cobra.Command {
 	cmd := &cobra.Command{
@@ -167,6 +167,8 @@ func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val exported.Validato
 		if err != nil {
 			return nil, err
 		}
+
+		k.recordWithdrawal(ctx, del.GetDelegatorAddr(), del.GetValidatorAddr(), coins, false)
 	}
 
 	// update the outstanding rewards and the community pool only if the
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -379,6 +380,172 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	)
 }
 
+func TestWithdrawRecords(t *testing.T) {
+	balancePower := int64(1000)
+	balanceTokens := sdk.TokensFromConsensusPower(balancePower)
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	delAddr := sdk.AccAddress(valAddrs[0])
+
+	sh := staking.NewHandler(app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with 50% commission
+	valTokens := sdk.TokensFromConsensusPower(100)
+	commission := stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := stakingtypes.NewMsgCreateValidator(
+		valAddrs[0], valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		stakingtypes.Description{}, commission, sdk.OneInt(),
+	)
+
+	res, err := sh(ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	initial := sdk.TokensFromConsensusPower(10)
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
+
+	// withdrawals are not recorded by default
+	ctx = ctx.WithBlockHeight(1)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+	_, err = app.DistrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddrs[0])
+	require.NoError(t, err)
+
+	_, found := app.DistrKeeper.GetWithdrawRecord(ctx, delAddr, 1, valAddrs[0], false)
+	require.False(t, found)
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.RecordWithdrawals = true
+	params.WithdrawRecordRetention = 10
+	app.DistrKeeper.SetParams(ctx, params)
+
+	// rewards and commission are recorded separately
+	ctx = ctx.WithBlockHeight(2)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+	_, err = app.DistrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddrs[0])
+	require.NoError(t, err)
+	_, err = app.DistrKeeper.WithdrawValidatorCommission(ctx, valAddrs[0])
+	require.NoError(t, err)
+
+	// the commission accumulated at height 1 was not withdrawn then, so the
+	// commission recorded at height 2 covers the allocations of both heights
+	rewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2)))
+	record, found := app.DistrKeeper.GetWithdrawRecord(ctx, delAddr, 2, valAddrs[0], false)
+	require.True(t, found)
+	require.Equal(t, types.WithdrawRecord{
+		DelegatorAddress: delAddr, ValidatorAddress: valAddrs[0], Height: 2, Amount: rewards,
+	}, record)
+
+	record, found = app.DistrKeeper.GetWithdrawRecord(ctx, delAddr, 2, valAddrs[0], true)
+	require.True(t, found)
+	require.Equal(t, types.WithdrawRecord{
+		DelegatorAddress: delAddr, ValidatorAddress: valAddrs[0], Height: 2, Amount: rewards.Add(rewards...), Commission: true,
+	}, record)
+
+	// withdrawals at the same height are merged
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+	_, err = app.DistrKeeper.WithdrawValidatorCommission(ctx, valAddrs[0])
+	require.NoError(t, err)
+
+	record, found = app.DistrKeeper.GetWithdrawRecord(ctx, delAddr, 2, valAddrs[0], true)
+	require.True(t, found)
+	require.Equal(t, rewards.Add(rewards...).Add(rewards...), record.Amount)
+
+	// records are kept for the retention period
+	ctx = ctx.WithBlockHeight(12)
+	app.DistrKeeper.PruneWithdrawRecords(ctx)
+	_, found = app.DistrKeeper.GetWithdrawRecord(ctx, delAddr, 2, valAddrs[0], false)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockHeight(13)
+	app.DistrKeeper.PruneWithdrawRecords(ctx)
+	_, found = app.DistrKeeper.GetWithdrawRecord(ctx, delAddr, 2, valAddrs[0], false)
+	require.False(t, found)
+	_, found = app.DistrKeeper.GetWithdrawRecord(ctx, delAddr, 2, valAddrs[0], true)
+	require.False(t, found)
+
+	count := 0
+	app.DistrKeeper.IterateWithdrawRecords(ctx, func(types.WithdrawRecord) bool {
+		count++
+		return false
+	})
+	require.Zero(t, count)
+}
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	sh := staking.NewHandler(app.StakingKeeper)
+
+	// create validator with 0% commission
+	valTokens := sdk.TokensFromConsensusPower(100)
+	commission := stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	msg := stakingtypes.NewMsgCreateValidator(
+		valAddrs[0], valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		stakingtypes.Description{}, commission, sdk.OneInt(),
+	)
+
+	res, err := sh(ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// delegate the same amount from a second account
+	res, err = sh(ctx, stakingtypes.NewMsgDelegate(addr[1], valAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, valTokens)))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// tokenize the whole delegation of the second account
+	res, err = sh(ctx, stakingtypes.NewMsgTokenizeShares(addr[1], valAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, valTokens), addr[1]))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// set module account coins and allocate some rewards
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	initial := sdk.TokensFromConsensusPower(10)
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	// accounts without tokenize share records cannot withdraw
+	_, err = app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addr[0])
+	require.Error(t, err)
+
+	balanceBefore := app.BankKeeper.GetBalance(ctx, addr[1], sdk.DefaultBondDenom)
+
+	// the tokenized delegation holds half of the shares
+	rewards, err := app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addr[1])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))), rewards)
+
+	balanceAfter := app.BankKeeper.GetBalance(ctx, addr[1], sdk.DefaultBondDenom)
+	require.Equal(t, initial.QuoRaw(2), balanceAfter.Amount.Sub(balanceBefore.Amount))
+}
+
 func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -695,3 +862,97 @@ func TestCalculateRewardsMultiDelegatorMultWithdraw(t *testing.T) {
 	// commission should be zero
 	require.True(t, app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[0]).Commission.IsZero())
 }
+
+func TestAutoCompoundRewards(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	sh := staking.NewHandler(app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1000)))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with 50% commission and a second delegation
+	valTokens := sdk.TokensFromConsensusPower(100)
+	commission := stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	res, err := sh(ctx, stakingtypes.NewMsgCreateValidator(
+		valAddrs[0], valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		stakingtypes.Description{}, commission, sdk.OneInt(),
+	))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	res, err = sh(ctx, stakingtypes.NewMsgDelegate(addrs[1], valAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, valTokens)))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))}
+
+	// the delegation must exist and rewards must be withdrawn to the delegator
+	require.Equal(t, types.ErrNoDelegationExists, app.DistrKeeper.SetDelegationAutoCompound(ctx, addrs[2], valAddrs[0], true))
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, addrs[1], addrs[2]))
+	require.Equal(t, types.ErrCompoundWithdrawAddr, app.DistrKeeper.SetDelegationAutoCompound(ctx, addrs[1], valAddrs[0], true))
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, addrs[1], addrs[1]))
+
+	require.NoError(t, app.DistrKeeper.SetDelegationAutoCompound(ctx, addrs[0], valAddrs[0], true))
+	require.NoError(t, app.DistrKeeper.SetDelegationAutoCompound(ctx, addrs[1], valAddrs[0], true))
+	require.True(t, app.DistrKeeper.HasAutoCompoundSetting(ctx, addrs[0], valAddrs[0]))
+	require.True(t, app.DistrKeeper.HasAutoCompoundSetting(ctx, addrs[1], valAddrs[0]))
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.AutoCompoundEpoch = 5
+	params.MaxAutoCompoundsPerBlock = 1
+	app.DistrKeeper.SetParams(ctx, params)
+
+	shares := func(delAddr sdk.AccAddress) sdk.Dec {
+		return app.StakingKeeper.Delegation(ctx, delAddr, valAddrs[0]).GetShares()
+	}
+	initShares0, initShares1 := shares(addrs[0]), shares(addrs[1])
+
+	// nothing is compounded outside of an epoch
+	ctx = ctx.WithBlockHeight(4)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+	app.DistrKeeper.AutoCompoundRewards(ctx)
+	require.Equal(t, initShares0, shares(addrs[0]))
+	require.Equal(t, initShares1, shares(addrs[1]))
+	_, found := app.DistrKeeper.GetAutoCompoundCursor(ctx)
+	require.False(t, found)
+
+	// a single delegation is compounded per block
+	ctx = ctx.WithBlockHeight(5)
+	app.DistrKeeper.AutoCompoundRewards(ctx)
+	compounded0 := shares(addrs[0]).GT(initShares0)
+	compounded1 := shares(addrs[1]).GT(initShares1)
+	require.True(t, compounded0 != compounded1)
+	_, found = app.DistrKeeper.GetAutoCompoundCursor(ctx)
+	require.True(t, found)
+
+	// the run resumes in the next block
+	ctx = ctx.WithBlockHeight(6)
+	app.DistrKeeper.AutoCompoundRewards(ctx)
+	require.True(t, shares(addrs[0]).GT(initShares0))
+	require.True(t, shares(addrs[1]).GT(initShares1))
+	_, found = app.DistrKeeper.GetAutoCompoundCursor(ctx)
+	require.False(t, found)
+
+	// delegation rewards are restaked, the commission is kept
+	require.Equal(t, initShares1.Add(sdk.NewDecFromInt(sdk.TokensFromConsensusPower(10).QuoRaw(4))), shares(addrs[1]))
+	require.False(t, app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[0]).Commission.IsZero())
+
+	// the setting can be disabled
+	require.NoError(t, app.DistrKeeper.SetDelegationAutoCompound(ctx, addrs[0], valAddrs[0], false))
+	require.False(t, app.DistrKeeper.HasAutoCompoundSetting(ctx, addrs[0], valAddrs[0]))
+
+	// the setting is removed along with the delegation
+	_, err = app.StakingKeeper.Undelegate(ctx, addrs[1], valAddrs[0], shares(addrs[1]))
+	require.NoError(t, err)
+	require.False(t, app.DistrKeeper.HasAutoCompoundSetting(ctx, addrs[1], valAddrs[0]))
+}
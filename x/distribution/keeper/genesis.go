@@ -39,6 +39,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	for _, evt := range data.ValidatorSlashEvents {
 		k.SetValidatorSlashEvent(ctx, evt.ValidatorAddress, evt.Height, evt.Period, evt.Event)
 	}
+	for _, record := range data.WithdrawRecords {
+		k.SetWithdrawRecord(ctx, record)
+	}
+	for _, setting := range data.AutoCompoundSettings {
+		k.SetAutoCompoundSetting(ctx, setting.DelegatorAddress, setting.ValidatorAddress)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -145,5 +151,21 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	withdrawRecords := make([]types.WithdrawRecord, 0)
+	k.IterateWithdrawRecords(ctx,
+		func(record types.WithdrawRecord) (stop bool) {
+			withdrawRecords = append(withdrawRecords, record)
+			return false
+		},
+	)
+
+	autoCompounds := make([]types.AutoCompoundSetting, 0)
+	k.IterateAutoCompoundSettings(ctx,
+		func(del sdk.AccAddress, val sdk.ValAddress) (stop bool) {
+			autoCompounds = append(autoCompounds, types.AutoCompoundSetting{DelegatorAddress: del, ValidatorAddress: val})
+			return false
+		},
+	)
+
+	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, withdrawRecords, autoCompounds)
 }
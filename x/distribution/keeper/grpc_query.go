@@ -19,8 +19,7 @@ var _ types.QueryServer = Keeper{}
 // Params queries params of distribution module
 func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	var params types.Params
-	k.paramSpace.GetParamSet(ctx, &params)
+	params := k.GetParams(ctx)
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
@@ -190,6 +189,32 @@ func (k Keeper) DelegatorValidators(c context.Context, req *types.QueryDelegator
 	return &types.QueryDelegatorValidatorsResponse{Validators: validators}, nil
 }
 
+// DelegatorAutoCompoundValidators queries the validators a delegator
+// auto-compounds the rewards of
+func (k Keeper) DelegatorAutoCompoundValidators(c context.Context, req *types.QueryDelegatorAutoCompoundValidatorsRequest) (*types.QueryDelegatorAutoCompoundValidatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+
+	var validators []sdk.ValAddress
+
+	iter := sdk.KVStorePrefixIterator(store, types.GetAutoCompoundSettingsPrefix(req.DelegatorAddress))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, valAddr := types.GetAutoCompoundSettingAddresses(iter.Key())
+		validators = append(validators, valAddr)
+	}
+
+	return &types.QueryDelegatorAutoCompoundValidatorsResponse{Validators: validators}, nil
+}
+
 // DelegatorWithdrawAddress queries Query/delegatorWithdrawAddress
 func (k Keeper) DelegatorWithdrawAddress(c context.Context, req *types.QueryDelegatorWithdrawAddressRequest) (*types.QueryDelegatorWithdrawAddressResponse, error) {
 	if req == nil {
@@ -206,6 +231,51 @@ func (k Keeper) DelegatorWithdrawAddress(c context.Context, req *types.QueryDele
 	return &types.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: withdrawAddr}, nil
 }
 
+// DelegatorWithdrawRecords queries the withdrawals recorded for a delegator
+func (k Keeper) DelegatorWithdrawRecords(c context.Context, req *types.QueryDelegatorWithdrawRecordsRequest) (*types.QueryDelegatorWithdrawRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	// a zero ending height means no upper bound
+	if req.EndingHeight != 0 && req.EndingHeight < req.StartingHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "starting height greater than ending height (%d > %d)", req.StartingHeight, req.EndingHeight)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	records := make([]types.WithdrawRecord, 0)
+	store := ctx.KVStore(k.storeKey)
+	recordsStore := prefix.NewStore(store, types.GetDelegatorWithdrawRecordsPrefix(req.DelegatorAddress))
+
+	pageRes, err := query.FilteredPaginate(recordsStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var result types.WithdrawRecord
+		err := k.cdc.UnmarshalBinaryBare(value, &result)
+
+		if err != nil {
+			return false, err
+		}
+
+		if result.Height < req.StartingHeight || (req.EndingHeight != 0 && result.Height > req.EndingHeight) {
+			return false, nil
+		}
+
+		if accumulate {
+			records = append(records, result)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return &types.QueryDelegatorWithdrawRecordsResponse{}, err
+	}
+
+	return &types.QueryDelegatorWithdrawRecordsResponse{Records: records, Pagination: pageRes}, nil
+}
+
 // CommunityPool queries the community pool coins
 func (k Keeper) CommunityPool(c context.Context, req *types.QueryCommunityPoolRequest) (*types.QueryCommunityPoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -603,6 +603,133 @@ func (suite *KeeperTestSuite) TestGRPCDelegatorWithdrawAddress() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCDelegatorWithdrawRecords() {
+	app, ctx, queryClient, addrs, valAddrs := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.valAddrs
+
+	records := []types.WithdrawRecord{
+		{DelegatorAddress: addrs[0], ValidatorAddress: valAddrs[0], Height: 1, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+		{DelegatorAddress: addrs[0], ValidatorAddress: valAddrs[0], Height: 2, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 20))},
+		{DelegatorAddress: addrs[0], ValidatorAddress: valAddrs[0], Height: 2, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), Commission: true},
+		{DelegatorAddress: addrs[0], ValidatorAddress: valAddrs[1], Height: 3, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 30))},
+	}
+	for _, record := range records {
+		app.DistrKeeper.SetWithdrawRecord(ctx, record)
+	}
+
+	// records of other delegators are not returned
+	app.DistrKeeper.SetWithdrawRecord(ctx, types.WithdrawRecord{
+		DelegatorAddress: addrs[1], ValidatorAddress: valAddrs[0], Height: 2, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 40)),
+	})
+
+	var (
+		req        *types.QueryDelegatorWithdrawRecordsRequest
+		expRecords []types.WithdrawRecord
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryDelegatorWithdrawRecordsRequest{}
+			},
+			false,
+		},
+		{
+			"invalid height range",
+			func() {
+				req = &types.QueryDelegatorWithdrawRecordsRequest{
+					DelegatorAddress: addrs[0],
+					StartingHeight:   3,
+					EndingHeight:     2,
+				}
+			},
+			false,
+		},
+		{
+			"all records",
+			func() {
+				req = &types.QueryDelegatorWithdrawRecordsRequest{DelegatorAddress: addrs[0]}
+				expRecords = records
+			},
+			true,
+		},
+		{
+			"height range",
+			func() {
+				req = &types.QueryDelegatorWithdrawRecordsRequest{
+					DelegatorAddress: addrs[0],
+					StartingHeight:   2,
+					EndingHeight:     2,
+				}
+				expRecords = records[1:3]
+			},
+			true,
+		},
+		{
+			"starting height only",
+			func() {
+				req = &types.QueryDelegatorWithdrawRecordsRequest{
+					DelegatorAddress: addrs[0],
+					StartingHeight:   3,
+				}
+				expRecords = records[3:]
+			},
+			true,
+		},
+		{
+			"pagination",
+			func() {
+				req = &types.QueryDelegatorWithdrawRecordsRequest{
+					DelegatorAddress: addrs[0],
+					Pagination:       &query.PageRequest{Offset: 1, Limit: 2},
+				}
+				expRecords = records[1:3]
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			res, err := queryClient.DelegatorWithdrawRecords(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRecords, res.Records)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCDelegatorAutoCompoundValidators() {
+	app, ctx, queryClient, addrs, valAddrs := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.valAddrs
+
+	app.DistrKeeper.SetAutoCompoundSetting(ctx, addrs[0], valAddrs[0])
+	app.DistrKeeper.SetAutoCompoundSetting(ctx, addrs[0], valAddrs[1])
+
+	_, err := queryClient.DelegatorAutoCompoundValidators(gocontext.Background(), &types.QueryDelegatorAutoCompoundValidatorsRequest{})
+	suite.Require().Error(err)
+
+	res, err := queryClient.DelegatorAutoCompoundValidators(gocontext.Background(),
+		&types.QueryDelegatorAutoCompoundValidatorsRequest{DelegatorAddress: addrs[0]})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]sdk.ValAddress{valAddrs[0], valAddrs[1]}, res.Validators)
+
+	res, err = queryClient.DelegatorAutoCompoundValidators(gocontext.Background(),
+		&types.QueryDelegatorAutoCompoundValidatorsRequest{DelegatorAddress: addrs[1]})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Validators)
+}
+
 func (suite *KeeperTestSuite) TestGRPCCommunityPool() {
 	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
 
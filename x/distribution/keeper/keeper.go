@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -24,13 +25,19 @@ type Keeper struct {
 	blockedAddrs map[string]bool
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	// the address capable of executing a MsgUpdateParams message, typically
+	// the x/gov module account
+	authority sdk.AccAddress
 }
 
-// NewKeeper creates a new distribution Keeper instance
+// NewKeeper creates a new distribution Keeper instance. The param subspace is
+// only used by the store migration moving the distribution parameters out of
+// it.
 func NewKeeper(
 	cdc codec.BinaryMarshaler, key sdk.StoreKey, paramSpace paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper,
-	feeCollectorName string, blockedAddrs map[string]bool,
+	feeCollectorName string, blockedAddrs map[string]bool, authority sdk.AccAddress,
 ) Keeper {
 
 	// ensure distribution module account is set
@@ -52,9 +59,15 @@ func NewKeeper(
 		stakingKeeper:    sk,
 		feeCollectorName: feeCollectorName,
 		blockedAddrs:     blockedAddrs,
+		authority:        authority,
 	}
 }
 
+// GetAuthority returns the x/distribution module's authority.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -112,6 +125,50 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 	return rewards, nil
 }
 
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the tokenized
+// delegations of all tokenize share records owned by ownerAddr and sends them,
+// along with any rewards already collected by the record accounts, to the
+// owner.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk.AccAddress) (sdk.Coins, error) {
+	records := k.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr)
+	if len(records) == 0 {
+		return nil, types.ErrNoTokenizeShareRecords
+	}
+
+	totalRewards := sdk.NewCoins()
+
+	for _, record := range records {
+		recordAddr := record.GetModuleAddress()
+
+		if k.stakingKeeper.Delegation(ctx, recordAddr, record.Validator) != nil {
+			if _, err := k.WithdrawDelegationRewards(ctx, recordAddr, record.Validator); err != nil {
+				return nil, err
+			}
+		}
+
+		rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr)
+		if rewards.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, recordAddr, ownerAddr, rewards); err != nil {
+			return nil, err
+		}
+
+		totalRewards = totalRewards.Add(rewards...)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawTokenizeShareReward,
+			sdk.NewAttribute(types.AttributeKeyOwner, ownerAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, totalRewards.String()),
+		),
+	)
+
+	return totalRewards, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
@@ -134,6 +191,8 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 		if err != nil {
 			return nil, err
 		}
+
+		k.recordWithdrawal(ctx, accAddr, valAddr, commission, true)
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -146,6 +205,169 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 	return commission, nil
 }
 
+// recordWithdrawal records the rewards withdrawn by a delegator from a
+// validator, or the commission withdrawn by a validator operator, at the
+// current height if withdrawals are recorded.
+func (k Keeper) recordWithdrawal(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coins, commission bool) {
+	if amount.IsZero() || !k.GetRecordWithdrawals(ctx) {
+		return
+	}
+
+	height := ctx.BlockHeight()
+
+	// withdrawals from the same validator at the same height are merged
+	record, found := k.GetWithdrawRecord(ctx, delAddr, height, valAddr, commission)
+	if !found {
+		record = types.WithdrawRecord{
+			DelegatorAddress: delAddr,
+			ValidatorAddress: valAddr,
+			Height:           height,
+			Commission:       commission,
+		}
+	}
+
+	record.Amount = record.Amount.Add(amount...)
+	k.SetWithdrawRecord(ctx, record)
+}
+
+// PruneWithdrawRecords deletes the withdraw records which are older than the
+// withdraw record retention.
+func (k Keeper) PruneWithdrawRecords(ctx sdk.Context) {
+	retention := k.GetWithdrawRecordRetention(ctx)
+	if retention == 0 || uint64(ctx.BlockHeight()) <= retention {
+		return
+	}
+
+	k.DeleteWithdrawRecordsBefore(ctx, ctx.BlockHeight()-int64(retention))
+}
+
+// SetDelegationAutoCompound enables or disables the automatic restaking of the
+// rewards of a delegation. Auto-compounded rewards are delegated from the
+// delegator account, which must therefore be its own withdraw address.
+func (k Keeper) SetDelegationAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enable bool) error {
+	if enable {
+		if k.stakingKeeper.Delegation(ctx, delAddr, valAddr) == nil {
+			return types.ErrNoDelegationExists
+		}
+
+		if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+			return types.ErrCompoundWithdrawAddr
+		}
+
+		k.SetAutoCompoundSetting(ctx, delAddr, valAddr)
+	} else {
+		k.DeleteAutoCompoundSetting(ctx, delAddr, valAddr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enable)),
+		),
+	)
+
+	return nil
+}
+
+// AutoCompoundRewards restakes the rewards of the delegations which opted into
+// auto-compounding. A run starts every auto-compound epoch and processes at
+// most MaxAutoCompoundsPerBlock settings per block, resuming in the following
+// blocks until all settings are processed.
+func (k Keeper) AutoCompoundRewards(ctx sdk.Context) {
+	start, inProgress := k.GetAutoCompoundCursor(ctx)
+	if !inProgress {
+		epoch := k.GetAutoCompoundEpoch(ctx)
+		if epoch == 0 || uint64(ctx.BlockHeight())%epoch != 0 {
+			return
+		}
+
+		start = types.AutoCompoundSettingPrefix
+	}
+
+	limit := int(k.GetMaxAutoCompoundsPerBlock(ctx))
+
+	// collect the settings first as compounding writes to the store
+	var (
+		keys [][]byte
+		next []byte
+	)
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.AutoCompoundSettingPrefix))
+	for ; iter.Valid(); iter.Next() {
+		if len(keys) == limit {
+			next = iter.Key()
+			break
+		}
+
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		delAddr, valAddr := types.GetAutoCompoundSettingAddresses(key)
+
+		// a failing delegation must not prevent the others from compounding
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.compoundDelegationRewards(cacheCtx, delAddr, valAddr); err != nil {
+			k.Logger(ctx).Error(
+				"failed to auto-compound rewards",
+				"delegator", delAddr.String(), "validator", valAddr.String(), "err", err,
+			)
+			continue
+		}
+
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+
+	if next == nil {
+		k.DeleteAutoCompoundCursor(ctx)
+	} else {
+		k.SetAutoCompoundCursor(ctx, next)
+	}
+}
+
+// compoundDelegationRewards withdraws the rewards of a delegation and
+// delegates the withdrawn bond denom amount back to the validator.
+func (k Keeper) compoundDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return types.ErrCompoundWithdrawAddr
+	}
+
+	rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	amount := rewards.AmountOf(k.stakingKeeper.BondDenom(ctx))
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorExists
+	}
+
+	if _, err := k.stakingKeeper.Delegate(ctx, delAddr, amount, sdk.Unbonded, validator, true); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoCompound,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		),
+	)
+
+	return nil
+}
+
 // GetTotalRewards returns the total amount of fee distribution rewards held in the store
 func (k Keeper) GetTotalRewards(ctx sdk.Context) (totalRewards sdk.DecCoins) {
 	k.IterateValidatorOutstandingRewards(ctx,
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
@@ -34,6 +35,24 @@ func TestSetWithdrawAddr(t *testing.T) {
 	require.Error(t, app.DistrKeeper.SetWithdrawAddr(ctx, addr[0], distrAcc.GetAddress()))
 }
 
+func TestMsgUpdateParams(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.CommunityTax = sdk.NewDecWithPrec(10, 2)
+
+	// only the authority may update the params
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(distrAcc.GetAddress(), params))
+	require.Error(t, err)
+	require.Equal(t, types.DefaultParams(), app.DistrKeeper.GetParams(ctx))
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(app.DistrKeeper.GetAuthority(), params))
+	require.NoError(t, err)
+	require.Equal(t, params, app.DistrKeeper.GetParams(ctx))
+}
+
 func TestWithdrawValidatorCommission(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -6,38 +6,63 @@ import (
 )
 
 // GetParams returns the total set of distribution parameters.
-func (k Keeper) GetParams(clientCtx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(clientCtx, &params)
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		panic("stored distribution params should not have been nil")
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &params)
 	return params
 }
 
-// SetParams sets the distribution parameters to the param space.
+// SetParams sets the distribution parameters to the store.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, k.cdc.MustMarshalBinaryBare(&params))
 }
 
 // GetCommunityTax returns the current distribution community tax.
-func (k Keeper) GetCommunityTax(ctx sdk.Context) (percent sdk.Dec) {
-	k.paramSpace.Get(ctx, types.ParamStoreKeyCommunityTax, &percent)
-	return percent
+func (k Keeper) GetCommunityTax(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).CommunityTax
 }
 
 // GetBaseProposerReward returns the current distribution base proposer rate.
-func (k Keeper) GetBaseProposerReward(ctx sdk.Context) (percent sdk.Dec) {
-	k.paramSpace.Get(ctx, types.ParamStoreKeyBaseProposerReward, &percent)
-	return percent
+func (k Keeper) GetBaseProposerReward(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).BaseProposerReward
 }
 
 // GetBonusProposerReward returns the current distribution bonus proposer reward
 // rate.
-func (k Keeper) GetBonusProposerReward(ctx sdk.Context) (percent sdk.Dec) {
-	k.paramSpace.Get(ctx, types.ParamStoreKeyBonusProposerReward, &percent)
-	return percent
+func (k Keeper) GetBonusProposerReward(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).BonusProposerReward
 }
 
 // GetWithdrawAddrEnabled returns the current distribution withdraw address
 // enabled parameter.
-func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) (enabled bool) {
-	k.paramSpace.Get(ctx, types.ParamStoreKeyWithdrawAddrEnabled, &enabled)
-	return enabled
+func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) bool {
+	return k.GetParams(ctx).WithdrawAddrEnabled
+}
+
+// GetRecordWithdrawals returns whether rewards and commission withdrawals are
+// recorded.
+func (k Keeper) GetRecordWithdrawals(ctx sdk.Context) bool {
+	return k.GetParams(ctx).RecordWithdrawals
+}
+
+// GetWithdrawRecordRetention returns the number of blocks withdraw records are
+// kept for.
+func (k Keeper) GetWithdrawRecordRetention(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).WithdrawRecordRetention
+}
+
+// GetAutoCompoundEpoch returns the number of blocks between two auto-compound
+// runs.
+func (k Keeper) GetAutoCompoundEpoch(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).AutoCompoundEpoch
+}
+
+// GetMaxAutoCompoundsPerBlock returns the maximum number of auto-compound
+// settings processed in a single block.
+func (k Keeper) GetMaxAutoCompoundsPerBlock(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).MaxAutoCompoundsPerBlock
 }
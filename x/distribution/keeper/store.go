@@ -382,3 +382,98 @@ func (k Keeper) DeleteAllValidatorSlashEvents(ctx sdk.Context) {
 		store.Delete(iter.Key())
 	}
 }
+
+// get a delegator withdraw record
+func (k Keeper) GetWithdrawRecord(ctx sdk.Context, delAddr sdk.AccAddress, height int64, val sdk.ValAddress, commission bool) (record types.WithdrawRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetDelegatorWithdrawRecordKey(delAddr, height, val, commission))
+	if b == nil {
+		return types.WithdrawRecord{}, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(b, &record)
+	return record, true
+}
+
+// set a delegator withdraw record along with its height index
+func (k Keeper) SetWithdrawRecord(ctx sdk.Context, record types.WithdrawRecord) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryBare(&record)
+	store.Set(types.GetDelegatorWithdrawRecordKey(record.DelegatorAddress, record.Height, record.ValidatorAddress, record.Commission), b)
+	store.Set(types.GetWithdrawRecordByHeightKey(record.DelegatorAddress, record.Height, record.ValidatorAddress, record.Commission), []byte{})
+}
+
+// iterate over all delegator withdraw records
+func (k Keeper) IterateWithdrawRecords(ctx sdk.Context, handler func(record types.WithdrawRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DelegatorWithdrawRecordPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.WithdrawRecord
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &record)
+		if handler(record) {
+			break
+		}
+	}
+}
+
+// delete the withdraw records of all delegators created before a height
+func (k Keeper) DeleteWithdrawRecordsBefore(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.WithdrawRecordByHeightPrefix, types.GetWithdrawRecordByHeightPrefix(height))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(types.GetDelegatorWithdrawRecordKeyFromByHeightKey(iter.Key()))
+		store.Delete(iter.Key())
+	}
+}
+
+// check whether a delegator auto-compounds the rewards of a validator
+func (k Keeper) HasAutoCompoundSetting(ctx sdk.Context, delAddr sdk.AccAddress, val sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAutoCompoundSettingKey(delAddr, val))
+}
+
+// set a delegator auto-compound setting
+func (k Keeper) SetAutoCompoundSetting(ctx sdk.Context, delAddr sdk.AccAddress, val sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAutoCompoundSettingKey(delAddr, val), []byte{})
+}
+
+// delete a delegator auto-compound setting
+func (k Keeper) DeleteAutoCompoundSetting(ctx sdk.Context, delAddr sdk.AccAddress, val sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAutoCompoundSettingKey(delAddr, val))
+}
+
+// iterate over all delegator auto-compound settings
+func (k Keeper) IterateAutoCompoundSettings(ctx sdk.Context, handler func(del sdk.AccAddress, val sdk.ValAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AutoCompoundSettingPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		del, val := types.GetAutoCompoundSettingAddresses(iter.Key())
+		if handler(del, val) {
+			break
+		}
+	}
+}
+
+// get the key of the next auto-compound setting to process, if an
+// auto-compound run is in progress
+func (k Keeper) GetAutoCompoundCursor(ctx sdk.Context) (key []byte, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	key = store.Get(types.AutoCompoundCursorKey)
+	return key, key != nil
+}
+
+// set the key of the next auto-compound setting to process
+func (k Keeper) SetAutoCompoundCursor(ctx sdk.Context, key []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AutoCompoundCursorKey, key)
+}
+
+// delete the auto-compound cursor once a run is complete
+func (k Keeper) DeleteAutoCompoundCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AutoCompoundCursorKey)
+}
@@ -0,0 +1,46 @@
+package v041
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.40 to v0.41. The
+// migration includes:
+//
+// - Set the RecordWithdrawals, WithdrawRecordRetention, AutoCompoundEpoch and
+// MaxAutoCompoundsPerBlock params to their defaults.
+// - Move the distribution parameters from the x/params subspace to the
+// distribution store under types.ParamsKey.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, paramSpace paramtypes.Subspace) error {
+	defaults := types.DefaultParams()
+
+	paramSpace.Set(ctx, types.ParamStoreKeyRecordWithdrawals, defaults.RecordWithdrawals)
+	paramSpace.Set(ctx, types.ParamStoreKeyWithdrawRecordRetention, defaults.WithdrawRecordRetention)
+	paramSpace.Set(ctx, types.ParamStoreKeyAutoCompoundEpoch, defaults.AutoCompoundEpoch)
+	paramSpace.Set(ctx, types.ParamStoreKeyMaxAutoCompoundsPerBlock, defaults.MaxAutoCompoundsPerBlock)
+
+	return migrateParams(ctx, ctx.KVStore(storeKey), cdc, paramSpace)
+}
+
+// migrateParams copies the distribution parameters from the x/params subspace
+// to the distribution store.
+func migrateParams(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryMarshaler, paramSpace paramtypes.Subspace) error {
+	var params types.Params
+	paramSpace.GetParamSet(ctx, &params)
+
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.MarshalBinaryBare(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package v041_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v041distribution "github.com/cosmos/cosmos-sdk/x/distribution/legacy/v0_41"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	storeKey := app.GetKey(types.StoreKey)
+	paramSpace := app.GetSubspace(types.ModuleName)
+
+	// write the v0.40 params, which lack the params added in v0.41, to the
+	// subspace
+	legacyParams := types.DefaultParams()
+	legacyParams.CommunityTax = sdk.NewDecWithPrec(5, 2)
+	paramSpace.Set(ctx, types.ParamStoreKeyCommunityTax, legacyParams.CommunityTax)
+	paramSpace.Set(ctx, types.ParamStoreKeyBaseProposerReward, legacyParams.BaseProposerReward)
+	paramSpace.Set(ctx, types.ParamStoreKeyBonusProposerReward, legacyParams.BonusProposerReward)
+	paramSpace.Set(ctx, types.ParamStoreKeyWithdrawAddrEnabled, legacyParams.WithdrawAddrEnabled)
+
+	require.NoError(t, v041distribution.MigrateStore(ctx, storeKey, app.AppCodec(), paramSpace))
+
+	// the params added in v0.41 are set to their defaults, the others are kept,
+	// and all of them are moved to the distribution store
+	expected := types.DefaultParams()
+	expected.CommunityTax = legacyParams.CommunityTax
+	require.Equal(t, expected, app.DistrKeeper.GetParams(ctx))
+}
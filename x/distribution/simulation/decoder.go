@@ -63,6 +63,17 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &eventB)
 			return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+		case bytes.Equal(kvA.Key[:1], types.DelegatorWithdrawRecordPrefix):
+			var recordA, recordB types.WithdrawRecord
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &recordA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+		case bytes.Equal(kvA.Key[:1], types.WithdrawRecordByHeightPrefix),
+			bytes.Equal(kvA.Key[:1], types.AutoCompoundSettingPrefix),
+			bytes.Equal(kvA.Key[:1], types.AutoCompoundCursorKey):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
 		default:
 			panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 		}
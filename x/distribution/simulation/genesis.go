@@ -19,6 +19,12 @@ const (
 	BaseProposerReward  = "base_proposer_reward"
 	BonusProposerReward = "bonus_proposer_reward"
 	WithdrawEnabled     = "withdraw_enabled"
+
+	RecordWithdrawals       = "record_withdrawals"
+	WithdrawRecordRetention = "withdraw_record_retention"
+
+	AutoCompoundEpoch        = "auto_compound_epoch"
+	MaxAutoCompoundsPerBlock = "max_auto_compounds_per_block"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -41,6 +47,28 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenRecordWithdrawals returns a randomized RecordWithdrawals parameter.
+func GenRecordWithdrawals(r *rand.Rand) bool {
+	return r.Int63n(101) <= 50 // 50% chance of withdrawals being recorded
+}
+
+// GenWithdrawRecordRetention returns a randomized WithdrawRecordRetention
+// parameter.
+func GenWithdrawRecordRetention(r *rand.Rand) uint64 {
+	return uint64(r.Intn(100))
+}
+
+// GenAutoCompoundEpoch returns a randomized AutoCompoundEpoch parameter.
+func GenAutoCompoundEpoch(r *rand.Rand) uint64 {
+	return uint64(r.Intn(50))
+}
+
+// GenMaxAutoCompoundsPerBlock returns a randomized MaxAutoCompoundsPerBlock
+// parameter.
+func GenMaxAutoCompoundsPerBlock(r *rand.Rand) uint32 {
+	return uint32(r.Intn(100) + 1)
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax sdk.Dec
@@ -67,13 +95,41 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var recordWithdrawals bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, RecordWithdrawals, &recordWithdrawals, simState.Rand,
+		func(r *rand.Rand) { recordWithdrawals = GenRecordWithdrawals(r) },
+	)
+
+	var withdrawRecordRetention uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, WithdrawRecordRetention, &withdrawRecordRetention, simState.Rand,
+		func(r *rand.Rand) { withdrawRecordRetention = GenWithdrawRecordRetention(r) },
+	)
+
+	var autoCompoundEpoch uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AutoCompoundEpoch, &autoCompoundEpoch, simState.Rand,
+		func(r *rand.Rand) { autoCompoundEpoch = GenAutoCompoundEpoch(r) },
+	)
+
+	var maxAutoCompoundsPerBlock uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxAutoCompoundsPerBlock, &maxAutoCompoundsPerBlock, simState.Rand,
+		func(r *rand.Rand) { maxAutoCompoundsPerBlock = GenMaxAutoCompoundsPerBlock(r) },
+	)
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
-			CommunityTax:        communityTax,
-			BaseProposerReward:  baseProposerReward,
-			BonusProposerReward: bonusProposerReward,
-			WithdrawAddrEnabled: withdrawEnabled,
+			CommunityTax:             communityTax,
+			BaseProposerReward:       baseProposerReward,
+			BonusProposerReward:      bonusProposerReward,
+			WithdrawAddrEnabled:      withdrawEnabled,
+			RecordWithdrawals:        recordWithdrawals,
+			WithdrawRecordRetention:  withdrawRecordRetention,
+			AutoCompoundEpoch:        autoCompoundEpoch,
+			MaxAutoCompoundsPerBlock: maxAutoCompoundsPerBlock,
 		},
 	}
 
@@ -32,6 +32,18 @@ type Params struct {
 	BaseProposerReward  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_proposer_reward" yaml:"base_proposer_reward"`
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward" yaml:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty" yaml:"withdraw_addr_enabled"`
+	// record_withdrawals defines whether the rewards and commission withdrawals
+	// are recorded as WithdrawRecords.
+	RecordWithdrawals bool `protobuf:"varint,5,opt,name=record_withdrawals,json=recordWithdrawals,proto3" json:"record_withdrawals,omitempty" yaml:"record_withdrawals"`
+	// withdraw_record_retention defines the number of blocks a WithdrawRecord is
+	// kept for before being pruned. Records are never pruned if it is zero.
+	WithdrawRecordRetention uint64 `protobuf:"varint,6,opt,name=withdraw_record_retention,json=withdrawRecordRetention,proto3" json:"withdraw_record_retention,omitempty" yaml:"withdraw_record_retention"`
+	// auto_compound_epoch defines the number of blocks between two auto-compound
+	// runs. Auto-compounding is disabled if it is zero.
+	AutoCompoundEpoch uint64 `protobuf:"varint,7,opt,name=auto_compound_epoch,json=autoCompoundEpoch,proto3" json:"auto_compound_epoch,omitempty" yaml:"auto_compound_epoch"`
+	// max_auto_compounds_per_block defines the maximum number of auto-compound
+	// settings processed in a single block.
+	MaxAutoCompoundsPerBlock uint32 `protobuf:"varint,8,opt,name=max_auto_compounds_per_block,json=maxAutoCompoundsPerBlock,proto3" json:"max_auto_compounds_per_block,omitempty" yaml:"max_auto_compounds_per_block"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -73,6 +85,34 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetRecordWithdrawals() bool {
+	if m != nil {
+		return m.RecordWithdrawals
+	}
+	return false
+}
+
+func (m *Params) GetWithdrawRecordRetention() uint64 {
+	if m != nil {
+		return m.WithdrawRecordRetention
+	}
+	return 0
+}
+
+func (m *Params) GetAutoCompoundEpoch() uint64 {
+	if m != nil {
+		return m.AutoCompoundEpoch
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAutoCompoundsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxAutoCompoundsPerBlock
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -80,10 +120,11 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 // The reference count indicates the number of objects
 // which might need to reference this historical entry at any point.
 // ReferenceCount =
-//    number of outstanding delegations which ended the associated period (and might need to read
-//    that record)
-//  + number of slashes which ended the associated period (and might need to read that record)
-//  + one per validator for the zeroeth period, set on initialization
+//
+//	  number of outstanding delegations which ended the associated period (and might need to read
+//	  that record)
+//	+ number of slashes which ended the associated period (and might need to read that record)
+//	+ one per validator for the zeroeth period, set on initialization
 type ValidatorHistoricalRewards struct {
 	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio" yaml:"cumulative_reward_ratio"`
 	ReferenceCount        uint32                                      `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty" yaml:"reference_count"`
@@ -577,6 +618,141 @@ func (m *DelegationDelegatorReward) GetReward() github_com_cosmos_cosmos_sdk_typ
 	return nil
 }
 
+// WithdrawRecord records the rewards withdrawn by a delegator from a
+// validator, or the commission withdrawn by a validator operator, at a given
+// height.
+type WithdrawRecord struct {
+	DelegatorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+	Height           int64                                         `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// commission is true if the record is a withdrawal of the validator
+	// commission by its operator.
+	Commission bool `protobuf:"varint,5,opt,name=commission,proto3" json:"commission,omitempty"`
+}
+
+func (m *WithdrawRecord) Reset()         { *m = WithdrawRecord{} }
+func (m *WithdrawRecord) String() string { return proto.CompactTextString(m) }
+func (*WithdrawRecord) ProtoMessage()    {}
+func (*WithdrawRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{11}
+}
+func (m *WithdrawRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawRecord.Merge(m, src)
+}
+func (m *WithdrawRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawRecord proto.InternalMessageInfo
+
+func (m *WithdrawRecord) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return nil
+}
+
+func (m *WithdrawRecord) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *WithdrawRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *WithdrawRecord) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *WithdrawRecord) GetCommission() bool {
+	if m != nil {
+		return m.Commission
+	}
+	return false
+}
+
+// AutoCompoundSetting defines a delegator opting into the automatic restaking
+// of its rewards from a validator.
+type AutoCompoundSetting struct {
+	DelegatorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+}
+
+func (m *AutoCompoundSetting) Reset()         { *m = AutoCompoundSetting{} }
+func (m *AutoCompoundSetting) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundSetting) ProtoMessage()    {}
+func (*AutoCompoundSetting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{12}
+}
+func (m *AutoCompoundSetting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundSetting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundSetting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundSetting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundSetting.Merge(m, src)
+}
+func (m *AutoCompoundSetting) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundSetting) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundSetting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundSetting proto.InternalMessageInfo
+
+func (m *AutoCompoundSetting) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return nil
+}
+
+func (m *AutoCompoundSetting) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
@@ -589,6 +765,8 @@ func init() {
 	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposal")
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
+	proto.RegisterType((*WithdrawRecord)(nil), "cosmos.distribution.v1beta1.WithdrawRecord")
+	proto.RegisterType((*AutoCompoundSetting)(nil), "cosmos.distribution.v1beta1.AutoCompoundSetting")
 }
 
 func init() {
@@ -596,71 +774,85 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1017 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x26, 0xae, 0x9b, 0x4e, 0xd3, 0x04, 0x36, 0x4e, 0xe2, 0x3a, 0x65, 0xd7, 0x1a, 0x09,
-	0x14, 0x09, 0xc5, 0x6e, 0xe8, 0x2d, 0x07, 0xa4, 0xac, 0x9b, 0x08, 0x10, 0x25, 0xd1, 0x36, 0xf4,
-	0xc0, 0x65, 0x35, 0xde, 0x9d, 0xd8, 0xa3, 0xec, 0xee, 0x2c, 0x33, 0x63, 0x27, 0x41, 0x42, 0x48,
-	0x3d, 0x71, 0x41, 0x80, 0xe0, 0xc0, 0x01, 0x50, 0x8f, 0xfc, 0xfa, 0x43, 0x7a, 0xec, 0x0d, 0xc4,
-	0xc1, 0xa0, 0xe4, 0xc6, 0xd1, 0x37, 0x38, 0xa1, 0x9d, 0x19, 0xef, 0x3a, 0xc6, 0xaa, 0x6c, 0xa4,
-	0x9e, 0xec, 0xf9, 0xe6, 0xcd, 0xf7, 0xbe, 0xf9, 0xde, 0xcc, 0x9b, 0x05, 0x75, 0x9f, 0xf2, 0x88,
-	0xf2, 0x46, 0x40, 0xb8, 0x60, 0xa4, 0xd5, 0x15, 0x84, 0xc6, 0x8d, 0xde, 0x76, 0x0b, 0x0b, 0xb4,
-	0x7d, 0x05, 0xac, 0x27, 0x8c, 0x0a, 0x6a, 0x6e, 0xa8, 0xf8, 0xfa, 0x95, 0x29, 0x1d, 0x5f, 0x2d,
-	0xb7, 0x69, 0x9b, 0xca, 0xb8, 0x46, 0xfa, 0x4f, 0x2d, 0xa9, 0x5a, 0x3a, 0x45, 0x0b, 0x71, 0x9c,
-	0x51, 0xfb, 0x94, 0x68, 0x4a, 0xf8, 0xeb, 0x3c, 0x28, 0x1d, 0x22, 0x86, 0x22, 0x6e, 0x9e, 0x80,
-	0x5b, 0x3e, 0x8d, 0xa2, 0x6e, 0x4c, 0xc4, 0xb9, 0x27, 0xd0, 0x59, 0xc5, 0xa8, 0x19, 0x9b, 0x37,
-	0x9c, 0xfd, 0xa7, 0x7d, 0xbb, 0xf0, 0x7b, 0xdf, 0x7e, 0xad, 0x4d, 0x44, 0xa7, 0xdb, 0xaa, 0xfb,
-	0x34, 0x6a, 0x68, 0x52, 0xf5, 0xb3, 0xc5, 0x83, 0x93, 0x86, 0x38, 0x4f, 0x30, 0xaf, 0xdf, 0xc7,
-	0xfe, 0xa0, 0x6f, 0x97, 0xcf, 0x51, 0x14, 0xee, 0xc0, 0x2b, 0x64, 0xd0, 0x5d, 0xcc, 0xc6, 0x47,
-	0xe8, 0xcc, 0xfc, 0x04, 0x94, 0x53, 0x49, 0x5e, 0xc2, 0x68, 0x42, 0x39, 0x66, 0x1e, 0xc3, 0xa7,
-	0x88, 0x05, 0x95, 0x39, 0x99, 0xf3, 0xc1, 0xcc, 0x39, 0x37, 0x54, 0xce, 0x49, 0x9c, 0xd0, 0x35,
-	0x53, 0xf8, 0x50, 0xa3, 0xae, 0x04, 0xcd, 0xc7, 0x06, 0x58, 0x6d, 0xd1, 0xb8, 0xcb, 0xff, 0x23,
-	0x61, 0x5e, 0x4a, 0x78, 0x6f, 0x66, 0x09, 0x77, 0xb4, 0x84, 0x49, 0xa4, 0xd0, 0x5d, 0x91, 0xf8,
-	0x98, 0x88, 0x23, 0xb0, 0x7a, 0x4a, 0x44, 0x27, 0x60, 0xe8, 0xd4, 0x43, 0x41, 0xc0, 0x3c, 0x1c,
-	0xa3, 0x56, 0x88, 0x83, 0x4a, 0xb1, 0x66, 0x6c, 0x2e, 0x38, 0xb5, 0x9c, 0x75, 0x62, 0x18, 0x74,
-	0x57, 0x86, 0xf8, 0x6e, 0x10, 0xb0, 0x3d, 0x85, 0xee, 0x14, 0xbf, 0x79, 0x62, 0x17, 0xe0, 0xe7,
-	0x73, 0xa0, 0xfa, 0x08, 0x85, 0x24, 0x40, 0x82, 0xb2, 0xb7, 0x08, 0x17, 0x94, 0x11, 0x1f, 0x85,
-	0x2a, 0x33, 0x37, 0x7f, 0x36, 0xc0, 0xba, 0xdf, 0x8d, 0xba, 0x21, 0x12, 0xa4, 0x87, 0xb5, 0x4c,
-	0x8f, 0x21, 0x41, 0x68, 0xc5, 0xa8, 0xcd, 0x6f, 0xde, 0x7c, 0xe3, 0x8e, 0x3e, 0x9e, 0xf5, 0xd4,
-	0xbd, 0xe1, 0x31, 0x4b, 0xf7, 0xda, 0xa4, 0x24, 0x76, 0xde, 0x4f, 0xfd, 0x19, 0xf4, 0x6d, 0x4b,
-	0x17, 0x7b, 0x32, 0x15, 0xfc, 0xe9, 0x0f, 0xfb, 0xf5, 0xe9, 0x1c, 0x4c, 0x59, 0xb9, 0xbb, 0x9a,
-	0x13, 0x29, 0xa5, 0x6e, 0x4a, 0x63, 0x36, 0xc1, 0x32, 0xc3, 0xc7, 0x98, 0xe1, 0xd8, 0xc7, 0x9e,
-	0x4f, 0xbb, 0xb1, 0x90, 0x27, 0xe5, 0x96, 0x53, 0x1d, 0xf4, 0xed, 0x35, 0x25, 0x61, 0x2c, 0x00,
-	0xba, 0x4b, 0x19, 0xd2, 0x94, 0xc0, 0xf7, 0x06, 0x58, 0xcf, 0x1c, 0x69, 0x76, 0x19, 0xc3, 0xb1,
-	0x18, 0xda, 0x71, 0x02, 0xae, 0x2b, 0xdd, 0x7c, 0xaa, 0xdd, 0xdf, 0x4b, 0x77, 0x3f, 0xeb, 0xde,
-	0x86, 0x19, 0xcc, 0x35, 0x50, 0x4a, 0x30, 0x23, 0x54, 0x1d, 0xf7, 0xa2, 0xab, 0x47, 0xf0, 0x2b,
-	0x03, 0x58, 0x99, 0xc0, 0x5d, 0x5f, 0x5b, 0x81, 0x83, 0x26, 0x8d, 0x22, 0xc2, 0x39, 0xa1, 0xb1,
-	0xf9, 0x21, 0x00, 0x7e, 0x36, 0x7a, 0x71, 0x52, 0x47, 0x92, 0xc0, 0x6f, 0x0d, 0xb0, 0x91, 0xa9,
-	0x3a, 0xe8, 0x0a, 0x2e, 0x50, 0x1c, 0x90, 0xb8, 0x3d, 0xb4, 0xee, 0xe3, 0xd9, 0xac, 0xdb, 0xd3,
-	0x07, 0x67, 0x69, 0x58, 0x35, 0xb9, 0x14, 0xfe, 0x5f, 0x33, 0xe1, 0x8f, 0x06, 0x58, 0xc9, 0xe4,
-	0x3d, 0x0c, 0x11, 0xef, 0xec, 0xf5, 0x70, 0x2c, 0xcc, 0x7d, 0xf0, 0x52, 0x6f, 0x08, 0x7b, 0xda,
-	0xee, 0xb4, 0xa3, 0x15, 0x9d, 0x8d, 0x41, 0xdf, 0x5e, 0x57, 0xd9, 0xc7, 0x23, 0xa0, 0xbb, 0x9c,
-	0x41, 0x87, 0x12, 0x31, 0xdf, 0x01, 0x0b, 0xc7, 0x0c, 0xf9, 0x69, 0xaf, 0xd5, 0xdd, 0xa9, 0x3e,
-	0x5b, 0x6b, 0x70, 0xb3, 0xf5, 0xf0, 0x17, 0x03, 0x94, 0x27, 0x68, 0xe5, 0xe6, 0x67, 0x06, 0x58,
-	0xcb, 0xb5, 0xf0, 0x74, 0xc6, 0xc3, 0x72, 0x4a, 0x7b, 0x7a, 0xb7, 0xfe, 0x9c, 0xde, 0x5f, 0x9f,
-	0xc0, 0xe9, 0xbc, 0xaa, 0x7d, 0x7e, 0x65, 0x7c, 0xa7, 0xa3, 0xec, 0xd0, 0x2d, 0xf7, 0x26, 0xe8,
-	0xd1, 0x2d, 0xe4, 0x3b, 0x03, 0x5c, 0xdf, 0xc7, 0xf8, 0x90, 0xd2, 0xd0, 0xfc, 0xd2, 0x00, 0x4b,
-	0x79, 0x47, 0x4f, 0x28, 0x0d, 0xa7, 0xaa, 0xf6, 0xbb, 0x5a, 0xc5, 0xea, 0xf8, 0x9b, 0x90, 0x32,
-	0xcc, 0x5c, 0xf4, 0xfc, 0x81, 0x4a, 0x35, 0xc1, 0xaf, 0xe7, 0x40, 0xb5, 0x39, 0x8a, 0x3c, 0x4c,
-	0x70, 0x1c, 0xa8, 0x1e, 0x8b, 0x42, 0xb3, 0x0c, 0xae, 0x09, 0x22, 0x42, 0xac, 0x1e, 0x32, 0x57,
-	0x0d, 0xcc, 0x1a, 0xb8, 0x19, 0x60, 0xee, 0x33, 0x92, 0xe4, 0x25, 0x75, 0x47, 0x21, 0xf3, 0x00,
-	0xdc, 0x60, 0xd8, 0x27, 0x09, 0xc1, 0xb1, 0x90, 0xaf, 0xc1, 0xa2, 0xb3, 0xfd, 0x4f, 0xdf, 0xde,
-	0x9a, 0x42, 0xe9, 0xae, 0xef, 0xa7, 0x9d, 0x18, 0x73, 0xee, 0xe6, 0x1c, 0xa6, 0x0f, 0x4a, 0x28,
-	0x92, 0x4d, 0xab, 0x28, 0x2d, 0xbb, 0x3d, 0xd1, 0x32, 0xe9, 0xd7, 0x5d, 0x7d, 0x5b, 0x37, 0xa7,
-	0x48, 0xa6, 0x3c, 0xd1, 0xd4, 0x3b, 0x0b, 0x9f, 0x3e, 0xb1, 0x0b, 0xb2, 0x6c, 0x7f, 0x1b, 0x60,
-	0xf5, 0x3e, 0x0e, 0x71, 0x5b, 0x56, 0x55, 0x20, 0x26, 0x48, 0xdc, 0x7e, 0x3b, 0x3e, 0x96, 0x6d,
-	0x34, 0x61, 0xb8, 0x47, 0x68, 0xfa, 0x42, 0x8d, 0x5e, 0x89, 0x91, 0x36, 0x3a, 0x16, 0x00, 0xdd,
-	0xa5, 0x21, 0xa2, 0x2f, 0xc4, 0x11, 0xb8, 0xc6, 0x05, 0x3a, 0xc1, 0xfa, 0x36, 0xbc, 0x39, 0xf3,
-	0x43, 0xb9, 0xa8, 0x12, 0x49, 0x12, 0xe8, 0x2a, 0x32, 0x73, 0x0f, 0x94, 0x3a, 0x98, 0xb4, 0x3b,
-	0xca, 0xf1, 0xa2, 0xb3, 0xf5, 0x57, 0xdf, 0x5e, 0xf6, 0x19, 0x4e, 0xdb, 0x7f, 0xec, 0xa9, 0xa9,
-	0x5c, 0xe4, 0xd8, 0x04, 0x74, 0xf5, 0x62, 0xf8, 0x78, 0x0e, 0xdc, 0xd6, 0x7b, 0x27, 0x34, 0xce,
-	0x5c, 0xd0, 0xef, 0xed, 0x47, 0xe0, 0xe5, 0xfc, 0x1e, 0x20, 0x55, 0x28, 0xe9, 0xc0, 0xa2, 0xf3,
-	0x60, 0xd0, 0xb7, 0x2b, 0xe3, 0x57, 0x45, 0x87, 0xc0, 0x29, 0xab, 0xff, 0x08, 0x85, 0xc3, 0xea,
-	0xe7, 0xbd, 0x47, 0x23, 0x26, 0x01, 0xa5, 0xec, 0x1b, 0xe7, 0x05, 0x75, 0x6d, 0x9d, 0xc0, 0x39,
-	0xf8, 0xe1, 0xc2, 0x32, 0x9e, 0x5e, 0x58, 0xc6, 0xb3, 0x0b, 0xcb, 0xf8, 0xf3, 0xc2, 0x32, 0xbe,
-	0xb8, 0xb4, 0x0a, 0xcf, 0x2e, 0xad, 0xc2, 0x6f, 0x97, 0x56, 0xe1, 0x83, 0xed, 0xe7, 0x52, 0x9e,
-	0x5d, 0xfd, 0x1a, 0x95, 0x19, 0x5a, 0x25, 0xf9, 0xb1, 0x78, 0xef, 0xdf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x07, 0x68, 0xf1, 0xe3, 0xb1, 0x0a, 0x00, 0x00,
+	// 1237 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0xcb, 0x6f, 0x1c, 0x35,
+	0x18, 0xcf, 0x24, 0xe9, 0x36, 0x75, 0xd3, 0xb4, 0x75, 0x5e, 0x9b, 0xa4, 0xdd, 0x59, 0x99, 0x57,
+	0x24, 0xd4, 0x4d, 0x43, 0x6f, 0x3d, 0x20, 0x65, 0xb7, 0xa9, 0x00, 0xf5, 0x11, 0xb9, 0xa5, 0x95,
+	0xb8, 0x0c, 0xde, 0x19, 0x77, 0x63, 0x65, 0x66, 0x3c, 0xd8, 0xde, 0x34, 0x45, 0x42, 0x48, 0x3d,
+	0x71, 0xa9, 0x00, 0xc1, 0x81, 0x03, 0xa0, 0x5e, 0x90, 0x78, 0xfd, 0x11, 0x1c, 0x7b, 0xec, 0x11,
+	0x71, 0x18, 0x50, 0x7a, 0xe3, 0xb8, 0x37, 0x38, 0xa1, 0xb1, 0x3d, 0xb3, 0x8f, 0x2e, 0xd5, 0x2e,
+	0x52, 0x7b, 0xe1, 0x94, 0xcc, 0xcf, 0x9f, 0x7f, 0xdf, 0xcf, 0xdf, 0xcb, 0x5e, 0x50, 0xf3, 0xb9,
+	0x8c, 0xb8, 0xdc, 0x08, 0x98, 0x54, 0x82, 0x35, 0xdb, 0x8a, 0xf1, 0x78, 0x63, 0x7f, 0xb3, 0x49,
+	0x15, 0xd9, 0xec, 0x03, 0x6b, 0x89, 0xe0, 0x8a, 0xc3, 0x35, 0x63, 0x5f, 0xeb, 0x5b, 0xb2, 0xf6,
+	0xab, 0x0b, 0x2d, 0xde, 0xe2, 0xda, 0x6e, 0x23, 0xfb, 0xcf, 0x6c, 0x59, 0xad, 0x58, 0x17, 0x4d,
+	0x22, 0x69, 0x41, 0xed, 0x73, 0x66, 0x29, 0xd1, 0x2f, 0x25, 0x50, 0xda, 0x21, 0x82, 0x44, 0x12,
+	0xee, 0x81, 0x13, 0x3e, 0x8f, 0xa2, 0x76, 0xcc, 0xd4, 0x3d, 0x4f, 0x91, 0x83, 0xb2, 0x53, 0x75,
+	0xd6, 0x8f, 0xd5, 0x2f, 0x3f, 0x4a, 0xdd, 0x89, 0xdf, 0x52, 0xf7, 0xd5, 0x16, 0x53, 0xbb, 0xed,
+	0x66, 0xcd, 0xe7, 0xd1, 0x86, 0x25, 0x35, 0x7f, 0xce, 0xc9, 0x60, 0x6f, 0x43, 0xdd, 0x4b, 0xa8,
+	0xac, 0x5d, 0xa2, 0x7e, 0x27, 0x75, 0x17, 0xee, 0x91, 0x28, 0xbc, 0x88, 0xfa, 0xc8, 0x10, 0x9e,
+	0x2d, 0xbe, 0x6f, 0x92, 0x03, 0xf8, 0x31, 0x58, 0xc8, 0x24, 0x79, 0x89, 0xe0, 0x09, 0x97, 0x54,
+	0x78, 0x82, 0xde, 0x25, 0x22, 0x28, 0x4f, 0x6a, 0x9f, 0x57, 0xc7, 0xf6, 0xb9, 0x66, 0x7c, 0x0e,
+	0xe3, 0x44, 0x18, 0x66, 0xf0, 0x8e, 0x45, 0xb1, 0x06, 0xe1, 0x7d, 0x07, 0x2c, 0x36, 0x79, 0xdc,
+	0x96, 0x4f, 0x49, 0x98, 0xd2, 0x12, 0xae, 0x8d, 0x2d, 0xe1, 0x8c, 0x95, 0x30, 0x8c, 0x14, 0xe1,
+	0x79, 0x8d, 0x0f, 0x88, 0xb8, 0x09, 0x16, 0xef, 0x32, 0xb5, 0x1b, 0x08, 0x72, 0xd7, 0x23, 0x41,
+	0x20, 0x3c, 0x1a, 0x93, 0x66, 0x48, 0x83, 0xf2, 0x74, 0xd5, 0x59, 0x9f, 0xa9, 0x57, 0xbb, 0xac,
+	0x43, 0xcd, 0x10, 0x9e, 0xcf, 0xf1, 0xad, 0x20, 0x10, 0xdb, 0x06, 0x85, 0x57, 0x00, 0x14, 0xd4,
+	0xe7, 0x22, 0xf0, 0xf2, 0x55, 0x12, 0xca, 0xf2, 0x11, 0x4d, 0x79, 0xb6, 0x93, 0xba, 0x2b, 0x86,
+	0xf2, 0x69, 0x1b, 0x84, 0x4f, 0x1b, 0xf0, 0x76, 0x17, 0x83, 0xef, 0x83, 0x95, 0xc2, 0xb9, 0xdd,
+	0x22, 0xa8, 0xa2, 0x71, 0x56, 0x7c, 0xe5, 0x52, 0xd5, 0x59, 0x9f, 0xae, 0xbf, 0xdc, 0x49, 0xdd,
+	0xea, 0x80, 0xce, 0x41, 0x53, 0x84, 0x97, 0xf3, 0x35, 0xac, 0x97, 0x70, 0xbe, 0x02, 0xaf, 0x81,
+	0x79, 0xd2, 0x56, 0xdc, 0xf3, 0x79, 0x94, 0xf0, 0x76, 0x1c, 0x78, 0x34, 0xe1, 0xfe, 0x6e, 0xf9,
+	0xa8, 0xe6, 0xae, 0x74, 0x52, 0x77, 0xd5, 0x70, 0x0f, 0x31, 0x42, 0xf8, 0x74, 0x86, 0x36, 0x2c,
+	0xb8, 0x9d, 0x61, 0xb0, 0x05, 0xce, 0x44, 0xe4, 0xc0, 0xeb, 0x33, 0x97, 0x5e, 0x42, 0x85, 0xd7,
+	0x0c, 0xb9, 0xbf, 0x57, 0x9e, 0xa9, 0x3a, 0xeb, 0x27, 0xea, 0xaf, 0x75, 0x52, 0xf7, 0x25, 0x43,
+	0xfc, 0x2c, 0x6b, 0x84, 0xcb, 0x11, 0x39, 0xd8, 0xea, 0x71, 0x22, 0x77, 0xa8, 0xa8, 0x67, 0x4b,
+	0x17, 0xa7, 0xbf, 0x7a, 0xe8, 0x4e, 0xa0, 0x4f, 0x27, 0xc1, 0xea, 0x2d, 0x12, 0xb2, 0x80, 0x28,
+	0x2e, 0xde, 0x62, 0x52, 0x71, 0xc1, 0x7c, 0x12, 0x9a, 0x14, 0x4b, 0xf8, 0x93, 0x03, 0x96, 0xfd,
+	0x76, 0xd4, 0x0e, 0x89, 0x62, 0xfb, 0xd4, 0xd6, 0x83, 0x27, 0x88, 0x62, 0xbc, 0xec, 0x54, 0xa7,
+	0xd6, 0x8f, 0xbf, 0x71, 0xc6, 0xce, 0x81, 0x5a, 0x56, 0xa6, 0x79, 0x3f, 0x67, 0x45, 0xd5, 0xe0,
+	0x2c, 0xae, 0xbf, 0x9b, 0x15, 0x62, 0x27, 0x75, 0x2b, 0xb6, 0xab, 0x86, 0x53, 0xa1, 0x1f, 0x7f,
+	0x77, 0x5f, 0x1f, 0xad, 0x54, 0x33, 0x56, 0x89, 0x17, 0xbb, 0x44, 0x46, 0x29, 0xce, 0x68, 0x60,
+	0x03, 0x9c, 0x14, 0xf4, 0x0e, 0x15, 0x34, 0xf6, 0xa9, 0xe7, 0xf3, 0x76, 0xac, 0x74, 0x4b, 0x9e,
+	0xa8, 0xaf, 0x76, 0x52, 0x77, 0x29, 0x2f, 0x9c, 0x3e, 0x03, 0x84, 0xe7, 0x0a, 0xa4, 0xa1, 0x81,
+	0x6f, 0x1d, 0xb0, 0x5c, 0x44, 0xa4, 0xd1, 0x16, 0x82, 0xc6, 0x2a, 0x0f, 0xc7, 0x1e, 0x38, 0x6a,
+	0x74, 0xcb, 0x91, 0x4e, 0x7f, 0x21, 0x3b, 0xfd, 0xb8, 0x67, 0xcb, 0x3d, 0xc0, 0x25, 0x50, 0x4a,
+	0xa8, 0x60, 0xdc, 0xcc, 0x95, 0x69, 0x6c, 0xbf, 0xd0, 0x17, 0x0e, 0xa8, 0x14, 0x02, 0xb7, 0x7c,
+	0x1b, 0x0a, 0x1a, 0x34, 0x78, 0x14, 0x31, 0x29, 0xb3, 0xa2, 0xfc, 0x00, 0x00, 0xbf, 0xf8, 0x7a,
+	0x7e, 0x52, 0x7b, 0x9c, 0xa0, 0xaf, 0x1d, 0xb0, 0x56, 0xa8, 0xba, 0xde, 0x56, 0x52, 0x91, 0x38,
+	0x60, 0x71, 0x2b, 0x0f, 0xdd, 0x47, 0xe3, 0x85, 0x6e, 0xdb, 0x16, 0xce, 0x5c, 0x9e, 0x35, 0xbd,
+	0x15, 0xfd, 0xd7, 0x60, 0xa2, 0x1f, 0x1c, 0x30, 0x5f, 0xc8, 0xbb, 0x11, 0x12, 0xb9, 0xbb, 0xbd,
+	0x4f, 0x63, 0x05, 0x2f, 0x83, 0x53, 0xfb, 0x39, 0xec, 0xd9, 0x70, 0x3b, 0xba, 0x77, 0xd7, 0x3a,
+	0xa9, 0xbb, 0x6c, 0xbc, 0x0f, 0x5a, 0x20, 0x7c, 0xb2, 0x80, 0x76, 0x34, 0x02, 0xdf, 0x01, 0x33,
+	0x77, 0x04, 0xf1, 0xf5, 0x5c, 0x31, 0xd7, 0x40, 0x6d, 0xbc, 0x19, 0x8c, 0x8b, 0xfd, 0xe8, 0x67,
+	0x07, 0x2c, 0x0c, 0xd1, 0x2a, 0xe1, 0x03, 0x07, 0x2c, 0x75, 0xb5, 0xc8, 0x6c, 0xc5, 0xa3, 0x7a,
+	0xc9, 0xc6, 0xf4, 0x7c, 0xed, 0x19, 0x97, 0x6c, 0x6d, 0x08, 0x67, 0xfd, 0x15, 0x1b, 0xe7, 0xb3,
+	0x83, 0x27, 0xed, 0x65, 0x47, 0x78, 0x61, 0x7f, 0x88, 0x1e, 0x3b, 0x42, 0xbe, 0x71, 0xc0, 0xd1,
+	0xcb, 0x94, 0xee, 0x70, 0x1e, 0xc2, 0xcf, 0x1d, 0x30, 0xd7, 0xbd, 0x3a, 0x13, 0xce, 0xc3, 0x91,
+	0xb2, 0x7d, 0xc5, 0xaa, 0x58, 0x1c, 0xbc, 0x7c, 0x33, 0x86, 0xb1, 0x93, 0xde, 0x7d, 0x09, 0x64,
+	0x9a, 0xd0, 0x97, 0x93, 0x60, 0xb5, 0xd1, 0x8b, 0xdc, 0x48, 0x68, 0x1c, 0x98, 0xcb, 0x8c, 0x84,
+	0x70, 0x01, 0x1c, 0x51, 0x4c, 0x85, 0xd4, 0xbc, 0x18, 0xb0, 0xf9, 0x80, 0x55, 0x70, 0x3c, 0xa0,
+	0xd2, 0x17, 0x2c, 0xe9, 0xa6, 0x14, 0xf7, 0x42, 0xf0, 0x3a, 0x38, 0x26, 0xa8, 0xcf, 0x12, 0x46,
+	0x63, 0xa5, 0xaf, 0xdd, 0xd9, 0xfa, 0xe6, 0xdf, 0xa9, 0x7b, 0x6e, 0x04, 0xa5, 0x5b, 0xbe, 0x9f,
+	0x5d, 0x79, 0x54, 0x4a, 0xdc, 0xe5, 0x80, 0x3e, 0x28, 0x91, 0x48, 0x0f, 0xad, 0x69, 0x1d, 0xb2,
+	0x95, 0xa1, 0x21, 0xd3, 0xf1, 0x3a, 0x6f, 0xbb, 0x75, 0x7d, 0x04, 0x67, 0x26, 0x26, 0x96, 0xfa,
+	0xe2, 0xcc, 0x27, 0x0f, 0xdd, 0x09, 0x9d, 0xb6, 0xbf, 0x1c, 0xb0, 0x78, 0x89, 0x86, 0xb4, 0xa5,
+	0xb3, 0xaa, 0x88, 0x50, 0x2c, 0x6e, 0xbd, 0x1d, 0xdf, 0xd1, 0x63, 0x34, 0x11, 0x74, 0x9f, 0xf1,
+	0xb6, 0xec, 0x6f, 0x89, 0x9e, 0x31, 0x3a, 0x60, 0x80, 0xf0, 0x5c, 0x8e, 0xd8, 0x86, 0xb8, 0x09,
+	0x8e, 0x48, 0x45, 0xf6, 0xa8, 0xed, 0x86, 0x37, 0xc7, 0x7e, 0x91, 0xcc, 0x1a, 0x47, 0x9a, 0x04,
+	0x61, 0x43, 0x06, 0xb7, 0x41, 0x69, 0x97, 0xb2, 0xd6, 0xae, 0x89, 0xf8, 0x74, 0xfd, 0xdc, 0x9f,
+	0xa9, 0x7b, 0xd2, 0x17, 0x34, 0x1b, 0xff, 0xb1, 0x67, 0x96, 0xba, 0x22, 0x07, 0x16, 0x10, 0xb6,
+	0x9b, 0xd1, 0xfd, 0x49, 0xb0, 0x62, 0xcf, 0xce, 0x78, 0x5c, 0x44, 0xc1, 0x3e, 0x6c, 0x3e, 0x04,
+	0xa7, 0xbb, 0x7d, 0x40, 0x4c, 0xa2, 0x74, 0x04, 0x66, 0xeb, 0x57, 0x3b, 0xa9, 0x5b, 0x1e, 0x6c,
+	0x15, 0x6b, 0x82, 0x46, 0xcc, 0xfe, 0x2d, 0x12, 0xe6, 0xd9, 0xef, 0xce, 0x1e, 0x8b, 0x40, 0x06,
+	0x4a, 0xc5, 0x63, 0xf2, 0x39, 0x4d, 0x6d, 0xeb, 0x00, 0x7d, 0x37, 0x05, 0xe6, 0x6e, 0xf7, 0xbd,
+	0x6a, 0xb2, 0x93, 0x07, 0x79, 0x30, 0xfe, 0xfd, 0xe4, 0x4f, 0x99, 0xa0, 0xf1, 0xeb, 0xfe, 0x54,
+	0x41, 0x92, 0x9f, 0x7c, 0x68, 0xd4, 0x27, 0x5f, 0x4c, 0xd4, 0x97, 0xfa, 0xca, 0x6a, 0x2a, 0xaf,
+	0x93, 0x17, 0xd2, 0x92, 0xb0, 0xd2, 0x77, 0x59, 0xeb, 0x97, 0x6e, 0xdf, 0xcd, 0xfa, 0x60, 0x12,
+	0xcc, 0xf7, 0x3e, 0xe1, 0x6e, 0x50, 0x95, 0xb5, 0xea, 0xff, 0x35, 0x59, 0xf5, 0xeb, 0xdf, 0x1f,
+	0x56, 0x9c, 0x47, 0x87, 0x15, 0xe7, 0xf1, 0x61, 0xc5, 0xf9, 0xe3, 0xb0, 0xe2, 0x7c, 0xf6, 0xa4,
+	0x32, 0xf1, 0xf8, 0x49, 0x65, 0xe2, 0xd7, 0x27, 0x95, 0x89, 0xf7, 0x36, 0x9f, 0x49, 0x7f, 0xd0,
+	0xff, 0x73, 0x55, 0x7b, 0x6b, 0x96, 0xf4, 0xaf, 0xc9, 0x0b, 0xff, 0x0c, 0x00, 0x34, 0x48, 0xe3,
+	0xdc, 0xd2, 0x0e, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -694,6 +886,18 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.RecordWithdrawals != that1.RecordWithdrawals {
+		return false
+	}
+	if this.WithdrawRecordRetention != that1.WithdrawRecordRetention {
+		return false
+	}
+	if this.AutoCompoundEpoch != that1.AutoCompoundEpoch {
+		return false
+	}
+	if this.MaxAutoCompoundsPerBlock != that1.MaxAutoCompoundsPerBlock {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1003,6 +1207,74 @@ func (this *DelegationDelegatorReward) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *WithdrawRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WithdrawRecord)
+	if !ok {
+		that2, ok := that.(WithdrawRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
+		return false
+	}
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if this.Commission != that1.Commission {
+		return false
+	}
+	return true
+}
+func (this *AutoCompoundSetting) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AutoCompoundSetting)
+	if !ok {
+		that2, ok := that.(AutoCompoundSetting)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
+		return false
+	}
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1023,6 +1295,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxAutoCompoundsPerBlock != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.MaxAutoCompoundsPerBlock))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AutoCompoundEpoch != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundEpoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.WithdrawRecordRetention != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.WithdrawRecordRetention))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RecordWithdrawals {
+		i--
+		if m.RecordWithdrawals {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1481,58 +1778,173 @@ func (m *DelegationDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDistribution(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *WithdrawRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CommunityTax.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	l = m.BaseProposerReward.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	l = m.BonusProposerReward.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	if m.WithdrawAddrEnabled {
-		n += 2
-	}
-	return n
+
+func (m *WithdrawRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorHistoricalRewards) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WithdrawRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CumulativeRewardRatio) > 0 {
-		for _, e := range m.CumulativeRewardRatio {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
+	if m.Commission {
+		i--
+		if m.Commission {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.ReferenceCount != 0 {
-		n += 1 + sovDistribution(uint64(m.ReferenceCount))
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	return n
-}
-
-func (m *ValidatorCurrentRewards) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Height != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoCompoundSetting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundSetting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundSetting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDistribution(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommunityTax.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.BaseProposerReward.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.BonusProposerReward.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.WithdrawAddrEnabled {
+		n += 2
+	}
+	if m.RecordWithdrawals {
+		n += 2
+	}
+	if m.WithdrawRecordRetention != 0 {
+		n += 1 + sovDistribution(uint64(m.WithdrawRecordRetention))
+	}
+	if m.AutoCompoundEpoch != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundEpoch))
+	}
+	if m.MaxAutoCompoundsPerBlock != 0 {
+		n += 1 + sovDistribution(uint64(m.MaxAutoCompoundsPerBlock))
+	}
+	return n
+}
+
+func (m *ValidatorHistoricalRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CumulativeRewardRatio) > 0 {
+		for _, e := range m.CumulativeRewardRatio {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.ReferenceCount != 0 {
+		n += 1 + sovDistribution(uint64(m.ReferenceCount))
+	}
+	return n
+}
+
+func (m *ValidatorCurrentRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	if len(m.Rewards) > 0 {
 		for _, e := range m.Rewards {
@@ -1683,6 +2095,52 @@ func (m *DelegationDelegatorReward) Size() (n int) {
 	return n
 }
 
+func (m *WithdrawRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovDistribution(uint64(m.Height))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.Commission {
+		n += 2
+	}
+	return n
+}
+
+func (m *AutoCompoundSetting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
 func sovDistribution(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1840,6 +2298,83 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordWithdrawals", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RecordWithdrawals = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawRecordRetention", wireType)
+			}
+			m.WithdrawRecordRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawRecordRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundEpoch", wireType)
+			}
+			m.AutoCompoundEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoCompoundsPerBlock", wireType)
+			}
+			m.MaxAutoCompoundsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoCompoundsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2961,6 +3496,321 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WithdrawRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Commission = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoCompoundSetting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundSetting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundSetting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDistribution(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18,4 +18,6 @@ var (
 	ErrEmptyProposalRecipient  = sdkerrors.Register(ModuleName, 11, "invalid community pool spend proposal recipient")
 	ErrNoValidatorExists       = sdkerrors.Register(ModuleName, 12, "validator does not exist")
 	ErrNoDelegationExists      = sdkerrors.Register(ModuleName, 13, "delegation does not exist")
+	ErrNoTokenizeShareRecords  = sdkerrors.Register(ModuleName, 14, "no tokenize share records owned")
+	ErrCompoundWithdrawAddr    = sdkerrors.Register(ModuleName, 15, "auto-compounding requires the withdraw address to be the delegator address")
 )
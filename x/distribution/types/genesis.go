@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -8,6 +10,7 @@ func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	withdrawRecords []WithdrawRecord, autoCompounds []AutoCompoundSetting,
 ) *GenesisState {
 
 	return &GenesisState{
@@ -21,6 +24,8 @@ func NewGenesisState(
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		WithdrawRecords:                 withdrawRecords,
+		AutoCompoundSettings:            autoCompounds,
 	}
 }
 
@@ -37,6 +42,8 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		WithdrawRecords:                 []WithdrawRecord{},
+		AutoCompoundSettings:            []AutoCompoundSetting{},
 	}
 }
 
@@ -45,5 +52,43 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	for _, record := range gs.WithdrawRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, setting := range gs.AutoCompoundSettings {
+		if err := setting.Validate(); err != nil {
+			return err
+		}
+	}
 	return gs.FeePool.ValidateGenesis()
 }
+
+// Validate performs a basic validation of a withdraw record.
+func (r WithdrawRecord) Validate() error {
+	if r.DelegatorAddress.Empty() {
+		return fmt.Errorf("withdraw record at height %d has an empty delegator address", r.Height)
+	}
+	if r.ValidatorAddress.Empty() {
+		return fmt.Errorf("withdraw record at height %d has an empty validator address", r.Height)
+	}
+	if r.Height <= 0 {
+		return fmt.Errorf("withdraw record of delegator %s has an invalid height: %d", r.DelegatorAddress, r.Height)
+	}
+	if !r.Amount.IsValid() || r.Amount.IsZero() {
+		return fmt.Errorf("withdraw record of delegator %s has an invalid amount: %s", r.DelegatorAddress, r.Amount)
+	}
+	return nil
+}
+
+// Validate performs a basic validation of an auto-compound setting.
+func (s AutoCompoundSetting) Validate() error {
+	if s.DelegatorAddress.Empty() {
+		return fmt.Errorf("auto-compound setting of validator %s has an empty delegator address", s.ValidatorAddress)
+	}
+	if s.ValidatorAddress.Empty() {
+		return fmt.Errorf("auto-compound setting of delegator %s has an empty validator address", s.DelegatorAddress)
+	}
+	return nil
+}
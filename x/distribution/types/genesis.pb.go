@@ -470,6 +470,12 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos" yaml:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events" yaml:"validator_slash_events"`
+	// withdraw_records defines the rewards and commission withdrawals recorded
+	// at genesis.
+	WithdrawRecords []WithdrawRecord `protobuf:"bytes,11,rep,name=withdraw_records,json=withdrawRecords,proto3" json:"withdraw_records" yaml:"withdraw_records"`
+	// auto_compound_settings defines the auto-compound settings of all
+	// delegators at genesis.
+	AutoCompoundSettings []AutoCompoundSetting `protobuf:"bytes,12,rep,name=auto_compound_settings,json=autoCompoundSettings,proto3" json:"auto_compound_settings" yaml:"auto_compound_settings"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -575,6 +581,20 @@ func (m *GenesisState) GetValidatorSlashEvents() []ValidatorSlashEventRecord {
 	return nil
 }
 
+func (m *GenesisState) GetWithdrawRecords() []WithdrawRecord {
+	if m != nil {
+		return m.WithdrawRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoCompoundSettings() []AutoCompoundSetting {
+	if m != nil {
+		return m.AutoCompoundSettings
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DelegatorWithdrawInfo)(nil), "cosmos.distribution.v1beta1.DelegatorWithdrawInfo")
 	proto.RegisterType((*ValidatorOutstandingRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorOutstandingRewardsRecord")
@@ -591,73 +611,77 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 1044 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xae, 0xdb, 0xb4, 0x8c, 0x5d, 0xda, 0x2e, 0x69, 0xe2, 0xba, 0xa9, 0x9d, 0x4e, 0x8b,
-	0x08, 0xaa, 0xba, 0x6e, 0x02, 0x02, 0x54, 0x04, 0x52, 0x36, 0xe5, 0x97, 0x04, 0x34, 0x6c, 0xa4,
-	0x82, 0x10, 0x92, 0x35, 0xde, 0x1d, 0xdb, 0x23, 0xd6, 0x3b, 0xd6, 0xcc, 0xd8, 0x21, 0x70, 0xe4,
-	0xda, 0x03, 0x12, 0xe2, 0x84, 0x90, 0xe0, 0x80, 0x84, 0x10, 0x12, 0x7f, 0x02, 0x12, 0xa7, 0x1e,
-	0x7b, 0xe4, 0x64, 0x50, 0xf2, 0x1f, 0xe4, 0xc8, 0x09, 0xed, 0xcc, 0xec, 0x2f, 0xff, 0xaa, 0x1b,
-	0x24, 0xc8, 0x29, 0xf1, 0xee, 0x9b, 0xef, 0xfb, 0xe6, 0x9b, 0xf7, 0xde, 0xbc, 0x05, 0xcf, 0x7b,
-	0x94, 0x77, 0x29, 0xaf, 0xfb, 0x84, 0x0b, 0x46, 0x9a, 0x7d, 0x41, 0x68, 0x58, 0x1f, 0x6c, 0x34,
-	0xb1, 0x40, 0x1b, 0xf5, 0x36, 0x0e, 0x31, 0x27, 0xdc, 0xee, 0x31, 0x2a, 0xa8, 0x75, 0x45, 0x85,
-	0xda, 0xd9, 0x50, 0x5b, 0x87, 0x56, 0x96, 0xda, 0xb4, 0x4d, 0x65, 0x5c, 0x3d, 0xfa, 0x4f, 0x2d,
-	0xa9, 0x54, 0x35, 0x7a, 0x13, 0x71, 0x9c, 0xa0, 0x7a, 0x94, 0x84, 0xfa, 0xbd, 0x3d, 0x8b, 0x3d,
-	0xc7, 0x23, 0xe3, 0xe1, 0x03, 0x13, 0x5c, 0xba, 0x8b, 0x03, 0xdc, 0x46, 0x82, 0xb2, 0x0f, 0x89,
-	0xe8, 0xf8, 0x0c, 0xed, 0xbd, 0x13, 0xb6, 0xa8, 0xf5, 0x39, 0xb8, 0xe8, 0xc7, 0x2f, 0x1a, 0xc8,
-	0xf7, 0x19, 0xe6, 0xbc, 0x6c, 0xac, 0x19, 0xeb, 0x25, 0xe7, 0xbd, 0xa3, 0x61, 0xad, 0xbc, 0x8f,
-	0xba, 0xc1, 0x1d, 0x38, 0x16, 0x02, 0xff, 0x1e, 0xd6, 0x6e, 0xb5, 0x89, 0xe8, 0xf4, 0x9b, 0xb6,
-	0x47, 0xbb, 0x75, 0xad, 0x47, 0xfd, 0xb9, 0xc5, 0xfd, 0x4f, 0xeb, 0x62, 0xbf, 0x87, 0xb9, 0xbd,
-	0xe5, 0x79, 0x5b, 0x6a, 0x85, 0x7b, 0x21, 0x01, 0xd1, 0x4f, 0xac, 0x3d, 0x70, 0x61, 0x4f, 0x6b,
-	0x49, 0xa8, 0x4d, 0x49, 0xfd, 0xee, 0xd1, 0xb0, 0xb6, 0xa2, 0xa8, 0x47, 0x23, 0x8e, 0xc1, 0x7c,
-	0x3e, 0xc6, 0xd0, 0x0f, 0xe0, 0xef, 0x26, 0xb8, 0x76, 0x1f, 0x05, 0xc4, 0x8f, 0xd4, 0xdc, 0xeb,
-	0x0b, 0x2e, 0x50, 0xe8, 0x93, 0xb0, 0xed, 0xe2, 0x3d, 0xc4, 0x7c, 0xee, 0x62, 0x8f, 0x32, 0x3f,
-	0xb2, 0x66, 0x10, 0x07, 0x4d, 0xb7, 0x66, 0x2c, 0x64, 0x5e, 0x81, 0xf7, 0x51, 0x90, 0x58, 0x93,
-	0x80, 0xc4, 0xd6, 0x7c, 0x6f, 0x80, 0x67, 0x68, 0x2a, 0xac, 0xc1, 0x94, 0xb2, 0xb2, 0xb9, 0x56,
-	0x58, 0x2f, 0x6e, 0xae, 0xea, 0xf3, 0xb7, 0xa3, 0xfc, 0x88, 0x53, 0xc9, 0xbe, 0x8b, 0xbd, 0x6d,
-	0x4a, 0x42, 0xe7, 0x83, 0x87, 0xc3, 0xda, 0xc2, 0xd1, 0xb0, 0x56, 0x51, 0x02, 0x27, 0xc0, 0xc0,
-	0x9f, 0xff, 0xac, 0xdd, 0x9c, 0x43, 0xa2, 0x46, 0xe4, 0xae, 0x45, 0xc7, 0x4c, 0x82, 0xdf, 0x99,
-	0xe0, 0x46, 0x62, 0xe2, 0x96, 0xe7, 0xf5, 0xbb, 0xfd, 0x00, 0x09, 0xec, 0x6f, 0xd3, 0x6e, 0x97,
-	0x70, 0x4e, 0x68, 0x78, 0x02, 0x7c, 0xdc, 0x07, 0x45, 0x94, 0x4a, 0x93, 0xd9, 0x55, 0xdc, 0x7c,
-	0xd5, 0x9e, 0x51, 0x91, 0xf6, 0xec, 0x3d, 0x39, 0x15, 0xed, 0xae, 0xa5, 0x64, 0x67, 0xd0, 0xa1,
-	0x9b, 0xe5, 0x82, 0x3f, 0x98, 0x60, 0x2d, 0xc1, 0x7a, 0x9b, 0x70, 0x41, 0x19, 0xf1, 0x50, 0x70,
-	0x72, 0x72, 0x6c, 0x19, 0x2c, 0xf6, 0x30, 0x23, 0x54, 0xd9, 0x72, 0xca, 0xd5, 0xbf, 0x2c, 0x02,
-	0xce, 0xc4, 0xe9, 0x56, 0x90, 0x7e, 0xbd, 0x3c, 0x9f, 0x5f, 0x63, 0x7b, 0x74, 0x96, 0xb5, 0x57,
-	0x4f, 0xab, 0x6d, 0xc4, 0xd9, 0xe7, 0xc6, 0xf8, 0xf0, 0x4b, 0x13, 0x5c, 0x4d, 0xd6, 0x6f, 0xf7,
-	0x19, 0xc3, 0xa1, 0x38, 0x39, 0x06, 0xb5, 0x52, 0x23, 0x54, 0xe2, 0xbc, 0x38, 0x9f, 0x11, 0xf9,
-	0x8d, 0x3c, 0xde, 0x85, 0x07, 0x05, 0x70, 0x25, 0xe9, 0xce, 0xbb, 0x02, 0x31, 0x41, 0xc2, 0x76,
-	0xd4, 0x9d, 0x53, 0x0f, 0xfe, 0xb7, 0x1e, 0x3d, 0xd1, 0x7f, 0xf3, 0xbf, 0xf1, 0xbf, 0x0f, 0xce,
-	0x71, 0xed, 0x46, 0x83, 0x84, 0x2d, 0xaa, 0xd3, 0x71, 0x73, 0xe6, 0x29, 0x4c, 0x34, 0xd2, 0x59,
-	0xd5, 0x67, 0xb0, 0xa4, 0xf4, 0xe6, 0x60, 0xa1, 0x5b, 0xe2, 0x99, 0x58, 0xf8, 0xa3, 0x09, 0x2e,
-	0x27, 0x67, 0xb9, 0x1b, 0x20, 0xde, 0x79, 0x63, 0x20, 0x8f, 0xf3, 0x24, 0x54, 0x6c, 0x07, 0x93,
-	0x76, 0x47, 0xc4, 0x15, 0xab, 0x7e, 0x65, 0x2a, 0xb9, 0x90, 0xab, 0xe4, 0x4f, 0xc0, 0x69, 0x1c,
-	0x49, 0x2f, 0x9f, 0x92, 0xc6, 0xdd, 0x9e, 0x2f, 0x7d, 0xd3, 0x2d, 0x3b, 0x4b, 0xda, 0xb6, 0x92,
-	0xda, 0x95, 0x04, 0x83, 0xae, 0x02, 0x85, 0xbf, 0x16, 0x41, 0xe9, 0x2d, 0x35, 0xe9, 0xec, 0x0a,
-	0x24, 0xb0, 0xe5, 0x82, 0xc5, 0x1e, 0x62, 0xa8, 0xab, 0xfc, 0x28, 0x6e, 0x5e, 0x9f, 0xc9, 0xb7,
-	0x23, 0x43, 0x9d, 0x4b, 0x9a, 0xe2, 0x9c, 0xa2, 0x50, 0x00, 0xd0, 0xd5, 0x48, 0xd6, 0x47, 0xe0,
-	0x6c, 0x0b, 0xe3, 0x46, 0x8f, 0xd2, 0x40, 0x17, 0xe1, 0x8d, 0x99, 0xa8, 0x6f, 0x62, 0xbc, 0x43,
-	0x69, 0xe0, 0xac, 0x68, 0xd8, 0xf3, 0x0a, 0x36, 0xc6, 0x80, 0xee, 0x99, 0x96, 0x8a, 0xb0, 0xbe,
-	0x31, 0x40, 0x39, 0xad, 0x99, 0x64, 0xcc, 0x88, 0x32, 0x22, 0x6a, 0x7c, 0x85, 0xf9, 0x33, 0x2d,
-	0x3b, 0x50, 0x39, 0xcf, 0x69, 0xe2, 0xda, 0x68, 0x55, 0xe6, 0x19, 0xa0, 0xbb, 0xec, 0x4f, 0x5a,
-	0xcf, 0xad, 0x2f, 0xc0, 0xc5, 0x1e, 0xc3, 0x03, 0x42, 0xfb, 0xbc, 0xd1, 0x63, 0xb4, 0x47, 0x39,
-	0x66, 0xf2, 0x00, 0x4b, 0xce, 0xfb, 0x69, 0x82, 0x8d, 0x85, 0x44, 0x09, 0x66, 0xcf, 0x91, 0x60,
-	0xdb, 0x34, 0xe4, 0x49, 0x86, 0xc5, 0x28, 0x3b, 0x1a, 0xc4, 0xfa, 0x7a, 0xca, 0xdc, 0x71, 0x5a,
-	0xfa, 0xf1, 0xfa, 0x7c, 0x09, 0x34, 0x6d, 0xa2, 0x72, 0xe0, 0xe3, 0x27, 0x93, 0x49, 0xa3, 0x86,
-	0xf5, 0x9b, 0x01, 0xae, 0x65, 0x2a, 0x2a, 0xbd, 0x64, 0x1b, 0x5e, 0x72, 0x31, 0xf3, 0xf2, 0xa2,
-	0xd4, 0xb8, 0xf5, 0x2f, 0x2e, 0x77, 0x2d, 0xf3, 0xb6, 0x96, 0xb9, 0x3e, 0x56, 0xcb, 0x93, 0x99,
-	0xa1, 0x5b, 0x1b, 0xcc, 0xc4, 0xe5, 0xd6, 0x2f, 0x06, 0x58, 0x4d, 0x71, 0x3a, 0xc9, 0x4d, 0x99,
-	0x18, 0x7c, 0x46, 0x8a, 0x7f, 0xed, 0x98, 0x37, 0xad, 0x16, 0x7e, 0x53, 0x0b, 0xbf, 0x3e, 0x2a,
-	0x7c, 0x9c, 0x10, 0xba, 0x95, 0xc1, 0x54, 0xb8, 0x68, 0xfc, 0xbc, 0x9c, 0xae, 0xf6, 0xd4, 0x7d,
-	0x96, 0x68, 0x3d, 0x2b, 0xb5, 0xde, 0x39, 0xce, 0x65, 0xa8, 0x85, 0xae, 0x6b, 0xa1, 0x6b, 0xa3,
-	0x42, 0x47, 0xa8, 0xa0, 0xbb, 0x32, 0x98, 0x0c, 0x64, 0x7d, 0x9b, 0x2b, 0xdf, 0x5c, 0x43, 0xe7,
-	0xe5, 0xa7, 0xa4, 0xc2, 0x57, 0x9e, 0xfc, 0xa2, 0xd0, 0xfa, 0xa6, 0x16, 0x71, 0x9e, 0x27, 0x5b,
-	0xc4, 0x59, 0x14, 0x1e, 0xd5, 0xd1, 0x72, 0xba, 0x2b, 0x1e, 0x75, 0xd4, 0x86, 0xec, 0x9a, 0xbc,
-	0x0c, 0xa4, 0xb6, 0x97, 0x9e, 0xb4, 0x17, 0x6b, 0x65, 0xcf, 0x6a, 0x65, 0x57, 0x47, 0x9d, 0xcb,
-	0x72, 0x40, 0x77, 0x69, 0x30, 0x8e, 0xc0, 0x9d, 0x7b, 0x3f, 0x1d, 0x54, 0x8d, 0x87, 0x07, 0x55,
-	0xe3, 0xd1, 0x41, 0xd5, 0xf8, 0xeb, 0xa0, 0x6a, 0x7c, 0x75, 0x58, 0x5d, 0x78, 0x74, 0x58, 0x5d,
-	0xf8, 0xe3, 0xb0, 0xba, 0xf0, 0xf1, 0xc6, 0xcc, 0xee, 0xf1, 0x59, 0xfe, 0x63, 0x53, 0x36, 0x93,
-	0xe6, 0xa2, 0xfc, 0xbc, 0x7c, 0xe1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x59, 0x32, 0x1e, 0xb6,
-	0x0e, 0x0f, 0x00, 0x00,
+	// 1113 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4d, 0x6f, 0x1b, 0xc5,
+	0x1f, 0xce, 0xda, 0x6d, 0xd2, 0xff, 0xc4, 0xf9, 0xb7, 0x5d, 0xd2, 0x64, 0xeb, 0xa6, 0xb6, 0x3b,
+	0x2d, 0x22, 0x28, 0xea, 0xba, 0x09, 0x08, 0x50, 0x11, 0x48, 0x5e, 0x97, 0x37, 0x09, 0x68, 0xd8,
+	0x48, 0x05, 0x21, 0x24, 0x6b, 0xbc, 0x3b, 0xb6, 0x47, 0xd8, 0x3b, 0xd6, 0xce, 0xac, 0x43, 0xe0,
+	0xc8, 0xb5, 0x48, 0x48, 0x88, 0x13, 0x42, 0x82, 0x03, 0x12, 0x42, 0x7c, 0x00, 0x6e, 0x48, 0x9c,
+	0x7a, 0xec, 0x91, 0x93, 0x41, 0xc9, 0x37, 0xc8, 0x91, 0x13, 0xda, 0x99, 0xd9, 0x37, 0xbf, 0xc5,
+	0x0d, 0x12, 0xe4, 0x94, 0xd8, 0xfe, 0xcd, 0xf3, 0x3c, 0xf3, 0xcc, 0xef, 0x65, 0x06, 0x3c, 0xeb,
+	0x50, 0xd6, 0xa3, 0xac, 0xea, 0x12, 0xc6, 0x7d, 0xd2, 0x0c, 0x38, 0xa1, 0x5e, 0x75, 0xb0, 0xdd,
+	0xc4, 0x1c, 0x6d, 0x57, 0xdb, 0xd8, 0xc3, 0x8c, 0x30, 0xb3, 0xef, 0x53, 0x4e, 0xf5, 0x6b, 0x32,
+	0xd4, 0x4c, 0x87, 0x9a, 0x2a, 0xb4, 0xb8, 0xda, 0xa6, 0x6d, 0x2a, 0xe2, 0xaa, 0xe1, 0x7f, 0x72,
+	0x49, 0xb1, 0xa4, 0xd0, 0x9b, 0x88, 0xe1, 0x18, 0xd5, 0xa1, 0xc4, 0x53, 0xbf, 0x9b, 0xb3, 0xd8,
+	0x33, 0x3c, 0x22, 0x1e, 0x3e, 0xcc, 0x81, 0x2b, 0xf7, 0x70, 0x17, 0xb7, 0x11, 0xa7, 0xfe, 0xfb,
+	0x84, 0x77, 0x5c, 0x1f, 0xed, 0xbf, 0xe5, 0xb5, 0xa8, 0xfe, 0x29, 0xb8, 0xec, 0x46, 0x3f, 0x34,
+	0x90, 0xeb, 0xfa, 0x98, 0x31, 0x43, 0xab, 0x68, 0x9b, 0x05, 0xeb, 0x9d, 0xe3, 0x61, 0xd9, 0x38,
+	0x40, 0xbd, 0xee, 0x5d, 0x38, 0x16, 0x02, 0xff, 0x1a, 0x96, 0x6f, 0xb7, 0x09, 0xef, 0x04, 0x4d,
+	0xd3, 0xa1, 0xbd, 0xaa, 0xd2, 0x23, 0xff, 0xdc, 0x66, 0xee, 0xc7, 0x55, 0x7e, 0xd0, 0xc7, 0xcc,
+	0xac, 0x39, 0x4e, 0x4d, 0xae, 0xb0, 0x2f, 0xc5, 0x20, 0xea, 0x1b, 0x7d, 0x1f, 0x5c, 0xda, 0x57,
+	0x5a, 0x62, 0xea, 0x9c, 0xa0, 0x7e, 0xfb, 0x78, 0x58, 0x5e, 0x97, 0xd4, 0xa3, 0x11, 0xa7, 0x60,
+	0xbe, 0x18, 0x61, 0xa8, 0x2f, 0xe0, 0x6f, 0x39, 0x70, 0xe3, 0x01, 0xea, 0x12, 0x37, 0x54, 0x73,
+	0x3f, 0xe0, 0x8c, 0x23, 0xcf, 0x25, 0x5e, 0xdb, 0xc6, 0xfb, 0xc8, 0x77, 0x99, 0x8d, 0x1d, 0xea,
+	0xbb, 0xa1, 0x35, 0x83, 0x28, 0x68, 0xba, 0x35, 0x63, 0x21, 0xf3, 0x0a, 0x7c, 0x80, 0xba, 0xb1,
+	0x35, 0x31, 0x48, 0x64, 0xcd, 0x77, 0x1a, 0x78, 0x8a, 0x26, 0xc2, 0x1a, 0xbe, 0x54, 0x66, 0xe4,
+	0x2a, 0xf9, 0xcd, 0xe5, 0x9d, 0x0d, 0x75, 0xfe, 0x66, 0x98, 0x1f, 0x51, 0x2a, 0x99, 0xf7, 0xb0,
+	0x53, 0xa7, 0xc4, 0xb3, 0xde, 0x7b, 0x34, 0x2c, 0x2f, 0x1c, 0x0f, 0xcb, 0x45, 0x29, 0x70, 0x02,
+	0x0c, 0xfc, 0xe9, 0x8f, 0xf2, 0xd6, 0x1c, 0x12, 0x15, 0x22, 0xb3, 0x75, 0x3a, 0x66, 0x12, 0xfc,
+	0x36, 0x07, 0x6e, 0xc5, 0x26, 0xd6, 0x1c, 0x27, 0xe8, 0x05, 0x5d, 0xc4, 0xb1, 0x5b, 0xa7, 0xbd,
+	0x1e, 0x61, 0x8c, 0x50, 0xef, 0x0c, 0xf8, 0x78, 0x00, 0x96, 0x51, 0x22, 0x4d, 0x64, 0xd7, 0xf2,
+	0xce, 0xcb, 0xe6, 0x8c, 0x8a, 0x34, 0x67, 0xef, 0xc9, 0x2a, 0x2a, 0x77, 0x75, 0x29, 0x3b, 0x85,
+	0x0e, 0xed, 0x34, 0x17, 0xfc, 0x3e, 0x07, 0x2a, 0x31, 0xd6, 0x9b, 0x84, 0x71, 0xea, 0x13, 0x07,
+	0x75, 0xcf, 0x4e, 0x8e, 0xad, 0x81, 0xc5, 0x3e, 0xf6, 0x09, 0x95, 0xb6, 0x9c, 0xb3, 0xd5, 0x27,
+	0x9d, 0x80, 0xa5, 0x28, 0xdd, 0xf2, 0xc2, 0xaf, 0x17, 0xe7, 0xf3, 0x6b, 0x6c, 0x8f, 0xd6, 0x9a,
+	0xf2, 0xea, 0xff, 0x72, 0x1b, 0x51, 0xf6, 0xd9, 0x11, 0x3e, 0xfc, 0x3c, 0x07, 0xae, 0xc7, 0xeb,
+	0xeb, 0x81, 0xef, 0x63, 0x8f, 0x9f, 0x1d, 0x83, 0x5a, 0x89, 0x11, 0x32, 0x71, 0x9e, 0x9f, 0xcf,
+	0x88, 0xec, 0x46, 0x4e, 0x76, 0xe1, 0x61, 0x1e, 0x5c, 0x8b, 0xbb, 0xf3, 0x1e, 0x47, 0x3e, 0x27,
+	0x5e, 0x3b, 0xec, 0xce, 0x89, 0x07, 0xff, 0x59, 0x8f, 0x9e, 0xe8, 0x7f, 0xee, 0xdf, 0xf1, 0x3f,
+	0x00, 0x2b, 0x4c, 0xb9, 0xd1, 0x20, 0x5e, 0x8b, 0xaa, 0x74, 0xdc, 0x99, 0x79, 0x0a, 0x13, 0x8d,
+	0xb4, 0x36, 0xd4, 0x19, 0xac, 0x4a, 0xbd, 0x19, 0x58, 0x68, 0x17, 0x58, 0x2a, 0x16, 0xfe, 0x90,
+	0x03, 0x57, 0xe3, 0xb3, 0xdc, 0xeb, 0x22, 0xd6, 0x79, 0x6d, 0x20, 0x8e, 0xf3, 0x2c, 0x54, 0x6c,
+	0x07, 0x93, 0x76, 0x87, 0x47, 0x15, 0x2b, 0x3f, 0xa5, 0x2a, 0x39, 0x9f, 0xa9, 0xe4, 0x8f, 0xc0,
+	0x79, 0x1c, 0x4a, 0x37, 0xce, 0x09, 0xe3, 0xee, 0xcc, 0x97, 0xbe, 0xc9, 0x96, 0xad, 0x55, 0x65,
+	0x5b, 0x41, 0xee, 0x4a, 0x80, 0x41, 0x5b, 0x82, 0xc2, 0x5f, 0x56, 0x40, 0xe1, 0x0d, 0x79, 0xd3,
+	0xd9, 0xe3, 0x88, 0x63, 0xdd, 0x06, 0x8b, 0x7d, 0xe4, 0xa3, 0x9e, 0xf4, 0x63, 0x79, 0xe7, 0xe6,
+	0x4c, 0xbe, 0x5d, 0x11, 0x6a, 0x5d, 0x51, 0x14, 0x2b, 0x92, 0x42, 0x02, 0x40, 0x5b, 0x21, 0xe9,
+	0x1f, 0x80, 0x0b, 0x2d, 0x8c, 0x1b, 0x7d, 0x4a, 0xbb, 0xaa, 0x08, 0x6f, 0xcd, 0x44, 0x7d, 0x1d,
+	0xe3, 0x5d, 0x4a, 0xbb, 0xd6, 0xba, 0x82, 0xbd, 0x28, 0x61, 0x23, 0x0c, 0x68, 0x2f, 0xb5, 0x64,
+	0x84, 0xfe, 0xb5, 0x06, 0x8c, 0xa4, 0x66, 0xe2, 0x6b, 0x46, 0x98, 0x11, 0x61, 0xe3, 0xcb, 0xcf,
+	0x9f, 0x69, 0xe9, 0x0b, 0x95, 0xf5, 0x8c, 0x22, 0x2e, 0x8f, 0x56, 0x65, 0x96, 0x01, 0xda, 0x6b,
+	0xee, 0xa4, 0xf5, 0x4c, 0xff, 0x0c, 0x5c, 0xee, 0xfb, 0x78, 0x40, 0x68, 0xc0, 0x1a, 0x7d, 0x9f,
+	0xf6, 0x29, 0xc3, 0xbe, 0x38, 0xc0, 0x82, 0xf5, 0x6e, 0x92, 0x60, 0x63, 0x21, 0x61, 0x82, 0x99,
+	0x73, 0x24, 0x58, 0x9d, 0x7a, 0x2c, 0xce, 0xb0, 0x08, 0x65, 0x57, 0x81, 0xe8, 0x5f, 0x4d, 0xb9,
+	0x77, 0x9c, 0x17, 0x7e, 0xbc, 0x3a, 0x5f, 0x02, 0x4d, 0xbb, 0x51, 0x59, 0xf0, 0xe4, 0x9b, 0xc9,
+	0xa4, 0xab, 0x86, 0xfe, 0xab, 0x06, 0x6e, 0xa4, 0x2a, 0x2a, 0x19, 0xb2, 0x0d, 0x27, 0x1e, 0xcc,
+	0xcc, 0x58, 0x14, 0x1a, 0x6b, 0xff, 0x60, 0xb8, 0x2b, 0x99, 0x77, 0x94, 0xcc, 0xcd, 0xb1, 0x5a,
+	0x9e, 0xcc, 0x0c, 0xed, 0xf2, 0x60, 0x26, 0x2e, 0xd3, 0x7f, 0xd6, 0xc0, 0x46, 0x82, 0xd3, 0x89,
+	0x27, 0x65, 0x6c, 0xf0, 0x92, 0x10, 0xff, 0xca, 0x29, 0x27, 0xad, 0x12, 0xbe, 0xa5, 0x84, 0xdf,
+	0x1c, 0x15, 0x3e, 0x4e, 0x08, 0xed, 0xe2, 0x60, 0x2a, 0x5c, 0x78, 0xfd, 0xbc, 0x9a, 0xac, 0x76,
+	0xe4, 0x3c, 0x8b, 0xb5, 0x5e, 0x10, 0x5a, 0xef, 0x9e, 0x66, 0x18, 0x2a, 0xa1, 0x9b, 0x4a, 0x68,
+	0x65, 0x54, 0xe8, 0x08, 0x15, 0xb4, 0xd7, 0x07, 0x93, 0x81, 0xf4, 0x6f, 0x32, 0xe5, 0x9b, 0x69,
+	0xe8, 0xcc, 0xf8, 0x9f, 0x50, 0xf8, 0xd2, 0x93, 0x0f, 0x0a, 0xa5, 0x6f, 0x6a, 0x11, 0x67, 0x79,
+	0xd2, 0x45, 0x9c, 0x46, 0x61, 0x61, 0x1d, 0xad, 0x25, 0xbb, 0x62, 0x61, 0x47, 0x6d, 0x88, 0xae,
+	0xc9, 0x0c, 0x20, 0xb4, 0xbd, 0xf0, 0xa4, 0xbd, 0x58, 0x29, 0x7b, 0x5a, 0x29, 0xbb, 0x3e, 0xea,
+	0x5c, 0x9a, 0x03, 0xda, 0xab, 0x83, 0x71, 0x84, 0xec, 0x83, 0xcb, 0x17, 0x78, 0xcc, 0x58, 0x16,
+	0x72, 0xb6, 0x66, 0xca, 0x89, 0x1a, 0x94, 0xd2, 0x50, 0x56, 0x1a, 0x46, 0x5f, 0x68, 0x0a, 0x12,
+	0x26, 0x0f, 0x2e, 0xb9, 0x80, 0xe9, 0x5f, 0x68, 0x60, 0x0d, 0x05, 0x9c, 0x86, 0x55, 0xd3, 0xa7,
+	0x81, 0xe7, 0x36, 0x18, 0xe6, 0xa1, 0x5b, 0xcc, 0x28, 0x54, 0xf2, 0x27, 0x8e, 0xa6, 0x5a, 0xc0,
+	0x69, 0x5d, 0xad, 0xdc, 0x93, 0x0b, 0x47, 0x8d, 0x98, 0x8c, 0x0e, 0xed, 0x55, 0x34, 0xbe, 0x96,
+	0x59, 0xf7, 0x7f, 0x3c, 0x2c, 0x69, 0x8f, 0x0e, 0x4b, 0xda, 0xe3, 0xc3, 0x92, 0xf6, 0xe7, 0x61,
+	0x49, 0xfb, 0xf2, 0xa8, 0xb4, 0xf0, 0xf8, 0xa8, 0xb4, 0xf0, 0xfb, 0x51, 0x69, 0xe1, 0xc3, 0xed,
+	0x99, 0x6d, 0xf4, 0x93, 0xec, 0xab, 0x5b, 0x74, 0xd5, 0xe6, 0xa2, 0x78, 0x67, 0x3f, 0xf7, 0xf7,
+	0x00, 0x06, 0x72, 0x02, 0x89, 0x17, 0x10, 0x00, 0x00,
 }
 
 func (this *DelegatorWithdrawInfo) Equal(that interface{}) bool {
@@ -950,6 +974,22 @@ func (this *GenesisState) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.WithdrawRecords) != len(that1.WithdrawRecords) {
+		return false
+	}
+	for i := range this.WithdrawRecords {
+		if !this.WithdrawRecords[i].Equal(&that1.WithdrawRecords[i]) {
+			return false
+		}
+	}
+	if len(this.AutoCompoundSettings) != len(that1.AutoCompoundSettings) {
+		return false
+	}
+	for i := range this.AutoCompoundSettings {
+		if !this.AutoCompoundSettings[i].Equal(&that1.AutoCompoundSettings[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -1275,6 +1315,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoCompoundSettings) > 0 {
+		for iNdEx := len(m.AutoCompoundSettings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundSettings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.WithdrawRecords) > 0 {
+		for iNdEx := len(m.WithdrawRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1594,6 +1662,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.WithdrawRecords) > 0 {
+		for _, e := range m.WithdrawRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoCompoundSettings) > 0 {
+		for _, e := range m.AutoCompoundSettings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2903,6 +2983,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawRecords = append(m.WithdrawRecords, WithdrawRecord{})
+			if err := m.WithdrawRecords[len(m.WithdrawRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundSettings = append(m.AutoCompoundSettings, AutoCompoundSetting{})
+			if err := m.AutoCompoundSettings[len(m.AutoCompoundSettings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
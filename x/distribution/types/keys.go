@@ -40,6 +40,14 @@ const (
 // - 0x07<valAddr_Bytes>: ValidatorCurrentRewards
 //
 // - 0x08<valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<accAddr_Bytes><height><valAddr_Bytes><commission>: WithdrawRecord
+//
+// - 0x0A<height><accAddr_Bytes><valAddr_Bytes><commission>: []byte{}
+//
+// - 0x0B<accAddr_Bytes><valAddr_Bytes>: []byte{}
+//
+// - 0x0C: []byte (next auto-compound setting key)
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -51,6 +59,11 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+	DelegatorWithdrawRecordPrefix        = []byte{0x09} // key for delegator withdraw records
+	WithdrawRecordByHeightPrefix         = []byte{0x0A} // key for withdraw records indexed by height
+	AutoCompoundSettingPrefix            = []byte{0x0B} // key for delegator auto-compound settings
+	AutoCompoundCursorKey                = []byte{0x0C} // key for the next auto-compound setting to process
+	ParamsKey                            = []byte{0x0D} // key for the distribution parameters
 )
 
 // gets an address from a validator's outstanding rewards key
@@ -191,3 +204,75 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 	prefix := GetValidatorSlashEventKeyPrefix(v, height)
 	return append(prefix, periodBz...)
 }
+
+// gets the prefix key for a delegator's withdraw records
+func GetDelegatorWithdrawRecordsPrefix(d sdk.AccAddress) []byte {
+	return append(DelegatorWithdrawRecordPrefix, d.Bytes()...)
+}
+
+// gets the key for a delegator's withdraw record
+func GetDelegatorWithdrawRecordKey(d sdk.AccAddress, height int64, v sdk.ValAddress, commission bool) []byte {
+	return append(GetDelegatorWithdrawRecordsPrefix(d), withdrawRecordKeySuffix(height, v, commission)...)
+}
+
+// gets the prefix key for the withdraw records of a height
+func GetWithdrawRecordByHeightPrefix(height int64) []byte {
+	heightBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBz, uint64(height))
+	return append(WithdrawRecordByHeightPrefix, heightBz...)
+}
+
+// gets the height index key for a delegator's withdraw record
+func GetWithdrawRecordByHeightKey(d sdk.AccAddress, height int64, v sdk.ValAddress, commission bool) []byte {
+	key := append(append(GetWithdrawRecordByHeightPrefix(height), d.Bytes()...), v.Bytes()...)
+	return append(key, commissionByte(commission))
+}
+
+// gets the delegator withdraw record key from a height index key
+func GetDelegatorWithdrawRecordKeyFromByHeightKey(key []byte) []byte {
+	if len(key) != 1+8+2*sdk.AddrLen+1 {
+		panic("unexpected key length")
+	}
+
+	heightBz := key[1:9]
+	delAddr := key[9 : 9+sdk.AddrLen]
+	rest := key[9+sdk.AddrLen:]
+
+	return append(append(append(DelegatorWithdrawRecordPrefix, delAddr...), heightBz...), rest...)
+}
+
+func withdrawRecordKeySuffix(height int64, v sdk.ValAddress, commission bool) []byte {
+	heightBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBz, uint64(height))
+
+	return append(append(heightBz, v.Bytes()...), commissionByte(commission))
+}
+
+func commissionByte(commission bool) byte {
+	if commission {
+		return 0x01
+	}
+
+	return 0x00
+}
+
+// gets the addresses from an auto-compound setting key
+func GetAutoCompoundSettingAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	addr := key[1:]
+	if len(addr) != 2*sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	delAddr = sdk.AccAddress(addr[:sdk.AddrLen])
+	valAddr = sdk.ValAddress(addr[sdk.AddrLen:])
+	return
+}
+
+// gets the prefix key for a delegator's auto-compound settings
+func GetAutoCompoundSettingsPrefix(d sdk.AccAddress) []byte {
+	return append(AutoCompoundSettingPrefix, d.Bytes()...)
+}
+
+// gets the key for a delegator's auto-compound setting of a validator
+func GetAutoCompoundSettingKey(d sdk.AccAddress, v sdk.ValAddress) []byte {
+	return append(GetAutoCompoundSettingsPrefix(d), v.Bytes()...)
+}
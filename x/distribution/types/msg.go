@@ -1,4 +1,4 @@
-//nolint
+// nolint
 package types
 
 import (
@@ -12,6 +12,10 @@ const (
 	TypeMsgWithdrawDelegatorReward     = "withdraw_delegator_reward"
 	TypeMsgWithdrawValidatorCommission = "withdraw_validator_commission"
 	TypeMsgFundCommunityPool           = "fund_community_pool"
+
+	TypeMsgWithdrawTokenizeShareRecordReward = "withdraw_tokenize_share_record_reward"
+	TypeMsgSetAutoCompound                   = "set_auto_compound"
+	TypeMsgUpdateParams                      = "update_params"
 )
 
 // Verify interface at compile time
@@ -149,3 +153,119 @@ func (msg MsgFundCommunityPool) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgWithdrawTokenizeShareRecordReward returns a new
+// MsgWithdrawTokenizeShareRecordReward.
+func NewMsgWithdrawTokenizeShareRecordReward(ownerAddr sdk.AccAddress) *MsgWithdrawTokenizeShareRecordReward {
+	return &MsgWithdrawTokenizeShareRecordReward{
+		OwnerAddress: ownerAddr,
+	}
+}
+
+// Route returns the MsgWithdrawTokenizeShareRecordReward message route.
+func (msg MsgWithdrawTokenizeShareRecordReward) Route() string { return ModuleName }
+
+// Type returns the MsgWithdrawTokenizeShareRecordReward message type.
+func (msg MsgWithdrawTokenizeShareRecordReward) Type() string {
+	return TypeMsgWithdrawTokenizeShareRecordReward
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgWithdrawTokenizeShareRecordReward) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
+
+// GetSignBytes returns the raw bytes for a MsgWithdrawTokenizeShareRecordReward
+// message that the expected signer needs to sign.
+func (msg MsgWithdrawTokenizeShareRecordReward) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgWithdrawTokenizeShareRecordReward message validation.
+func (msg MsgWithdrawTokenizeShareRecordReward) ValidateBasic() error {
+	if msg.OwnerAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty owner address")
+	}
+
+	return nil
+}
+
+// NewMsgSetAutoCompound returns a new MsgSetAutoCompound.
+func NewMsgSetAutoCompound(delAddr sdk.AccAddress, valAddr sdk.ValAddress, enable bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Enable:           enable,
+	}
+}
+
+// Route returns the MsgSetAutoCompound message route.
+func (msg MsgSetAutoCompound) Route() string { return ModuleName }
+
+// Type returns the MsgSetAutoCompound message type.
+func (msg MsgSetAutoCompound) Type() string { return TypeMsgSetAutoCompound }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetAutoCompound message that the
+// expected signer needs to sign.
+func (msg MsgSetAutoCompound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSetAutoCompound message validation.
+func (msg MsgSetAutoCompound) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrEmptyDelegatorAddr
+	}
+	if msg.ValidatorAddress.Empty() {
+		return ErrEmptyValidatorAddr
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgUpdateParams{}
+
+// NewMsgUpdateParams returns a new MsgUpdateParams.
+func NewMsgUpdateParams(authority sdk.AccAddress, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// Route returns the MsgUpdateParams message route.
+func (msg MsgUpdateParams) Route() string { return ModuleName }
+
+// Type returns the MsgUpdateParams message type.
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
+
+// GetSignBytes returns the raw bytes for a MsgUpdateParams message that the
+// expected signer needs to sign.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgUpdateParams message validation.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if msg.Authority.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty authority address")
+	}
+
+	return msg.Params.ValidateBasic()
+}
@@ -93,3 +93,52 @@ func TestMsgDepositIntoCommunityPool(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgSetAutoCompound
+func TestMsgSetAutoCompound(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		enable        bool
+		expectPass    bool
+	}{
+		{delAddr1, valAddr1, true, true},
+		{delAddr1, valAddr1, false, true},
+		{emptyDelAddr, valAddr1, true, false},
+		{delAddr1, emptyValAddr, true, false},
+		{emptyDelAddr, emptyValAddr, false, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetAutoCompound(tc.delegatorAddr, tc.validatorAddr, tc.enable)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgUpdateParams
+func TestMsgUpdateParams(t *testing.T) {
+	invalidParams := DefaultParams()
+	invalidParams.CommunityTax = sdk.NewDec(2)
+
+	tests := []struct {
+		authority  sdk.AccAddress
+		params     Params
+		expectPass bool
+	}{
+		{delAddr1, DefaultParams(), true},
+		{emptyDelAddr, DefaultParams(), false},
+		{delAddr1, invalidParams, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgUpdateParams(tc.authority, tc.params)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
@@ -15,6 +15,12 @@ var (
 	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
 	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+
+	ParamStoreKeyRecordWithdrawals       = []byte("recordwithdrawals")
+	ParamStoreKeyWithdrawRecordRetention = []byte("withdrawrecordretention")
+
+	ParamStoreKeyAutoCompoundEpoch        = []byte("autocompoundepoch")
+	ParamStoreKeyMaxAutoCompoundsPerBlock = []byte("maxautocompoundsperblock")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -25,10 +31,14 @@ func ParamKeyTable() paramtypes.KeyTable {
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        sdk.NewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
-		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
-		WithdrawAddrEnabled: true,
+		CommunityTax:             sdk.NewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:       sdk.NewDecWithPrec(1, 2), // 1%
+		BonusProposerReward:      sdk.NewDecWithPrec(4, 2), // 4%
+		WithdrawAddrEnabled:      true,
+		RecordWithdrawals:        false,
+		WithdrawRecordRetention:  0,
+		AutoCompoundEpoch:        0,
+		MaxAutoCompoundsPerBlock: 100,
 	}
 }
 
@@ -44,6 +54,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		paramtypes.NewParamSetPair(ParamStoreKeyRecordWithdrawals, &p.RecordWithdrawals, validateRecordWithdrawals),
+		paramtypes.NewParamSetPair(ParamStoreKeyWithdrawRecordRetention, &p.WithdrawRecordRetention, validateWithdrawRecordRetention),
+		paramtypes.NewParamSetPair(ParamStoreKeyAutoCompoundEpoch, &p.AutoCompoundEpoch, validateAutoCompoundEpoch),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxAutoCompoundsPerBlock, &p.MaxAutoCompoundsPerBlock, validateMaxAutoCompoundsPerBlock),
 	}
 }
 
@@ -69,6 +83,11 @@ func (p Params) ValidateBasic() error {
 			"sum of base and bonus proposer reward cannot greater than one: %s", v,
 		)
 	}
+	if p.MaxAutoCompoundsPerBlock == 0 {
+		return fmt.Errorf(
+			"max auto-compounds per block must be positive",
+		)
+	}
 
 	return nil
 }
@@ -138,3 +157,45 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateRecordWithdrawals(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateWithdrawRecordRetention(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateAutoCompoundEpoch(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxAutoCompoundsPerBlock(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an auto-compound run in progress never completes without processing at
+	// least one setting per block
+	if v == 0 {
+		return fmt.Errorf("max auto-compounds per block must be positive: %d", v)
+	}
+
+	return nil
+}
@@ -32,3 +32,16 @@ func Test_validateAuxFuncs(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateMaxAutoCompoundsPerBlock(t *testing.T) {
+	require.Error(t, validateMaxAutoCompoundsPerBlock(10))
+	require.Error(t, validateMaxAutoCompoundsPerBlock(uint32(0)))
+	require.NoError(t, validateMaxAutoCompoundsPerBlock(uint32(1)))
+
+	// zero is rejected even when auto-compounding is disabled, as a run in
+	// progress would never complete
+	params := DefaultParams()
+	params.AutoCompoundEpoch = 0
+	params.MaxAutoCompoundsPerBlock = 0
+	require.Error(t, params.ValidateBasic())
+}
@@ -816,6 +816,240 @@ func (m *QueryDelegatorWithdrawAddressResponse) GetWithdrawAddress() github_com_
 	return nil
 }
 
+// QueryDelegatorWithdrawRecordsRequest is the request type for the
+// Query/DelegatorWithdrawRecords RPC method.
+type QueryDelegatorWithdrawRecordsRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty"`
+	// starting_height defines the optional starting height to query the records.
+	StartingHeight int64 `protobuf:"varint,2,opt,name=starting_height,json=startingHeight,proto3" json:"starting_height,omitempty"`
+	// ending_height defines the optional ending height to query the records.
+	EndingHeight int64 `protobuf:"varint,3,opt,name=ending_height,json=endingHeight,proto3" json:"ending_height,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegatorWithdrawRecordsRequest) Reset()         { *m = QueryDelegatorWithdrawRecordsRequest{} }
+func (m *QueryDelegatorWithdrawRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorWithdrawRecordsRequest) ProtoMessage()    {}
+func (*QueryDelegatorWithdrawRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{16}
+}
+func (m *QueryDelegatorWithdrawRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorWithdrawRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorWithdrawRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorWithdrawRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorWithdrawRecordsRequest.Merge(m, src)
+}
+func (m *QueryDelegatorWithdrawRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorWithdrawRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorWithdrawRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorWithdrawRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorWithdrawRecordsRequest) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return nil
+}
+
+func (m *QueryDelegatorWithdrawRecordsRequest) GetStartingHeight() int64 {
+	if m != nil {
+		return m.StartingHeight
+	}
+	return 0
+}
+
+func (m *QueryDelegatorWithdrawRecordsRequest) GetEndingHeight() int64 {
+	if m != nil {
+		return m.EndingHeight
+	}
+	return 0
+}
+
+func (m *QueryDelegatorWithdrawRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDelegatorWithdrawRecordsResponse is the response type for the
+// Query/DelegatorWithdrawRecords RPC method.
+type QueryDelegatorWithdrawRecordsResponse struct {
+	// records defines the withdrawals recorded for the delegator, ordered by
+	// height.
+	Records []WithdrawRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegatorWithdrawRecordsResponse) Reset()         { *m = QueryDelegatorWithdrawRecordsResponse{} }
+func (m *QueryDelegatorWithdrawRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorWithdrawRecordsResponse) ProtoMessage()    {}
+func (*QueryDelegatorWithdrawRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{17}
+}
+func (m *QueryDelegatorWithdrawRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorWithdrawRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorWithdrawRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorWithdrawRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorWithdrawRecordsResponse.Merge(m, src)
+}
+func (m *QueryDelegatorWithdrawRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorWithdrawRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorWithdrawRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorWithdrawRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorWithdrawRecordsResponse) GetRecords() []WithdrawRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryDelegatorWithdrawRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDelegatorAutoCompoundValidatorsRequest is the request type for the
+// Query/DelegatorAutoCompoundValidators RPC method.
+type QueryDelegatorAutoCompoundValidatorsRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) Reset() {
+	*m = QueryDelegatorAutoCompoundValidatorsRequest{}
+}
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegatorAutoCompoundValidatorsRequest) ProtoMessage() {}
+func (*QueryDelegatorAutoCompoundValidatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+}
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundValidatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundValidatorsRequest.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundValidatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundValidatorsRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return nil
+}
+
+// QueryDelegatorAutoCompoundValidatorsResponse is the response type for the
+// Query/DelegatorAutoCompoundValidators RPC method.
+type QueryDelegatorAutoCompoundValidatorsResponse struct {
+	// validators defines the validators the delegator auto-compounds the
+	// rewards of.
+	Validators []github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,rep,name=validators,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validators,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) Reset() {
+	*m = QueryDelegatorAutoCompoundValidatorsResponse{}
+}
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegatorAutoCompoundValidatorsResponse) ProtoMessage() {}
+func (*QueryDelegatorAutoCompoundValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+}
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundValidatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundValidatorsResponse.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundValidatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundValidatorsResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) GetValidators() []github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
 // QueryCommunityPoolRequest is the request type for the Query/CommunityPool RPC method.
 type QueryCommunityPoolRequest struct {
 }
@@ -824,7 +1058,7 @@ func (m *QueryCommunityPoolRequest) Reset()         { *m = QueryCommunityPoolReq
 func (m *QueryCommunityPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolRequest) ProtoMessage()    {}
 func (*QueryCommunityPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{16}
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
 }
 func (m *QueryCommunityPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -863,7 +1097,7 @@ func (m *QueryCommunityPoolResponse) Reset()         { *m = QueryCommunityPoolRe
 func (m *QueryCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolResponse) ProtoMessage()    {}
 func (*QueryCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{17}
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
 }
 func (m *QueryCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -916,6 +1150,10 @@ func init() {
 	proto.RegisterType((*QueryDelegatorValidatorsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorValidatorsResponse")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressRequest")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
+	proto.RegisterType((*QueryDelegatorWithdrawRecordsRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawRecordsRequest")
+	proto.RegisterType((*QueryDelegatorWithdrawRecordsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawRecordsResponse")
+	proto.RegisterType((*QueryDelegatorAutoCompoundValidatorsRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundValidatorsRequest")
+	proto.RegisterType((*QueryDelegatorAutoCompoundValidatorsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundValidatorsResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
 }
@@ -925,77 +1163,85 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1111 bytes of a gzipped FileDescriptorProto
+	// 1244 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xb8, 0x69, 0x2a, 0xbd, 0xb6, 0x24, 0x9d, 0x56, 0xc8, 0x6c, 0x82, 0x13, 0x6d, 0x28,
-	0x89, 0x88, 0xe2, 0x6d, 0x12, 0xa9, 0xfc, 0x28, 0x08, 0xe2, 0x24, 0xa5, 0x52, 0x51, 0x9b, 0x98,
-	0x2a, 0x29, 0x08, 0x61, 0x8d, 0x77, 0x47, 0xeb, 0x55, 0xed, 0x1d, 0x77, 0x67, 0x9c, 0x10, 0x55,
-	0x3d, 0x40, 0x5b, 0x24, 0x0e, 0x48, 0x48, 0x70, 0xe0, 0x6f, 0xe0, 0xce, 0x85, 0xbf, 0xa0, 0xc7,
-	0x4a, 0x48, 0xa8, 0xa7, 0x16, 0x25, 0x08, 0x71, 0xe1, 0x8c, 0xc4, 0x09, 0x79, 0x76, 0xd6, 0xeb,
-	0xb5, 0xd7, 0xeb, 0x5f, 0xd4, 0xa7, 0x58, 0x6f, 0xe7, 0x7d, 0xf3, 0x7d, 0x6f, 0xe6, 0xbd, 0xf9,
-	0x14, 0x58, 0x30, 0x19, 0xaf, 0x30, 0x6e, 0x58, 0x0e, 0x17, 0x9e, 0x53, 0xac, 0x09, 0x87, 0xb9,
-	0xc6, 0xfe, 0x4a, 0x91, 0x0a, 0xb2, 0x62, 0xdc, 0xad, 0x51, 0xef, 0x30, 0x5b, 0xf5, 0x98, 0x60,
-	0x78, 0xda, 0x5f, 0x98, 0x6d, 0x5e, 0x98, 0x55, 0x0b, 0xb5, 0x37, 0x14, 0x4a, 0x91, 0x70, 0xea,
-	0x67, 0x35, 0x30, 0xaa, 0xc4, 0x76, 0x5c, 0x22, 0x57, 0x4b, 0x20, 0xed, 0x82, 0xcd, 0x6c, 0x26,
-	0x7f, 0x1a, 0xf5, 0x5f, 0x2a, 0x3a, 0x63, 0x33, 0x66, 0x97, 0xa9, 0x41, 0xaa, 0x8e, 0x41, 0x5c,
-	0x97, 0x09, 0x99, 0xc2, 0xd5, 0xd7, 0x4c, 0x33, 0x7e, 0x80, 0x6c, 0x32, 0x27, 0xc0, 0xcc, 0x26,
-	0xa9, 0x88, 0x30, 0x96, 0xeb, 0xf5, 0x0b, 0x80, 0x77, 0xea, 0x2c, 0xb7, 0x89, 0x47, 0x2a, 0x3c,
-	0x4f, 0xef, 0xd6, 0x28, 0x17, 0xfa, 0x6d, 0x38, 0x1f, 0x89, 0xf2, 0x2a, 0x73, 0x39, 0xc5, 0xeb,
-	0x30, 0x51, 0x95, 0x91, 0x34, 0x9a, 0x43, 0x8b, 0xa7, 0x57, 0xe7, 0xb3, 0x09, 0xa5, 0xc8, 0xfa,
-	0xc9, 0xb9, 0xf1, 0xc7, 0xcf, 0x66, 0xc7, 0xf2, 0x2a, 0x51, 0xff, 0x06, 0xc1, 0x82, 0x84, 0xde,
-	0x25, 0x65, 0xc7, 0x22, 0x82, 0x79, 0x37, 0x6b, 0x82, 0x0b, 0xe2, 0x5a, 0x8e, 0x6b, 0xe7, 0xe9,
-	0x01, 0xf1, 0xac, 0x80, 0x05, 0xfe, 0x1c, 0xce, 0xed, 0x07, 0xab, 0x0a, 0xc4, 0xb2, 0x3c, 0xca,
-	0xfd, 0x9d, 0xcf, 0xe4, 0x56, 0xfe, 0x7d, 0x36, 0xbb, 0x6c, 0x3b, 0xa2, 0x54, 0x2b, 0x66, 0x4d,
-	0x56, 0x31, 0x94, 0x6a, 0xff, 0xcf, 0x32, 0xb7, 0xee, 0x18, 0xe2, 0xb0, 0x4a, 0x79, 0x76, 0x97,
-	0x94, 0xd7, 0xfd, 0xc4, 0xfc, 0x54, 0x03, 0x4b, 0x45, 0xf4, 0x87, 0x08, 0x16, 0xbb, 0x73, 0x51,
-	0xda, 0x6f, 0xc3, 0x29, 0xcf, 0x0f, 0x29, 0xf1, 0x6f, 0x25, 0x8a, 0x4f, 0x80, 0x54, 0x15, 0x09,
-	0xe0, 0xf4, 0x2f, 0x11, 0xcc, 0x46, 0x69, 0x6c, 0xb0, 0x4a, 0xc5, 0xe1, 0xdc, 0x61, 0xee, 0xa8,
-	0x4a, 0xf1, 0x08, 0xc1, 0x5c, 0x67, 0x0e, 0xaa, 0x04, 0x04, 0xc0, 0x6c, 0x44, 0x55, 0x15, 0xae,
-	0xf4, 0x56, 0x85, 0x75, 0xd3, 0xac, 0x55, 0x6a, 0x65, 0x22, 0xa8, 0x15, 0x02, 0xab, 0x42, 0x34,
-	0x81, 0xea, 0x3f, 0xa4, 0x60, 0x26, 0xca, 0xe3, 0xe3, 0x32, 0xe1, 0x25, 0x3a, 0xaa, 0x3b, 0x81,
-	0x17, 0x60, 0x92, 0x0b, 0xe2, 0x09, 0xc7, 0xb5, 0x0b, 0x25, 0xea, 0xd8, 0x25, 0x91, 0x4e, 0xcd,
-	0xa1, 0xc5, 0xf1, 0xfc, 0x4b, 0x41, 0xf8, 0x9a, 0x8c, 0xe2, 0x79, 0x38, 0x4b, 0xe5, 0xa9, 0x06,
-	0xcb, 0x4e, 0xc8, 0x65, 0x67, 0xfc, 0xa0, 0x5a, 0x74, 0x15, 0x20, 0xec, 0xfa, 0xf4, 0xb8, 0xac,
-	0xd8, 0xeb, 0x41, 0xc5, 0xea, 0x2d, 0x9c, 0xf5, 0x07, 0x4b, 0xd8, 0x32, 0x36, 0x55, 0x4a, 0xf3,
-	0x4d, 0x99, 0xfa, 0x2f, 0x08, 0x5e, 0xed, 0x50, 0x16, 0x75, 0x36, 0xdb, 0x70, 0x8a, 0xfb, 0xa1,
-	0x34, 0x9a, 0x3b, 0xb1, 0x78, 0x7a, 0xf5, 0x52, 0x6f, 0x07, 0x23, 0x71, 0xb6, 0xf6, 0xa9, 0x2b,
-	0x82, 0x6b, 0xa9, 0x60, 0xf0, 0x87, 0x11, 0xee, 0x29, 0xc9, 0x7d, 0xa1, 0x2b, 0x77, 0x9f, 0x4e,
-	0x84, 0xfc, 0xf3, 0x80, 0xfc, 0x26, 0x2d, 0x53, 0x5b, 0xc6, 0xda, 0x1b, 0xdd, 0xf2, 0xbf, 0x0d,
-	0x7c, 0xa8, 0xeb, 0xa6, 0xd9, 0x38, 0xd4, 0x06, 0x56, 0x70, 0xa8, 0xb1, 0x97, 0x26, 0xf5, 0xff,
-	0x75, 0xcf, 0xb7, 0x08, 0x32, 0x9d, 0x14, 0xaa, 0xf3, 0xb9, 0xd3, 0x3c, 0x3e, 0xea, 0xe7, 0x33,
-	0x13, 0x29, 0x65, 0x50, 0xc4, 0x4d, 0x6a, 0x6e, 0x30, 0xc7, 0xcd, 0xad, 0xd5, 0xcf, 0xe2, 0xa7,
-	0xe7, 0xb3, 0x4b, 0x3d, 0x50, 0x53, 0x39, 0x3c, 0x9c, 0x28, 0x0f, 0x11, 0xe8, 0x2d, 0x7c, 0x6e,
-	0x31, 0x41, 0xca, 0xa3, 0x2d, 0xbb, 0xfe, 0x27, 0x82, 0xf9, 0x44, 0x1a, 0xaa, 0x36, 0xbb, 0xad,
-	0xb5, 0xb9, 0x9c, 0x78, 0x77, 0x43, 0xb4, 0xcd, 0x60, 0x47, 0x1f, 0xb1, 0x65, 0xb0, 0x62, 0x1b,
-	0x4e, 0x8a, 0xfa, 0x7e, 0xe9, 0xd4, 0x8b, 0xaa, 0xb8, 0x8f, 0x1f, 0x4e, 0xf0, 0x06, 0xa1, 0x46,
-	0x7f, 0x8d, 0xac, 0xd8, 0x35, 0x35, 0xc0, 0x63, 0x29, 0xa8, 0x42, 0xef, 0x00, 0x34, 0xee, 0xae,
-	0x5f, 0xeb, 0x81, 0x1a, 0xa0, 0x09, 0x44, 0xff, 0x1a, 0xc1, 0x6b, 0xd1, 0x7d, 0xf7, 0x1c, 0x51,
-	0xb2, 0x3c, 0x72, 0x10, 0xac, 0x1e, 0x91, 0xfe, 0x47, 0x08, 0x2e, 0x76, 0x21, 0xa2, 0xaa, 0xf0,
-	0x19, 0x4c, 0x1d, 0xa8, 0x4f, 0xc3, 0x13, 0x99, 0x3c, 0x88, 0xee, 0xa2, 0x4f, 0xc3, 0x2b, 0x92,
-	0x46, 0xfd, 0x99, 0xab, 0xb9, 0x8e, 0x38, 0xdc, 0x66, 0xac, 0x1c, 0xf8, 0xaa, 0x07, 0x08, 0xb4,
-	0xb8, 0xaf, 0x8a, 0x19, 0x85, 0xf1, 0x2a, 0x63, 0xe5, 0x17, 0x37, 0x21, 0x24, 0xfc, 0xea, 0xd3,
-	0x49, 0x38, 0x29, 0x59, 0xe0, 0x1f, 0x11, 0x4c, 0xf8, 0x36, 0x0d, 0x1b, 0x89, 0x3d, 0xd7, 0xee,
-	0x11, 0xb5, 0x4b, 0xbd, 0x27, 0xf8, 0xf2, 0xf4, 0xa5, 0xaf, 0x7e, 0xfd, 0xe3, 0xfb, 0xd4, 0x45,
-	0x3c, 0x6f, 0x24, 0x99, 0x54, 0xdf, 0x28, 0xe2, 0x07, 0x29, 0x98, 0x4e, 0x30, 0x51, 0x78, 0xb3,
-	0xfb, 0xf6, 0xdd, 0x2d, 0xa6, 0xb6, 0x35, 0x24, 0x8a, 0x52, 0xb6, 0x27, 0x95, 0xed, 0xe0, 0x9b,
-	0x89, 0xca, 0xc2, 0xb6, 0x31, 0xee, 0xb5, 0xbd, 0x47, 0xf7, 0x0d, 0x16, 0xe2, 0x17, 0x82, 0x11,
-	0x76, 0x84, 0xe0, 0x7c, 0x8c, 0x25, 0xc3, 0xef, 0xf6, 0xc1, 0xbb, 0xcd, 0x4d, 0x6a, 0xef, 0x0d,
-	0x98, 0xad, 0xd4, 0xde, 0x90, 0x6a, 0xaf, 0xe1, 0xab, 0xc3, 0xa8, 0x0d, 0x4d, 0x1f, 0xfe, 0x0d,
-	0xc1, 0x54, 0xab, 0xb1, 0xc1, 0x6f, 0xf7, 0xc1, 0x31, 0xea, 0x11, 0xb5, 0x77, 0x06, 0x49, 0x55,
-	0xda, 0xae, 0x4b, 0x6d, 0x5b, 0x78, 0x63, 0x18, 0x6d, 0x81, 0x85, 0xfa, 0x1b, 0xc1, 0xb9, 0x36,
-	0x4b, 0x80, 0x7b, 0xa0, 0xd7, 0xc9, 0x29, 0x69, 0x57, 0x06, 0xca, 0x55, 0xda, 0x0a, 0x52, 0xdb,
-	0x27, 0x78, 0x2f, 0x51, 0x5b, 0x63, 0xb2, 0x72, 0xe3, 0x5e, 0xdb, 0xc4, 0xbe, 0x6f, 0xa8, 0x9b,
-	0x19, 0xa7, 0x1b, 0xff, 0x85, 0xe0, 0xe5, 0xf8, 0xb7, 0x1e, 0xbf, 0xdf, 0x0f, 0xf1, 0x18, 0xb3,
-	0xa2, 0x7d, 0x30, 0x38, 0x40, 0x5f, 0x47, 0xdb, 0x9b, 0x7c, 0xd9, 0x98, 0x31, 0x4f, 0x6d, 0x2f,
-	0x8d, 0xd9, 0xd9, 0x24, 0xf4, 0xd2, 0x98, 0x09, 0xef, 0x7b, 0x8f, 0x8d, 0xd9, 0x45, 0x61, 0x78,
-	0xb7, 0xf1, 0x3f, 0x08, 0xd2, 0x9d, 0x9e, 0x53, 0xbc, 0xde, 0x07, 0xd7, 0x78, 0x4f, 0xa0, 0xe5,
-	0x86, 0x81, 0x50, 0x9a, 0x6f, 0x49, 0xcd, 0x37, 0xf0, 0x47, 0xc3, 0x68, 0x6e, 0xf5, 0x03, 0xf8,
-	0x67, 0x04, 0x67, 0x23, 0x6f, 0x34, 0xbe, 0xdc, 0x9d, 0x6b, 0xdc, 0x93, 0xaf, 0xbd, 0xd9, 0x77,
-	0x9e, 0x12, 0xb6, 0x26, 0x85, 0x2d, 0xe3, 0xa5, 0x44, 0x61, 0x66, 0x90, 0x5b, 0xa8, 0x3f, 0xed,
-	0xb9, 0xeb, 0x8f, 0x8f, 0x32, 0xe8, 0xc9, 0x51, 0x06, 0xfd, 0x7e, 0x94, 0x41, 0xdf, 0x1d, 0x67,
-	0xc6, 0x9e, 0x1c, 0x67, 0xc6, 0x9e, 0x1e, 0x67, 0xc6, 0x3e, 0x5d, 0x49, 0xf4, 0x09, 0x5f, 0x44,
-	0xd1, 0xa5, 0x6d, 0x28, 0x4e, 0xc8, 0x7f, 0x11, 0xad, 0xfd, 0x17, 0x00, 0x00, 0xff, 0xff, 0x5f,
-	0xcd, 0x9d, 0x50, 0x1a, 0x13, 0x00, 0x00,
+	0x14, 0xce, 0x38, 0x69, 0x2b, 0xbd, 0xb6, 0x34, 0x9d, 0x54, 0xc8, 0x6c, 0x82, 0x13, 0x6d, 0x28,
+	0x89, 0x08, 0xf1, 0x36, 0x89, 0x54, 0x7e, 0x94, 0x0a, 0xec, 0x24, 0x25, 0x28, 0xa8, 0x4d, 0x4c,
+	0x94, 0x14, 0x54, 0x61, 0x4d, 0x76, 0x47, 0x9b, 0x55, 0xed, 0x1d, 0xd7, 0x3b, 0x9b, 0x10, 0x55,
+	0x3d, 0x94, 0xb6, 0x48, 0x1c, 0x2a, 0x21, 0xd1, 0x03, 0x07, 0xfe, 0x02, 0xee, 0x5c, 0x90, 0xb8,
+	0xf7, 0x58, 0x09, 0x09, 0x71, 0x6a, 0x51, 0x82, 0x10, 0x1c, 0x38, 0x23, 0x71, 0x42, 0x9e, 0x9d,
+	0xf5, 0x7a, 0x63, 0xef, 0xfa, 0x57, 0xec, 0x53, 0xac, 0xd9, 0x79, 0xdf, 0x7c, 0xdf, 0x37, 0x33,
+	0x6f, 0xde, 0x0b, 0x4c, 0xe9, 0xcc, 0x29, 0x32, 0x47, 0x33, 0x2c, 0x87, 0x97, 0xad, 0x6d, 0x97,
+	0x5b, 0xcc, 0xd6, 0x76, 0xe7, 0xb6, 0x29, 0x27, 0x73, 0xda, 0x1d, 0x97, 0x96, 0xf7, 0xd3, 0xa5,
+	0x32, 0xe3, 0x0c, 0x8f, 0x7a, 0x13, 0xd3, 0xb5, 0x13, 0xd3, 0x72, 0xa2, 0xf2, 0x86, 0x44, 0xd9,
+	0x26, 0x0e, 0xf5, 0xa2, 0xaa, 0x18, 0x25, 0x62, 0x5a, 0x36, 0x11, 0xb3, 0x05, 0x90, 0x72, 0xc1,
+	0x64, 0x26, 0x13, 0x3f, 0xb5, 0xca, 0x2f, 0x39, 0x3a, 0x66, 0x32, 0x66, 0x16, 0xa8, 0x46, 0x4a,
+	0x96, 0x46, 0x6c, 0x9b, 0x71, 0x11, 0xe2, 0xc8, 0xaf, 0xa9, 0x5a, 0x7c, 0x1f, 0x59, 0x67, 0x96,
+	0x8f, 0x99, 0x8e, 0x53, 0x11, 0x62, 0x2c, 0xe6, 0xab, 0x17, 0x00, 0xaf, 0x57, 0x58, 0xae, 0x91,
+	0x32, 0x29, 0x3a, 0x39, 0x7a, 0xc7, 0xa5, 0x0e, 0x57, 0x6f, 0xc2, 0x48, 0x68, 0xd4, 0x29, 0x31,
+	0xdb, 0xa1, 0x38, 0x03, 0x27, 0x4b, 0x62, 0x24, 0x89, 0x26, 0xd0, 0xf4, 0xe9, 0xf9, 0xc9, 0x74,
+	0x8c, 0x15, 0x69, 0x2f, 0x38, 0x3b, 0xf4, 0xf4, 0xf9, 0xf8, 0x40, 0x4e, 0x06, 0xaa, 0x5f, 0x23,
+	0x98, 0x12, 0xd0, 0x9b, 0xa4, 0x60, 0x19, 0x84, 0xb3, 0xf2, 0x0d, 0x97, 0x3b, 0x9c, 0xd8, 0x86,
+	0x65, 0x9b, 0x39, 0xba, 0x47, 0xca, 0x86, 0xcf, 0x02, 0x7f, 0x0e, 0xe7, 0x77, 0xfd, 0x59, 0x79,
+	0x62, 0x18, 0x65, 0xea, 0x78, 0x2b, 0x9f, 0xc9, 0xce, 0xfd, 0xf7, 0x7c, 0x7c, 0xd6, 0xb4, 0xf8,
+	0x8e, 0xbb, 0x9d, 0xd6, 0x59, 0x51, 0x93, 0xaa, 0xbd, 0x3f, 0xb3, 0x8e, 0x71, 0x5b, 0xe3, 0xfb,
+	0x25, 0xea, 0xa4, 0x37, 0x49, 0x21, 0xe3, 0x05, 0xe6, 0x86, 0xab, 0x58, 0x72, 0x44, 0x7d, 0x88,
+	0x60, 0xba, 0x39, 0x17, 0xa9, 0xfd, 0x26, 0x9c, 0x2a, 0x7b, 0x43, 0x52, 0xfc, 0xdb, 0xb1, 0xe2,
+	0x63, 0x20, 0xa5, 0x23, 0x3e, 0x9c, 0x7a, 0x1f, 0xc1, 0x78, 0x98, 0xc6, 0x22, 0x2b, 0x16, 0x2d,
+	0xc7, 0xb1, 0x98, 0xdd, 0x2f, 0x2b, 0x1e, 0x21, 0x98, 0x88, 0xe6, 0x20, 0x2d, 0x20, 0x00, 0x7a,
+	0x75, 0x54, 0xba, 0x70, 0xa5, 0x35, 0x17, 0x32, 0xba, 0xee, 0x16, 0xdd, 0x02, 0xe1, 0xd4, 0x08,
+	0x80, 0xa5, 0x11, 0x35, 0xa0, 0xea, 0x93, 0x04, 0x8c, 0x85, 0x79, 0x7c, 0x52, 0x20, 0xce, 0x0e,
+	0xed, 0xd7, 0x99, 0xc0, 0x53, 0x70, 0xce, 0xe1, 0xa4, 0xcc, 0x2d, 0xdb, 0xcc, 0xef, 0x50, 0xcb,
+	0xdc, 0xe1, 0xc9, 0xc4, 0x04, 0x9a, 0x1e, 0xca, 0xbd, 0xe4, 0x0f, 0xaf, 0x88, 0x51, 0x3c, 0x09,
+	0x67, 0xa9, 0x6d, 0xd4, 0x4c, 0x1b, 0x14, 0xd3, 0xce, 0x78, 0x83, 0x72, 0xd2, 0x35, 0x80, 0xe0,
+	0xd6, 0x27, 0x87, 0x84, 0x63, 0xaf, 0xfb, 0x8e, 0x55, 0xae, 0x70, 0xda, 0x4b, 0x2c, 0xc1, 0x95,
+	0x31, 0xa9, 0x54, 0x9a, 0xab, 0x89, 0x54, 0x7f, 0x42, 0xf0, 0x6a, 0x84, 0x2d, 0x72, 0x6f, 0xd6,
+	0xe0, 0x94, 0xe3, 0x0d, 0x25, 0xd1, 0xc4, 0xe0, 0xf4, 0xe9, 0xf9, 0x4b, 0xad, 0x6d, 0x8c, 0xc0,
+	0x59, 0xde, 0xa5, 0x36, 0xf7, 0x8f, 0xa5, 0x84, 0xc1, 0x1f, 0x86, 0xb8, 0x27, 0x04, 0xf7, 0xa9,
+	0xa6, 0xdc, 0x3d, 0x3a, 0x21, 0xf2, 0x2f, 0x7c, 0xf2, 0x4b, 0xb4, 0x40, 0x4d, 0x31, 0x56, 0x7f,
+	0xd1, 0x0d, 0xef, 0x5b, 0xc7, 0x9b, 0x9a, 0xd1, 0xf5, 0xea, 0xa6, 0x56, 0xb1, 0xfc, 0x4d, 0x6d,
+	0x78, 0x68, 0x12, 0xc7, 0x77, 0x7b, 0x1e, 0x23, 0x48, 0x45, 0x29, 0x94, 0xfb, 0x73, 0xbb, 0x36,
+	0x7d, 0x54, 0xf6, 0x67, 0x2c, 0x64, 0xa5, 0x6f, 0xe2, 0x12, 0xd5, 0x17, 0x99, 0x65, 0x67, 0x17,
+	0x2a, 0x7b, 0xf1, 0xc3, 0x8b, 0xf1, 0x99, 0x16, 0xa8, 0xc9, 0x18, 0x27, 0xc8, 0x28, 0x0f, 0x11,
+	0xa8, 0x47, 0xf8, 0x6c, 0x30, 0x4e, 0x0a, 0xfd, 0xb5, 0x5d, 0xfd, 0x13, 0xc1, 0x64, 0x2c, 0x0d,
+	0xe9, 0xcd, 0xe6, 0x51, 0x6f, 0x2e, 0xc7, 0x9e, 0xdd, 0x00, 0x6d, 0xc9, 0x5f, 0xd1, 0x43, 0x3c,
+	0x92, 0x58, 0xb1, 0x09, 0x27, 0x78, 0x65, 0xbd, 0x64, 0xa2, 0x57, 0x8e, 0x7b, 0xf8, 0x41, 0x06,
+	0xaf, 0x12, 0xaa, 0xde, 0xaf, 0xbe, 0x99, 0xed, 0xc2, 0x44, 0x34, 0x05, 0x69, 0xf4, 0x3a, 0x40,
+	0xf5, 0xec, 0x7a, 0x5e, 0x77, 0x74, 0x01, 0x6a, 0x40, 0xd4, 0xaf, 0x10, 0xbc, 0x16, 0x5e, 0x77,
+	0xcb, 0xe2, 0x3b, 0x46, 0x99, 0xec, 0xf9, 0xb3, 0xfb, 0xa4, 0xff, 0x11, 0x82, 0x8b, 0x4d, 0x88,
+	0x48, 0x17, 0x6e, 0xc1, 0xf0, 0x9e, 0xfc, 0xd4, 0x3d, 0x91, 0x73, 0x7b, 0xe1, 0x55, 0xd4, 0xef,
+	0x13, 0x51, 0x86, 0xe4, 0xa8, 0xce, 0xfa, 0x98, 0xf4, 0x22, 0x5e, 0xb2, 0xc1, 0xd6, 0x5e, 0xb2,
+	0xc1, 0x1e, 0xbd, 0x64, 0x3f, 0x47, 0x6e, 0x53, 0xd5, 0x1e, 0xb9, 0x4d, 0xab, 0x95, 0xac, 0x20,
+	0x86, 0x64, 0x56, 0x98, 0x89, 0xcd, 0x0a, 0x61, 0x98, 0x20, 0x15, 0x08, 0x84, 0xe3, 0x7b, 0xcc,
+	0x1e, 0x23, 0x98, 0x09, 0xf3, 0xcf, 0xb8, 0x9c, 0x2d, 0xb2, 0x62, 0x89, 0xb9, 0xb6, 0xd1, 0xff,
+	0x6b, 0x7f, 0x1f, 0xc1, 0x9b, 0xad, 0xf1, 0xe9, 0x5d, 0x0e, 0x18, 0x85, 0x57, 0x04, 0x85, 0x4a,
+	0x65, 0xe7, 0xda, 0x16, 0xdf, 0x5f, 0x63, 0xac, 0xe0, 0xb7, 0x12, 0x0f, 0x10, 0x28, 0x8d, 0xbe,
+	0x4a, 0x3a, 0x14, 0x86, 0x4a, 0x8c, 0x15, 0x7a, 0xf7, 0x28, 0x0a, 0xf8, 0xf9, 0xbf, 0x47, 0xe0,
+	0x84, 0x60, 0x81, 0xbf, 0x43, 0x70, 0xd2, 0xeb, 0x4c, 0xb0, 0x16, 0x7b, 0xa0, 0xea, 0xdb, 0x22,
+	0xe5, 0x52, 0xeb, 0x01, 0x9e, 0x3c, 0x75, 0xe6, 0xcb, 0x5f, 0xfe, 0xf8, 0x36, 0x71, 0x11, 0x4f,
+	0x6a, 0x71, 0x7d, 0x99, 0xd7, 0x1b, 0xe1, 0x07, 0x09, 0x18, 0x8d, 0xe9, 0x1b, 0xf0, 0x52, 0xf3,
+	0xe5, 0x9b, 0x77, 0x55, 0xca, 0x72, 0x97, 0x28, 0x52, 0xd9, 0x96, 0x50, 0xb6, 0x8e, 0x6f, 0xc4,
+	0x2a, 0x0b, 0x4e, 0x89, 0x76, 0xb7, 0xae, 0x04, 0xbb, 0xa7, 0xb1, 0x00, 0x3f, 0xef, 0xbf, 0xda,
+	0x07, 0x08, 0x46, 0x1a, 0x74, 0x21, 0xf8, 0xbd, 0x36, 0x78, 0xd7, 0x35, 0x50, 0xca, 0xd5, 0x0e,
+	0xa3, 0xa5, 0xda, 0xeb, 0x42, 0xed, 0x0a, 0xbe, 0xd6, 0x8d, 0xda, 0xa0, 0xcf, 0xc1, 0xbf, 0x22,
+	0x18, 0x3e, 0x5a, 0xcb, 0xe3, 0x77, 0xda, 0xe0, 0x18, 0x6e, 0x8b, 0x94, 0x77, 0x3b, 0x09, 0x95,
+	0xda, 0x56, 0x85, 0xb6, 0x65, 0xbc, 0xd8, 0x8d, 0x36, 0xbf, 0x6b, 0xf8, 0x07, 0xc1, 0xf9, 0xba,
+	0x2a, 0x18, 0xb7, 0x40, 0x2f, 0xaa, 0x39, 0x50, 0xae, 0x74, 0x14, 0x2b, 0xb5, 0xe5, 0x85, 0xb6,
+	0x4f, 0xf1, 0x56, 0xac, 0xb6, 0x6a, 0x56, 0x75, 0xb4, 0xbb, 0x75, 0xd9, 0xfa, 0x9e, 0x26, 0x4f,
+	0x66, 0x23, 0xdd, 0xf8, 0x2f, 0x04, 0x2f, 0x37, 0x2e, 0x6f, 0xf1, 0xfb, 0xed, 0x10, 0x6f, 0x50,
+	0x9f, 0x2b, 0x1f, 0x74, 0x0e, 0xd0, 0xd6, 0xd6, 0xb6, 0x26, 0x5f, 0x5c, 0xcc, 0x06, 0xd5, 0x65,
+	0x2b, 0x17, 0x33, 0xba, 0x2e, 0x56, 0xae, 0x76, 0x18, 0xdd, 0xd6, 0xc5, 0x6c, 0xa2, 0x30, 0x38,
+	0xdb, 0xf8, 0x5f, 0x04, 0xc9, 0xa8, 0x0a, 0x12, 0x67, 0xda, 0xe0, 0xda, 0xb8, 0x0c, 0x56, 0xb2,
+	0xdd, 0x40, 0x48, 0xcd, 0x1b, 0x42, 0xf3, 0x75, 0xfc, 0x71, 0x37, 0x9a, 0x8f, 0x96, 0xc0, 0x8d,
+	0x95, 0xcb, 0xa2, 0xac, 0x23, 0xe5, 0xe1, 0x7a, 0x57, 0xc9, 0x76, 0x03, 0xd1, 0x13, 0xe5, 0x7e,
+	0x71, 0xf8, 0x24, 0x01, 0xe3, 0x4d, 0xca, 0x27, 0xbc, 0xd2, 0x06, 0xfb, 0xd8, 0x8a, 0x50, 0xf9,
+	0xe8, 0x18, 0x90, 0xa4, 0x1d, 0xb7, 0x84, 0x1d, 0x9b, 0x78, 0xa3, 0x1b, 0x3b, 0x88, 0xcb, 0x59,
+	0x5e, 0x97, 0x8b, 0xe4, 0x6b, 0xae, 0xc2, 0x8f, 0x08, 0xce, 0x86, 0x8a, 0x36, 0x7c, 0xb9, 0x39,
+	0xf5, 0x46, 0x35, 0xa0, 0xf2, 0x56, 0xdb, 0x71, 0x52, 0xe0, 0x82, 0x10, 0x38, 0x8b, 0x67, 0x62,
+	0x05, 0xea, 0x7e, 0x6c, 0xbe, 0x52, 0xeb, 0x65, 0x57, 0x9f, 0x1e, 0xa4, 0xd0, 0xb3, 0x83, 0x14,
+	0xfa, 0xfd, 0x20, 0x85, 0xbe, 0x39, 0x4c, 0x0d, 0x3c, 0x3b, 0x4c, 0x0d, 0xfc, 0x76, 0x98, 0x1a,
+	0xf8, 0x6c, 0x2e, 0xb6, 0x70, 0xfc, 0x22, 0x8c, 0x2e, 0xea, 0xc8, 0xed, 0x93, 0xe2, 0xdf, 0xe4,
+	0x0b, 0xff, 0x0f, 0x00, 0xb0, 0x6c, 0x23, 0xb0, 0x1e, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1026,6 +1272,12 @@ type QueryClient interface {
 	DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorWithdrawRecords queries the rewards and commission withdrawals
+	// recorded for a delegator.
+	DelegatorWithdrawRecords(ctx context.Context, in *QueryDelegatorWithdrawRecordsRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawRecordsResponse, error)
+	// DelegatorAutoCompoundValidators queries the validators a delegator
+	// auto-compounds the rewards of.
+	DelegatorAutoCompoundValidators(ctx context.Context, in *QueryDelegatorAutoCompoundValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundValidatorsResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
 }
@@ -1110,6 +1362,24 @@ func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDel
 	return out, nil
 }
 
+func (c *queryClient) DelegatorWithdrawRecords(ctx context.Context, in *QueryDelegatorWithdrawRecordsRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawRecordsResponse, error) {
+	out := new(QueryDelegatorWithdrawRecordsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorAutoCompoundValidators(ctx context.Context, in *QueryDelegatorAutoCompoundValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundValidatorsResponse, error) {
+	out := new(QueryDelegatorAutoCompoundValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompoundValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error) {
 	out := new(QueryCommunityPoolResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/CommunityPool", in, out, opts...)
@@ -1137,6 +1407,12 @@ type QueryServer interface {
 	DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorWithdrawRecords queries the rewards and commission withdrawals
+	// recorded for a delegator.
+	DelegatorWithdrawRecords(context.Context, *QueryDelegatorWithdrawRecordsRequest) (*QueryDelegatorWithdrawRecordsResponse, error)
+	// DelegatorAutoCompoundValidators queries the validators a delegator
+	// auto-compounds the rewards of.
+	DelegatorAutoCompoundValidators(context.Context, *QueryDelegatorAutoCompoundValidatorsRequest) (*QueryDelegatorAutoCompoundValidatorsResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
 }
@@ -1169,6 +1445,12 @@ func (*UnimplementedQueryServer) DelegatorValidators(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, req *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddress not implemented")
 }
+func (*UnimplementedQueryServer) DelegatorWithdrawRecords(ctx context.Context, req *QueryDelegatorWithdrawRecordsRequest) (*QueryDelegatorWithdrawRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawRecords not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorAutoCompoundValidators(ctx context.Context, req *QueryDelegatorAutoCompoundValidatorsRequest) (*QueryDelegatorAutoCompoundValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorAutoCompoundValidators not implemented")
+}
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
@@ -1321,6 +1603,42 @@ func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorWithdrawRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorWithdrawRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorWithdrawRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorWithdrawRecords(ctx, req.(*QueryDelegatorWithdrawRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorAutoCompoundValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAutoCompoundValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorAutoCompoundValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompoundValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorAutoCompoundValidators(ctx, req.(*QueryDelegatorAutoCompoundValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCommunityPoolRequest)
 	if err := dec(in); err != nil {
@@ -1375,6 +1693,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorWithdrawAddress",
 			Handler:    _Query_DelegatorWithdrawAddress_Handler,
 		},
+		{
+			MethodName: "DelegatorWithdrawRecords",
+			Handler:    _Query_DelegatorWithdrawRecords_Handler,
+		},
+		{
+			MethodName: "DelegatorAutoCompoundValidators",
+			Handler:    _Query_DelegatorAutoCompoundValidators_Handler,
+		},
 		{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
@@ -1944,7 +2270,7 @@ func (m *QueryDelegatorWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCommunityPoolRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDelegatorWithdrawRecordsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1954,20 +2280,49 @@ func (m *QueryCommunityPoolRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCommunityPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDelegatorWithdrawRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCommunityPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDelegatorWithdrawRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndingHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndingHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartingHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartingHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDelegatorWithdrawRecordsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1977,20 +2332,32 @@ func (m *QueryCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCommunityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDelegatorWithdrawRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDelegatorWithdrawRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Pool) > 0 {
-		for iNdEx := len(m.Pool) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Pool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2004,16 +2371,138 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommunityPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommunityPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommunityPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommunityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		for iNdEx := len(m.Pool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
@@ -2245,6 +2734,76 @@ func (m *QueryDelegatorWithdrawAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDelegatorWithdrawRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartingHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartingHeight))
+	}
+	if m.EndingHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndingHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorWithdrawRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, b := range m.Validators {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryCommunityPoolRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3806,6 +4365,462 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDelegatorWithdrawRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorWithdrawRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorWithdrawRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingHeight", wireType)
+			}
+			m.StartingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartingHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndingHeight", wireType)
+			}
+			m.EndingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndingHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorWithdrawRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorWithdrawRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorWithdrawRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, WithdrawRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, make([]byte, postIndex-iNdEx))
+			copy(m.Validators[len(m.Validators)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/tendermint/tendermint/crypto/ed25519"
@@ -114,7 +115,10 @@ func (suite *HandlerTestSuite) TestMsgSubmitEvidence() {
 			suite.Require().NotNil(res, "expected non-nil result; tc #%d", i)
 
 			msg := tc.msg.(exported.MsgSubmitEvidence)
-			suite.Require().Equal(msg.GetEvidence().Hash().Bytes(), res.Data, "invalid hash; tc #%d", i)
+
+			var resultData types.MsgSubmitEvidenceResponse
+			suite.Require().NoError(proto.Unmarshal(res.Data, &resultData))
+			suite.Require().Equal(msg.GetEvidence().Hash().Bytes(), resultData.Hash, "invalid hash; tc #%d", i)
 		}
 	}
 }
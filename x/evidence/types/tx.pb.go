@@ -5,12 +5,17 @@ package types
 
 import (
 	bytes "bytes"
+	context "context"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/regen-network/cosmos-proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -67,32 +72,83 @@ func (m *MsgSubmitEvidence) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitEvidence proto.InternalMessageInfo
 
+// MsgSubmitEvidenceResponse defines the Msg/SubmitEvidence response type.
+type MsgSubmitEvidenceResponse struct {
+	// hash defines the hash of the evidence.
+	Hash []byte `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *MsgSubmitEvidenceResponse) Reset()         { *m = MsgSubmitEvidenceResponse{} }
+func (m *MsgSubmitEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitEvidenceResponse) ProtoMessage()    {}
+func (*MsgSubmitEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3242cb23c956e0, []int{1}
+}
+func (m *MsgSubmitEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitEvidenceResponse.Merge(m, src)
+}
+func (m *MsgSubmitEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitEvidenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitEvidenceResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitEvidenceResponse) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgSubmitEvidence)(nil), "cosmos.evidence.v1beta1.MsgSubmitEvidence")
+	proto.RegisterType((*MsgSubmitEvidenceResponse)(nil), "cosmos.evidence.v1beta1.MsgSubmitEvidenceResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/evidence/v1beta1/tx.proto", fileDescriptor_3e3242cb23c956e0) }
 
 var fileDescriptor_3e3242cb23c956e0 = []byte{
-	// 275 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0xa5, 0x24, 0xd3, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5, 0xc1, 0xbc, 0xa4, 0xd2,
-	0x34, 0xfd, 0xc4, 0xbc, 0x4a, 0x98, 0x14, 0xc4, 0xa4, 0x78, 0x88, 0x1e, 0xa8, 0xb1, 0x60, 0x8e,
-	0xd2, 0x2a, 0x46, 0x2e, 0x41, 0xdf, 0xe2, 0xf4, 0xe0, 0xd2, 0xa4, 0xdc, 0xcc, 0x12, 0x57, 0xa8,
-	0x4d, 0x42, 0xfe, 0x5c, 0x9c, 0xc5, 0x60, 0x91, 0x92, 0xd4, 0x22, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x1e, 0x27, 0xc3, 0x5f, 0xf7, 0xe4, 0x75, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3,
-	0x73, 0xa1, 0xa6, 0x40, 0x29, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x92, 0xca, 0x82, 0xd4, 0x62, 0x3d,
-	0xc7, 0xe4, 0x64, 0xc7, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0xe2, 0x20, 0x84, 0x19, 0x42, 0x76, 0x5c,
-	0x1c, 0x30, 0x6f, 0x48, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x1b, 0x89, 0xe8, 0x41, 0xdc, 0xab, 0x07,
-	0x73, 0xaf, 0x9e, 0x63, 0x5e, 0xa5, 0x13, 0xcf, 0xa9, 0x2d, 0xba, 0x1c, 0x30, 0x67, 0x04, 0xc1,
-	0xf5, 0x58, 0xb1, 0x74, 0x2c, 0x90, 0x67, 0x70, 0xf2, 0x5e, 0xf1, 0x48, 0x8e, 0xf1, 0xc4, 0x23,
-	0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2,
-	0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xf0, 0xbb, 0xae, 0x02, 0x11, 0xd2, 0x60, 0x87,
-	0x26, 0xb1, 0x81, 0x2d, 0x36, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xcf, 0x20, 0xcf, 0x5a, 0x89,
-	0x01, 0x00, 0x00,
+	// 337 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x4b, 0x02, 0x41,
+	0x14, 0xc7, 0x77, 0x4a, 0xc2, 0x26, 0x09, 0x1a, 0x84, 0xd4, 0xc3, 0x28, 0x9e, 0x24, 0x70, 0x06,
+	0xed, 0xd6, 0x21, 0x50, 0xe8, 0x14, 0x12, 0x6c, 0xb7, 0x2e, 0xe1, 0xee, 0x4e, 0xa3, 0x94, 0x3b,
+	0x8b, 0x6f, 0x34, 0xfd, 0x06, 0x1d, 0xfb, 0x08, 0x1d, 0xa3, 0x73, 0x1f, 0x22, 0x3a, 0x79, 0xec,
+	0x14, 0xb1, 0xfb, 0x2d, 0x3a, 0x85, 0xb3, 0x3b, 0x09, 0x49, 0xd1, 0x69, 0xdf, 0xdb, 0xfd, 0xbf,
+	0xff, 0xfb, 0xbd, 0x3f, 0x8b, 0x6b, 0xbe, 0x82, 0x91, 0x02, 0x2e, 0xa6, 0xc3, 0x40, 0x84, 0xbe,
+	0xe0, 0xd3, 0x96, 0x27, 0x74, 0xbf, 0xc5, 0xf5, 0x8c, 0x45, 0x63, 0xa5, 0x15, 0xd9, 0x4f, 0x15,
+	0xcc, 0x2a, 0x58, 0xa6, 0xa8, 0x14, 0xa5, 0x92, 0xca, 0x68, 0xf8, 0xb2, 0x4a, 0xe5, 0x95, 0xb2,
+	0x54, 0x4a, 0xde, 0x08, 0x6e, 0x3a, 0x6f, 0x72, 0xc5, 0xfb, 0xe1, 0xdc, 0x7e, 0x4a, 0x9d, 0x2e,
+	0xd3, 0x99, 0xcc, 0xd6, 0x34, 0xf5, 0x27, 0x84, 0xf7, 0x7a, 0x20, 0xcf, 0x27, 0xde, 0x68, 0xa8,
+	0x4f, 0xb2, 0x4d, 0xe4, 0x0c, 0x6f, 0x83, 0x79, 0xa3, 0xc5, 0xb8, 0x84, 0x6a, 0xa8, 0x51, 0xe8,
+	0xb6, 0x3e, 0xdf, 0xab, 0x4d, 0x39, 0xd4, 0x83, 0x89, 0xc7, 0x7c, 0x35, 0xca, 0x5c, 0xb2, 0x47,
+	0x13, 0x82, 0x6b, 0xae, 0xe7, 0x91, 0x00, 0xd6, 0xf1, 0xfd, 0x4e, 0x10, 0x8c, 0x05, 0x80, 0xbb,
+	0xf2, 0x20, 0xc7, 0x38, 0x6f, 0xcf, 0x28, 0x6d, 0xd4, 0x50, 0x63, 0xa7, 0x5d, 0x64, 0x29, 0x2f,
+	0xb3, 0xbc, 0xac, 0x13, 0xce, 0xbb, 0x85, 0xd7, 0xe7, 0x66, 0xde, 0x62, 0xb8, 0xdf, 0x33, 0x47,
+	0xb9, 0xbb, 0x87, 0xaa, 0x53, 0xe7, 0xb8, 0xbc, 0xc6, 0xea, 0x0a, 0x88, 0x54, 0x08, 0x82, 0x10,
+	0x9c, 0x1b, 0xf4, 0x61, 0x50, 0xca, 0x2d, 0x71, 0x5d, 0x53, 0xb7, 0x6f, 0xf1, 0x66, 0x0f, 0x24,
+	0x89, 0xf0, 0xee, 0x8f, 0x03, 0x0f, 0xd8, 0x2f, 0xe1, 0xb2, 0xb5, 0x05, 0x95, 0xf6, 0xff, 0xb5,
+	0x16, 0xa6, 0x7b, 0xfa, 0x18, 0x53, 0xf4, 0x12, 0x53, 0xb4, 0x88, 0x29, 0xfa, 0x88, 0x29, 0xba,
+	0x4f, 0xa8, 0xb3, 0x48, 0xa8, 0xf3, 0x96, 0x50, 0xe7, 0xe2, 0xef, 0x1c, 0x67, 0xab, 0x7f, 0xc2,
+	0x44, 0xea, 0x6d, 0x99, 0x88, 0x0e, 0xbf, 0x06, 0x00, 0x28, 0xf5, 0xad, 0x17, 0x33, 0x02, 0x00,
+	0x00,
 }
 
 func (this *MsgSubmitEvidence) Equal(that interface{}) bool {
@@ -122,6 +178,115 @@ func (this *MsgSubmitEvidence) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgSubmitEvidenceResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSubmitEvidenceResponse)
+	if !ok {
+		that2, ok := that.(MsgSubmitEvidenceResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SubmitEvidence submits an arbitrary Evidence of misbehavior such as equivocation or
+	// counterfactual signing.
+	SubmitEvidence(ctx context.Context, in *MsgSubmitEvidence, opts ...grpc.CallOption) (*MsgSubmitEvidenceResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SubmitEvidence(ctx context.Context, in *MsgSubmitEvidence, opts ...grpc.CallOption) (*MsgSubmitEvidenceResponse, error) {
+	out := new(MsgSubmitEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evidence.v1beta1.Msg/SubmitEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SubmitEvidence submits an arbitrary Evidence of misbehavior such as equivocation or
+	// counterfactual signing.
+	SubmitEvidence(context.Context, *MsgSubmitEvidence) (*MsgSubmitEvidenceResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SubmitEvidence(ctx context.Context, req *MsgSubmitEvidence) (*MsgSubmitEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitEvidence not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SubmitEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitEvidence)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evidence.v1beta1.Msg/SubmitEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitEvidence(ctx, req.(*MsgSubmitEvidence))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.evidence.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitEvidence",
+			Handler:    _Msg_SubmitEvidence_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/evidence/v1beta1/tx.proto",
+}
+
 func (m *MsgSubmitEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -164,6 +329,36 @@ func (m *MsgSubmitEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSubmitEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -192,6 +387,19 @@ func (m *MsgSubmitEvidence) Size() (n int) {
 	return n
 }
 
+func (m *MsgSubmitEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -321,6 +529,93 @@ func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSubmitEvidenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitEvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
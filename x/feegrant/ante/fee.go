@@ -0,0 +1,83 @@
+package ante
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// FeegrantKeeper defines the expected feegrant keeper.
+type FeegrantKeeper interface {
+	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
+}
+
+// DeductGrantedFeeDecorator deducts fees from the fee payer. If the tx sets a
+// fee granter, the fees are instead deducted from the granter's account,
+// provided the granter has a valid fee allowance for the fee payer.
+// If there is no valid allowance or the paying account does not have the funds
+// to pay for the fees, return with an error.
+// Call next AnteHandler if fees successfully deducted
+// CONTRACT: Tx must implement FeeTx interface to use DeductGrantedFeeDecorator
+type DeductGrantedFeeDecorator struct {
+	ak authante.AccountKeeper
+	k  FeegrantKeeper
+	bk authtypes.BankKeeper
+}
+
+func NewDeductGrantedFeeDecorator(ak authante.AccountKeeper, bk authtypes.BankKeeper, k FeegrantKeeper) DeductGrantedFeeDecorator {
+	return DeductGrantedFeeDecorator{
+		ak: ak,
+		k:  k,
+		bk: bk,
+	}
+}
+
+// AnteHandle performs a decorated ante-handler responsible for deducting transaction
+// fees. Fees will be deducted from the account designated by the FeePayer on a
+// transaction by default. However, if the fee granter is set, the fees will be
+// deducted from the fee granter's account as long as the fee payer has been
+// granted a valid fee allowance by the granter.
+func (d DeductGrantedFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if addr := d.ak.GetModuleAddress(authtypes.FeeCollectorName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", authtypes.FeeCollectorName))
+	}
+
+	fee := feeTx.GetFee()
+	feePayer := feeTx.FeePayer()
+	feeGranter := feeTx.FeeGranter()
+
+	deductFeesFrom := feePayer
+
+	// ensure the grant is allowed, if we request a different fee payer
+	if feeGranter != nil && !feeGranter.Equals(feePayer) {
+		err := d.k.UseGrantedFees(ctx, feeGranter, feePayer, fee, tx.GetMsgs())
+		if err != nil {
+			return ctx, sdkerrors.Wrapf(err, "%s not allowed to pay fees from %s", feePayer, feeGranter)
+		}
+
+		deductFeesFrom = feeGranter
+	}
+
+	deductFeesFromAcc := d.ak.GetAccount(ctx, deductFeesFrom)
+	if deductFeesFromAcc == nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", deductFeesFrom)
+	}
+
+	// deduct the fees
+	if !fee.IsZero() {
+		err := authante.DeductFees(d.bk, ctx, deductFeesFromAcc, fee)
+		if err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
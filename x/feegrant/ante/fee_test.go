@@ -0,0 +1,93 @@
+package ante_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/ante"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func TestDeductGrantedFees(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	app.AccountKeeper.SetParams(ctx, authtypes.DefaultParams())
+
+	encodingConfig := simapp.MakeEncodingConfig()
+	dfd := ante.NewDeductGrantedFeeDecorator(app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper)
+	anteHandler := sdk.ChainAnteDecorators(dfd)
+
+	// grantee has no funds, granter has funds, stranger has funds but gave no allowance
+	_, _, grantee := testdata.KeyTestPubAddr()
+	_, _, granter := testdata.KeyTestPubAddr()
+	_, _, stranger := testdata.KeyTestPubAddr()
+
+	for _, addr := range []sdk.AccAddress{grantee, granter, stranger} {
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	}
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, granter, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))))
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, stranger, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))))
+
+	err := app.FeeGrantKeeper.GrantAllowance(ctx, granter, grantee, &types.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 500)),
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		feeGranter sdk.AccAddress
+		fee        int64
+		expErr     error
+	}{
+		{"paid by grantee without funds", nil, 50, sdkerrors.ErrInsufficientFunds},
+		{"paid by granter within allowance", granter, 50, nil},
+		{"paid by granter above allowance", granter, 1000, types.ErrFeeLimitExceeded},
+		{"paid by account without allowance", stranger, 50, types.ErrNoAllowance},
+		{"zero fee without funds", nil, 0, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(grantee)))
+			txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", tc.fee)))
+			txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+			txBuilder.SetFeeGranter(tc.feeGranter)
+
+			cacheCtx, _ := ctx.CacheContext()
+			_, err := anteHandler(cacheCtx, txBuilder.GetTx(), false)
+			if tc.expErr != nil {
+				require.Error(t, err)
+				require.True(t, errors.Is(err, tc.expErr), err.Error())
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+
+	t.Log("verify the allowance and the granter balance are updated")
+	txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(grantee)))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 200)))
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	txBuilder.SetFeeGranter(granter)
+
+	_, err = anteHandler(ctx, txBuilder.GetTx(), false)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 800)), app.BankKeeper.GetAllBalances(ctx, granter))
+
+	allowance, err := app.FeeGrantKeeper.GetAllowance(ctx, granter, grantee)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 300)), allowance.(*types.BasicAllowance).SpendLimit)
+}
@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	feegrantQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the feegrant module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feegrantQueryCmd.AddCommand(
+		GetCmdQueryFeeGrant(),
+		GetCmdQueryFeeGrants(),
+	)
+
+	return feegrantQueryCmd
+}
+
+// GetCmdQueryFeeGrant returns cmd to query for a grant between granter and grantee.
+func GetCmdQueryFeeGrant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [granter] [grantee]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query details of a single grant",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details for a grant.
+You can find the fee-grant of a granter and grantee.
+
+Example:
+$ %s query %s grant [granter] [grantee]
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			granterAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			granteeAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Allowance(
+				context.Background(),
+				&types.QueryAllowanceRequest{
+					Granter: granterAddr,
+					Grantee: granteeAddr,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res.Allowance)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryFeeGrants returns cmd to query for all grants for a grantee.
+func GetCmdQueryFeeGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grants [grantee]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query all grants of a grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries all the grants for a grantee address.
+
+Example:
+$ %s query %s grants [grantee]
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			granteeAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Allowances(
+				context.Background(),
+				&types.QueryAllowancesRequest{
+					Grantee:    granteeAddr,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "grants")
+
+	return cmd
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Allowance returns fee granted to the grantee by the granter.
+func (k Keeper) Allowance(c context.Context, req *types.QueryAllowanceRequest) (*types.QueryAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Granter.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "empty granter address")
+	}
+
+	if req.Grantee.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "empty grantee address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	grant, err := k.getGrant(ctx, req.Granter, req.Grantee)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryAllowanceResponse{
+		Allowance: grant,
+	}, nil
+}
+
+// Allowances queries all the allowances granted to the given grantee.
+func (k Keeper) Allowances(c context.Context, req *types.QueryAllowancesRequest) (*types.QueryAllowancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Grantee.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "empty grantee address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var grants []*types.Grant
+
+	store := ctx.KVStore(k.storeKey)
+	grantsStore := prefix.NewStore(store, types.FeeAllowancePrefixByGrantee(req.Grantee))
+
+	pageRes, err := query.Paginate(grantsStore, req.Pagination, func(key []byte, value []byte) error {
+		var grant types.Grant
+		if err := k.cdc.UnmarshalBinaryBare(value, &grant); err != nil {
+			return err
+		}
+
+		grants = append(grants, &grant)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllowancesResponse{Allowances: grants, Pagination: pageRes}, nil
+}
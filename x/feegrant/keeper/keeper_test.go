@@ -0,0 +1,231 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	msgSrvr     types.MsgServer
+	queryClient types.QueryClient
+	atom        sdk.Coins
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.FeeGrantKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.queryClient = types.NewQueryClient(queryHelper)
+	suite.msgSrvr = keeper.NewMsgServerImpl(app.FeeGrantKeeper)
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 4, sdk.NewInt(30000000))
+	suite.atom = sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(555)))
+}
+
+func (suite *KeeperTestSuite) TestKeeperCrud() {
+	app, ctx, addrs := suite.app, suite.ctx, suite.addrs
+
+	// some helpers
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
+	exp := ctx.BlockTime().AddDate(1, 0, 0)
+	basic := &types.BasicAllowance{SpendLimit: suite.atom, Expiration: &exp}
+	basic2 := &types.BasicAllowance{SpendLimit: eth, Expiration: &exp}
+
+	// let's set up some initial state here
+	suite.Require().NoError(app.FeeGrantKeeper.GrantAllowance(ctx, addrs[0], addrs[1], basic))
+	suite.Require().NoError(app.FeeGrantKeeper.GrantAllowance(ctx, addrs[0], addrs[2], basic2))
+	suite.Require().NoError(app.FeeGrantKeeper.GrantAllowance(ctx, addrs[1], addrs[2], basic))
+	suite.Require().NoError(app.FeeGrantKeeper.GrantAllowance(ctx, addrs[1], addrs[3], basic))
+	suite.Require().NoError(app.FeeGrantKeeper.GrantAllowance(ctx, addrs[3], addrs[0], basic2))
+
+	// revoke one, and overwrite another
+	_, err := suite.msgSrvr.RevokeAllowance(sdk.WrapSDKContext(ctx), &types.MsgRevokeAllowance{Granter: addrs[1], Grantee: addrs[2]})
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.RevokeAllowance(sdk.WrapSDKContext(ctx), &types.MsgRevokeAllowance{Granter: addrs[1], Grantee: addrs[2]})
+	suite.Require().Error(err)
+
+	suite.Require().NoError(app.FeeGrantKeeper.GrantAllowance(ctx, addrs[0], addrs[2], basic))
+
+	cases := map[string]struct {
+		grantee   sdk.AccAddress
+		granter   sdk.AccAddress
+		allowance types.FeeAllowanceI
+	}{
+		"addr revoked":           {granter: addrs[1], grantee: addrs[2]},
+		"addr revoked and added": {granter: addrs[0], grantee: addrs[2], allowance: basic},
+		"addr never there":       {granter: addrs[2], grantee: addrs[0]},
+		"addr modified":          {granter: addrs[0], grantee: addrs[1], allowance: basic},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		suite.Run(name, func() {
+			allow, _ := app.FeeGrantKeeper.GetAllowance(ctx, tc.granter, tc.grantee)
+			if tc.allowance == nil {
+				suite.Nil(allow)
+				return
+			}
+
+			suite.NotNil(allow)
+			suite.Equal(tc.allowance, allow)
+		})
+	}
+
+	var grants []types.Grant
+	suite.Require().NoError(app.FeeGrantKeeper.IterateAllFeeAllowances(ctx, func(grant types.Grant) bool {
+		grants = append(grants, grant)
+		return false
+	}))
+	suite.Require().Len(grants, 4)
+}
+
+func (suite *KeeperTestSuite) TestUseGrantedFee() {
+	app, ctx, addrs := suite.app, suite.ctx, suite.addrs
+
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
+	blockTime := ctx.BlockTime()
+	oneYear := blockTime.AddDate(1, 0, 0)
+
+	future := &types.BasicAllowance{SpendLimit: suite.atom, Expiration: &oneYear}
+
+	// for testing limits of the contract
+	hugeAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 9999))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 1))
+	futureAfterSmall := &types.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 554)),
+		Expiration: &oneYear,
+	}
+
+	// then lots of queries
+	cases := map[string]struct {
+		grantee sdk.AccAddress
+		granter sdk.AccAddress
+		fee     sdk.Coins
+		allowed bool
+		final   types.FeeAllowanceI
+	}{
+		"use entire pot": {
+			granter: addrs[0],
+			grantee: addrs[1],
+			fee:     suite.atom,
+			allowed: true,
+			final:   nil,
+		},
+		"too high": {
+			granter: addrs[0],
+			grantee: addrs[1],
+			fee:     hugeAtom,
+			allowed: false,
+			final:   future,
+		},
+		"use a little": {
+			granter: addrs[0],
+			grantee: addrs[1],
+			fee:     smallAtom,
+			allowed: true,
+			final:   futureAfterSmall,
+		},
+		"wrong denom": {
+			granter: addrs[0],
+			grantee: addrs[1],
+			fee:     eth,
+			allowed: false,
+			final:   future,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		suite.Run(name, func() {
+			ctx, _ := ctx.CacheContext()
+
+			err := app.FeeGrantKeeper.GrantAllowance(ctx, tc.granter, tc.grantee, future)
+			suite.Require().NoError(err)
+
+			err = app.FeeGrantKeeper.UseGrantedFees(ctx, tc.granter, tc.grantee, tc.fee, nil)
+			if tc.allowed {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+
+			loaded, _ := app.FeeGrantKeeper.GetAllowance(ctx, tc.granter, tc.grantee)
+			suite.Equal(tc.final, loaded)
+		})
+	}
+
+	suite.T().Log("verify an expired allowance is removed")
+	expired := blockTime.Add(-time.Hour)
+	err := app.FeeGrantKeeper.GrantAllowance(ctx, addrs[2], addrs[3], &types.BasicAllowance{SpendLimit: suite.atom, Expiration: &expired})
+	suite.Require().NoError(err)
+	err = app.FeeGrantKeeper.UseGrantedFees(ctx, addrs[2], addrs[3], smallAtom, nil)
+	suite.Require().Error(err)
+	_, err = app.FeeGrantKeeper.GetAllowance(ctx, addrs[2], addrs[3])
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGrantAllowanceMsg() {
+	ctx, addrs := suite.ctx, suite.addrs
+
+	msg, err := types.NewMsgGrantAllowance(&types.BasicAllowance{SpendLimit: suite.atom}, addrs[0], addrs[1])
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.GrantAllowance(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+
+	// a second grant for the same pair is rejected
+	_, err = suite.msgSrvr.GrantAllowance(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueries() {
+	ctx, addrs, queryClient := suite.ctx, suite.addrs, suite.queryClient
+
+	_, err := queryClient.Allowance(gocontext.Background(), &types.QueryAllowanceRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.Allowance(gocontext.Background(), &types.QueryAllowanceRequest{Granter: addrs[0], Grantee: addrs[1]})
+	suite.Require().Error(err)
+
+	basic := &types.BasicAllowance{SpendLimit: suite.atom}
+	suite.Require().NoError(suite.app.FeeGrantKeeper.GrantAllowance(ctx, addrs[0], addrs[1], basic))
+	suite.Require().NoError(suite.app.FeeGrantKeeper.GrantAllowance(ctx, addrs[2], addrs[1], basic))
+	suite.Require().NoError(suite.app.FeeGrantKeeper.GrantAllowance(ctx, addrs[0], addrs[2], basic))
+
+	res, err := queryClient.Allowance(gocontext.Background(), &types.QueryAllowanceRequest{Granter: addrs[0], Grantee: addrs[1]})
+	suite.Require().NoError(err)
+	allowance, err := res.Allowance.GetGrant()
+	suite.Require().NoError(err)
+	suite.Require().Equal(basic, allowance)
+
+	_, err = queryClient.Allowances(gocontext.Background(), &types.QueryAllowancesRequest{})
+	suite.Require().Error(err)
+
+	allowances, err := queryClient.Allowances(gocontext.Background(), &types.QueryAllowancesRequest{Grantee: addrs[1]})
+	suite.Require().NoError(err)
+	suite.Require().Len(allowances.Allowances, 2)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,142 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func TestBasicFeeValidAllow(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 10))
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	bigAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 512))
+	now := time.Now().UTC()
+	oneHour := now.Add(1 * time.Hour)
+
+	cases := map[string]struct {
+		allowance *types.BasicAllowance
+		// all other checks are ignored if valid=false
+		fee       sdk.Coins
+		blockTime time.Time
+		valid     bool
+		accept    bool
+		remove    bool
+		remains   sdk.Coins
+	}{
+		"empty": {
+			allowance: &types.BasicAllowance{},
+			accept:    true,
+		},
+		"small fee without expire": {
+			allowance: &types.BasicAllowance{
+				SpendLimit: atom,
+			},
+			fee:     smallAtom,
+			accept:  true,
+			remove:  false,
+			remains: leftAtom,
+		},
+		"all fee without expire": {
+			allowance: &types.BasicAllowance{
+				SpendLimit: smallAtom,
+			},
+			fee:    smallAtom,
+			accept: true,
+			remove: true,
+		},
+		"wrong fee": {
+			allowance: &types.BasicAllowance{
+				SpendLimit: smallAtom,
+			},
+			fee:    eth,
+			accept: false,
+		},
+		"non-expired": {
+			allowance: &types.BasicAllowance{
+				SpendLimit: atom,
+				Expiration: &oneHour,
+			},
+			valid:     true,
+			fee:       smallAtom,
+			blockTime: now,
+			accept:    true,
+			remove:    false,
+			remains:   leftAtom,
+		},
+		"expired": {
+			allowance: &types.BasicAllowance{
+				SpendLimit: atom,
+				Expiration: &now,
+			},
+			valid:     true,
+			fee:       smallAtom,
+			blockTime: oneHour,
+			accept:    false,
+			remove:    true,
+		},
+		"fee more than allowed": {
+			allowance: &types.BasicAllowance{
+				SpendLimit: atom,
+				Expiration: &oneHour,
+			},
+			valid:     true,
+			fee:       bigAtom,
+			blockTime: now,
+			accept:    false,
+		},
+		"with out spend limit": {
+			allowance: &types.BasicAllowance{
+				Expiration: &oneHour,
+			},
+			valid:     true,
+			fee:       bigAtom,
+			blockTime: now,
+			accept:    true,
+		},
+		"expired no spend limit": {
+			allowance: &types.BasicAllowance{
+				Expiration: &now,
+			},
+			valid:     true,
+			fee:       bigAtom,
+			blockTime: oneHour,
+			accept:    false,
+			remove:    true,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			err := tc.allowance.ValidateBasic()
+			require.NoError(t, err)
+
+			ctx := ctx.WithBlockTime(tc.blockTime)
+
+			// now try to deduct
+			removed, err := tc.allowance.Accept(ctx, tc.fee, []sdk.Msg{})
+			if !tc.accept {
+				require.Error(t, err)
+				require.Equal(t, tc.remove, removed)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.remove, removed)
+			if !removed {
+				require.Equal(t, tc.remains, tc.allowance.SpendLimit)
+			}
+		})
+	}
+}
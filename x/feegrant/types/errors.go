@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/feegrant module sentinel errors
+var (
+	// ErrFeeLimitExceeded error if there are not enough allowance to cover the fees
+	ErrFeeLimitExceeded = sdkerrors.Register(ModuleName, 2, "fee limit exceeded")
+	// ErrFeeLimitExpired error if the allowance has expired
+	ErrFeeLimitExpired = sdkerrors.Register(ModuleName, 3, "fee allowance expired")
+	// ErrInvalidDuration error if the Duration is invalid or doesn't match the expiration
+	ErrInvalidDuration = sdkerrors.Register(ModuleName, 4, "invalid duration")
+	// ErrNoAllowance error if there is no allowance for that pair
+	ErrNoAllowance = sdkerrors.Register(ModuleName, 5, "no allowance")
+	// ErrNoMessages error if there is no message
+	ErrNoMessages = sdkerrors.Register(ModuleName, 6, "allowed messages are empty")
+	// ErrMessageNotAllowed error if message is not allowed
+	ErrMessageNotAllowed = sdkerrors.Register(ModuleName, 7, "message not allowed")
+)
@@ -0,0 +1,76 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func TestFilteredFeeValidAllow(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 512))
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	cases := map[string]struct {
+		allowed []string
+		msgs    []sdk.Msg
+		valid   bool
+		accept  bool
+	}{
+		"no allowed messages": {
+			allowed: nil,
+			valid:   false,
+		},
+		"allowed message": {
+			allowed: []string{sendURL},
+			msgs:    []sdk.Msg{&banktypes.MsgSend{}},
+			valid:   true,
+			accept:  true,
+		},
+		"message not allowed": {
+			allowed: []string{sendURL},
+			msgs:    []sdk.Msg{&banktypes.MsgSend{}, &banktypes.MsgMultiSend{}},
+			valid:   true,
+			accept:  false,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			allowance, err := types.NewAllowedMsgAllowance(&types.BasicAllowance{SpendLimit: atom}, tc.allowed)
+			require.NoError(t, err)
+
+			err = allowance.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			ctx, _ := ctx.CacheContext()
+			removed, err := allowance.Accept(ctx, smallAtom, tc.msgs)
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, removed)
+
+			// the inner allowance must reflect the deducted fee
+			inner, err := allowance.GetAllowance()
+			require.NoError(t, err)
+			require.Equal(t, leftAtom, inner.(*types.BasicAllowance).SpendLimit)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ types.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates new GenesisState object
+func NewGenesisState(entries []Grant) *GenesisState {
+	return &GenesisState{
+		Allowances: entries,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state of the feegrant module
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// ValidateGenesis ensures all grants in the genesis state are valid
+func ValidateGenesis(data GenesisState) error {
+	for _, f := range data.Allowances {
+		grant, err := f.GetGrant()
+		if err != nil {
+			return err
+		}
+
+		if err := grant.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, f := range data.Allowances {
+		if err := f.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ codectypes.UnpackInterfacesMessage = &Grant{}
+
+// NewGrant creates a new FeeAllowanceGrant.
+func NewGrant(granter, grantee sdk.AccAddress, feeAllowance FeeAllowanceI) (Grant, error) {
+	msg, ok := feeAllowance.(proto.Message)
+	if !ok {
+		return Grant{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot proto marshal %T", feeAllowance)
+	}
+
+	any, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return Grant{}, err
+	}
+
+	return Grant{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: any,
+	}, nil
+}
+
+// ValidateBasic performs basic validation on
+// FeeAllowanceGrant
+func (a Grant) ValidateBasic() error {
+	if a.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if a.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if a.Grantee.Equals(a.Granter) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fee authorization")
+	}
+
+	f, err := a.GetGrant()
+	if err != nil {
+		return err
+	}
+
+	return f.ValidateBasic()
+}
+
+// GetGrant unpacks allowance
+func (a Grant) GetGrant() (FeeAllowanceI, error) {
+	if a.Allowance == nil {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "allowance is nil")
+	}
+
+	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a Grant) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
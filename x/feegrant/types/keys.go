@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "feegrant"
+
+	// StoreKey is the store key string for feegrant
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for feegrant
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for feegrant
+	QuerierRoute = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	FeeAllowanceKeyPrefix = []byte{0x00} // prefix for each key to a fee allowance
+)
+
+// FeeAllowanceKey is the canonical key to store a grant from granter to grantee
+// We store by grantee first to allow searching by everyone who granted to you:
+// 0x00 | len(grantee) | grantee | len(granter) | granter
+func FeeAllowanceKey(granter, grantee sdk.AccAddress) []byte {
+	key := FeeAllowancePrefixByGrantee(grantee)
+	key = append(key, byte(len(granter)))
+
+	return append(key, granter...)
+}
+
+// FeeAllowancePrefixByGrantee returns a prefix to scan for all grants to this given address.
+func FeeAllowancePrefixByGrantee(grantee sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(FeeAllowanceKeyPrefix)+1+len(grantee))
+	key = append(key, FeeAllowanceKeyPrefix...)
+	key = append(key, byte(len(grantee)))
+
+	return append(key, grantee...)
+}
+
+// ParseAddressesFromFeeAllowanceKey splits a fee allowance store key into its
+// granter and grantee.
+func ParseAddressesFromFeeAllowanceKey(key []byte) (granter, grantee sdk.AccAddress) {
+	key = key[len(FeeAllowanceKeyPrefix):]
+
+	granteeLen := int(key[0])
+	grantee = sdk.AccAddress(key[1 : 1+granteeLen])
+	key = key[1+granteeLen:]
+
+	granterLen := int(key[0])
+	granter = sdk.AccAddress(key[1 : 1+granterLen])
+
+	return granter, grantee
+}
@@ -0,0 +1,134 @@
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// feegrant message types
+const (
+	TypeMsgGrantAllowance  = "grant_allowance"
+	TypeMsgRevokeAllowance = "revoke_allowance"
+)
+
+var (
+	_ sdk.Msg = &MsgGrantAllowance{}
+	_ sdk.Msg = &MsgRevokeAllowance{}
+
+	_ codectypes.UnpackInterfacesMessage = &MsgGrantAllowance{}
+)
+
+// NewMsgGrantAllowance creates a new MsgGrantAllowance.
+func NewMsgGrantAllowance(feeAllowance FeeAllowanceI, granter, grantee sdk.AccAddress) (*MsgGrantAllowance, error) {
+	msg, ok := feeAllowance.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot proto marshal %T", feeAllowance)
+	}
+
+	any, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgGrantAllowance{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: any,
+	}, nil
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgGrantAllowance) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgGrantAllowance) Type() string { return TypeMsgGrantAllowance }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgGrantAllowance) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if msg.Grantee.Equals(msg.Granter) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fee authorization")
+	}
+
+	allowance, err := msg.GetFeeAllowanceI()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgGrantAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgGrantAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetFeeAllowanceI returns unpacked FeeAllowance
+func (msg MsgGrantAllowance) GetFeeAllowanceI() (FeeAllowanceI, error) {
+	if msg.Allowance == nil {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "allowance is nil")
+	}
+
+	allowance, ok := msg.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrantAllowance) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(msg.Allowance, &allowance)
+}
+
+// NewMsgRevokeAllowance returns a message to revoke a fee allowance for a given
+// granter and grantee
+func NewMsgRevokeAllowance(granter, grantee sdk.AccAddress) MsgRevokeAllowance {
+	return MsgRevokeAllowance{Granter: granter, Grantee: grantee}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRevokeAllowance) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRevokeAllowance) Type() string { return TypeMsgRevokeAllowance }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRevokeAllowance) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if msg.Grantee.Equals(msg.Granter) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "addresses must be different")
+	}
+
+	return nil
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRevokeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRevokeAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
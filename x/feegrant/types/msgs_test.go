@@ -0,0 +1,58 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func TestMsgGrantAllowance(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32("cosmos1aeuqja06474dfrj7uqsvukm6rael982kk89mqr")
+	addr2, _ := sdk.AccAddressFromBech32("cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl")
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 2))
+	basic := &types.BasicAllowance{SpendLimit: atom}
+
+	cases := map[string]struct {
+		grantee sdk.AccAddress
+		granter sdk.AccAddress
+		valid   bool
+	}{
+		"valid":              {grantee: addr, granter: addr2, valid: true},
+		"no grantee":         {granter: addr2},
+		"no granter":         {grantee: addr2},
+		"grantee == granter": {grantee: addr, granter: addr},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg, err := types.NewMsgGrantAllowance(basic, tc.granter, tc.grantee)
+			require.NoError(t, err)
+
+			err = msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []sdk.AccAddress{tc.granter}, msg.GetSigners())
+
+			allowance, err := msg.GetFeeAllowanceI()
+			require.NoError(t, err)
+			require.Equal(t, basic, allowance)
+		})
+	}
+}
+
+func TestMsgRevokeAllowance(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32("cosmos1aeuqja06474dfrj7uqsvukm6rael982kk89mqr")
+	addr2, _ := sdk.AccAddressFromBech32("cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl")
+
+	require.NoError(t, types.NewMsgRevokeAllowance(addr2, addr).ValidateBasic())
+	require.Error(t, types.NewMsgRevokeAllowance(nil, addr).ValidateBasic())
+	require.Error(t, types.NewMsgRevokeAllowance(addr2, nil).ValidateBasic())
+	require.Error(t, types.NewMsgRevokeAllowance(addr, addr).ValidateBasic())
+}
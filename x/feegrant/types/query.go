@@ -0,0 +1,28 @@
+package types
+
+import codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryAllowanceResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.Allowance == nil {
+		return nil
+	}
+
+	return m.Allowance.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryAllowancesResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, allowance := range m.Allowances {
+		if err := allowance.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var (
+	_ codectypes.UnpackInterfacesMessage = &QueryAllowanceResponse{}
+	_ codectypes.UnpackInterfacesMessage = &QueryAllowancesResponse{}
+)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -29,11 +30,16 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 			),
 		)
 
+		minDeposit := keeper.GetDepositParams(ctx).MinDeposit
+		if proposal.IsExpedited {
+			minDeposit = keeper.GetDepositParams(ctx).ExpeditedMinDeposit
+		}
+
 		logger.Info(
 			fmt.Sprintf("proposal %d (%s) didn't meet minimum deposit of %s (had only %s); deleted",
 				proposal.ProposalId,
 				proposal.GetTitle(),
-				keeper.GetDepositParams(ctx).MinDeposit,
+				minDeposit,
 				proposal.TotalDeposit,
 			),
 		)
@@ -46,6 +52,37 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 
 		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
 
+		// An expedited proposal which does not pass is converted to a regular
+		// proposal, keeping its deposits and votes, and continues voting until
+		// the end of the regular voting period.
+		if proposal.IsExpedited && !passes {
+			keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+
+			proposal.IsExpedited = false
+			proposal.VotingEndTime = proposal.VotingStartTime.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+
+			keeper.SetProposal(ctx, proposal)
+			keeper.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+
+			logger.Info(
+				fmt.Sprintf(
+					"expedited proposal %d (%s) tallied; result: rejected, converted to a regular proposal",
+					proposal.ProposalId, proposal.GetTitle(),
+				),
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+				),
+			)
+			return false
+		}
+
+		keeper.DeleteVotes(ctx, proposal.ProposalId)
+
 		if burnDeposits {
 			keeper.DeleteDeposits(ctx, proposal.ProposalId)
 		} else {
@@ -56,10 +93,13 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 			handler := keeper.Router().GetRoute(proposal.ProposalRoute())
 			cacheCtx, writeCache := ctx.CacheContext()
 
-			// The proposal handler may execute state mutating logic depending
-			// on the proposal content. If the handler fails, no state mutation
-			// is written and the error message is logged.
+			// The proposal handler and the proposal messages may execute state
+			// mutating logic. If either fails, no state mutation is written and
+			// the error message is logged.
 			err := handler(cacheCtx, proposal.GetContent())
+			if err == nil {
+				err = executeProposalMsgs(cacheCtx, keeper, proposal)
+			}
 			if err == nil {
 				proposal.Status = types.StatusPassed
 				tagValue = types.AttributeValueProposalPassed
@@ -75,6 +115,7 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 				writeCache()
 			} else {
 				proposal.Status = types.StatusFailed
+				proposal.FailedReason = err.Error()
 				tagValue = types.AttributeValueProposalFailed
 				logMsg = fmt.Sprintf("passed, but failed on execution: %s", err)
 			}
@@ -96,13 +137,43 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 			),
 		)
 
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeActiveProposal,
-				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
-				sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
-			),
+		event := sdk.NewEvent(
+			types.EventTypeActiveProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+			sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
 		)
+		if proposal.FailedReason != "" {
+			event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyProposalLog, proposal.FailedReason))
+		}
+
+		ctx.EventManager().EmitEvent(event)
 		return false
 	})
 }
+
+// executeProposalMsgs routes the messages of a passed proposal to their
+// handlers. Their only signer, the gov module account, was checked on
+// submission.
+func executeProposalMsgs(ctx sdk.Context, keeper keeper.Keeper, proposal types.Proposal) error {
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	for i, msg := range msgs {
+		handler := keeper.MsgServiceRouter().Handler(msg)
+		if handler == nil {
+			return sdkerrors.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+		}
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "message %d", i)
+		}
+
+		// emit the events from the executed messages
+		ctx.EventManager().EmitEvents(res.GetEvents())
+	}
+
+	return nil
+}
@@ -4,14 +4,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
@@ -172,7 +175,11 @@ func TestTickPassedDepositPeriod(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	proposalID := types.GetProposalIDFromBytes(res.Data)
+	var proposalData types.MsgSubmitProposalResponse
+	err = proto.Unmarshal(res.Data, &proposalData)
+	require.NoError(t, err)
+
+	proposalID := proposalData.ProposalId
 
 	inactiveQueue = app.GovKeeper.InactiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
 	require.False(t, inactiveQueue.Valid())
@@ -224,7 +231,11 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	proposalID := types.GetProposalIDFromBytes(res.Data)
+	var proposalData types.MsgSubmitProposalResponse
+	err = proto.Unmarshal(res.Data, &proposalData)
+	require.NoError(t, err)
+
+	proposalID := proposalData.ProposalId
 
 	newHeader := ctx.BlockHeader()
 	newHeader.Time = ctx.BlockHeader().Time.Add(time.Duration(1) * time.Second)
@@ -283,7 +294,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.NotNil(t, macc)
 	initialModuleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
 
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))}
@@ -300,7 +311,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	deposits := initialModuleAccCoins.Add(proposal.TotalDeposit...).Add(proposalCoins...)
 	require.True(t, moduleAccCoins.IsEqual(deposits))
 
-	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.OptionYes)
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
@@ -335,7 +346,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// Create a proposal where the handler will pass for the test proposal
 	// because the value of contextKeyBadProposal is true.
 	ctx = ctx.WithValue(contextKeyBadProposal, true)
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)))
@@ -345,7 +356,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.OptionYes)
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
@@ -359,3 +370,142 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// validate that the proposal fails/has been rejected
 	gov.EndBlocker(ctx, app.GovKeeper)
 }
+
+func TestEndBlockerProposalMsgs(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 1, valTokens)
+
+	handler := gov.NewHandler(app.GovKeeper)
+	stakingHandler := staking.NewHandler(app.StakingKeeper)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	govAcct := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+	mintParams := app.MintKeeper.GetParams(ctx)
+	mintParams.BlocksPerYear = 1234
+	updateParams := minttypes.NewMsgUpdateParams(govAcct, mintParams)
+
+	// the gov account cannot send more than the deposits it holds
+	sendTooMuch := banktypes.NewMsgSend(govAcct, addrs[0], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, valTokens.MulRaw(10))))
+
+	testCases := []struct {
+		name           string
+		msgs           []sdk.Msg
+		expectedStatus types.ProposalStatus
+	}{
+		{"failing message reverts all messages", []sdk.Msg{updateParams, sendTooMuch}, types.StatusFailed},
+		{"messages are executed", []sdk.Msg{updateParams}, types.StatusPassed},
+	}
+
+	for _, tc := range testCases {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, tc.msgs, false)
+		require.NoError(t, err, tc.name)
+
+		proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)))
+		_, err = handler(ctx, types.NewMsgDeposit(addrs[0], proposal.ProposalId, proposalCoins))
+		require.NoError(t, err, tc.name)
+
+		err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+		require.NoError(t, err, tc.name)
+
+		newHeader := ctx.BlockHeader()
+		newHeader.Time = ctx.BlockHeader().Time.Add(app.GovKeeper.GetDepositParams(ctx).MaxDepositPeriod).Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+		ctx = ctx.WithBlockHeader(newHeader)
+
+		gov.EndBlocker(ctx, app.GovKeeper)
+
+		proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+		require.True(t, ok, tc.name)
+		require.Equal(t, tc.expectedStatus, proposal.Status, tc.name)
+		require.Equal(t, tc.expectedStatus == types.StatusFailed, proposal.FailedReason != "", tc.name)
+		require.Equal(t, tc.expectedStatus == types.StatusPassed, app.MintKeeper.GetParams(ctx).BlocksPerYear == 1234, tc.name)
+	}
+}
+
+func TestExpeditedProposal(t *testing.T) {
+	testCases := []struct {
+		name            string
+		validatorNoVote bool
+	}{
+		{"expedited proposal passes", false},
+		{"expedited proposal converted to a regular proposal", true},
+	}
+
+	for _, tc := range testCases {
+		app := simapp.Setup(false)
+		ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+		addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.TokensFromConsensusPower(100))
+
+		stakingHandler := staking.NewHandler(app.StakingKeeper)
+
+		header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+		valAddrs := []sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])}
+		createValidators(t, stakingHandler, ctx, valAddrs, []int64{6, 4})
+		staking.EndBlocker(ctx, app.StakingKeeper)
+
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, true)
+		require.NoError(t, err, tc.name)
+		require.True(t, proposal.IsExpedited, tc.name)
+
+		// the min deposit of a regular proposal doesn't start the voting period
+		votingStarted, err := app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[0], app.GovKeeper.GetDepositParams(ctx).MinDeposit)
+		require.NoError(t, err, tc.name)
+		require.False(t, votingStarted, tc.name)
+
+		votingStarted, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[0], app.GovKeeper.GetDepositParams(ctx).ExpeditedMinDeposit)
+		require.NoError(t, err, tc.name)
+		require.True(t, votingStarted, tc.name)
+
+		proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+		require.True(t, ok, tc.name)
+		require.Equal(t, ctx.BlockHeader().Time.Add(app.GovKeeper.GetVotingParams(ctx).ExpeditedVotingPeriod), proposal.VotingEndTime, tc.name)
+
+		// 60% of the voting power votes yes, which is not enough to pass the
+		// expedited threshold if the other validator votes no
+		require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)), tc.name)
+		secondOption := types.OptionYes
+		if tc.validatorNoVote {
+			secondOption = types.OptionNo
+		}
+		require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(secondOption)), tc.name)
+
+		newHeader := ctx.BlockHeader()
+		newHeader.Time = proposal.VotingEndTime
+		ctx = ctx.WithBlockHeader(newHeader)
+
+		gov.EndBlocker(ctx, app.GovKeeper)
+
+		proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+		require.True(t, ok, tc.name)
+
+		if !tc.validatorNoVote {
+			require.Equal(t, types.StatusPassed, proposal.Status, tc.name)
+			continue
+		}
+
+		// the proposal continues as a regular proposal, keeping its votes
+		require.Equal(t, types.StatusVotingPeriod, proposal.Status, tc.name)
+		require.False(t, proposal.IsExpedited, tc.name)
+		require.Equal(t, proposal.VotingStartTime.Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod), proposal.VotingEndTime, tc.name)
+		require.Len(t, app.GovKeeper.GetVotes(ctx, proposal.ProposalId), 2, tc.name)
+
+		newHeader = ctx.BlockHeader()
+		newHeader.Time = proposal.VotingEndTime
+		ctx = ctx.WithBlockHeader(newHeader)
+
+		gov.EndBlocker(ctx, app.GovKeeper)
+
+		// 60% of the voting power passes the regular threshold
+		proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+		require.True(t, ok, tc.name)
+		require.Equal(t, types.StatusPassed, proposal.Status, tc.name)
+		require.Empty(t, app.GovKeeper.GetVotes(ctx, proposal.ProposalId), tc.name)
+	}
+}
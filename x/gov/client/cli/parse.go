@@ -7,6 +7,9 @@ import (
 
 	"github.com/spf13/pflag"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 )
 
@@ -42,3 +45,24 @@ func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 
 	return proposal, nil
 }
+
+// parseProposalMsgs decodes the JSON encoded proposal messages, each of them
+// being an Any with its "@type" URL.
+func parseProposalMsgs(clientCtx client.Context, rawMsgs []json.RawMessage) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(rawMsgs))
+	for i, rawMsg := range rawMsgs {
+		var any codectypes.Any
+		if err := clientCtx.JSONMarshaler.UnmarshalJSON(rawMsg, &any); err != nil {
+			return nil, fmt.Errorf("msg %d: %w", i, err)
+		}
+
+		var msg sdk.Msg
+		if err := clientCtx.InterfaceRegistry.UnpackAny(&any, &msg); err != nil {
+			return nil, fmt.Errorf("msg %d: %w", i, err)
+		}
+
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
@@ -1,11 +1,18 @@
 package cli
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func TestParseSubmitProposalFlags(t *testing.T) {
@@ -14,7 +21,8 @@ func TestParseSubmitProposalFlags(t *testing.T) {
   "title": "Test Proposal",
   "description": "My awesome proposal",
   "type": "Text",
-  "deposit": "1000test"
+  "deposit": "1000test",
+  "messages": [{"@type": "/cosmos.bank.v1beta1.MsgUpdateParams"}]
 }
 `)
 	t.Cleanup(cleanup1)
@@ -42,6 +50,7 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	require.Equal(t, "My awesome proposal", proposal1.Description)
 	require.Equal(t, "Text", proposal1.Type)
 	require.Equal(t, "1000test", proposal1.Deposit)
+	require.Len(t, proposal1.Messages, 1)
 
 	// flags that can't be used with --proposal
 	for _, incompatibleFlag := range ProposalFlags {
@@ -70,3 +79,30 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	err = badJSON.Close()
 	require.Nil(t, err, "unexpected error")
 }
+
+func TestParseProposalMsgs(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	clientCtx := client.Context{}.WithJSONMarshaler(cdc).WithInterfaceRegistry(interfaceRegistry)
+
+	authority := authtypes.NewModuleAddress("gov")
+	updateParams := banktypes.NewMsgUpdateParams(authority, banktypes.NewParams(false, nil))
+
+	any, err := codectypes.NewAnyWithValue(updateParams)
+	require.NoError(t, err)
+	bz, err := cdc.MarshalJSON(any)
+	require.NoError(t, err)
+
+	msgs, err := parseProposalMsgs(clientCtx, []json.RawMessage{bz})
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{updateParams}, msgs)
+
+	// unknown type URL
+	_, err = parseProposalMsgs(clientCtx, []json.RawMessage{json.RawMessage(`{"@type": "/cosmos.unknown.MsgUnknown"}`)})
+	require.Error(t, err)
+
+	// not an Any
+	_, err = parseProposalMsgs(clientCtx, []json.RawMessage{json.RawMessage(`"bad json"`)})
+	require.Error(t, err)
+}
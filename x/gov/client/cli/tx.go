@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -26,6 +27,7 @@ const (
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
 	FlagProposal     = "proposal"
+	FlagExpedited    = "expedited"
 )
 
 type proposal struct {
@@ -33,6 +35,7 @@ type proposal struct {
 	Description string
 	Type        string
 	Deposit     string
+	Messages    []json.RawMessage
 }
 
 // ProposalFlags defines the core required fields of a proposal. It is used to
@@ -68,6 +71,7 @@ func NewTxCmd(propCmds []*cobra.Command) *cobra.Command {
 	govTxCmd.AddCommand(
 		NewCmdDeposit(),
 		NewCmdVote(),
+		NewCmdWeightedVote(),
 		cmdSubmitProp,
 	)
 
@@ -82,6 +86,9 @@ func NewCmdSubmitProposal() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Submit a proposal along with an initial deposit.
 Proposal title, description, type and deposit can be given directly or through a proposal JSON file.
+The messages to execute if the proposal passes can only be given through a proposal JSON file. Their
+only signer must be the gov module account. An expedited proposal, submitted with the --expedited flag,
+needs a higher deposit and threshold but has a shorter voting period.
 
 Example:
 $ %s tx gov submit-proposal --proposal="path/to/proposal.json" --from mykey
@@ -98,6 +105,22 @@ Where proposal.json contains:
 Which is equivalent to:
 
 $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from mykey
+
+A proposal updating the x/bank params would use a proposal.json containing:
+
+{
+  "title": "Update bank params",
+  "description": "Disable sending coins by default",
+  "type": "Text",
+  "deposit": "10test",
+  "messages": [
+    {
+      "@type": "/cosmos.bank.v1beta1.MsgUpdateParams",
+      "authority": "<gov module account address>",
+      "params": {"send_enabled": [], "default_send_enabled": false}
+    }
+  ]
+}
 `,
 				version.AppName, version.AppName,
 			),
@@ -126,6 +149,17 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 				return fmt.Errorf("invalid message: %w", err)
 			}
 
+			msgs, err := parseProposalMsgs(clientCtx, proposal.Messages)
+			if err != nil {
+				return fmt.Errorf("failed to parse proposal messages: %w", err)
+			}
+
+			if err = msg.SetMsgs(msgs); err != nil {
+				return fmt.Errorf("invalid message: %w", err)
+			}
+
+			msg.IsExpedited, _ = cmd.Flags().GetBool(FlagExpedited)
+
 			if err = msg.ValidateBasic(); err != nil {
 				return fmt.Errorf("message validation failed: %w", err)
 			}
@@ -139,6 +173,7 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 	cmd.Flags().String(FlagProposalType, "", "The proposal Type")
 	cmd.Flags().String(FlagDeposit, "", "The proposal deposit")
 	cmd.Flags().String(FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+	cmd.Flags().Bool(FlagExpedited, false, "Submit an expedited proposal")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -251,3 +286,59 @@ $ %s tx gov vote 1 yes --from mykey
 
 	return cmd
 }
+
+// NewCmdWeightedVote implements creating a new weighted vote command.
+func NewCmdWeightedVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "weighted-vote [proposal-id] [weighted-options]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an active proposal, options: yes/no/no_with_veto/abstain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a vote for an active proposal with the voting power split
+across several options. The weights must sum to 1. You can find the
+proposal-id by running "%s query gov proposals".
+
+
+Example:
+$ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// Get voter address
+			from := clientCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// Figure out which vote options user chose
+			options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+
+			// Build vote message and run basic validation
+			msg := types.NewMsgVoteWeighted(from, proposalID, options)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
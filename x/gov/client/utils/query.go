@@ -78,8 +78,9 @@ func QueryDepositsByTxQuery(clientCtx client.Context, params types.QueryProposal
 // marshalled result or any error that occurred.
 func QueryVotesByTxQuery(clientCtx client.Context, params types.QueryProposalVotesParams) ([]byte, error) {
 	var (
+		// the proposal_vote event is emitted by both MsgVote and MsgVoteWeighted,
+		// so the message action is not part of the query
 		events = []string{
-			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
 			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
 		}
 		votes      []types.Vote
@@ -95,14 +96,8 @@ func QueryVotesByTxQuery(clientCtx client.Context, params types.QueryProposalVot
 		nextTxPage++
 		for _, info := range searchResult.Txs {
 			for _, msg := range info.GetTx().GetMsgs() {
-				if msg.Type() == types.TypeMsgVote {
-					voteMsg := msg.(*types.MsgVote)
-
-					votes = append(votes, types.Vote{
-						Voter:      voteMsg.Voter,
-						ProposalId: params.ProposalID,
-						Option:     voteMsg.Option,
-					})
+				if vote, ok := voteFromMsg(msg, params.ProposalID); ok {
+					votes = append(votes, vote)
 				}
 			}
 		}
@@ -128,7 +123,6 @@ func QueryVotesByTxQuery(clientCtx client.Context, params types.QueryProposalVot
 // QueryVoteByTxQuery will query for a single vote via a direct txs tags query.
 func QueryVoteByTxQuery(clientCtx client.Context, params types.QueryVoteParams) ([]byte, error) {
 	events := []string{
-		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
 		fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
 		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Voter.String())),
 	}
@@ -142,15 +136,7 @@ func QueryVoteByTxQuery(clientCtx client.Context, params types.QueryVoteParams)
 	for _, info := range searchResult.Txs {
 		for _, msg := range info.GetTx().GetMsgs() {
 			// there should only be a single vote under the given conditions
-			if msg.Type() == types.TypeMsgVote {
-				voteMsg := msg.(*types.MsgVote)
-
-				vote := types.Vote{
-					Voter:      voteMsg.Voter,
-					ProposalId: params.ProposalID,
-					Option:     voteMsg.Option,
-				}
-
+			if vote, ok := voteFromMsg(msg, params.ProposalID); ok {
 				bz, err := clientCtx.JSONMarshaler.MarshalJSON(vote)
 				if err != nil {
 					return nil, err
@@ -164,6 +150,21 @@ func QueryVoteByTxQuery(clientCtx client.Context, params types.QueryVoteParams)
 	return nil, fmt.Errorf("address '%s' did not vote on proposalID %d", params.Voter, params.ProposalID)
 }
 
+// voteFromMsg builds the vote cast by a MsgVote or MsgVoteWeighted. It
+// returns false if the message is not a vote.
+func voteFromMsg(msg sdk.Msg, proposalID uint64) (types.Vote, bool) {
+	switch msg := msg.(type) {
+	case *types.MsgVote:
+		return types.NewVote(proposalID, msg.Voter, types.NewNonSplitVoteOption(msg.Option)), true
+
+	case *types.MsgVoteWeighted:
+		return types.NewVote(proposalID, msg.Voter, msg.Options), true
+
+	default:
+		return types.Vote{}, false
+	}
+}
+
 // QueryDepositByTxQuery will query for a single deposit via a direct txs tags
 // query.
 func QueryDepositByTxQuery(clientCtx client.Context, params types.QueryDepositParams) ([]byte, error) {
@@ -84,8 +84,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				{Msgs: acc2Msgs[:1]},
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc1, types.OptionYes),
-				types.NewVote(0, acc2, types.OptionYes)},
+				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes)),
+				types.NewVote(0, acc2, types.NewNonSplitVoteOption(types.OptionYes))},
 		},
 
 		{
@@ -97,8 +97,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				{Msgs: acc2Msgs},
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc1, types.OptionYes),
-				types.NewVote(0, acc1, types.OptionYes)},
+				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes)),
+				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes))},
 		},
 		{
 			description: "2MsgPerTx2Chunk",
@@ -109,8 +109,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				{Msgs: acc2Msgs},
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc2, types.OptionYes),
-				types.NewVote(0, acc2, types.OptionYes)},
+				types.NewVote(0, acc2, types.NewNonSplitVoteOption(types.OptionYes)),
+				types.NewVote(0, acc2, types.NewNonSplitVoteOption(types.OptionYes))},
 		},
 		{
 			description: "IncompleteSearchTx",
@@ -119,7 +119,7 @@ func TestGetPaginatedVotes(t *testing.T) {
 			txs: []authtypes.StdTx{
 				{Msgs: acc1Msgs[:1]},
 			},
-			votes: []types.Vote{types.NewVote(0, acc1, types.OptionYes)},
+			votes: []types.Vote{types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes))},
 		},
 		{
 			description: "InvalidPage",
@@ -129,6 +129,7 @@ func (q Keeper) Votes(c context.Context, req *types.QueryVotesRequest) (*types.Q
 		if err := q.cdc.UnmarshalBinaryBare(value, &vote); err != nil {
 			return err
 		}
+		populateVoteOptions(&vote)
 
 		votes = append(votes, vote)
 		return nil
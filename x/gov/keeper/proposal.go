@@ -9,12 +9,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
-// SubmitProposal create new proposal given a content
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (types.Proposal, error) {
+// SubmitProposal create new proposal given a content, the messages to
+// execute if it passes and whether it is expedited
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content, messages []sdk.Msg, isExpedited bool) (types.Proposal, error) {
 	if !keeper.router.HasRoute(content.ProposalRoute()) {
 		return types.Proposal{}, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
 	}
 
+	if err := keeper.validateProposalMsgs(ctx, messages); err != nil {
+		return types.Proposal{}, err
+	}
+
 	// Execute the proposal content in a cache-wrapped context to validate the
 	// actual parameter changes before the proposal proceeds through the
 	// governance process. State is not persisted.
@@ -37,6 +42,12 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 		return types.Proposal{}, err
 	}
 
+	if err := proposal.SetMsgs(messages); err != nil {
+		return types.Proposal{}, err
+	}
+
+	proposal.IsExpedited = isExpedited
+
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
 	keeper.SetProposalID(ctx, proposalID+1)
@@ -51,6 +62,29 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	return proposal, nil
 }
 
+// validateProposalMsgs checks that every proposal message is valid, is signed
+// by the gov module account only and can be routed by the Msg service router.
+func (keeper Keeper) validateProposalMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	govAcct := keeper.authKeeper.GetModuleAddress(types.ModuleName)
+
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidProposalMsg, "msg %d: %s", i, err)
+		}
+
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(govAcct) {
+			return sdkerrors.Wrapf(types.ErrInvalidSigner, "msg %d", i)
+		}
+
+		if keeper.msgServiceRouter.Handler(msg) == nil {
+			return sdkerrors.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+		}
+	}
+
+	return nil
+}
+
 // GetProposal get proposal from store by ProposalID
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (types.Proposal, bool) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -183,6 +217,10 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal types.Proposal) {
 	proposal.VotingStartTime = ctx.BlockHeader().Time
 	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	if proposal.IsExpedited {
+		votingPeriod = keeper.GetVotingParams(ctx).ExpeditedVotingPeriod
+	}
+
 	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
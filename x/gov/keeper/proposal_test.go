@@ -11,8 +11,11 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
 func TestGetSetProposal(t *testing.T) {
@@ -20,7 +23,7 @@ func TestGetSetProposal(t *testing.T) {
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	app.GovKeeper.SetProposal(ctx, proposal)
@@ -35,7 +38,7 @@ func TestActivateVotingPeriod(t *testing.T) {
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, false)
 	require.NoError(t, err)
 
 	require.True(t, proposal.VotingStartTime.Equal(time.Time{}))
@@ -78,11 +81,51 @@ func TestSubmitProposal(t *testing.T) {
 	}
 
 	for i, tc := range testCases {
-		_, err := app.GovKeeper.SubmitProposal(ctx, tc.content)
+		_, err := app.GovKeeper.SubmitProposal(ctx, tc.content, nil, false)
 		require.True(t, errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
 
+func TestSubmitProposalMsgs(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	govAcct := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+	addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000))
+
+	validMsg := minttypes.NewMsgUpdateParams(govAcct, minttypes.DefaultParams())
+	invalidParams := minttypes.DefaultParams()
+	invalidParams.MintDenom = ""
+
+	testCases := []struct {
+		name        string
+		msgs        []sdk.Msg
+		expectedErr error
+	}{
+		{"no messages", nil, nil},
+		{"gov signed message", []sdk.Msg{validMsg}, nil},
+		{"invalid message", []sdk.Msg{minttypes.NewMsgUpdateParams(govAcct, invalidParams)}, types.ErrInvalidProposalMsg},
+		{"message not signed by gov", []sdk.Msg{minttypes.NewMsgUpdateParams(addrs[0], minttypes.DefaultParams())}, types.ErrInvalidSigner},
+		{"one message not signed by gov", []sdk.Msg{validMsg, banktypes.NewMsgSend(addrs[0], govAcct, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))}, types.ErrInvalidSigner},
+		{"unroutable message", []sdk.Msg{testdata.NewTestMsg(govAcct)}, types.ErrUnroutableProposalMsg},
+	}
+
+	for _, tc := range testCases {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, tc.msgs, false)
+		if tc.expectedErr != nil {
+			require.True(t, errors.Is(err, tc.expectedErr), "%s; got: %v, expected: %v", tc.name, err, tc.expectedErr)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		stored, found := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+		require.True(t, found)
+		msgs, err := stored.GetMsgs()
+		require.NoError(t, err)
+		require.Len(t, msgs, len(tc.msgs), tc.name)
+	}
+}
+
 func TestGetProposalsFiltered(t *testing.T) {
 	proposalID := uint64(1)
 	app := simapp.Setup(false)
@@ -101,7 +144,7 @@ func TestGetProposalsFiltered(t *testing.T) {
 
 			if i%2 == 0 {
 				d := types.NewDeposit(proposalID, addr1, nil)
-				v := types.NewVote(proposalID, addr1, types.OptionYes)
+				v := types.NewVote(proposalID, addr1, types.NewNonSplitVoteOption(types.OptionYes))
 				app.GovKeeper.SetDeposit(ctx, d)
 				app.GovKeeper.SetVote(ctx, v)
 			}
@@ -161,7 +161,7 @@ func TestQueries(t *testing.T) {
 	depositParams, _, _ := getQueriedParams(t, ctx, appCodec, querier)
 
 	// TestAddrs[0] proposes (and deposits) proposals #1 and #2
-	proposal1, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal1, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, false)
 	require.NoError(t, err)
 	deposit1 := types.NewDeposit(proposal1.ProposalId, TestAddrs[0], oneCoins)
 	_, err = app.GovKeeper.AddDeposit(ctx, deposit1.ProposalId, deposit1.Depositor, deposit1.Amount)
@@ -169,7 +169,7 @@ func TestQueries(t *testing.T) {
 
 	proposal1.TotalDeposit = proposal1.TotalDeposit.Add(deposit1.Amount...)
 
-	proposal2, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal2, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, false)
 	require.NoError(t, err)
 	deposit2 := types.NewDeposit(proposal2.ProposalId, TestAddrs[0], consCoins)
 	_, err = app.GovKeeper.AddDeposit(ctx, deposit2.ProposalId, deposit2.Depositor, deposit2.Amount)
@@ -178,7 +178,7 @@ func TestQueries(t *testing.T) {
 	proposal2.TotalDeposit = proposal2.TotalDeposit.Add(deposit2.Amount...)
 
 	// TestAddrs[1] proposes (and deposits) on proposal #3
-	proposal3, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal3, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, false)
 	require.NoError(t, err)
 	deposit3 := types.NewDeposit(proposal3.ProposalId, TestAddrs[1], oneCoins)
 	_, err = app.GovKeeper.AddDeposit(ctx, deposit3.ProposalId, deposit3.Depositor, deposit3.Amount)
@@ -240,13 +240,13 @@ func TestQueries(t *testing.T) {
 	require.Equal(t, proposal3, proposals[1])
 
 	// Addrs[0] votes on proposals #2 & #3
-	vote1 := types.NewVote(proposal2.ProposalId, TestAddrs[0], types.OptionYes)
-	vote2 := types.NewVote(proposal3.ProposalId, TestAddrs[0], types.OptionYes)
+	vote1 := types.NewVote(proposal2.ProposalId, TestAddrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+	vote2 := types.NewVote(proposal3.ProposalId, TestAddrs[0], types.NewNonSplitVoteOption(types.OptionYes))
 	app.GovKeeper.SetVote(ctx, vote1)
 	app.GovKeeper.SetVote(ctx, vote2)
 
 	// Addrs[1] votes on proposal #3
-	vote3 := types.NewVote(proposal3.ProposalId, TestAddrs[1], types.OptionYes)
+	vote3 := types.NewVote(proposal3.ProposalId, TestAddrs[1], types.NewNonSplitVoteOption(types.OptionYes))
 	app.GovKeeper.SetVote(ctx, vote3)
 
 	// Test query voted by TestAddrs[0]
@@ -9,7 +9,8 @@ import (
 // TODO: Break into several smaller functions for clarity
 
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
-// voters
+// voters. Votes are not deleted here. An expedited proposal that does not pass keeps its votes for the
+// regular voting period, and EndBlocker deletes them once the proposal is finalized.
 func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes bool, burnDeposits bool, tallyResults types.TallyResult) {
 	results := make(map[types.VoteOption]sdk.Dec)
 	results[types.OptionYes] = sdk.ZeroDec()
@@ -27,7 +28,7 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 			validator.GetBondedTokens(),
 			validator.GetDelegatorShares(),
 			sdk.ZeroDec(),
-			types.OptionEmpty,
+			types.WeightedVoteOptions{},
 		)
 
 		return false
@@ -37,7 +38,7 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 		// if validator, just record it in the map
 		valAddrStr := sdk.ValAddress(vote.Voter).String()
 		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Option
+			val.Vote = vote.Options
 			currValidators[valAddrStr] = val
 		}
 
@@ -54,20 +55,22 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 				delegatorShare := delegation.GetShares().Quo(val.DelegatorShares)
 				votingPower := delegatorShare.MulInt(val.BondedTokens)
 
-				results[vote.Option] = results[vote.Option].Add(votingPower)
+				for _, option := range vote.Options {
+					subPower := votingPower.Mul(option.Weight)
+					results[option.Option] = results[option.Option].Add(subPower)
+				}
 				totalVotingPower = totalVotingPower.Add(votingPower)
 			}
 
 			return false
 		})
 
-		keeper.deleteVote(ctx, vote.ProposalId, vote.Voter)
 		return false
 	})
 
 	// iterate over the validators again to tally their voting power
 	for _, val := range currValidators {
-		if val.Vote == types.OptionEmpty {
+		if len(val.Vote) == 0 {
 			continue
 		}
 
@@ -75,13 +78,21 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 		fractionAfterDeductions := sharesAfterDeductions.Quo(val.DelegatorShares)
 		votingPower := fractionAfterDeductions.MulInt(val.BondedTokens)
 
-		results[val.Vote] = results[val.Vote].Add(votingPower)
+		for _, option := range val.Vote {
+			subPower := votingPower.Mul(option.Weight)
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
 	tallyParams := keeper.GetTallyParams(ctx)
 	tallyResults = types.NewTallyResultFromMap(results)
 
+	threshold := tallyParams.Threshold
+	if proposal.IsExpedited {
+		threshold = tallyParams.ExpeditedThreshold
+	}
+
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
 	// If there is no staked coins, the proposal fails
 	if keeper.sk.TotalBondedTokens(ctx).IsZero() {
@@ -104,8 +115,8 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 		return false, true, tallyResults
 	}
 
-	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(tallyParams.Threshold) {
+	// If more than 1/2 (2/3 for expedited proposals) of non-abstaining voters vote Yes, proposal passes
+	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(threshold) {
 		return true, false, tallyResults
 	}
 
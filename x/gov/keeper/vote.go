@@ -9,7 +9,7 @@ import (
 )
 
 // AddVote adds a vote on a specific proposal
-func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, option types.VoteOption) error {
+func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions) error {
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
 		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
@@ -18,17 +18,17 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
 
-	if !types.ValidVoteOption(option) {
-		return sdkerrors.Wrap(types.ErrInvalidVote, option.String())
+	if err := types.ValidateWeightedVoteOptions(options); err != nil {
+		return err
 	}
 
-	vote := types.NewVote(proposalID, voterAddr, option)
+	vote := types.NewVote(proposalID, voterAddr, options)
 	keeper.SetVote(ctx, vote)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeProposalVote,
-			sdk.NewAttribute(types.AttributeKeyOption, option.String()),
+			sdk.NewAttribute(types.AttributeKeyOption, options.String()),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
 		),
 	)
@@ -63,6 +63,8 @@ func (keeper Keeper) GetVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 	}
 
 	keeper.cdc.MustUnmarshalBinaryBare(bz, &vote)
+	populateVoteOptions(&vote)
+
 	return vote, true
 }
 
@@ -82,6 +84,7 @@ func (keeper Keeper) IterateAllVotes(ctx sdk.Context, cb func(vote types.Vote) (
 	for ; iterator.Valid(); iterator.Next() {
 		var vote types.Vote
 		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &vote)
+		populateVoteOptions(&vote)
 
 		if cb(vote) {
 			break
@@ -98,6 +101,7 @@ func (keeper Keeper) IterateVotes(ctx sdk.Context, proposalID uint64, cb func(vo
 	for ; iterator.Valid(); iterator.Next() {
 		var vote types.Vote
 		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &vote)
+		populateVoteOptions(&vote)
 
 		if cb(vote) {
 			break
@@ -110,3 +114,19 @@ func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sd
 	store := ctx.KVStore(keeper.storeKey)
 	store.Delete(types.VoteKey(proposalID, voterAddr))
 }
+
+// DeleteVotes deletes all the votes of a proposal from the store
+func (keeper Keeper) DeleteVotes(ctx sdk.Context, proposalID uint64) {
+	keeper.IterateVotes(ctx, proposalID, func(vote types.Vote) bool {
+		keeper.deleteVote(ctx, vote.ProposalId, vote.Voter)
+		return false
+	})
+}
+
+// populateVoteOptions converts a vote stored before weighted voting, which
+// only carries the deprecated Option, into a single option with weight 1.
+func populateVoteOptions(vote *types.Vote) {
+	if len(vote.Options) == 0 && vote.Option != types.OptionEmpty { //nolint:staticcheck
+		*vote = types.NewVote(vote.ProposalId, vote.Voter, types.NewNonSplitVoteOption(vote.Option)) //nolint:staticcheck
+	}
+}
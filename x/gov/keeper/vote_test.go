@@ -18,22 +18,22 @@ func TestVotes(t *testing.T) {
 	addrs := simapp.AddTestAddrsIncremental(app, ctx, 5, sdk.NewInt(30000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 
 	var invalidOption types.VoteOption = 0x10
 
-	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.OptionYes), "proposal not on voting period")
-	require.Error(t, app.GovKeeper.AddVote(ctx, 10, addrs[0], types.OptionYes), "invalid proposal ID")
+	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)), "proposal not on voting period")
+	require.Error(t, app.GovKeeper.AddVote(ctx, 10, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)), "invalid proposal ID")
 
 	proposal.Status = types.StatusVotingPeriod
 	app.GovKeeper.SetProposal(ctx, proposal)
 
-	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], invalidOption), "invalid option")
+	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(invalidOption)), "invalid option")
 
 	// Test first vote
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.OptionAbstain))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionAbstain)))
 	vote, found := app.GovKeeper.GetVote(ctx, proposalID, addrs[0])
 	require.True(t, found)
 	require.Equal(t, addrs[0], vote.Voter)
@@ -41,7 +41,7 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, types.OptionAbstain, vote.Option)
 
 	// Test change of vote
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.OptionYes))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
 	vote, found = app.GovKeeper.GetVote(ctx, proposalID, addrs[0])
 	require.True(t, found)
 	require.Equal(t, addrs[0], vote.Voter)
@@ -49,17 +49,53 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, types.OptionYes, vote.Option)
 
 	// Test second vote
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.OptionNoWithVeto))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionNoWithVeto)))
 	vote, found = app.GovKeeper.GetVote(ctx, proposalID, addrs[1])
 	require.True(t, found)
 	require.Equal(t, addrs[1], vote.Voter)
 	require.Equal(t, proposalID, vote.ProposalId)
 	require.Equal(t, types.OptionNoWithVeto, vote.Option)
 
+	// Test invalid weighted votes
+	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], types.WeightedVoteOptions{}), "empty options")
+	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], types.WeightedVoteOptions{
+		{Option: types.OptionYes, Weight: sdk.NewDecWithPrec(6, 1)},
+		{Option: types.OptionNo, Weight: sdk.NewDecWithPrec(3, 1)},
+	}), "weights not summing to 1")
+	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], types.WeightedVoteOptions{
+		{Option: types.OptionYes, Weight: sdk.NewDecWithPrec(5, 1)},
+		{Option: types.OptionYes, Weight: sdk.NewDecWithPrec(5, 1)},
+	}), "duplicated option")
+	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], types.WeightedVoteOptions{
+		{Option: types.OptionYes, Weight: sdk.NewDecWithPrec(15, 1)},
+		{Option: types.OptionNo, Weight: sdk.NewDecWithPrec(-5, 1)},
+	}), "negative weight")
+
+	// Test weighted vote
+	weightedOptions := types.WeightedVoteOptions{
+		{Option: types.OptionYes, Weight: sdk.NewDecWithPrec(60, 2)},
+		{Option: types.OptionNo, Weight: sdk.NewDecWithPrec(30, 2)},
+		{Option: types.OptionAbstain, Weight: sdk.NewDecWithPrec(5, 2)},
+		{Option: types.OptionNoWithVeto, Weight: sdk.NewDecWithPrec(5, 2)},
+	}
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], weightedOptions))
+	vote, found = app.GovKeeper.GetVote(ctx, proposalID, addrs[2])
+	require.True(t, found)
+	require.Equal(t, addrs[2], vote.Voter)
+	require.Equal(t, proposalID, vote.ProposalId)
+	require.Equal(t, weightedOptions, vote.Options)
+	require.Equal(t, types.OptionEmpty, vote.Option)
+
+	// Test vote stored before weighted voting
+	app.GovKeeper.SetVote(ctx, types.Vote{ProposalId: proposalID, Voter: addrs[3], Option: types.OptionNo})
+	vote, found = app.GovKeeper.GetVote(ctx, proposalID, addrs[3])
+	require.True(t, found)
+	require.Equal(t, types.NewVote(proposalID, addrs[3], types.NewNonSplitVoteOption(types.OptionNo)), vote)
+
 	// Test vote iterator
 	// NOTE order of deposits is determined by the addresses
 	votes := app.GovKeeper.GetAllVotes(ctx)
-	require.Len(t, votes, 2)
+	require.Len(t, votes, 4)
 	require.Equal(t, votes, app.GovKeeper.GetVotes(ctx, proposalID))
 	require.Equal(t, addrs[0], votes[0].Voter)
 	require.Equal(t, proposalID, votes[0].ProposalId)
@@ -67,4 +103,8 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, addrs[1], votes[1].Voter)
 	require.Equal(t, proposalID, votes[1].ProposalId)
 	require.Equal(t, types.OptionNoWithVeto, votes[1].Option)
+	require.Equal(t, addrs[2], votes[2].Voter)
+	require.Equal(t, weightedOptions, votes[2].Options)
+	require.Equal(t, addrs[3], votes[3].Voter)
+	require.Equal(t, types.NewNonSplitVoteOption(types.OptionNo), votes[3].Options)
 }
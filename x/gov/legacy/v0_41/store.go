@@ -0,0 +1,110 @@
+package v041
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// ExpeditedMinDepositRatio is the ratio between the expedited min deposit and
+// the min deposit set by the migration.
+const ExpeditedMinDepositRatio = 5
+
+// MigrateStore performs in-place store migrations from v0.40 to v0.41. The
+// migration includes:
+//
+// - Convert every vote stored with a single Option and no weighted Options
+// into a vote with a single WeightedVoteOption of weight 1.
+// - Set the expedited proposal params, derived from the regular proposal
+// params so that they are valid. The migration fails if the vote threshold is
+// 1, as no expedited threshold may then exceed it.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, paramSpace types.ParamSubspace) error {
+	if err := migrateVotes(ctx.KVStore(storeKey), cdc); err != nil {
+		return err
+	}
+
+	return migrateExpeditedParams(ctx, paramSpace)
+}
+
+// migrateVotes rewrites the legacy non-weighted votes as weighted votes.
+func migrateVotes(store sdk.KVStore, cdc codec.BinaryMarshaler) error {
+	votesStore := prefix.NewStore(store, types.VotesKeyPrefix)
+
+	iterator := votesStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.Vote
+		if err := cdc.UnmarshalBinaryBare(iterator.Value(), &vote); err != nil {
+			return err
+		}
+
+		if len(vote.Options) != 0 || vote.Option == types.OptionEmpty { //nolint:staticcheck
+			continue
+		}
+
+		vote = types.NewVote(vote.ProposalId, vote.Voter, types.NewNonSplitVoteOption(vote.Option)) //nolint:staticcheck
+
+		bz, err := cdc.MarshalBinaryBare(&vote)
+		if err != nil {
+			return err
+		}
+
+		votesStore.Set(iterator.Key(), bz)
+	}
+
+	return nil
+}
+
+// migrateExpeditedParams sets the expedited proposal params which are not set
+// yet. The expedited min deposit is ExpeditedMinDepositRatio times the min
+// deposit, the expedited voting period the default one, capped at half the
+// voting period, and the expedited threshold the default one, raised halfway
+// between the threshold and 1 if the threshold is not lower. An error is
+// returned if the expedited threshold is not set and the threshold is 1.
+func migrateExpeditedParams(ctx sdk.Context, paramSpace types.ParamSubspace) error {
+	var tallyParams types.TallyParams
+	paramSpace.Get(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+	if tallyParams.ExpeditedThreshold.IsNil() || tallyParams.ExpeditedThreshold.IsZero() {
+		if tallyParams.Threshold.GTE(sdk.OneDec()) {
+			return fmt.Errorf(
+				"cannot set an expedited threshold greater than the vote threshold %s; lower the vote threshold before migrating",
+				tallyParams.Threshold,
+			)
+		}
+
+		tallyParams.ExpeditedThreshold = types.DefaultExpeditedThreshold
+		if tallyParams.ExpeditedThreshold.LTE(tallyParams.Threshold) {
+			tallyParams.ExpeditedThreshold = tallyParams.Threshold.Add(sdk.OneDec().Sub(tallyParams.Threshold).QuoInt64(2))
+		}
+		paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, tallyParams)
+	}
+
+	var depositParams types.DepositParams
+	paramSpace.Get(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+	if depositParams.ExpeditedMinDeposit.Empty() {
+		depositParams.ExpeditedMinDeposit = types.DefaultDepositParams().ExpeditedMinDeposit
+		if !depositParams.MinDeposit.Empty() {
+			depositParams.ExpeditedMinDeposit = make(sdk.Coins, len(depositParams.MinDeposit))
+			for i, coin := range depositParams.MinDeposit {
+				depositParams.ExpeditedMinDeposit[i] = sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(ExpeditedMinDepositRatio))
+			}
+		}
+		paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, depositParams)
+	}
+
+	var votingParams types.VotingParams
+	paramSpace.Get(ctx, types.ParamStoreKeyVotingParams, &votingParams)
+	if votingParams.ExpeditedVotingPeriod == 0 {
+		votingParams.ExpeditedVotingPeriod = types.DefaultExpeditedPeriod
+		if votingParams.ExpeditedVotingPeriod >= votingParams.VotingPeriod {
+			votingParams.ExpeditedVotingPeriod = votingParams.VotingPeriod / 2
+		}
+		paramSpace.Set(ctx, types.ParamStoreKeyVotingParams, votingParams)
+	}
+
+	return nil
+}
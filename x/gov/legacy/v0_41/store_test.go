@@ -0,0 +1,88 @@
+package v041_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v041gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_41"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	cdc := app.AppCodec()
+	storeKey := app.GetKey(types.StoreKey)
+	store := ctx.KVStore(storeKey)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(10000000))
+
+	// a legacy vote, stored with a single option and no weighted options
+	legacyVote := types.Vote{ProposalId: 1, Voter: addrs[0], Option: types.OptionNo}
+	store.Set(types.VoteKey(1, addrs[0]), cdc.MustMarshalBinaryBare(&legacyVote))
+
+	// a weighted vote, which must be left unchanged
+	weightedVote := types.NewVote(1, addrs[1], types.WeightedVoteOptions{
+		{Option: types.OptionYes, Weight: sdk.NewDecWithPrec(60, 2)},
+		{Option: types.OptionAbstain, Weight: sdk.NewDecWithPrec(40, 2)},
+	})
+	store.Set(types.VoteKey(1, addrs[1]), cdc.MustMarshalBinaryBare(&weightedVote))
+
+	require.NoError(t, v041gov.MigrateStore(ctx, storeKey, cdc, app.GetSubspace(types.ModuleName)))
+
+	var vote types.Vote
+	cdc.MustUnmarshalBinaryBare(store.Get(types.VoteKey(1, addrs[0])), &vote)
+	require.Equal(t, types.NewVote(1, addrs[0], types.NewNonSplitVoteOption(types.OptionNo)), vote)
+
+	vote = types.Vote{}
+	cdc.MustUnmarshalBinaryBare(store.Get(types.VoteKey(1, addrs[1])), &vote)
+	require.Equal(t, weightedVote, vote)
+}
+
+func TestMigrateStoreExpeditedParams(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	storeKey := app.GetKey(types.StoreKey)
+	paramSpace := app.GetSubspace(types.ModuleName)
+
+	// v0.40 params, without the expedited proposal params
+	minDeposit := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, types.DepositParams{MinDeposit: minDeposit, MaxDepositPeriod: time.Hour})
+	paramSpace.Set(ctx, types.ParamStoreKeyVotingParams, types.VotingParams{VotingPeriod: time.Hour})
+	paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, types.TallyParams{
+		Quorum:        types.DefaultQuorum,
+		Threshold:     sdk.NewDecWithPrec(8, 1),
+		VetoThreshold: types.DefaultVetoThreshold,
+	})
+
+	require.NoError(t, v041gov.MigrateStore(ctx, storeKey, app.AppCodec(), paramSpace))
+
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 500)), depositParams.ExpeditedMinDeposit)
+	require.Equal(t, 30*time.Minute, app.GovKeeper.GetVotingParams(ctx).ExpeditedVotingPeriod)
+	require.Equal(t, sdk.NewDecWithPrec(9, 1), app.GovKeeper.GetTallyParams(ctx).ExpeditedThreshold)
+
+	// the migrated params are valid
+	genState := types.NewGenesisState(1, depositParams, app.GovKeeper.GetVotingParams(ctx), app.GovKeeper.GetTallyParams(ctx))
+	require.NoError(t, types.ValidateGenesis(genState))
+
+	// the default params are left unchanged
+	paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, types.DefaultDepositParams())
+	paramSpace.Set(ctx, types.ParamStoreKeyVotingParams, types.DefaultVotingParams())
+	paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, types.DefaultTallyParams())
+
+	require.NoError(t, v041gov.MigrateStore(ctx, storeKey, app.AppCodec(), paramSpace))
+	require.Equal(t, types.DefaultDepositParams(), app.GovKeeper.GetDepositParams(ctx))
+	require.Equal(t, types.DefaultVotingParams(), app.GovKeeper.GetVotingParams(ctx))
+	require.Equal(t, types.DefaultTallyParams(), app.GovKeeper.GetTallyParams(ctx))
+
+	// no expedited threshold may exceed a vote threshold of 1
+	tallyParams := types.TallyParams{Quorum: types.DefaultQuorum, Threshold: sdk.OneDec(), VetoThreshold: types.DefaultVetoThreshold}
+	paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, tallyParams)
+	require.Error(t, v041gov.MigrateStore(ctx, storeKey, app.AppCodec(), paramSpace))
+}
@@ -23,6 +23,10 @@ const (
 	TallyParamsQuorum          = "tally_params_quorum"
 	TallyParamsThreshold       = "tally_params_threshold"
 	TallyParamsVeto            = "tally_params_veto"
+
+	DepositParamsExpeditedMinDeposit  = "deposit_params_expedited_min_deposit"
+	VotingParamsExpeditedVotingPeriod = "voting_params_expedited_voting_period"
+	TallyParamsExpeditedThreshold     = "tally_params_expedited_threshold"
 )
 
 // GenDepositParamsDepositPeriod randomized DepositParamsDepositPeriod
@@ -35,11 +39,23 @@ func GenDepositParamsMinDeposit(r *rand.Rand) sdk.Coins {
 	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 1, 1e3))))
 }
 
+// GenDepositParamsExpeditedMinDeposit randomized DepositParamsExpeditedMinDeposit,
+// greater than the given minimum deposit
+func GenDepositParamsExpeditedMinDeposit(r *rand.Rand, minDeposit sdk.Coins) sdk.Coins {
+	return minDeposit.Add(GenDepositParamsMinDeposit(r)...)
+}
+
 // GenVotingParamsVotingPeriod randomized VotingParamsVotingPeriod
 func GenVotingParamsVotingPeriod(r *rand.Rand) time.Duration {
 	return time.Duration(simulation.RandIntBetween(r, 1, 2*60*60*24*2)) * time.Second
 }
 
+// GenVotingParamsExpeditedVotingPeriod randomized VotingParamsExpeditedVotingPeriod,
+// shorter than the given voting period
+func GenVotingParamsExpeditedVotingPeriod(r *rand.Rand, votingPeriod time.Duration) time.Duration {
+	return votingPeriod / time.Duration(simulation.RandIntBetween(r, 2, 10))
+}
+
 // GenTallyParamsQuorum randomized TallyParamsQuorum
 func GenTallyParamsQuorum(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 334, 500)), 3)
@@ -50,6 +66,12 @@ func GenTallyParamsThreshold(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 450, 550)), 3)
 }
 
+// GenTallyParamsExpeditedThreshold randomized TallyParamsExpeditedThreshold,
+// greater than the range of TallyParamsThreshold
+func GenTallyParamsExpeditedThreshold(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 551, 750)), 3)
+}
+
 // GenTallyParamsVeto randomized TallyParamsVeto
 func GenTallyParamsVeto(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 250, 334)), 3)
@@ -95,11 +117,29 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { veto = GenTallyParamsVeto(r) },
 	)
 
+	var expeditedMinDeposit sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsExpeditedMinDeposit, &expeditedMinDeposit, simState.Rand,
+		func(r *rand.Rand) { expeditedMinDeposit = GenDepositParamsExpeditedMinDeposit(r, minDeposit) },
+	)
+
+	var expeditedVotingPeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, VotingParamsExpeditedVotingPeriod, &expeditedVotingPeriod, simState.Rand,
+		func(r *rand.Rand) { expeditedVotingPeriod = GenVotingParamsExpeditedVotingPeriod(r, votingPeriod) },
+	)
+
+	var expeditedThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TallyParamsExpeditedThreshold, &expeditedThreshold, simState.Rand,
+		func(r *rand.Rand) { expeditedThreshold = GenTallyParamsExpeditedThreshold(r) },
+	)
+
 	govGenesis := types.NewGenesisState(
 		startingProposalID,
-		types.NewDepositParams(minDeposit, depositPeriod),
-		types.NewVotingParams(votingPeriod),
-		types.NewTallyParams(quorum, threshold, veto),
+		types.NewDepositParams(minDeposit, depositPeriod, expeditedMinDeposit),
+		types.NewVotingParams(votingPeriod, expeditedVotingPeriod),
+		types.NewTallyParams(quorum, threshold, veto, expeditedThreshold),
 	)
 
 	fmt.Printf("Selected randomly generated governance parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, govGenesis))
@@ -20,8 +20,9 @@ var initialProposalID = uint64(100000000000000)
 
 // Simulation operation weights constants
 const (
-	OpWeightMsgDeposit = "op_weight_msg_deposit"
-	OpWeightMsgVote    = "op_weight_msg_vote"
+	OpWeightMsgDeposit      = "op_weight_msg_deposit"
+	OpWeightMsgVote         = "op_weight_msg_vote"
+	OpWeightMsgVoteWeighted = "op_weight_msg_weighted_vote"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -31,8 +32,9 @@ func WeightedOperations(
 ) simulation.WeightedOperations {
 
 	var (
-		weightMsgDeposit int
-		weightMsgVote    int
+		weightMsgDeposit      int
+		weightMsgVote         int
+		weightMsgVoteWeighted int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgDeposit, &weightMsgDeposit, nil,
@@ -47,6 +49,12 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgVoteWeighted, &weightMsgVoteWeighted, nil,
+		func(_ *rand.Rand) {
+			weightMsgVoteWeighted = simappparams.DefaultWeightMsgVoteWeighted
+		},
+	)
+
 	// generate the weighted operations for the proposal contents
 	var wProposalOps simulation.WeightedOperations
 
@@ -74,6 +82,10 @@ func WeightedOperations(
 			weightMsgVote,
 			SimulateMsgVote(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgVoteWeighted,
+			SimulateMsgVoteWeighted(ak, bk, k),
+		),
 	}
 
 	return append(wProposalOps, wGovOps...)
@@ -315,6 +327,69 @@ func operationSimulateMsgVote(ak types.AccountKeeper, bk types.BankKeeper, k kee
 	}
 }
 
+// SimulateMsgVoteWeighted generates a MsgVoteWeighted with random values.
+func SimulateMsgVoteWeighted(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return operationSimulateMsgVoteWeighted(ak, bk, k, simtypes.Account{}, -1)
+}
+
+func operationSimulateMsgVoteWeighted(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+	simAccount simtypes.Account, proposalIDInt int64) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if simAccount.Equals(simtypes.Account{}) {
+			simAccount, _ = simtypes.RandomAcc(r, accs)
+		}
+
+		var proposalID uint64
+
+		switch {
+		case proposalIDInt < 0:
+			var ok bool
+			proposalID, ok = randomProposalID(r, k, ctx, types.StatusVotingPeriod)
+			if !ok {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgVoteWeighted, "unable to generate proposalID"), nil, nil
+			}
+		default:
+			proposalID = uint64(proposalIDInt)
+		}
+
+		options := randomWeightedVotingOptions(r)
+		msg := types.NewMsgVoteWeighted(simAccount.Address, proposalID, options)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+		}
+
+		txGen := simappparams.MakeEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
 // Pick a random deposit with a random denomination with a
 // deposit amount between (0, min(balance, minDepositAmount))
 // This is to simulate multiple users depositing to get the
@@ -393,3 +468,32 @@ func randomVotingOption(r *rand.Rand) types.VoteOption {
 		panic("invalid vote option")
 	}
 }
+
+// Pick a random set of weighted voting options, with weights summing to 1
+func randomWeightedVotingOptions(r *rand.Rand) types.WeightedVoteOptions {
+	w1 := r.Intn(100 + 1)
+	w2 := r.Intn(100 - w1 + 1)
+	w3 := r.Intn(100 - w1 - w2 + 1)
+	w4 := 100 - w1 - w2 - w3
+
+	weights := map[types.VoteOption]int{
+		types.OptionYes:        w1,
+		types.OptionAbstain:    w2,
+		types.OptionNo:         w3,
+		types.OptionNoWithVeto: w4,
+	}
+
+	weightedVoteOptions := types.WeightedVoteOptions{}
+	for _, option := range []types.VoteOption{types.OptionYes, types.OptionAbstain, types.OptionNo, types.OptionNoWithVeto} {
+		if weights[option] == 0 {
+			continue
+		}
+
+		weightedVoteOptions = append(weightedVoteOptions, types.WeightedVoteOption{
+			Option: option,
+			Weight: sdk.NewDecWithPrec(int64(weights[option]), 2),
+		})
+	}
+
+	return weightedVoteOptions
+}
@@ -14,4 +14,7 @@ var (
 	ErrInvalidVote             = sdkerrors.Register(ModuleName, 7, "invalid vote option")
 	ErrInvalidGenesis          = sdkerrors.Register(ModuleName, 8, "invalid genesis state")
 	ErrNoProposalHandlerExists = sdkerrors.Register(ModuleName, 9, "no handler exists for proposal type")
+	ErrUnroutableProposalMsg   = sdkerrors.Register(ModuleName, 10, "proposal message not recognized by router")
+	ErrInvalidProposalMsg      = sdkerrors.Register(ModuleName, 11, "invalid proposal messages")
+	ErrInvalidSigner           = sdkerrors.Register(ModuleName, 12, "expected gov account as only signer for proposal message")
 )
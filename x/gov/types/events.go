@@ -18,4 +18,7 @@ const (
 	AttributeValueProposalRejected = "proposal_rejected" // didn't meet vote quorum
 	AttributeValueProposalFailed   = "proposal_failed"   // error on proposal handler
 	AttributeKeyProposalType       = "proposal_type"
+	AttributeKeyProposalLog        = "proposal_log" // failure reason of a passed proposal
+
+	AttributeValueExpeditedProposalRejected = "expedited_proposal_rejected" // didn't meet expedited threshold, converted to a regular proposal
 )
@@ -61,7 +61,14 @@ func ValidateGenesis(data *GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
-	return nil
+	if err := validateDepositParams(data.DepositParams); err != nil {
+		return err
+	}
+	if err := validateVotingParams(data.VotingParams); err != nil {
+		return err
+	}
+
+	return validateTallyParams(data.TallyParams)
 }
 
 var _ types.UnpackInterfacesMessage = GenesisState{}
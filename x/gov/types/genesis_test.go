@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestEqualProposalID(t *testing.T) {
@@ -20,3 +22,31 @@ func TestEqualProposalID(t *testing.T) {
 	require.Equal(t, state1, state2)
 	require.True(t, state1.Equal(state2))
 }
+
+func TestValidateGenesisExpeditedParams(t *testing.T) {
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+
+	testCases := []struct {
+		name     string
+		malleate func(*GenesisState)
+	}{
+		{"expedited min deposit not greater than min deposit", func(data *GenesisState) {
+			data.DepositParams.ExpeditedMinDeposit = data.DepositParams.MinDeposit
+		}},
+		{"expedited voting period not shorter than voting period", func(data *GenesisState) {
+			data.VotingParams.ExpeditedVotingPeriod = data.VotingParams.VotingPeriod
+		}},
+		{"expedited threshold not greater than threshold", func(data *GenesisState) {
+			data.TallyParams.ExpeditedThreshold = data.TallyParams.Threshold
+		}},
+		{"expedited threshold too large", func(data *GenesisState) {
+			data.TallyParams.ExpeditedThreshold = sdk.NewDecWithPrec(11, 1)
+		}},
+	}
+
+	for _, tc := range testCases {
+		data := DefaultGenesisState()
+		tc.malleate(data)
+		require.Error(t, ValidateGenesis(data), tc.name)
+	}
+}
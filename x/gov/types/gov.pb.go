@@ -206,6 +206,15 @@ type Proposal struct {
 	TotalDeposit     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_deposit" yaml:"total_deposit"`
 	VotingStartTime  time.Time                                `protobuf:"bytes,8,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time" yaml:"voting_start_time"`
 	VotingEndTime    time.Time                                `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time" yaml:"voting_end_time"`
+	// messages are the sdk.Msgs executed, with the gov module account as their
+	// only signer, when the proposal passes.
+	Messages []*types1.Any `protobuf:"bytes,10,rep,name=messages,proto3" json:"messages,omitempty"`
+	// failed_reason is the error returned by the execution of the proposal
+	// content or messages when a passed proposal failed.
+	FailedReason string `protobuf:"bytes,11,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty" yaml:"failed_reason"`
+	// is_expedited is true for an expedited proposal, which uses the expedited
+	// min deposit, voting period and threshold.
+	IsExpedited bool `protobuf:"varint,12,opt,name=is_expedited,json=isExpedited,proto3" json:"is_expedited,omitempty" yaml:"is_expedited"`
 }
 
 func (m *Proposal) Reset()      { *m = Proposal{} }
@@ -280,18 +289,61 @@ func (m *TallyResult) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TallyResult proto.InternalMessageInfo
 
+// WeightedVoteOption defines a unit of vote for vote split.
+type WeightedVoteOption struct {
+	Option VoteOption                             `protobuf:"varint,1,opt,name=option,proto3,enum=cosmos.gov.v1beta1.VoteOption" json:"option,omitempty"`
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"weight"`
+}
+
+func (m *WeightedVoteOption) Reset()      { *m = WeightedVoteOption{} }
+func (*WeightedVoteOption) ProtoMessage() {}
+func (*WeightedVoteOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{4}
+}
+func (m *WeightedVoteOption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedVoteOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedVoteOption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedVoteOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedVoteOption.Merge(m, src)
+}
+func (m *WeightedVoteOption) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedVoteOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedVoteOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedVoteOption proto.InternalMessageInfo
+
 // Vote defines a vote on a governance proposal.
-// A Vote consists of a proposal ID, the voter, and the vote option.
+// A Vote consists of a proposal ID, the voter, and the vote options the
+// voter's voting power is split across.
 type Vote struct {
 	ProposalId uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
 	Voter      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
-	Option     VoteOption                                    `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.gov.v1beta1.VoteOption" json:"option,omitempty"`
+	// Deprecated: prefer to use `options` instead. This field is set in queries
+	// if and only if `len(options) == 1` and that option has weight 1. In all
+	// other cases, this field will default to VOTE_OPTION_UNSPECIFIED.
+	Option  VoteOption          `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.gov.v1beta1.VoteOption" json:"option,omitempty"` // Deprecated: Do not use.
+	Options WeightedVoteOptions `protobuf:"bytes,4,rep,name=options,proto3,castrepeated=WeightedVoteOptions" json:"options"`
 }
 
 func (m *Vote) Reset()      { *m = Vote{} }
 func (*Vote) ProtoMessage() {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{4}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{5}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -326,12 +378,14 @@ type DepositParams struct {
 	MinDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_deposit,omitempty" yaml:"min_deposit"`
 	//  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months.
 	MaxDepositPeriod time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty" yaml:"max_deposit_period"`
+	//  Minimum deposit for an expedited proposal to enter voting period.
+	ExpeditedMinDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"expedited_min_deposit,omitempty" yaml:"expedited_min_deposit"`
 }
 
 func (m *DepositParams) Reset()      { *m = DepositParams{} }
 func (*DepositParams) ProtoMessage() {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{5}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -364,12 +418,14 @@ var xxx_messageInfo_DepositParams proto.InternalMessageInfo
 type VotingParams struct {
 	//  Length of the voting period.
 	VotingPeriod time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty" yaml:"voting_period"`
+	//  Length of the voting period of expedited proposals.
+	ExpeditedVotingPeriod time.Duration `protobuf:"bytes,2,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty" yaml:"expedited_voting_period"`
 }
 
 func (m *VotingParams) Reset()      { *m = VotingParams{} }
 func (*VotingParams) ProtoMessage() {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,12 +462,14 @@ type TallyParams struct {
 	Threshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"threshold,omitempty"`
 	//  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Default value: 1/3.
 	VetoThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"veto_threshold,omitempty" yaml:"veto_threshold"`
+	//  Minimum proportion of Yes votes for an expedited proposal to pass. Default value: 0.667.
+	ExpeditedThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=expedited_threshold,json=expeditedThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"expedited_threshold,omitempty" yaml:"expedited_threshold"`
 }
 
 func (m *TallyParams) Reset()      { *m = TallyParams{} }
 func (*TallyParams) ProtoMessage() {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{8}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -447,6 +505,7 @@ func init() {
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1beta1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1beta1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.v1beta1.TallyResult")
+	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1beta1.WeightedVoteOption")
 	proto.RegisterType((*Vote)(nil), "cosmos.gov.v1beta1.Vote")
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1beta1.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1beta1.VotingParams")
@@ -456,94 +515,111 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/gov.proto", fileDescriptor_6e82113c1a9a4b7c) }
 
 var fileDescriptor_6e82113c1a9a4b7c = []byte{
-	// 1389 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4f, 0x6c, 0x1b, 0x45,
-	0x17, 0xf7, 0x3a, 0xff, 0xea, 0xb1, 0x93, 0x6c, 0x27, 0x69, 0xe2, 0xec, 0xd7, 0x6f, 0x77, 0xbf,
-	0xfd, 0x10, 0x8a, 0xaa, 0xd6, 0x69, 0x03, 0x02, 0xd1, 0x4a, 0x08, 0x3b, 0xde, 0xb6, 0x46, 0x95,
-	0x6d, 0xad, 0xb7, 0xae, 0x5a, 0x0e, 0xab, 0xb5, 0x77, 0xea, 0x2c, 0x78, 0x77, 0x8c, 0x77, 0x1c,
-	0x62, 0x71, 0xe1, 0x58, 0x19, 0x09, 0xf5, 0x88, 0x84, 0x2c, 0x21, 0x71, 0xe3, 0xcc, 0x99, 0x23,
-	0x8a, 0x10, 0x87, 0x8a, 0x53, 0x05, 0x92, 0x4b, 0x53, 0x09, 0xa1, 0x1c, 0x73, 0xe0, 0xc0, 0x01,
-	0xa1, 0xdd, 0x99, 0x8d, 0xd7, 0x76, 0x44, 0x6a, 0x38, 0x65, 0xf7, 0xcd, 0xfb, 0xfd, 0x7e, 0x6f,
-	0x7e, 0x33, 0xef, 0x6d, 0x0c, 0x2e, 0xd6, 0xb1, 0xe7, 0x60, 0x6f, 0xab, 0x81, 0xf7, 0xb6, 0xf6,
-	0xae, 0xd5, 0x10, 0x31, 0xaf, 0xf9, 0xcf, 0x99, 0x56, 0x1b, 0x13, 0x0c, 0x21, 0x5d, 0xcd, 0xf8,
-	0x11, 0xb6, 0x2a, 0x88, 0x0c, 0x51, 0x33, 0x3d, 0x74, 0x02, 0xa9, 0x63, 0xdb, 0xa5, 0x18, 0x61,
-	0xb5, 0x81, 0x1b, 0x38, 0x78, 0xdc, 0xf2, 0x9f, 0x58, 0x74, 0x83, 0xa2, 0x0c, 0xba, 0xc0, 0x68,
-	0xe9, 0x92, 0xd4, 0xc0, 0xb8, 0xd1, 0x44, 0x5b, 0xc1, 0x5b, 0xad, 0xf3, 0x70, 0x8b, 0xd8, 0x0e,
-	0xf2, 0x88, 0xe9, 0xb4, 0x42, 0xec, 0x78, 0x82, 0xe9, 0x76, 0xd9, 0x92, 0x38, 0xbe, 0x64, 0x75,
-	0xda, 0x26, 0xb1, 0x31, 0x2b, 0x46, 0xb9, 0x07, 0x52, 0x3a, 0xda, 0x27, 0xe5, 0x36, 0x6e, 0x61,
-	0xcf, 0x6c, 0xc2, 0x55, 0x30, 0x47, 0x6c, 0xd2, 0x44, 0x69, 0x4e, 0xe6, 0x36, 0x13, 0x1a, 0x7d,
-	0x81, 0x32, 0x48, 0x5a, 0xc8, 0xab, 0xb7, 0xed, 0x96, 0x0f, 0x4d, 0xc7, 0x83, 0xb5, 0x68, 0xe8,
-	0xfa, 0xf2, 0x6f, 0x5f, 0x4a, 0xdc, 0x8f, 0xdf, 0x5c, 0x59, 0xd8, 0xc1, 0x2e, 0x41, 0x2e, 0x51,
-	0xfe, 0xe4, 0xc0, 0x42, 0x1e, 0xb5, 0xb0, 0x67, 0x13, 0xf8, 0x26, 0x48, 0xb6, 0x98, 0x80, 0x61,
-	0x5b, 0x01, 0xf5, 0x6c, 0x6e, 0xed, 0x78, 0x20, 0xc1, 0xae, 0xe9, 0x34, 0xaf, 0x2b, 0x91, 0x45,
-	0x45, 0x03, 0xe1, 0x5b, 0xc1, 0x82, 0x25, 0x90, 0xb0, 0x28, 0x07, 0x6e, 0x07, 0xaa, 0xa9, 0xdc,
-	0xb5, 0x3f, 0x06, 0xd2, 0x95, 0x86, 0x4d, 0x76, 0x3b, 0xb5, 0x4c, 0x1d, 0x3b, 0xcc, 0x29, 0xf6,
-	0xe7, 0x8a, 0x67, 0x7d, 0xb0, 0x45, 0xba, 0x2d, 0xe4, 0x65, 0xb2, 0xf5, 0x7a, 0xd6, 0xb2, 0xda,
-	0xc8, 0xf3, 0xb4, 0x21, 0x07, 0xac, 0x83, 0x79, 0xd3, 0xc1, 0x1d, 0x97, 0xa4, 0x67, 0xe4, 0x99,
-	0xcd, 0xe4, 0xf6, 0x46, 0x86, 0x39, 0xed, 0x1f, 0x56, 0x78, 0x82, 0x99, 0x1d, 0x6c, 0xbb, 0xb9,
-	0xab, 0x07, 0x03, 0x29, 0xf6, 0xf5, 0x33, 0x69, 0xf3, 0x25, 0xc4, 0x7c, 0x80, 0xa7, 0x31, 0xea,
-	0xeb, 0xb3, 0xbe, 0x17, 0xca, 0xef, 0xf3, 0xe0, 0xdc, 0x89, 0xad, 0xaf, 0x9f, 0xe6, 0xc0, 0xca,
-	0xd1, 0x40, 0x8a, 0xdb, 0xd6, 0xf1, 0x40, 0x4a, 0x50, 0x1f, 0xc6, 0xb7, 0x7f, 0x03, 0x2c, 0xd4,
-	0xa9, 0x9d, 0xc1, 0xe6, 0x93, 0xdb, 0xab, 0x19, 0x7a, 0x9c, 0x99, 0xf0, 0x38, 0x33, 0x59, 0xb7,
-	0x9b, 0x4b, 0x7e, 0x3f, 0xf4, 0x5d, 0x0b, 0x11, 0xb0, 0x0a, 0xe6, 0x3d, 0x62, 0x92, 0x8e, 0x97,
-	0x9e, 0x91, 0xb9, 0xcd, 0xa5, 0x6d, 0x25, 0x33, 0x79, 0x57, 0x33, 0x61, 0x81, 0x95, 0x20, 0x33,
-	0x27, 0x1c, 0x0f, 0xa4, 0xb5, 0xb1, 0x33, 0xa1, 0x24, 0x8a, 0xc6, 0xd8, 0x60, 0x0b, 0xc0, 0x87,
-	0xb6, 0x6b, 0x36, 0x0d, 0x62, 0x36, 0x9b, 0x5d, 0xa3, 0x8d, 0xbc, 0x4e, 0x93, 0xa4, 0x67, 0x83,
-	0xfa, 0xa4, 0xd3, 0x34, 0x74, 0x3f, 0x4f, 0x0b, 0xd2, 0x72, 0xff, 0xf3, 0x4d, 0x3d, 0x1e, 0x48,
-	0x1b, 0x54, 0x64, 0x92, 0x48, 0xd1, 0xf8, 0x20, 0x18, 0x01, 0xc1, 0xf7, 0x40, 0xd2, 0xeb, 0xd4,
-	0x1c, 0x9b, 0x18, 0xfe, 0xc5, 0x4f, 0xcf, 0x05, 0x52, 0xc2, 0x84, 0x15, 0x7a, 0xd8, 0x15, 0x39,
-	0x91, 0xa9, 0xb0, 0xeb, 0x15, 0x01, 0x2b, 0x8f, 0x9f, 0x49, 0x9c, 0x06, 0x68, 0xc4, 0x07, 0x40,
-	0x1b, 0xf0, 0xec, 0x7a, 0x18, 0xc8, 0xb5, 0xa8, 0xc2, 0xfc, 0x99, 0x0a, 0xff, 0x67, 0x0a, 0xeb,
-	0x54, 0x61, 0x9c, 0x81, 0xca, 0x2c, 0xb1, 0xb0, 0xea, 0x5a, 0x81, 0xd4, 0x23, 0x0e, 0x2c, 0x12,
-	0x4c, 0xcc, 0xa6, 0xc1, 0x16, 0xd2, 0x0b, 0x67, 0x5d, 0xc2, 0xdb, 0x4c, 0x67, 0x95, 0xea, 0x8c,
-	0xa0, 0x95, 0xa9, 0x2e, 0x67, 0x2a, 0xc0, 0x86, 0x1d, 0xd9, 0x04, 0xe7, 0xf7, 0x30, 0xb1, 0xdd,
-	0x86, 0x7f, 0xbc, 0x6d, 0x66, 0xec, 0xb9, 0x33, 0xb7, 0xfd, 0x0a, 0x2b, 0x27, 0x4d, 0xcb, 0x99,
-	0xa0, 0xa0, 0xfb, 0x5e, 0xa6, 0xf1, 0x8a, 0x1f, 0x0e, 0x36, 0xfe, 0x10, 0xb0, 0xd0, 0xd0, 0xe2,
-	0xc4, 0x99, 0x5a, 0x0a, 0xd3, 0x5a, 0x1b, 0xd1, 0x1a, 0x75, 0x78, 0x91, 0x46, 0x99, 0xc1, 0xac,
-	0xf1, 0x0e, 0xe2, 0x20, 0x19, 0xbd, 0x3e, 0xef, 0x80, 0x99, 0x2e, 0xf2, 0xe8, 0x40, 0xcb, 0x65,
-	0x7c, 0xd6, 0x9f, 0x06, 0xd2, 0xab, 0x2f, 0x61, 0x5c, 0xc1, 0x25, 0x9a, 0x0f, 0x85, 0xb7, 0xc1,
-	0x82, 0x59, 0xf3, 0x88, 0x69, 0xb3, 0xd1, 0x37, 0x35, 0x4b, 0x08, 0x87, 0x6f, 0x83, 0xb8, 0x8b,
-	0x83, 0x86, 0x9c, 0x9e, 0x24, 0xee, 0x62, 0xd8, 0x00, 0x29, 0x17, 0x1b, 0x1f, 0xd9, 0x64, 0xd7,
-	0xd8, 0x43, 0x04, 0x07, 0x6d, 0x97, 0xc8, 0xa9, 0xd3, 0x31, 0x1d, 0x0f, 0xa4, 0x15, 0x6a, 0x6a,
-	0x94, 0x4b, 0xd1, 0x80, 0x8b, 0xef, 0xd9, 0x64, 0xb7, 0x8a, 0x08, 0x66, 0x56, 0x7e, 0xc7, 0x81,
-	0xd9, 0x2a, 0x26, 0xe8, 0x9f, 0x4f, 0xf0, 0x5b, 0x60, 0x6e, 0x0f, 0x13, 0xf4, 0x2f, 0xa6, 0x37,
-	0xc5, 0xc3, 0x37, 0xc0, 0x3c, 0xa6, 0x5f, 0x1f, 0x3a, 0xce, 0xc4, 0xd3, 0x46, 0x8d, 0x5f, 0x6b,
-	0x29, 0xc8, 0xd2, 0x58, 0x36, 0xdb, 0xc8, 0xb7, 0x71, 0xb0, 0xc8, 0xee, 0x7e, 0xd9, 0x6c, 0x9b,
-	0x8e, 0x07, 0xbf, 0xe0, 0x40, 0xd2, 0xb1, 0xdd, 0x93, 0x56, 0xe4, 0xce, 0x6a, 0x45, 0xc3, 0x37,
-	0xf9, 0x68, 0x20, 0x5d, 0x88, 0xa0, 0x2e, 0x63, 0xc7, 0x26, 0xc8, 0x69, 0x91, 0xee, 0xd0, 0x8a,
-	0xc8, 0xf2, 0x74, 0x1d, 0x0a, 0x1c, 0xdb, 0x0d, 0xfb, 0xf3, 0x33, 0x0e, 0x40, 0xc7, 0xdc, 0x0f,
-	0x89, 0x8c, 0x16, 0x6a, 0xdb, 0xd8, 0x62, 0x5f, 0x81, 0x8d, 0x89, 0xae, 0xc9, 0xb3, 0x8f, 0x3a,
-	0xbd, 0x09, 0x47, 0x03, 0xe9, 0xe2, 0x24, 0x78, 0xa4, 0x56, 0x36, 0x7f, 0x27, 0xb3, 0x94, 0xcf,
-	0xfd, 0xbe, 0xe2, 0x1d, 0x73, 0x3f, 0xb4, 0x8b, 0x86, 0x3f, 0xe5, 0x40, 0xaa, 0x1a, 0x34, 0x1b,
-	0xf3, 0xef, 0x63, 0xc0, 0x9a, 0x2f, 0xac, 0x8d, 0x3b, 0xab, 0xb6, 0x1b, 0xac, 0xb6, 0xf5, 0x11,
-	0xdc, 0x48, 0x59, 0xab, 0x23, 0xbd, 0x1e, 0xad, 0x28, 0x45, 0x63, 0xac, 0x9a, 0x9f, 0xc3, 0x16,
-	0x67, 0xc5, 0x3c, 0x00, 0xf3, 0x1f, 0x76, 0x70, 0xbb, 0xe3, 0x04, 0x55, 0xa4, 0x72, 0xb9, 0x29,
-	0x1a, 0x22, 0x8f, 0xea, 0x47, 0x03, 0x89, 0xa7, 0xf8, 0x61, 0x35, 0x1a, 0x63, 0x84, 0x75, 0x90,
-	0x20, 0xbb, 0x6d, 0xe4, 0xed, 0xe2, 0xa6, 0xc5, 0x6e, 0xb1, 0x3a, 0x35, 0xfd, 0xca, 0x09, 0x45,
-	0x44, 0x61, 0xc8, 0x0b, 0x7b, 0x1c, 0x58, 0xf2, 0x9b, 0xd0, 0x18, 0x4a, 0xcd, 0x04, 0x52, 0xf5,
-	0xa9, 0xa5, 0xd2, 0xa3, 0x3c, 0x23, 0xfe, 0x5e, 0x60, 0xfe, 0x8e, 0x64, 0x28, 0xda, 0xa2, 0x1f,
-	0xd0, 0xc3, 0xf7, 0x4b, 0xbf, 0x72, 0x00, 0x0c, 0x3b, 0x09, 0x5e, 0x06, 0xeb, 0xd5, 0x92, 0xae,
-	0x1a, 0xa5, 0xb2, 0x5e, 0x28, 0x15, 0x8d, 0xbb, 0xc5, 0x4a, 0x59, 0xdd, 0x29, 0xdc, 0x2c, 0xa8,
-	0x79, 0x3e, 0x26, 0x2c, 0xf7, 0xfa, 0x72, 0x92, 0x26, 0xaa, 0xbe, 0x08, 0x54, 0xc0, 0x72, 0x34,
-	0xfb, 0xbe, 0x5a, 0xe1, 0x39, 0x61, 0xb1, 0xd7, 0x97, 0x13, 0x34, 0xeb, 0x3e, 0xf2, 0xe0, 0x25,
-	0xb0, 0x12, 0xcd, 0xc9, 0xe6, 0x2a, 0x7a, 0xb6, 0x50, 0xe4, 0xe3, 0xc2, 0xf9, 0x5e, 0x5f, 0x5e,
-	0xa4, 0x79, 0x59, 0x36, 0x31, 0x65, 0xb0, 0x14, 0xcd, 0x2d, 0x96, 0xf8, 0x19, 0x21, 0xd5, 0xeb,
-	0xcb, 0xe7, 0x68, 0x5a, 0x11, 0xc3, 0x6d, 0x90, 0x1e, 0xcd, 0x30, 0xee, 0x15, 0xf4, 0xdb, 0x46,
-	0x55, 0xd5, 0x4b, 0xfc, 0xac, 0xb0, 0xda, 0xeb, 0xcb, 0x7c, 0x98, 0x1b, 0x8e, 0x37, 0x61, 0xf6,
-	0xd1, 0x57, 0x62, 0xec, 0xd2, 0x0f, 0x71, 0xb0, 0x34, 0xfa, 0x1f, 0x10, 0xcc, 0x80, 0xff, 0x94,
-	0xb5, 0x52, 0xb9, 0x54, 0xc9, 0xde, 0x31, 0x2a, 0x7a, 0x56, 0xbf, 0x5b, 0x19, 0xdb, 0x70, 0xb0,
-	0x15, 0x9a, 0x5c, 0xb4, 0x9b, 0xf0, 0x06, 0x10, 0xc7, 0xf3, 0xf3, 0x6a, 0xb9, 0x54, 0x29, 0xe8,
-	0x46, 0x59, 0xd5, 0x0a, 0xa5, 0x3c, 0xcf, 0x09, 0xeb, 0xbd, 0xbe, 0xbc, 0x42, 0x21, 0x23, 0x4d,
-	0x05, 0xdf, 0x02, 0xff, 0x1d, 0x07, 0x57, 0x4b, 0x7a, 0xa1, 0x78, 0x2b, 0xc4, 0xc6, 0x85, 0xb5,
-	0x5e, 0x5f, 0x86, 0x14, 0x5b, 0x8d, 0x74, 0x00, 0xbc, 0x0c, 0xd6, 0xc6, 0xa1, 0xe5, 0x6c, 0xa5,
-	0xa2, 0xe6, 0xf9, 0x19, 0x81, 0xef, 0xf5, 0xe5, 0x14, 0xc5, 0x94, 0x4d, 0xcf, 0x43, 0x16, 0xbc,
-	0x0a, 0xd2, 0xe3, 0xd9, 0x9a, 0xfa, 0xae, 0xba, 0xa3, 0xab, 0x79, 0x7e, 0x56, 0x80, 0xbd, 0xbe,
-	0xbc, 0x44, 0xf3, 0x35, 0xf4, 0x3e, 0xaa, 0x13, 0x74, 0x2a, 0xff, 0xcd, 0x6c, 0xe1, 0x8e, 0x9a,
-	0xe7, 0xe7, 0xa2, 0xfc, 0x37, 0x4d, 0xbb, 0x89, 0x2c, 0x6a, 0x67, 0xae, 0x78, 0xf0, 0x5c, 0x8c,
-	0x3d, 0x7d, 0x2e, 0xc6, 0x3e, 0x39, 0x14, 0x63, 0x07, 0x87, 0x22, 0xf7, 0xe4, 0x50, 0xe4, 0x7e,
-	0x39, 0x14, 0xb9, 0xc7, 0x2f, 0xc4, 0xd8, 0x93, 0x17, 0x62, 0xec, 0xe9, 0x0b, 0x31, 0xf6, 0xe0,
-	0xef, 0x07, 0xe2, 0x7e, 0xf0, 0x43, 0x2b, 0xb8, 0xcf, 0xb5, 0xf9, 0x60, 0x86, 0xbc, 0xf6, 0x57,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x92, 0x64, 0xc1, 0x80, 0x83, 0x0d, 0x00, 0x00,
+	// 1651 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcf, 0x6f, 0xe3, 0xc6,
+	0x15, 0x16, 0x25, 0xad, 0x7f, 0x8c, 0x24, 0xaf, 0x32, 0xf6, 0xda, 0x5c, 0x65, 0x43, 0x32, 0x6c,
+	0x11, 0x18, 0x8b, 0x5d, 0x39, 0xeb, 0x16, 0x2d, 0xea, 0x45, 0xda, 0x8a, 0x2b, 0x6e, 0x56, 0x45,
+	0x22, 0x09, 0x94, 0x22, 0x23, 0x29, 0x0a, 0x82, 0x16, 0x67, 0x65, 0x36, 0x22, 0x47, 0xd5, 0x8c,
+	0x1c, 0x1b, 0xbd, 0x14, 0xe8, 0x25, 0xd0, 0xa1, 0xc8, 0xb1, 0x40, 0xa1, 0xa2, 0x68, 0x4f, 0x6d,
+	0x2f, 0x39, 0xf4, 0x2f, 0xe8, 0x69, 0x51, 0xf4, 0x10, 0xf4, 0x14, 0xf4, 0xa0, 0x34, 0x5e, 0xa0,
+	0x28, 0x7c, 0xf4, 0xb1, 0x28, 0x8a, 0x82, 0x9c, 0xa1, 0x44, 0x4a, 0x6a, 0xb4, 0x4a, 0x4e, 0x26,
+	0xdf, 0xbc, 0xef, 0x7b, 0xdf, 0x7c, 0x33, 0xf3, 0x86, 0x16, 0xb8, 0xd3, 0xc6, 0xc4, 0xc5, 0xe4,
+	0xa0, 0x83, 0xcf, 0x0e, 0xce, 0x1e, 0x9c, 0x20, 0x6a, 0x3d, 0xf0, 0x9f, 0x8b, 0xbd, 0x3e, 0xa6,
+	0x18, 0x42, 0x36, 0x5a, 0xf4, 0x23, 0x7c, 0xb4, 0x20, 0x71, 0xc4, 0x89, 0x45, 0xd0, 0x04, 0xd2,
+	0xc6, 0x8e, 0xc7, 0x30, 0x85, 0x9d, 0x0e, 0xee, 0xe0, 0xe0, 0xf1, 0xc0, 0x7f, 0xe2, 0xd1, 0xdb,
+	0x0c, 0x65, 0xb2, 0x01, 0x4e, 0xcb, 0x86, 0xe4, 0x0e, 0xc6, 0x9d, 0x2e, 0x3a, 0x08, 0xde, 0x4e,
+	0x06, 0x4f, 0x0f, 0xa8, 0xe3, 0x22, 0x42, 0x2d, 0xb7, 0x17, 0x62, 0x67, 0x13, 0x2c, 0xef, 0x82,
+	0x0f, 0x49, 0xb3, 0x43, 0xf6, 0xa0, 0x6f, 0x51, 0x07, 0x73, 0x31, 0xea, 0x31, 0xc8, 0x36, 0xd1,
+	0x39, 0xad, 0xf7, 0x71, 0x0f, 0x13, 0xab, 0x0b, 0x77, 0xc0, 0x0d, 0xea, 0xd0, 0x2e, 0x12, 0x05,
+	0x45, 0xd8, 0xdf, 0x34, 0xd8, 0x0b, 0x54, 0x40, 0xc6, 0x46, 0xa4, 0xdd, 0x77, 0x7a, 0x3e, 0x54,
+	0x4c, 0x06, 0x63, 0xd1, 0xd0, 0xd1, 0xcd, 0x7f, 0xfd, 0x46, 0x16, 0xfe, 0xf6, 0xa7, 0xfb, 0xeb,
+	0x8f, 0xb0, 0x47, 0x91, 0x47, 0xd5, 0xff, 0x0a, 0x60, 0xbd, 0x8c, 0x7a, 0x98, 0x38, 0x14, 0x7e,
+	0x1b, 0x64, 0x7a, 0xbc, 0x80, 0xe9, 0xd8, 0x01, 0x75, 0x5a, 0xdb, 0xbd, 0x1e, 0xcb, 0xf0, 0xc2,
+	0x72, 0xbb, 0x47, 0x6a, 0x64, 0x50, 0x35, 0x40, 0xf8, 0x56, 0xb1, 0x61, 0x0d, 0x6c, 0xda, 0x8c,
+	0x03, 0xf7, 0x83, 0xaa, 0x59, 0xed, 0xc1, 0xbf, 0xc7, 0xf2, 0xfd, 0x8e, 0x43, 0x4f, 0x07, 0x27,
+	0xc5, 0x36, 0x76, 0xb9, 0x53, 0xfc, 0xcf, 0x7d, 0x62, 0xbf, 0x7f, 0x40, 0x2f, 0x7a, 0x88, 0x14,
+	0x4b, 0xed, 0x76, 0xc9, 0xb6, 0xfb, 0x88, 0x10, 0x63, 0xca, 0x01, 0xdb, 0x60, 0xcd, 0x72, 0xf1,
+	0xc0, 0xa3, 0x62, 0x4a, 0x49, 0xed, 0x67, 0x0e, 0x6f, 0x17, 0xb9, 0xd3, 0xfe, 0x62, 0x85, 0x2b,
+	0x58, 0x7c, 0x84, 0x1d, 0x4f, 0x7b, 0xfd, 0xd9, 0x58, 0x4e, 0xfc, 0xe1, 0x33, 0x79, 0xff, 0x05,
+	0x8a, 0xf9, 0x00, 0x62, 0x70, 0xea, 0xa3, 0xb4, 0xef, 0x85, 0xfa, 0xf1, 0x06, 0xd8, 0x98, 0xd8,
+	0xfa, 0xcd, 0x45, 0x0e, 0x6c, 0x5f, 0x8d, 0xe5, 0xa4, 0x63, 0x5f, 0x8f, 0xe5, 0x4d, 0xe6, 0xc3,
+	0xec, 0xf4, 0x1f, 0x82, 0xf5, 0x36, 0xb3, 0x33, 0x98, 0x7c, 0xe6, 0x70, 0xa7, 0xc8, 0x96, 0xb3,
+	0x18, 0x2e, 0x67, 0xb1, 0xe4, 0x5d, 0x68, 0x99, 0xbf, 0x4c, 0x7d, 0x37, 0x42, 0x04, 0x6c, 0x81,
+	0x35, 0x42, 0x2d, 0x3a, 0x20, 0x62, 0x4a, 0x11, 0xf6, 0xb7, 0x0e, 0xd5, 0xe2, 0xfc, 0x5e, 0x2d,
+	0x86, 0x02, 0x1b, 0x41, 0xa6, 0x56, 0xb8, 0x1e, 0xcb, 0xbb, 0x33, 0x6b, 0xc2, 0x48, 0x54, 0x83,
+	0xb3, 0xc1, 0x1e, 0x80, 0x4f, 0x1d, 0xcf, 0xea, 0x9a, 0xd4, 0xea, 0x76, 0x2f, 0xcc, 0x3e, 0x22,
+	0x83, 0x2e, 0x15, 0xd3, 0x81, 0x3e, 0x79, 0x51, 0x8d, 0xa6, 0x9f, 0x67, 0x04, 0x69, 0xda, 0xab,
+	0xbe, 0xa9, 0xd7, 0x63, 0xf9, 0x36, 0x2b, 0x32, 0x4f, 0xa4, 0x1a, 0xf9, 0x20, 0x18, 0x01, 0xc1,
+	0x1f, 0x82, 0x0c, 0x19, 0x9c, 0xb8, 0x0e, 0x35, 0xfd, 0x8d, 0x2f, 0xde, 0x08, 0x4a, 0x15, 0xe6,
+	0xac, 0x68, 0x86, 0xa7, 0x42, 0x93, 0x78, 0x15, 0xbe, 0xbd, 0x22, 0x60, 0xf5, 0xa3, 0xcf, 0x64,
+	0xc1, 0x00, 0x2c, 0xe2, 0x03, 0xa0, 0x03, 0xf2, 0x7c, 0x7b, 0x98, 0xc8, 0xb3, 0x59, 0x85, 0xb5,
+	0xa5, 0x15, 0xbe, 0xc6, 0x2b, 0xec, 0xb1, 0x0a, 0xb3, 0x0c, 0xac, 0xcc, 0x16, 0x0f, 0xeb, 0x9e,
+	0x1d, 0x94, 0xfa, 0x50, 0x00, 0x39, 0x8a, 0xa9, 0xd5, 0x35, 0xf9, 0x80, 0xb8, 0xbe, 0x6c, 0x13,
+	0x3e, 0xe1, 0x75, 0x76, 0x58, 0x9d, 0x18, 0x5a, 0x5d, 0x69, 0x73, 0x66, 0x03, 0x6c, 0x78, 0x22,
+	0xbb, 0xe0, 0xa5, 0x33, 0x4c, 0x1d, 0xaf, 0xe3, 0x2f, 0x6f, 0x9f, 0x1b, 0xbb, 0xb1, 0x74, 0xda,
+	0x5f, 0xe7, 0x72, 0x44, 0x26, 0x67, 0x8e, 0x82, 0xcd, 0xfb, 0x26, 0x8b, 0x37, 0xfc, 0x70, 0x30,
+	0xf1, 0xa7, 0x80, 0x87, 0xa6, 0x16, 0x6f, 0x2e, 0xad, 0xa5, 0xf2, 0x5a, 0xbb, 0xb1, 0x5a, 0x71,
+	0x87, 0x73, 0x2c, 0x1a, 0x1a, 0xfc, 0x06, 0xd8, 0x70, 0x11, 0x21, 0x56, 0x07, 0x11, 0x11, 0x28,
+	0xa9, 0x2f, 0x3e, 0x30, 0xc4, 0x7e, 0xbf, 0xf8, 0x36, 0xe9, 0x18, 0x13, 0x08, 0x7c, 0x03, 0xe4,
+	0x9e, 0x5a, 0x4e, 0x17, 0xd9, 0x66, 0x1f, 0x59, 0x04, 0x7b, 0x62, 0xc6, 0xef, 0x73, 0x9a, 0x38,
+	0xf5, 0x3f, 0x36, 0xac, 0x1a, 0x59, 0xf6, 0x6e, 0x04, 0xaf, 0xf0, 0x08, 0x64, 0x1d, 0x62, 0xa2,
+	0xf3, 0x1e, 0xb2, 0x1d, 0x8a, 0x6c, 0x31, 0xab, 0x08, 0xfb, 0x1b, 0xda, 0xde, 0xf5, 0x58, 0xde,
+	0xe6, 0xc7, 0x3b, 0x32, 0xaa, 0x1a, 0x19, 0x87, 0xe8, 0xe1, 0x1b, 0x6f, 0x19, 0xcf, 0x92, 0x20,
+	0x13, 0xdd, 0xf8, 0xdf, 0x07, 0xa9, 0x0b, 0x44, 0x58, 0x2b, 0xd6, 0x8a, 0xbe, 0x1f, 0x7f, 0x1f,
+	0xcb, 0xaf, 0xbd, 0xc0, 0x92, 0x57, 0x3c, 0x6a, 0xf8, 0x50, 0xf8, 0x04, 0xac, 0x5b, 0x27, 0x84,
+	0x5a, 0x0e, 0x6f, 0xda, 0x2b, 0xb3, 0x84, 0x70, 0xf8, 0x5d, 0x90, 0xf4, 0xb0, 0x98, 0xfa, 0x52,
+	0x24, 0x49, 0x0f, 0xc3, 0x0e, 0xc8, 0x7a, 0xd8, 0xfc, 0xc0, 0xa1, 0xa7, 0xe6, 0x19, 0xa2, 0x38,
+	0x68, 0x18, 0x9b, 0x9a, 0xbe, 0x1a, 0xd3, 0xd4, 0xcb, 0x28, 0x97, 0x6a, 0x00, 0x0f, 0x1f, 0x3b,
+	0xf4, 0xb4, 0x85, 0x28, 0xe6, 0x56, 0xfe, 0x51, 0x00, 0xf0, 0x18, 0x39, 0x9d, 0x53, 0x8a, 0xec,
+	0x16, 0xa6, 0xa8, 0x16, 0x5c, 0x53, 0xf0, 0x5b, 0x60, 0x0d, 0x07, 0x4f, 0x81, 0xa9, 0x5b, 0x87,
+	0xd2, 0xa2, 0x86, 0x35, 0xcd, 0x37, 0x78, 0x36, 0x3c, 0x06, 0x6b, 0x1f, 0x04, 0x6c, 0xdc, 0xc6,
+	0xef, 0xad, 0xa0, 0xbb, 0x8c, 0xda, 0xd7, 0x63, 0x39, 0xc7, 0x74, 0x33, 0x16, 0xd5, 0xe0, 0x74,
+	0x5c, 0xed, 0xef, 0x93, 0x20, 0xed, 0x57, 0xfd, 0xf2, 0x37, 0xe5, 0x9b, 0xe0, 0xc6, 0x19, 0xa6,
+	0xe8, 0x2b, 0xdc, 0x92, 0x0c, 0x0f, 0x8f, 0x26, 0x0e, 0xa5, 0x5e, 0xc4, 0x21, 0x2d, 0x29, 0x0a,
+	0x13, 0x97, 0x7e, 0x04, 0xd6, 0xd9, 0x13, 0x11, 0xd3, 0xc1, 0xf1, 0x7b, 0x6d, 0x11, 0x78, 0x7e,
+	0x59, 0xb4, 0x97, 0xf9, 0x5d, 0xbb, 0x3d, 0x3f, 0x46, 0x8c, 0x90, 0x93, 0x7b, 0xf5, 0xf3, 0x34,
+	0xc8, 0xf1, 0x36, 0x56, 0xb7, 0xfa, 0x96, 0x4b, 0xe0, 0xaf, 0x04, 0x90, 0x71, 0x1d, 0x6f, 0xd2,
+	0x55, 0x85, 0x65, 0x5d, 0xd5, 0xf4, 0xcb, 0x5d, 0x8d, 0xe5, 0x5b, 0x11, 0xd4, 0x3d, 0xec, 0x3a,
+	0x14, 0xb9, 0x3d, 0x7a, 0x31, 0x75, 0x3b, 0x32, 0xbc, 0x5a, 0xb3, 0x05, 0xae, 0xe3, 0x85, 0xad,
+	0xf6, 0x17, 0x02, 0x80, 0xae, 0x75, 0x1e, 0x12, 0x99, 0x3d, 0xd4, 0x77, 0xb0, 0xcd, 0x2f, 0xf4,
+	0xdb, 0x73, 0xfd, 0xa9, 0xcc, 0xbf, 0xcf, 0xd8, 0xd1, 0xb8, 0x1a, 0xcb, 0x77, 0xe6, 0xc1, 0x31,
+	0xad, 0xfc, 0x2a, 0x9d, 0xcf, 0x52, 0x7f, 0xe9, 0xb7, 0xc8, 0xbc, 0x6b, 0x9d, 0x87, 0x76, 0x05,
+	0x61, 0xf8, 0x67, 0x01, 0xdc, 0x9a, 0xf4, 0x21, 0x33, 0x6a, 0xdc, 0xd2, 0x6f, 0x22, 0xc2, 0x35,
+	0xc9, 0x0b, 0xf1, 0x31, 0x59, 0x77, 0x98, 0xac, 0x85, 0x89, 0xab, 0x99, 0xb9, 0x3d, 0xe1, 0x78,
+	0x7b, 0xe2, 0xaa, 0xfa, 0x71, 0x12, 0x64, 0x5b, 0x41, 0xf3, 0xe7, 0x9b, 0xe0, 0xa7, 0x80, 0x5f,
+	0x06, 0xa1, 0xc1, 0xc2, 0x32, 0x83, 0x1f, 0xf2, 0xc9, 0xec, 0xc5, 0x70, 0xb1, 0x49, 0xec, 0xc4,
+	0xee, 0x9e, 0xa8, 0xad, 0x59, 0x16, 0xe3, 0x96, 0xfe, 0x56, 0x00, 0x7b, 0xd3, 0x99, 0xc6, 0x75,
+	0x2c, 0x5d, 0xe8, 0x1a, 0xd7, 0xf1, 0xea, 0xff, 0x61, 0x88, 0x29, 0x92, 0x66, 0x6d, 0x5d, 0xa0,
+	0x6d, 0xba, 0xba, 0xad, 0x88, 0x48, 0xf5, 0x3f, 0x29, 0x7e, 0xbb, 0x70, 0xc7, 0xde, 0x03, 0x6b,
+	0x3f, 0x19, 0xe0, 0xfe, 0xc0, 0x0d, 0xac, 0xca, 0x6a, 0xda, 0x6a, 0x3d, 0xed, 0x6a, 0x2c, 0xe7,
+	0x19, 0x7e, 0x2a, 0xd0, 0xe0, 0x8c, 0xb0, 0x0d, 0x36, 0xe9, 0x69, 0x1f, 0x91, 0x53, 0xdc, 0xb5,
+	0x79, 0x4b, 0xd2, 0x57, 0xa6, 0xdf, 0x9e, 0x50, 0x44, 0x2a, 0x4c, 0x79, 0xe1, 0x50, 0x00, 0x5b,
+	0x7e, 0xff, 0x37, 0xa7, 0xa5, 0x52, 0x41, 0xa9, 0xf6, 0xca, 0xa5, 0xc4, 0x38, 0x4f, 0xcc, 0xf2,
+	0x5b, 0x7c, 0x13, 0xc4, 0x32, 0x54, 0x23, 0xe7, 0x07, 0x9a, 0x13, 0x31, 0xbf, 0x16, 0xc0, 0x74,
+	0xa3, 0x46, 0x14, 0xa5, 0x03, 0x45, 0xee, 0xca, 0x8a, 0x5e, 0x59, 0x40, 0x16, 0x93, 0x55, 0x98,
+	0xdd, 0x09, 0x11, 0x6d, 0x70, 0x12, 0x9d, 0x08, 0xbc, 0xfb, 0x4f, 0x01, 0x80, 0xc8, 0x4d, 0x78,
+	0x0f, 0xec, 0xb5, 0x6a, 0x4d, 0xdd, 0xac, 0xd5, 0x9b, 0x95, 0x5a, 0xd5, 0x7c, 0xa7, 0xda, 0xa8,
+	0xeb, 0x8f, 0x2a, 0x8f, 0x2b, 0x7a, 0x39, 0x9f, 0x28, 0xdc, 0x1c, 0x8e, 0x94, 0x0c, 0x4b, 0xd4,
+	0xfd, 0x72, 0x50, 0x05, 0x37, 0xa3, 0xd9, 0xef, 0xea, 0x8d, 0xbc, 0x50, 0xc8, 0x0d, 0x47, 0xca,
+	0x26, 0xcb, 0x7a, 0x17, 0x11, 0x78, 0x17, 0x6c, 0x47, 0x73, 0x4a, 0x5a, 0xa3, 0x59, 0xaa, 0x54,
+	0xf3, 0xc9, 0xc2, 0x4b, 0xc3, 0x91, 0x92, 0x63, 0x79, 0x25, 0xfe, 0x35, 0xa1, 0x80, 0xad, 0x68,
+	0x6e, 0xb5, 0x96, 0x4f, 0x15, 0xb2, 0xc3, 0x91, 0xb2, 0xc1, 0xd2, 0xaa, 0x18, 0x1e, 0x02, 0x31,
+	0x9e, 0x61, 0x1e, 0x57, 0x9a, 0x4f, 0xcc, 0x96, 0xde, 0xac, 0xe5, 0xd3, 0x85, 0x9d, 0xe1, 0x48,
+	0xc9, 0x87, 0xb9, 0xe1, 0xd5, 0x5f, 0x48, 0x7f, 0xf8, 0x3b, 0x29, 0x71, 0xf7, 0xaf, 0x49, 0xb0,
+	0x15, 0xff, 0xbf, 0x06, 0x16, 0xc1, 0xcb, 0x75, 0xa3, 0x56, 0xaf, 0x35, 0x4a, 0x6f, 0x99, 0x8d,
+	0x66, 0xa9, 0xf9, 0x4e, 0x63, 0x66, 0xc2, 0xc1, 0x54, 0x58, 0x72, 0xd5, 0xe9, 0xc2, 0x87, 0x40,
+	0x9a, 0xcd, 0x2f, 0xeb, 0xf5, 0x5a, 0xa3, 0xd2, 0x34, 0xeb, 0xba, 0x51, 0xa9, 0x95, 0xf3, 0x42,
+	0x61, 0x6f, 0x38, 0x52, 0xb6, 0x19, 0x24, 0xde, 0x5f, 0xbf, 0x03, 0x5e, 0x99, 0x05, 0xb7, 0x6a,
+	0xcd, 0x4a, 0xf5, 0xcd, 0x10, 0x9b, 0x2c, 0xec, 0x0e, 0x47, 0x0a, 0x64, 0xd8, 0xe8, 0x11, 0x85,
+	0xf7, 0xc0, 0xee, 0x2c, 0xb4, 0x5e, 0x6a, 0x34, 0xf4, 0x72, 0x3e, 0x55, 0xc8, 0x0f, 0x47, 0x4a,
+	0x96, 0x61, 0xea, 0x16, 0x21, 0xc8, 0x86, 0xaf, 0x03, 0x71, 0x36, 0xdb, 0xd0, 0x7f, 0xa0, 0x3f,
+	0x6a, 0xea, 0xe5, 0x7c, 0xba, 0x00, 0x87, 0x23, 0x65, 0x8b, 0xe5, 0x1b, 0xe8, 0xc7, 0xa8, 0x4d,
+	0xd1, 0x42, 0xfe, 0xc7, 0xa5, 0xca, 0x5b, 0x7a, 0x39, 0x7f, 0x23, 0xca, 0xff, 0x38, 0xf8, 0xac,
+	0x65, 0x76, 0x6a, 0xd5, 0x67, 0x9f, 0x4b, 0x89, 0x4f, 0x3f, 0x97, 0x12, 0x3f, 0xbb, 0x94, 0x12,
+	0xcf, 0x2e, 0x25, 0xe1, 0x93, 0x4b, 0x49, 0xf8, 0xc7, 0xa5, 0x24, 0x7c, 0xf4, 0x5c, 0x4a, 0x7c,
+	0xf2, 0x5c, 0x4a, 0x7c, 0xfa, 0x5c, 0x4a, 0xbc, 0xf7, 0xc5, 0xed, 0xfc, 0x3c, 0xf8, 0xf9, 0x24,
+	0xd8, 0xde, 0x27, 0x6b, 0x41, 0x07, 0xfc, 0xc6, 0xff, 0x06, 0x00, 0x11, 0x6b, 0xf1, 0xf6, 0x59,
+	0x11, 0x00, 0x00,
 }
 
 func (this *TextProposal) Equal(that interface{}) bool {
@@ -659,6 +735,20 @@ func (this *Proposal) Equal(that interface{}) bool {
 	if !this.VotingEndTime.Equal(that1.VotingEndTime) {
 		return false
 	}
+	if len(this.Messages) != len(that1.Messages) {
+		return false
+	}
+	for i := range this.Messages {
+		if !this.Messages[i].Equal(that1.Messages[i]) {
+			return false
+		}
+	}
+	if this.FailedReason != that1.FailedReason {
+		return false
+	}
+	if this.IsExpedited != that1.IsExpedited {
+		return false
+	}
 	return true
 }
 func (this *TallyResult) Equal(that interface{}) bool {
@@ -694,6 +784,33 @@ func (this *TallyResult) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *WeightedVoteOption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WeightedVoteOption)
+	if !ok {
+		that2, ok := that.(WeightedVoteOption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Option != that1.Option {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
 func (this *Vote) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -722,6 +839,14 @@ func (this *Vote) Equal(that interface{}) bool {
 	if this.Option != that1.Option {
 		return false
 	}
+	if len(this.Options) != len(that1.Options) {
+		return false
+	}
+	for i := range this.Options {
+		if !this.Options[i].Equal(&that1.Options[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *TextProposal) Marshal() (dAtA []byte, err error) {
@@ -830,6 +955,37 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsExpedited {
+		i--
+		if m.IsExpedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.FailedReason) > 0 {
+		i -= len(m.FailedReason)
+		copy(dAtA[i:], m.FailedReason)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.FailedReason)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
 	if err1 != nil {
 		return 0, err1
@@ -974,6 +1130,44 @@ func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Option != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Vote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -994,6 +1188,20 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Option != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.Option))
 		i--
@@ -1034,6 +1242,20 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for iNdEx := len(m.ExpeditedMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpeditedMinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod):])
 	if err7 != nil {
 		return 0, err7
@@ -1079,13 +1301,21 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ExpeditedVotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExpeditedVotingPeriod):])
 	if err8 != nil {
 		return 0, err8
 	}
 	i -= n8
 	i = encodeVarintGov(dAtA, i, uint64(n8))
 	i--
+	dAtA[i] = 0x12
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintGov(dAtA, i, uint64(n9))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
@@ -1110,6 +1340,16 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ExpeditedThreshold.Size()
+		i -= size
+		if _, err := m.ExpeditedThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	{
 		size := m.VetoThreshold.Size()
 		i -= size
@@ -1225,6 +1465,19 @@ func (m *Proposal) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
 	n += 1 + l + sovGov(uint64(l))
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.FailedReason)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.IsExpedited {
+		n += 2
+	}
 	return n
 }
 
@@ -1245,6 +1498,20 @@ func (m *TallyResult) Size() (n int) {
 	return n
 }
 
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovGov(uint64(m.Option))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
 func (m *Vote) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1261,6 +1528,12 @@ func (m *Vote) Size() (n int) {
 	if m.Option != 0 {
 		n += 1 + sovGov(uint64(m.Option))
 	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1278,6 +1551,12 @@ func (m *DepositParams) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod)
 	n += 1 + l + sovGov(uint64(l))
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for _, e := range m.ExpeditedMinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1289,6 +1568,8 @@ func (m *VotingParams) Size() (n int) {
 	_ = l
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod)
 	n += 1 + l + sovGov(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExpeditedVotingPeriod)
+	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
@@ -1304,6 +1585,8 @@ func (m *TallyParams) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = m.VetoThreshold.Size()
 	n += 1 + l + sovGov(uint64(l))
+	l = m.ExpeditedThreshold.Size()
+	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
@@ -1872,6 +2155,92 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsExpedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsExpedited = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2085,6 +2454,112 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Vote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2186,6 +2661,40 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2306,6 +2815,40 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedMinDeposit = append(m.ExpeditedMinDeposit, types.Coin{})
+			if err := m.ExpeditedMinDeposit[len(m.ExpeditedMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2392,6 +2935,39 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2544,6 +3120,39 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpeditedThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 
 	yaml "gopkg.in/yaml.v2"
@@ -16,13 +17,14 @@ import (
 const (
 	TypeMsgDeposit        = "deposit"
 	TypeMsgVote           = "vote"
+	TypeMsgVoteWeighted   = "weighted_vote"
 	TypeMsgSubmitProposal = "submit_proposal"
 )
 
 var (
-	_, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}
-	_       MsgSubmitProposalI            = &MsgSubmitProposal{}
-	_       types.UnpackInterfacesMessage = &MsgSubmitProposal{}
+	_, _, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}
+	_          MsgSubmitProposalI            = &MsgSubmitProposal{}
+	_          types.UnpackInterfacesMessage = &MsgSubmitProposal{}
 )
 
 // MsgSubmitProposalI defines the specific interface a concrete message must
@@ -66,6 +68,22 @@ func (m *MsgSubmitProposal) GetContent() Content {
 	return content
 }
 
+// GetMsgs returns the cached sdk.Msgs to execute if the proposal passes.
+func (m *MsgSubmitProposal) GetMsgs() ([]sdk.Msg, error) {
+	return unpackCachedMsgs(m.Messages)
+}
+
+// SetMsgs packs the given sdk.Msgs into the message.
+func (m *MsgSubmitProposal) SetMsgs(msgs []sdk.Msg) error {
+	anys, err := packMsgs(msgs)
+	if err != nil {
+		return err
+	}
+
+	m.Messages = anys
+	return nil
+}
+
 func (m *MsgSubmitProposal) SetInitialDeposit(coins sdk.Coins) {
 	m.InitialDeposit = coins
 }
@@ -116,12 +134,59 @@ func (m MsgSubmitProposal) ValidateBasic() error {
 		return err
 	}
 
+	msgs, err := m.GetMsgs()
+	if err != nil {
+		return err
+	}
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidProposalMsg, "msg %d: %s", i, err)
+		}
+	}
+
 	return nil
 }
 
-// GetSignBytes implements Msg
+// GetSignBytes implements Msg. The proposal messages, if any, are embedded
+// using their own sign bytes, so that x/gov does not need to know the Amino
+// names of every Msg type a proposal may carry.
 func (m MsgSubmitProposal) GetSignBytes() []byte {
-	bz := ModuleCdc.MustMarshalJSON(m)
+	if len(m.Messages) == 0 {
+		bz := ModuleCdc.MustMarshalJSON(m)
+		return sdk.MustSortJSON(bz)
+	}
+
+	msgs, err := m.GetMsgs()
+	if err != nil {
+		panic(err)
+	}
+
+	msgsBz := make([]json.RawMessage, len(msgs))
+	for i, msg := range msgs {
+		msgsBz[i] = msg.GetSignBytes()
+	}
+
+	withoutMsgs := m
+	withoutMsgs.Messages = nil
+
+	var signDoc struct {
+		Type  string                     `json:"type"`
+		Value map[string]json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(ModuleCdc.MustMarshalJSON(withoutMsgs), &signDoc); err != nil {
+		panic(err)
+	}
+
+	signDoc.Value["messages"], err = json.Marshal(msgsBz)
+	if err != nil {
+		panic(err)
+	}
+
+	bz, err := json.Marshal(signDoc)
+	if err != nil {
+		panic(err)
+	}
+
 	return sdk.MustSortJSON(bz)
 }
 
@@ -139,7 +204,11 @@ func (m MsgSubmitProposal) String() string {
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (m MsgSubmitProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	var content Content
-	return unpacker.UnpackAny(m.Content, &content)
+	if err := unpacker.UnpackAny(m.Content, &content); err != nil {
+		return err
+	}
+
+	return unpackMsgs(unpacker, m.Messages)
 }
 
 // NewMsgDeposit creates a new MsgDeposit instance
@@ -224,3 +293,41 @@ func (msg MsgVote) GetSignBytes() []byte {
 func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
+
+// NewMsgVoteWeighted creates a message to cast a vote on an active proposal
+// with the voter's voting power split across the given options
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) *MsgVoteWeighted {
+	return &MsgVoteWeighted{proposalID, voter, options}
+}
+
+// Route implements Msg
+func (msg MsgVoteWeighted) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgVoteWeighted) Type() string { return TypeMsgVoteWeighted }
+
+// ValidateBasic implements Msg
+func (msg MsgVoteWeighted) ValidateBasic() error {
+	if msg.Voter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Voter.String())
+	}
+
+	return ValidateWeightedVoteOptions(msg.Options)
+}
+
+// String implements the Stringer interface
+func (msg MsgVoteWeighted) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
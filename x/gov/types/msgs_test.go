@@ -118,6 +118,72 @@ func TestMsgVote(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgVoteWeighted
+func TestMsgVoteWeighted(t *testing.T) {
+	tests := []struct {
+		proposalID uint64
+		voterAddr  sdk.AccAddress
+		options    WeightedVoteOptions
+		expectPass bool
+	}{
+		{0, addrs[0], NewNonSplitVoteOption(OptionYes), true},
+		{0, sdk.AccAddress{}, NewNonSplitVoteOption(OptionYes), false},
+		{0, addrs[0], NewNonSplitVoteOption(OptionNo), true},
+		{0, addrs[0], NewNonSplitVoteOption(OptionNoWithVeto), true},
+		{0, addrs[0], NewNonSplitVoteOption(OptionAbstain), true},
+		{0, addrs[0], WeightedVoteOptions{ // weight sum > 1
+			WeightedVoteOption{Option: OptionYes, Weight: sdk.NewDec(1)},
+			WeightedVoteOption{Option: OptionAbstain, Weight: sdk.NewDec(1)},
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{ // duplicate option
+			WeightedVoteOption{Option: OptionYes, Weight: sdk.NewDecWithPrec(5, 1)},
+			WeightedVoteOption{Option: OptionYes, Weight: sdk.NewDecWithPrec(5, 1)},
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{ // zero weight
+			WeightedVoteOption{Option: OptionYes, Weight: sdk.NewDec(0)},
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{ // negative weight
+			WeightedVoteOption{Option: OptionYes, Weight: sdk.NewDec(-1)},
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{}, false},
+		{0, addrs[0], NewNonSplitVoteOption(VoteOption(0x13)), false},
+		{0, addrs[0], WeightedVoteOptions{ // weight sum <1
+			WeightedVoteOption{Option: OptionYes, Weight: sdk.NewDecWithPrec(2, 1)},
+			WeightedVoteOption{Option: OptionAbstain, Weight: sdk.NewDecWithPrec(2, 1)},
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{
+			WeightedVoteOption{Option: OptionYes, Weight: sdk.NewDecWithPrec(6, 1)},
+			WeightedVoteOption{Option: OptionNo, Weight: sdk.NewDecWithPrec(3, 1)},
+			WeightedVoteOption{Option: OptionNoWithVeto, Weight: sdk.NewDecWithPrec(1, 1)},
+		}, true},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgVoteWeighted(tc.voterAddr, tc.proposalID, tc.options)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestWeightedVoteOptionsFromString(t *testing.T) {
+	options, err := WeightedVoteOptionsFromString("VOTE_OPTION_YES=0.6,VOTE_OPTION_NO=0.4")
+	require.NoError(t, err)
+	require.Equal(t, WeightedVoteOptions{
+		{Option: OptionYes, Weight: sdk.NewDecWithPrec(6, 1)},
+		{Option: OptionNo, Weight: sdk.NewDecWithPrec(4, 1)},
+	}, options)
+
+	_, err = WeightedVoteOptionsFromString("VOTE_OPTION_YES")
+	require.Error(t, err)
+	_, err = WeightedVoteOptionsFromString("VOTE_OPTION_YES=abc")
+	require.Error(t, err)
+	_, err = WeightedVoteOptionsFromString("yes=0.5")
+	require.Error(t, err)
+}
+
 // this tests that Amino JSON MsgSubmitProposal.GetSignBytes() still works with Content as Any using the ModuleCdc
 func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
 	msg, err := NewMsgSubmitProposal(NewTextProposal("test", "abcd"), sdk.NewCoins(), sdk.AccAddress{})
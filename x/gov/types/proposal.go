@@ -81,10 +81,30 @@ func (p Proposal) GetTitle() string {
 	return content.GetTitle()
 }
 
+// GetMsgs returns the cached sdk.Msgs executed when the proposal passes.
+func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
+	return unpackCachedMsgs(p.Messages)
+}
+
+// SetMsgs packs the given sdk.Msgs into the proposal.
+func (p *Proposal) SetMsgs(msgs []sdk.Msg) error {
+	anys, err := packMsgs(msgs)
+	if err != nil {
+		return err
+	}
+
+	p.Messages = anys
+	return nil
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Proposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	var content Content
-	return unpacker.UnpackAny(p.Content, &content)
+	if err := unpacker.UnpackAny(p.Content, &content); err != nil {
+		return err
+	}
+
+	return unpackMsgs(unpacker, p.Messages)
 }
 
 // Proposals is an array of proposal
@@ -261,3 +281,50 @@ func ProposalHandler(_ sdk.Context, c Content) error {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized gov proposal type: %s", c.ProposalType())
 	}
 }
+
+func packMsgs(msgs []sdk.Msg) ([]*types.Any, error) {
+	if len(msgs) == 0 {
+		return nil, nil
+	}
+
+	anys := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		pb, ok := msg.(proto.Message)
+		if !ok {
+			return nil, fmt.Errorf("cannot proto marshal %T", msg)
+		}
+
+		any, err := types.NewAnyWithValue(pb)
+		if err != nil {
+			return nil, err
+		}
+
+		anys[i] = any
+	}
+
+	return anys, nil
+}
+
+func unpackCachedMsgs(anys []*types.Any) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(anys))
+	for i, any := range anys {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(ErrInvalidProposalMsg, "messages contains %T which is not a sdk.Msg", any)
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+func unpackMsgs(unpacker types.AnyUnpacker, anys []*types.Any) error {
+	for _, any := range anys {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -2,15 +2,23 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	yaml "gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// NewVote creates a new Vote instance
-func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter, option}
+// NewVote creates a new Vote instance. The deprecated Option field is set
+// only if the options consist of a single option with weight 1.
+func NewVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	vote := Vote{ProposalId: proposalID, Voter: voter, Options: options}
+	if len(options) == 1 && options[0].Weight.Equal(sdk.OneDec()) {
+		vote.Option = options[0].Option //nolint:staticcheck
+	}
+
+	return vote
 }
 
 func (v Vote) String() string {
@@ -42,7 +50,7 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalId)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Options)
 	}
 	return out
 }
@@ -52,6 +60,91 @@ func (v Vote) Empty() bool {
 	return v.Equal(Vote{})
 }
 
+// NewNonSplitVoteOption creates a single option vote with weight 1
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{{option, sdk.NewDec(1)}}
+}
+
+func (v WeightedVoteOption) String() string {
+	out, _ := yaml.Marshal(v)
+	return string(out)
+}
+
+// WeightedVoteOptions describes array of WeightedVoteOptions
+type WeightedVoteOptions []WeightedVoteOption
+
+func (v WeightedVoteOptions) String() (out string) {
+	for _, opt := range v {
+		out += opt.String() + "\n"
+	}
+
+	return strings.TrimSpace(out)
+}
+
+// ValidWeightedVoteOption returns true if the sub vote is valid and false otherwise.
+func ValidWeightedVoteOption(option WeightedVoteOption) bool {
+	if !option.Weight.IsPositive() || option.Weight.GT(sdk.NewDec(1)) {
+		return false
+	}
+	return ValidVoteOption(option.Option)
+}
+
+// ValidateWeightedVoteOptions returns an error if the options are empty, if
+// any of them is invalid or duplicated, or if their weights do not sum to 1.
+func ValidateWeightedVoteOptions(options WeightedVoteOptions) error {
+	if len(options) == 0 {
+		return sdkerrors.Wrap(ErrInvalidVote, "empty vote options")
+	}
+
+	usedOptions := make(map[VoteOption]bool)
+	totalWeight := sdk.ZeroDec()
+	for _, option := range options {
+		if !ValidWeightedVoteOption(option) {
+			return sdkerrors.Wrap(ErrInvalidVote, option.String())
+		}
+		if usedOptions[option.Option] {
+			return sdkerrors.Wrapf(ErrInvalidVote, "duplicated vote option %s", option.Option)
+		}
+
+		usedOptions[option.Option] = true
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.NewDec(1)) {
+		return sdkerrors.Wrapf(ErrInvalidVote, "total weight %s is not equal to 1", totalWeight)
+	}
+
+	return nil
+}
+
+// WeightedVoteOptionsFromString returns weighted vote options from a string
+// of the form "yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05". Each option
+// must be given by its VoteOption name. It returns an error if the string is
+// malformed or any option or weight is invalid.
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	options := WeightedVoteOptions{}
+	for _, option := range strings.Split(str, ",") {
+		fields := strings.Split(option, "=")
+		if len(fields) != 2 {
+			return options, fmt.Errorf("'%s' is not a valid weighted vote option", option)
+		}
+
+		voteOption, err := VoteOptionFromString(strings.TrimSpace(fields[0]))
+		if err != nil {
+			return options, err
+		}
+
+		weight, err := sdk.NewDecFromStr(strings.TrimSpace(fields[1]))
+		if err != nil {
+			return options, err
+		}
+
+		options = append(options, WeightedVoteOption{voteOption, weight})
+	}
+
+	return options, nil
+}
+
 // VoteOptionFromString returns a VoteOption from a string. It returns an error
 // if the string is invalid.
 func VoteOptionFromString(str string) (VoteOption, error) {
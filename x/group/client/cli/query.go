@@ -0,0 +1,480 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the group module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		QueryGroupInfoCmd(),
+		QueryGroupAccountInfoCmd(),
+		QueryGroupMembersCmd(),
+		QueryGroupsByAdminCmd(),
+		QueryGroupAccountsByGroupCmd(),
+		QueryGroupAccountsByAdminCmd(),
+		QueryProposalCmd(),
+		QueryProposalsByGroupAccountCmd(),
+		QueryVoteByProposalVoterCmd(),
+		QueryVotesByProposalCmd(),
+		QueryVotesByVoterCmd(),
+	)
+
+	return queryCmd
+}
+
+// QueryGroupInfoCmd creates a CLI command for Query/GroupInfo.
+func QueryGroupInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-info [id]",
+		Short: "Query for group info by group id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GroupInfo(context.Background(), &types.QueryGroupInfoRequest{
+				GroupId: groupID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryGroupAccountInfoCmd creates a CLI command for Query/GroupAccountInfo.
+func QueryGroupAccountInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-account-info [group-account]",
+		Short: "Query for group account info by group account address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GroupAccountInfo(context.Background(), &types.QueryGroupAccountInfoRequest{
+				Address: address,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryGroupMembersCmd creates a CLI command for Query/GroupMembers.
+func QueryGroupMembersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-members [id]",
+		Short: "Query for group members by group id with pagination flags",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GroupMembers(context.Background(), &types.QueryGroupMembersRequest{
+				GroupId:    groupID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "group-members")
+
+	return cmd
+}
+
+// QueryGroupsByAdminCmd creates a CLI command for Query/GroupsByAdmin.
+func QueryGroupsByAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "groups-by-admin [admin]",
+		Short: "Query for groups by admin account address with pagination flags",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			admin, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GroupsByAdmin(context.Background(), &types.QueryGroupsByAdminRequest{
+				Admin:      admin,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "groups-by-admin")
+
+	return cmd
+}
+
+// QueryGroupAccountsByGroupCmd creates a CLI command for Query/GroupAccountsByGroup.
+func QueryGroupAccountsByGroupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-accounts-by-group [group-id]",
+		Short: "Query for group accounts by group id with pagination flags",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GroupAccountsByGroup(context.Background(), &types.QueryGroupAccountsByGroupRequest{
+				GroupId:    groupID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "group-accounts-by-group")
+
+	return cmd
+}
+
+// QueryGroupAccountsByAdminCmd creates a CLI command for Query/GroupAccountsByAdmin.
+func QueryGroupAccountsByAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-accounts-by-admin [admin]",
+		Short: "Query for group accounts by admin account address with pagination flags",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			admin, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GroupAccountsByAdmin(context.Background(), &types.QueryGroupAccountsByAdminRequest{
+				Admin:      admin,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "group-accounts-by-admin")
+
+	return cmd
+}
+
+// QueryProposalCmd creates a CLI command for Query/Proposal.
+func QueryProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal [id]",
+		Short: "Query for proposal by id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Proposal(context.Background(), &types.QueryProposalRequest{
+				ProposalId: proposalID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryProposalsByGroupAccountCmd creates a CLI command for Query/ProposalsByGroupAccount.
+func QueryProposalsByGroupAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposals-by-group-account [group-account]",
+		Short: "Query for proposals by group account address with pagination flags",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ProposalsByGroupAccount(context.Background(), &types.QueryProposalsByGroupAccountRequest{
+				Address:    address,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "proposals-by-group-account")
+
+	return cmd
+}
+
+// QueryVoteByProposalVoterCmd creates a CLI command for Query/VoteByProposalVoter.
+func QueryVoteByProposalVoterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [proposal-id] [voter]",
+		Short: "Query for vote by proposal id and voter account address",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			voter, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.VoteByProposalVoter(context.Background(), &types.QueryVoteByProposalVoterRequest{
+				ProposalId: proposalID,
+				Voter:      voter,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryVotesByProposalCmd creates a CLI command for Query/VotesByProposal.
+func QueryVotesByProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "votes-by-proposal [proposal-id]",
+		Short: "Query for votes by proposal id with pagination flags",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.VotesByProposal(context.Background(), &types.QueryVotesByProposalRequest{
+				ProposalId: proposalID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "votes-by-proposal")
+
+	return cmd
+}
+
+// QueryVotesByVoterCmd creates a CLI command for Query/VotesByVoter.
+func QueryVotesByVoterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "votes-by-voter [voter]",
+		Short: "Query for votes by voter account address with pagination flags",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			voter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.VotesByVoter(context.Background(), &types.QueryVotesByVoterRequest{
+				Voter:      voter,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "votes-by-voter")
+
+	return cmd
+}
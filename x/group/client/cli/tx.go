@@ -0,0 +1,643 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// Flags for the group tx commands
+const (
+	FlagThreshold  = "threshold"
+	FlagPercentage = "percentage"
+	FlagTimeout    = "timeout"
+)
+
+// GetTxCmd returns the transaction commands for the group module
+func GetTxCmd() *cobra.Command {
+	groupTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Group transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	groupTxCmd.AddCommand(
+		NewCmdCreateGroup(),
+		NewCmdUpdateGroupMembers(),
+		NewCmdUpdateGroupAdmin(),
+		NewCmdUpdateGroupMetadata(),
+		NewCmdCreateGroupAccount(),
+		NewCmdUpdateGroupAccountAdmin(),
+		NewCmdUpdateGroupAccountDecisionPolicy(),
+		NewCmdUpdateGroupAccountMetadata(),
+		NewCmdCreateProposal(),
+		NewCmdVote(),
+		NewCmdExec(),
+	)
+
+	return groupTxCmd
+}
+
+// NewCmdCreateGroup returns a CLI command handler for creating a MsgCreateGroup transaction.
+func NewCmdCreateGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group [admin] [metadata] [members-json-file]",
+		Short: "Create a group which is an aggregation of member accounts with associated weights and an administrator account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a group which is an aggregation of member accounts with associated weights and
+an administrator account. Note, the '--from' flag is ignored as it is implied from [admin].
+Members accounts can be given through a members JSON file that contains an array of members.
+
+Example:
+$ %s tx %s create-group [admin] [metadata] [members-json-file]
+
+Where members.json contains:
+
+{
+	"members": [
+		{
+			"address": "addr1",
+			"weight": "1"
+		},
+		{
+			"address": "addr2",
+			"weight": "1"
+		}
+	]
+}
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			members, err := parseMembers(clientCtx, args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreateGroup{
+				Admin:    clientCtx.GetFromAddress(),
+				Members:  members,
+				Metadata: []byte(args[1]),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUpdateGroupMembers returns a CLI command handler for creating a MsgUpdateGroupMembers transaction.
+func NewCmdUpdateGroupMembers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-members [admin] [group-id] [members-json-file]",
+		Short: "Update a group's members. Set a member's weight to \"0\" to delete it.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update a group's members. Note, the '--from' flag is ignored as it is
+implied from [admin]. The members JSON file has the same format as for create-group.
+
+Example:
+$ %s tx %s update-group-members [admin] [group-id] [members-json-file]
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			members, err := parseMembers(clientCtx, args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateGroupMembers{
+				Admin:         clientCtx.GetFromAddress(),
+				GroupId:       groupID,
+				MemberUpdates: members,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUpdateGroupAdmin returns a CLI command handler for creating a MsgUpdateGroupAdmin transaction.
+func NewCmdUpdateGroupAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-admin [admin] [group-id] [new-admin]",
+		Short: "Update a group's admin",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			newAdmin, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateGroupAdmin{
+				Admin:    clientCtx.GetFromAddress(),
+				GroupId:  groupID,
+				NewAdmin: newAdmin,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUpdateGroupMetadata returns a CLI command handler for creating a MsgUpdateGroupMetadata transaction.
+func NewCmdUpdateGroupMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-metadata [admin] [group-id] [metadata]",
+		Short: "Update a group's metadata",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateGroupMetadata{
+				Admin:    clientCtx.GetFromAddress(),
+				GroupId:  groupID,
+				Metadata: []byte(args[2]),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdCreateGroupAccount returns a CLI command handler for creating a MsgCreateGroupAccount transaction.
+func NewCmdCreateGroupAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group-account [admin] [group-id] [metadata]",
+		Short: "Create a group account which is an account associated with a group and a decision policy",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a group account which is an account associated with a group and a decision policy.
+The decision policy is either a threshold of yes votes, or a percentage of the group's total weight.
+Note, the '--from' flag is ignored as it is implied from [admin].
+
+Example:
+$ %s tx %s create-group-account [admin] [group-id] [metadata] --threshold=2 --timeout=24h
+$ %s tx %s create-group-account [admin] [group-id] [metadata] --percentage=0.5 --timeout=24h
+`, version.AppName, types.ModuleName, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			policy, err := decisionPolicyFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgCreateGroupAccount(clientCtx.GetFromAddress(), groupID, []byte(args[2]), policy)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addDecisionPolicyFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUpdateGroupAccountAdmin returns a CLI command handler for creating a MsgUpdateGroupAccountAdmin transaction.
+func NewCmdUpdateGroupAccountAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-account-admin [admin] [group-account] [new-admin]",
+		Short: "Update a group account admin",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			newAdmin, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateGroupAccountAdmin{
+				Admin:    clientCtx.GetFromAddress(),
+				Address:  address,
+				NewAdmin: newAdmin,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUpdateGroupAccountDecisionPolicy returns a CLI command handler for creating a
+// MsgUpdateGroupAccountDecisionPolicy transaction.
+func NewCmdUpdateGroupAccountDecisionPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-account-policy [admin] [group-account]",
+		Short: "Update a group account decision policy",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update a group account decision policy. This aborts the pending proposals
+of the group account. Note, the '--from' flag is ignored as it is implied from [admin].
+
+Example:
+$ %s tx %s update-group-account-policy [admin] [group-account] --threshold=3 --timeout=48h
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			policy, err := decisionPolicyFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgUpdateGroupAccountDecisionPolicy(clientCtx.GetFromAddress(), address, policy)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addDecisionPolicyFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUpdateGroupAccountMetadata returns a CLI command handler for creating a MsgUpdateGroupAccountMetadata transaction.
+func NewCmdUpdateGroupAccountMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-account-metadata [admin] [group-account] [new-metadata]",
+		Short: "Update a group account metadata",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateGroupAccountMetadata{
+				Admin:    clientCtx.GetFromAddress(),
+				Address:  address,
+				Metadata: []byte(args[2]),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdCreateProposal returns a CLI command handler for creating a MsgCreateProposal transaction.
+func NewCmdCreateProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-proposal [group-account] [proposer[,proposer]*] [msg_tx_json_file] [metadata]",
+		Short: "Submit a new proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a new proposal to a group account. The proposed messages are read
+from a transaction file, and must all be signed by the group account only. The first
+proposer is used as the signer of the transaction, and every proposer must sign it.
+
+Example:
+$ %s tx bank send [group-account] [recipient] 100stake --generate-only > tx.json
+$ %s tx %s create-proposal [group-account] [proposer] tx.json [metadata]
+`, version.AppName, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposerAddrs := strings.Split(args[1], ",")
+			cmd.Flags().Set(flags.FlagFrom, proposerAddrs[0])
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			proposers := make([]sdk.AccAddress, len(proposerAddrs))
+			for i, p := range proposerAddrs {
+				proposers[i], err = sdk.AccAddressFromBech32(p)
+				if err != nil {
+					return err
+				}
+			}
+
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[2])
+			if err != nil {
+				return err
+			}
+
+			msgs := theTx.GetMsgs()
+			if len(msgs) == 0 {
+				return errors.New("no messages found in the transaction file")
+			}
+
+			msg, err := types.NewMsgCreateProposal(address, proposers, msgs, []byte(args[3]))
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdVote returns a CLI command handler for creating a MsgVote transaction.
+func NewCmdVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [proposal-id] [voter] [choice] [metadata]",
+		Short: "Vote on a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Vote on a proposal. Note, the '--from' flag is ignored as it is implied from [voter].
+
+Parameters:
+			proposal-id: unique ID of the proposal
+			voter: voter account addresses.
+			choice: choice of the voter(s)
+				CHOICE_UNSPECIFIED: no-op
+				CHOICE_NO: no
+				CHOICE_YES: yes
+				CHOICE_ABSTAIN: abstain
+				CHOICE_VETO: veto
+			metadata: metadata for the vote
+
+Example:
+$ %s tx %s vote 1 [voter] CHOICE_YES [metadata]
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[1])
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			choice, ok := types.Choice_value[args[2]]
+			if !ok {
+				return fmt.Errorf("invalid choice %s", args[2])
+			}
+
+			msg := &types.MsgVote{
+				ProposalId: proposalID,
+				Voter:      clientCtx.GetFromAddress(),
+				Choice:     types.Choice(choice),
+				Metadata:   []byte(args[3]),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdExec returns a CLI command handler for creating a MsgExec transaction.
+func NewCmdExec() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [proposal-id] --from [signer]",
+		Short: "Execute a proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgExec{
+				ProposalId: proposalID,
+				Signer:     clientCtx.GetFromAddress(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func addDecisionPolicyFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagThreshold, "", "The minimum weighted sum of yes votes for a proposal to pass")
+	cmd.Flags().String(FlagPercentage, "", "The minimum percentage of the group's total weight of yes votes for a proposal to pass")
+	cmd.Flags().Duration(FlagTimeout, 0, "The duration after proposal submission during which votes are accepted")
+}
+
+// decisionPolicyFromFlags returns the decision policy defined by either the
+// threshold or the percentage flag.
+func decisionPolicyFromFlags(cmd *cobra.Command) (types.DecisionPolicy, error) {
+	threshold, err := cmd.Flags().GetString(FlagThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	percentage, err := cmd.Flags().GetString(FlagPercentage)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout, err := cmd.Flags().GetDuration(FlagTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case threshold != "" && percentage != "":
+		return nil, fmt.Errorf("only one of --%s and --%s can be set", FlagThreshold, FlagPercentage)
+	case threshold != "":
+		return types.NewThresholdDecisionPolicy(threshold, timeout), nil
+	case percentage != "":
+		return types.NewPercentageDecisionPolicy(percentage, timeout), nil
+	default:
+		return nil, fmt.Errorf("one of --%s and --%s must be set", FlagThreshold, FlagPercentage)
+	}
+}
+
+// parseMembers reads the members from a JSON file.
+func parseMembers(clientCtx client.Context, membersFile string) ([]types.Member, error) {
+	members := types.Members{}
+
+	if membersFile == "" {
+		return members.Members, nil
+	}
+
+	contents, err := ioutil.ReadFile(membersFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := clientCtx.JSONMarshaler.UnmarshalJSON(contents, &members); err != nil {
+		return nil, err
+	}
+
+	return members.Members, nil
+}
+
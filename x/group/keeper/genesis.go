@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// InitGenesis initializes the group module's state from a provided genesis
+// state. The group accounts themselves are expected to be part of the auth
+// genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs *types.GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
+	}
+
+	k.setSequence(ctx, types.GroupSeqKey, gs.GroupSeq)
+	k.setSequence(ctx, types.GroupAccountSeqKey, gs.GroupAccountSeq)
+	k.setSequence(ctx, types.ProposalSeqKey, gs.ProposalSeq)
+
+	for _, g := range gs.Groups {
+		k.setGroupInfo(ctx, g)
+	}
+	for _, m := range gs.GroupMembers {
+		k.setGroupMember(ctx, m)
+	}
+	for _, a := range gs.GroupAccounts {
+		k.setGroupAccountInfo(ctx, a)
+	}
+	for _, p := range gs.Proposals {
+		k.setProposal(ctx, p)
+	}
+	for _, v := range gs.Votes {
+		k.setVote(ctx, v)
+	}
+}
+
+// ExportGenesis returns the group module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	gs := types.NewGenesisState()
+
+	gs.GroupSeq = k.getSequence(ctx, types.GroupSeqKey)
+	gs.GroupAccountSeq = k.getSequence(ctx, types.GroupAccountSeqKey)
+	gs.ProposalSeq = k.getSequence(ctx, types.ProposalSeqKey)
+
+	k.IterateGroups(ctx, func(g types.GroupInfo) bool {
+		gs.Groups = append(gs.Groups, g)
+		return false
+	})
+	k.IterateGroupMembers(ctx, func(m types.GroupMember) bool {
+		gs.GroupMembers = append(gs.GroupMembers, m)
+		return false
+	})
+	k.IterateGroupAccounts(ctx, func(a types.GroupAccountInfo) bool {
+		gs.GroupAccounts = append(gs.GroupAccounts, a)
+		return false
+	})
+	k.IterateProposals(ctx, func(p types.Proposal) bool {
+		gs.Proposals = append(gs.Proposals, p)
+		return false
+	})
+	k.IterateVotes(ctx, func(v types.Vote) bool {
+		gs.Votes = append(gs.Votes, v)
+		return false
+	})
+
+	return gs
+}
@@ -0,0 +1,302 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// GroupInfo implements the Query/GroupInfo gRPC method.
+func (k Keeper) GroupInfo(c context.Context, req *types.QueryGroupInfoRequest) (*types.QueryGroupInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	g, err := k.GetGroupInfo(ctx, req.GroupId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryGroupInfoResponse{Info: &g}, nil
+}
+
+// GroupAccountInfo implements the Query/GroupAccountInfo gRPC method.
+func (k Keeper) GroupAccountInfo(c context.Context, req *types.QueryGroupAccountInfoRequest) (*types.QueryGroupAccountInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "empty group account address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	a, err := k.GetGroupAccountInfo(ctx, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryGroupAccountInfoResponse{Info: &a}, nil
+}
+
+// GroupMembers implements the Query/GroupMembers gRPC method.
+func (k Keeper) GroupMembers(c context.Context, req *types.QueryGroupMembersRequest) (*types.QueryGroupMembersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	membersStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetGroupMembersPrefix(req.GroupId))
+
+	var members []*types.GroupMember
+	pageRes, err := query.Paginate(membersStore, req.Pagination, func(key []byte, value []byte) error {
+		var m types.GroupMember
+		if err := k.cdc.UnmarshalBinaryBare(value, &m); err != nil {
+			return err
+		}
+
+		members = append(members, &m)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGroupMembersResponse{
+		Members:    members,
+		Pagination: pageRes,
+	}, nil
+}
+
+// GroupsByAdmin implements the Query/GroupsByAdmin gRPC method.
+func (k Keeper) GroupsByAdmin(c context.Context, req *types.QueryGroupsByAdminRequest) (*types.QueryGroupsByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Admin.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "empty admin address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetGroupsByAdminPrefix(req.Admin))
+
+	var groups []*types.GroupInfo
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		g, err := k.GetGroupInfo(ctx, sdk.BigEndianToUint64(key))
+		if err != nil {
+			return err
+		}
+
+		groups = append(groups, &g)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGroupsByAdminResponse{
+		Groups:     groups,
+		Pagination: pageRes,
+	}, nil
+}
+
+// GroupAccountsByGroup implements the Query/GroupAccountsByGroup gRPC method.
+func (k Keeper) GroupAccountsByGroup(c context.Context, req *types.QueryGroupAccountsByGroupRequest) (*types.QueryGroupAccountsByGroupResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetGroupAccountsByGroupPrefix(req.GroupId))
+
+	accounts, pageRes, err := k.paginateGroupAccounts(ctx, indexStore, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGroupAccountsByGroupResponse{
+		GroupAccounts: accounts,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// GroupAccountsByAdmin implements the Query/GroupAccountsByAdmin gRPC method.
+func (k Keeper) GroupAccountsByAdmin(c context.Context, req *types.QueryGroupAccountsByAdminRequest) (*types.QueryGroupAccountsByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Admin.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "empty admin address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetGroupAccountsByAdminPrefix(req.Admin))
+
+	accounts, pageRes, err := k.paginateGroupAccounts(ctx, indexStore, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGroupAccountsByAdminResponse{
+		GroupAccounts: accounts,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// Proposal implements the Query/Proposal gRPC method.
+func (k Keeper) Proposal(c context.Context, req *types.QueryProposalRequest) (*types.QueryProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	p, err := k.GetProposal(ctx, req.ProposalId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryProposalResponse{Proposal: &p}, nil
+}
+
+// ProposalsByGroupAccount implements the Query/ProposalsByGroupAccount gRPC
+// method.
+func (k Keeper) ProposalsByGroupAccount(c context.Context, req *types.QueryProposalsByGroupAccountRequest) (*types.QueryProposalsByGroupAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "empty group account address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetProposalsByGroupAccountPrefix(req.Address))
+
+	var proposals []*types.Proposal
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		p, err := k.GetProposal(ctx, sdk.BigEndianToUint64(key))
+		if err != nil {
+			return err
+		}
+
+		proposals = append(proposals, &p)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProposalsByGroupAccountResponse{
+		Proposals:  proposals,
+		Pagination: pageRes,
+	}, nil
+}
+
+// VoteByProposalVoter implements the Query/VoteByProposalVoter gRPC method.
+func (k Keeper) VoteByProposalVoter(c context.Context, req *types.QueryVoteByProposalVoterRequest) (*types.QueryVoteByProposalVoterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Voter.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "empty voter address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	v, err := k.GetVote(ctx, req.ProposalId, req.Voter)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryVoteByProposalVoterResponse{Vote: &v}, nil
+}
+
+// VotesByProposal implements the Query/VotesByProposal gRPC method.
+func (k Keeper) VotesByProposal(c context.Context, req *types.QueryVotesByProposalRequest) (*types.QueryVotesByProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	votesStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetVotesByProposalPrefix(req.ProposalId))
+
+	var votes []*types.Vote
+	pageRes, err := query.Paginate(votesStore, req.Pagination, func(key []byte, value []byte) error {
+		var v types.Vote
+		if err := k.cdc.UnmarshalBinaryBare(value, &v); err != nil {
+			return err
+		}
+
+		votes = append(votes, &v)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVotesByProposalResponse{
+		Votes:      votes,
+		Pagination: pageRes,
+	}, nil
+}
+
+// VotesByVoter implements the Query/VotesByVoter gRPC method.
+func (k Keeper) VotesByVoter(c context.Context, req *types.QueryVotesByVoterRequest) (*types.QueryVotesByVoterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Voter.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "empty voter address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetVotesByVoterPrefix(req.Voter))
+
+	var votes []*types.Vote
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		v, err := k.GetVote(ctx, sdk.BigEndianToUint64(key), req.Voter)
+		if err != nil {
+			return err
+		}
+
+		votes = append(votes, &v)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVotesByVoterResponse{
+		Votes:      votes,
+		Pagination: pageRes,
+	}, nil
+}
+
+// paginateGroupAccounts returns the group accounts referenced by the keys of a
+// group account index.
+func (k Keeper) paginateGroupAccounts(ctx sdk.Context, indexStore prefix.Store, pageReq *query.PageRequest) ([]*types.GroupAccountInfo, *query.PageResponse, error) {
+	var accounts []*types.GroupAccountInfo
+	pageRes, err := query.Paginate(indexStore, pageReq, func(key []byte, _ []byte) error {
+		a, err := k.GetGroupAccountInfo(ctx, types.ParseLengthPrefixedAddress(key))
+		if err != nil {
+			return err
+		}
+
+		accounts = append(accounts, &a)
+		return nil
+	})
+
+	return accounts, pageRes, err
+}
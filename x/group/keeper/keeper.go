@@ -0,0 +1,304 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// Keeper defines the group module's keeper. It stores groups, their members
+// and accounts, as well as the proposals submitted to the group accounts and
+// the votes cast on them.
+type Keeper struct {
+	storeKey  sdk.StoreKey
+	cdc       codec.BinaryMarshaler
+	router    *baseapp.MsgServiceRouter
+	accKeeper types.AccountKeeper
+}
+
+// NewKeeper constructs a group Keeper
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, router *baseapp.MsgServiceRouter, ak types.AccountKeeper) Keeper {
+	return Keeper{
+		storeKey:  storeKey,
+		cdc:       cdc,
+		router:    router,
+		accKeeper: ak,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// getSequence returns the last value of the sequence stored under key.
+func (k Keeper) getSequence(ctx sdk.Context, key []byte) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setSequence sets the last value of the sequence stored under key.
+func (k Keeper) setSequence(ctx sdk.Context, key []byte, seq uint64) {
+	ctx.KVStore(k.storeKey).Set(key, sdk.Uint64ToBigEndian(seq))
+}
+
+// nextSequence increments the sequence stored under key and returns its new
+// value. Sequences start at 1.
+func (k Keeper) nextSequence(ctx sdk.Context, key []byte) uint64 {
+	seq := k.getSequence(ctx, key) + 1
+	k.setSequence(ctx, key, seq)
+	return seq
+}
+
+// GetGroupInfo returns the group with the given ID.
+func (k Keeper) GetGroupInfo(ctx sdk.Context, groupID uint64) (types.GroupInfo, error) {
+	var g types.GroupInfo
+	bz := ctx.KVStore(k.storeKey).Get(types.GetGroupKey(groupID))
+	if bz == nil {
+		return g, sdkerrors.Wrapf(types.ErrNotFound, "group %d", groupID)
+	}
+
+	err := k.cdc.UnmarshalBinaryBare(bz, &g)
+	return g, err
+}
+
+// setGroupInfo stores the group and indexes it by admin.
+func (k Keeper) setGroupInfo(ctx sdk.Context, g types.GroupInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetGroupKey(g.GroupId), k.cdc.MustMarshalBinaryBare(&g))
+	store.Set(types.GetGroupByAdminKey(g.Admin, g.GroupId), []byte{})
+}
+
+// IterateGroups iterates over all the groups. The iteration stops when the
+// callback returns true.
+func (k Keeper) IterateGroups(ctx sdk.Context, cb func(g types.GroupInfo) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GroupKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var g types.GroupInfo
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &g)
+		if cb(g) {
+			break
+		}
+	}
+}
+
+// GetGroupMember returns the member of the group with the given address.
+func (k Keeper) GetGroupMember(ctx sdk.Context, groupID uint64, address sdk.AccAddress) (types.GroupMember, error) {
+	var m types.GroupMember
+	bz := ctx.KVStore(k.storeKey).Get(types.GetGroupMemberKey(groupID, address))
+	if bz == nil {
+		return m, sdkerrors.Wrapf(types.ErrNotFound, "member %s of group %d", address, groupID)
+	}
+
+	err := k.cdc.UnmarshalBinaryBare(bz, &m)
+	return m, err
+}
+
+func (k Keeper) setGroupMember(ctx sdk.Context, m types.GroupMember) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetGroupMemberKey(m.GroupId, m.Member.Address), k.cdc.MustMarshalBinaryBare(&m))
+}
+
+func (k Keeper) deleteGroupMember(ctx sdk.Context, m types.GroupMember) {
+	ctx.KVStore(k.storeKey).Delete(types.GetGroupMemberKey(m.GroupId, m.Member.Address))
+}
+
+// IterateGroupMembers iterates over all the members of all the groups. The
+// iteration stops when the callback returns true.
+func (k Keeper) IterateGroupMembers(ctx sdk.Context, cb func(m types.GroupMember) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GroupMemberKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var m types.GroupMember
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &m)
+		if cb(m) {
+			break
+		}
+	}
+}
+
+// GetGroupAccountInfo returns the group account with the given address.
+func (k Keeper) GetGroupAccountInfo(ctx sdk.Context, address sdk.AccAddress) (types.GroupAccountInfo, error) {
+	var a types.GroupAccountInfo
+	bz := ctx.KVStore(k.storeKey).Get(types.GetGroupAccountKey(address))
+	if bz == nil {
+		return a, sdkerrors.Wrapf(types.ErrNotFound, "group account %s", address)
+	}
+
+	err := k.cdc.UnmarshalBinaryBare(bz, &a)
+	return a, err
+}
+
+// setGroupAccountInfo stores the group account and indexes it by group and
+// by admin.
+func (k Keeper) setGroupAccountInfo(ctx sdk.Context, a types.GroupAccountInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetGroupAccountKey(a.Address), k.cdc.MustMarshalBinaryBare(&a))
+	store.Set(types.GetGroupAccountByGroupKey(a.GroupId, a.Address), []byte{})
+	store.Set(types.GetGroupAccountByAdminKey(a.Admin, a.Address), []byte{})
+}
+
+// IterateGroupAccounts iterates over all the group accounts. The iteration
+// stops when the callback returns true.
+func (k Keeper) IterateGroupAccounts(ctx sdk.Context, cb func(a types.GroupAccountInfo) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GroupAccountKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var a types.GroupAccountInfo
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &a)
+		if cb(a) {
+			break
+		}
+	}
+}
+
+// GetProposal returns the proposal with the given ID.
+func (k Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (types.Proposal, error) {
+	var p types.Proposal
+	bz := ctx.KVStore(k.storeKey).Get(types.GetProposalKey(proposalID))
+	if bz == nil {
+		return p, sdkerrors.Wrapf(types.ErrNotFound, "proposal %d", proposalID)
+	}
+
+	err := k.cdc.UnmarshalBinaryBare(bz, &p)
+	return p, err
+}
+
+// setProposal stores the proposal and indexes it by group account.
+func (k Keeper) setProposal(ctx sdk.Context, p types.Proposal) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetProposalKey(p.ProposalId), k.cdc.MustMarshalBinaryBare(&p))
+	store.Set(types.GetProposalByGroupAccountKey(p.Address, p.ProposalId), []byte{})
+}
+
+// IterateProposals iterates over all the proposals. The iteration stops when
+// the callback returns true.
+func (k Keeper) IterateProposals(ctx sdk.Context, cb func(p types.Proposal) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ProposalKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var p types.Proposal
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &p)
+		if cb(p) {
+			break
+		}
+	}
+}
+
+// GetVote returns the vote cast by voter on the proposal with the given ID.
+func (k Keeper) GetVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (types.Vote, error) {
+	var v types.Vote
+	bz := ctx.KVStore(k.storeKey).Get(types.GetVoteKey(proposalID, voter))
+	if bz == nil {
+		return v, sdkerrors.Wrapf(types.ErrNotFound, "vote by %s on proposal %d", voter, proposalID)
+	}
+
+	err := k.cdc.UnmarshalBinaryBare(bz, &v)
+	return v, err
+}
+
+// setVote stores the vote and indexes it by voter.
+func (k Keeper) setVote(ctx sdk.Context, v types.Vote) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetVoteKey(v.ProposalId, v.Voter), k.cdc.MustMarshalBinaryBare(&v))
+	store.Set(types.GetVoteByVoterKey(v.Voter, v.ProposalId), []byte{})
+}
+
+// IterateVotes iterates over all the votes. The iteration stops when the
+// callback returns true.
+func (k Keeper) IterateVotes(ctx sdk.Context, cb func(v types.Vote) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.VoteKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var v types.Vote
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &v)
+		if cb(v) {
+			break
+		}
+	}
+}
+
+// doTally updates the result of a submitted proposal according to the
+// decision policy of its group account. The proposal is closed once the
+// result is final.
+func (k Keeper) doTally(ctx sdk.Context, p *types.Proposal, g types.GroupInfo, a types.GroupAccountInfo) error {
+	policy := a.GetDecisionPolicy()
+	if policy == nil {
+		return sdkerrors.Wrap(types.ErrEmpty, "decision policy")
+	}
+
+	result, err := policy.Allow(p.VoteState, g.TotalWeight, p.VotingDuration(ctx.BlockTime()))
+	if err != nil {
+		return sdkerrors.Wrap(err, "policy execution")
+	}
+
+	if result.Final {
+		p.Status = types.ProposalStatusClosed
+		if result.Allow {
+			p.Result = types.ProposalResultAccepted
+		} else {
+			p.Result = types.ProposalResultRejected
+		}
+	}
+
+	return nil
+}
+
+// doExecuteMsgs routes the messages of an accepted proposal to their
+// handlers. Every message must be signed by the group account only.
+func (k Keeper) doExecuteMsgs(ctx sdk.Context, p types.Proposal) error {
+	msgs, err := p.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	if err := ensureMsgAuthZ(msgs, p.Address); err != nil {
+		return err
+	}
+
+	for i, msg := range msgs {
+		handler := k.router.Handler(msg)
+		if handler == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		}
+
+		msgResp, err := handler(ctx, msg)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "message %d", i)
+		}
+
+		// emit the events from the executed messages
+		ctx.EventManager().EmitEvents(msgResp.GetEvents())
+	}
+
+	return nil
+}
+
+// ensureMsgAuthZ checks that the given messages are signed by the group
+// account only.
+func ensureMsgAuthZ(msgs []sdk.Msg, groupAccount sdk.AccAddress) error {
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(groupAccount) {
+			return sdkerrors.Wrapf(types.ErrUnauthorized, "msg %d must be signed by the group account only", i)
+		}
+	}
+
+	return nil
+}
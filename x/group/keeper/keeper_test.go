@@ -0,0 +1,377 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.GroupKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.msgServer = keeper.NewMsgServerImpl(app.GroupKeeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 4, sdk.NewInt(30000000))
+}
+
+// createGroupWithAccount creates a group administered by addrs[0] whose
+// members are addrs[1] (weight 1) and addrs[2] (weight 2), and a group
+// account with the given decision policy.
+func (s *KeeperTestSuite) createGroupWithAccount(policy types.DecisionPolicy) (uint64, sdk.AccAddress) {
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	groupRes, err := s.msgServer.CreateGroup(goCtx, &types.MsgCreateGroup{
+		Admin: s.addrs[0],
+		Members: []types.Member{
+			types.NewMember(s.addrs[1], "1", nil),
+			types.NewMember(s.addrs[2], "2", nil),
+		},
+		Metadata: []byte("treasury"),
+	})
+	s.Require().NoError(err)
+
+	msg, err := types.NewMsgCreateGroupAccount(s.addrs[0], groupRes.GroupId, nil, policy)
+	s.Require().NoError(err)
+	accountRes, err := s.msgServer.CreateGroupAccount(goCtx, msg)
+	s.Require().NoError(err)
+
+	return groupRes.GroupId, accountRes.Address
+}
+
+// createProposal submits a proposal for the group account to send coins to
+// addrs[3].
+func (s *KeeperTestSuite) createProposal(account sdk.AccAddress, proposer sdk.AccAddress) uint64 {
+	send := banktypes.NewMsgSend(account, s.addrs[3], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	msg, err := types.NewMsgCreateProposal(account, []sdk.AccAddress{proposer}, []sdk.Msg{send}, nil)
+	s.Require().NoError(err)
+
+	res, err := s.msgServer.CreateProposal(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	return res.ProposalId
+}
+
+func (s *KeeperTestSuite) vote(proposalID uint64, voter sdk.AccAddress, choice types.Choice) error {
+	_, err := s.msgServer.Vote(sdk.WrapSDKContext(s.ctx), &types.MsgVote{
+		ProposalId: proposalID,
+		Voter:      voter,
+		Choice:     choice,
+	})
+	return err
+}
+
+func (s *KeeperTestSuite) TestCreateGroup() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	groupID, _ := s.createGroupWithAccount(types.NewThresholdDecisionPolicy("2", time.Hour))
+	s.Require().Equal(uint64(1), groupID)
+
+	g, err := app.GroupKeeper.GetGroupInfo(ctx, groupID)
+	s.Require().NoError(err)
+	s.Require().Equal(addrs[0], g.Admin)
+	s.Require().Equal(uint64(1), g.Version)
+	s.Require().Equal(sdk.NewDec(3).String(), g.TotalWeight)
+
+	res, err := s.queryClient.GroupMembers(gocontext.Background(), &types.QueryGroupMembersRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Require().Len(res.Members, 2)
+
+	byAdmin, err := s.queryClient.GroupsByAdmin(gocontext.Background(), &types.QueryGroupsByAdminRequest{Admin: addrs[0]})
+	s.Require().NoError(err)
+	s.Require().Len(byAdmin.Groups, 1)
+	s.Require().Equal(groupID, byAdmin.Groups[0].GroupId)
+
+	s.T().Log("verify that a group cannot be created with duplicate members")
+	msg := &types.MsgCreateGroup{
+		Admin:   addrs[0],
+		Members: []types.Member{types.NewMember(addrs[1], "1", nil), types.NewMember(addrs[1], "2", nil)},
+	}
+	s.Require().Error(msg.ValidateBasic())
+}
+
+func (s *KeeperTestSuite) TestUpdateGroup() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	goCtx := sdk.WrapSDKContext(ctx)
+	groupID, _ := s.createGroupWithAccount(types.NewThresholdDecisionPolicy("2", time.Hour))
+
+	s.T().Log("verify that only the admin can update the members")
+	_, err := s.msgServer.UpdateGroupMembers(goCtx, &types.MsgUpdateGroupMembers{
+		Admin:         addrs[1],
+		GroupId:       groupID,
+		MemberUpdates: []types.Member{types.NewMember(addrs[3], "1", nil)},
+	})
+	s.Require().True(errors.Is(err, types.ErrUnauthorized))
+
+	s.T().Log("verify that members can be added, updated and removed")
+	_, err = s.msgServer.UpdateGroupMembers(goCtx, &types.MsgUpdateGroupMembers{
+		Admin:   addrs[0],
+		GroupId: groupID,
+		MemberUpdates: []types.Member{
+			types.NewMember(addrs[1], "0", nil),
+			types.NewMember(addrs[2], "5", nil),
+			types.NewMember(addrs[3], "1.5", nil),
+		},
+	})
+	s.Require().NoError(err)
+
+	g, err := app.GroupKeeper.GetGroupInfo(ctx, groupID)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), g.Version)
+	s.Require().Equal(sdk.MustNewDecFromStr("6.5").String(), g.TotalWeight)
+
+	_, err = app.GroupKeeper.GetGroupMember(ctx, groupID, addrs[1])
+	s.Require().True(errors.Is(err, types.ErrNotFound))
+
+	s.T().Log("verify that removing a non-member fails")
+	_, err = s.msgServer.UpdateGroupMembers(goCtx, &types.MsgUpdateGroupMembers{
+		Admin:         addrs[0],
+		GroupId:       groupID,
+		MemberUpdates: []types.Member{types.NewMember(addrs[1], "0", nil)},
+	})
+	s.Require().True(errors.Is(err, types.ErrNotFound))
+
+	s.T().Log("verify that the admin can be transferred")
+	_, err = s.msgServer.UpdateGroupAdmin(goCtx, &types.MsgUpdateGroupAdmin{
+		Admin:    addrs[0],
+		GroupId:  groupID,
+		NewAdmin: addrs[1],
+	})
+	s.Require().NoError(err)
+
+	byAdmin, err := s.queryClient.GroupsByAdmin(gocontext.Background(), &types.QueryGroupsByAdminRequest{Admin: addrs[0]})
+	s.Require().NoError(err)
+	s.Require().Empty(byAdmin.Groups)
+	byAdmin, err = s.queryClient.GroupsByAdmin(gocontext.Background(), &types.QueryGroupsByAdminRequest{Admin: addrs[1]})
+	s.Require().NoError(err)
+	s.Require().Len(byAdmin.Groups, 1)
+}
+
+func (s *KeeperTestSuite) TestCreateGroupAccount() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	policy := types.NewPercentageDecisionPolicy("0.5", time.Hour)
+	groupID, address := s.createGroupWithAccount(policy)
+
+	s.Require().Equal(types.GroupAccountAddress(1), address)
+	acc := app.AccountKeeper.GetAccount(ctx, address)
+	s.Require().NotNil(acc)
+	s.Require().IsType(&authtypes.ModuleAccount{}, acc)
+	s.Require().NoError(acc.(*authtypes.ModuleAccount).Validate())
+
+	res, err := s.queryClient.GroupAccountInfo(gocontext.Background(), &types.QueryGroupAccountInfoRequest{Address: address})
+	s.Require().NoError(err)
+	s.Require().Equal(groupID, res.Info.GroupId)
+	s.Require().Equal(policy, res.Info.GetDecisionPolicy())
+
+	byGroup, err := s.queryClient.GroupAccountsByGroup(gocontext.Background(), &types.QueryGroupAccountsByGroupRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Require().Len(byGroup.GroupAccounts, 1)
+
+	s.T().Log("verify that only the group admin can create a group account")
+	msg, err := types.NewMsgCreateGroupAccount(addrs[1], groupID, nil, policy)
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreateGroupAccount(sdk.WrapSDKContext(ctx), msg)
+	s.Require().True(errors.Is(err, types.ErrUnauthorized))
+}
+
+func (s *KeeperTestSuite) TestProposalAccepted() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	_, account := s.createGroupWithAccount(types.NewThresholdDecisionPolicy("2", time.Hour))
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	s.Require().NoError(app.BankKeeper.SendCoins(ctx, addrs[0], account, coins))
+
+	s.T().Log("verify that non-members cannot submit proposals")
+	send := banktypes.NewMsgSend(account, addrs[3], coins)
+	msg, err := types.NewMsgCreateProposal(account, []sdk.AccAddress{addrs[3]}, []sdk.Msg{send}, nil)
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreateProposal(sdk.WrapSDKContext(ctx), msg)
+	s.Require().True(errors.Is(err, types.ErrUnauthorized))
+
+	s.T().Log("verify that proposed messages must be signed by the group account")
+	send = banktypes.NewMsgSend(addrs[1], addrs[3], coins)
+	msg, err = types.NewMsgCreateProposal(account, []sdk.AccAddress{addrs[1]}, []sdk.Msg{send}, nil)
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreateProposal(sdk.WrapSDKContext(ctx), msg)
+	s.Require().True(errors.Is(err, types.ErrUnauthorized))
+
+	proposalID := s.createProposal(account, addrs[1])
+
+	s.T().Log("verify that a proposal is executed once it is accepted")
+	s.Require().NoError(s.vote(proposalID, addrs[1], types.ChoiceYes))
+	p, err := app.GroupKeeper.GetProposal(ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalStatusSubmitted, p.Status)
+
+	s.Require().Error(s.vote(proposalID, addrs[1], types.ChoiceNo))
+	s.Require().Error(s.vote(proposalID, addrs[3], types.ChoiceYes))
+
+	s.Require().NoError(s.vote(proposalID, addrs[2], types.ChoiceYes))
+	p, err = app.GroupKeeper.GetProposal(ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalStatusClosed, p.Status)
+	s.Require().Equal(types.ProposalResultAccepted, p.Result)
+	s.Require().Equal(sdk.NewDec(3).String(), p.VoteState.YesCount)
+
+	_, err = s.msgServer.Exec(sdk.WrapSDKContext(ctx), &types.MsgExec{ProposalId: proposalID, Signer: addrs[3]})
+	s.Require().NoError(err)
+	p, err = app.GroupKeeper.GetProposal(ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalExecutorResultSuccess, p.ExecutorResult)
+
+	balance := app.BankKeeper.GetBalance(ctx, account, sdk.DefaultBondDenom)
+	s.Require().Equal(sdk.NewInt(900), balance.Amount)
+
+	votes, err := s.queryClient.VotesByProposal(gocontext.Background(), &types.QueryVotesByProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Len(votes.Votes, 2)
+
+	byVoter, err := s.queryClient.VotesByVoter(gocontext.Background(), &types.QueryVotesByVoterRequest{Voter: addrs[2]})
+	s.Require().NoError(err)
+	s.Require().Len(byVoter.Votes, 1)
+
+	proposals, err := s.queryClient.ProposalsByGroupAccount(gocontext.Background(), &types.QueryProposalsByGroupAccountRequest{Address: account})
+	s.Require().NoError(err)
+	s.Require().Len(proposals.Proposals, 1)
+	msgs, err := proposals.Proposals[0].GetMsgs()
+	s.Require().NoError(err)
+	s.Require().Len(msgs, 1)
+}
+
+func (s *KeeperTestSuite) TestProposalExecutionFailure() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	_, account := s.createGroupWithAccount(types.NewThresholdDecisionPolicy("2", time.Hour))
+
+	// the group account has no funds, so the proposed send fails
+	proposalID := s.createProposal(account, addrs[2])
+	s.Require().NoError(s.vote(proposalID, addrs[2], types.ChoiceYes))
+
+	_, err := s.msgServer.Exec(sdk.WrapSDKContext(ctx), &types.MsgExec{ProposalId: proposalID, Signer: addrs[2]})
+	s.Require().NoError(err)
+
+	p, err := app.GroupKeeper.GetProposal(ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalResultAccepted, p.Result)
+	s.Require().Equal(types.ProposalExecutorResultFailure, p.ExecutorResult)
+}
+
+func (s *KeeperTestSuite) TestProposalRejected() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	_, account := s.createGroupWithAccount(types.NewPercentageDecisionPolicy("0.5", time.Hour))
+
+	proposalID := s.createProposal(account, addrs[1])
+
+	// the remaining voting power cannot reach the required percentage anymore
+	s.Require().NoError(s.vote(proposalID, addrs[2], types.ChoiceNo))
+
+	p, err := app.GroupKeeper.GetProposal(ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalStatusClosed, p.Status)
+	s.Require().Equal(types.ProposalResultRejected, p.Result)
+
+	_, err = s.msgServer.Exec(sdk.WrapSDKContext(ctx), &types.MsgExec{ProposalId: proposalID, Signer: addrs[1]})
+	s.Require().NoError(err)
+	p, err = app.GroupKeeper.GetProposal(ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalExecutorResultNotRun, p.ExecutorResult)
+}
+
+func (s *KeeperTestSuite) TestProposalTimeout() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	_, account := s.createGroupWithAccount(types.NewThresholdDecisionPolicy("2", time.Hour))
+
+	proposalID := s.createProposal(account, addrs[1])
+
+	s.ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	s.Require().True(errors.Is(s.vote(proposalID, addrs[2], types.ChoiceYes), types.ErrExpired))
+
+	_, err := s.msgServer.Exec(sdk.WrapSDKContext(s.ctx), &types.MsgExec{ProposalId: proposalID, Signer: addrs[1]})
+	s.Require().NoError(err)
+	p, err := app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalStatusClosed, p.Status)
+	s.Require().Equal(types.ProposalResultRejected, p.Result)
+}
+
+func (s *KeeperTestSuite) TestProposalAborted() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	goCtx := sdk.WrapSDKContext(ctx)
+	_, account := s.createGroupWithAccount(types.NewThresholdDecisionPolicy("2", time.Hour))
+
+	proposalID := s.createProposal(account, addrs[1])
+
+	msg, err := types.NewMsgUpdateGroupAccountDecisionPolicy(addrs[0], account, types.NewThresholdDecisionPolicy("1", time.Hour))
+	s.Require().NoError(err)
+	_, err = s.msgServer.UpdateGroupAccountDecisionPolicy(goCtx, msg)
+	s.Require().NoError(err)
+
+	s.T().Log("verify that votes are rejected once the group account is modified")
+	s.Require().True(errors.Is(s.vote(proposalID, addrs[2], types.ChoiceYes), types.ErrModified))
+
+	s.T().Log("verify that executing the proposal aborts it")
+	_, err = s.msgServer.Exec(goCtx, &types.MsgExec{ProposalId: proposalID, Signer: addrs[1]})
+	s.Require().NoError(err)
+	p, err := app.GroupKeeper.GetProposal(ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalStatusAborted, p.Status)
+
+	_, err = s.msgServer.Exec(goCtx, &types.MsgExec{ProposalId: proposalID, Signer: addrs[1]})
+	s.Require().True(errors.Is(err, types.ErrInvalid))
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	_, account := s.createGroupWithAccount(types.NewThresholdDecisionPolicy("2", time.Hour))
+	proposalID := s.createProposal(account, addrs[1])
+	s.Require().NoError(s.vote(proposalID, addrs[1], types.ChoiceYes))
+
+	gs := app.GroupKeeper.ExportGenesis(ctx)
+	s.Require().NoError(gs.Validate())
+	s.Require().Equal(uint64(1), gs.GroupSeq)
+	s.Require().Equal(uint64(1), gs.GroupAccountSeq)
+	s.Require().Equal(uint64(1), gs.ProposalSeq)
+	s.Require().Len(gs.Groups, 1)
+	s.Require().Len(gs.GroupMembers, 2)
+	s.Require().Len(gs.GroupAccounts, 1)
+	s.Require().Len(gs.Proposals, 1)
+	s.Require().Len(gs.Votes, 1)
+
+	bz := app.AppCodec().MustMarshalJSON(gs)
+	var imported types.GenesisState
+	app.AppCodec().MustUnmarshalJSON(bz, &imported)
+
+	newApp := simapp.Setup(false)
+	newCtx := newApp.BaseApp.NewContext(false, tmproto.Header{Time: ctx.BlockTime()})
+	newApp.GroupKeeper.InitGenesis(newCtx, &imported)
+	s.Require().Equal(bz, newApp.AppCodec().MustMarshalJSON(newApp.GroupKeeper.ExportGenesis(newCtx)))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
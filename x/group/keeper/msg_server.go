@@ -0,0 +1,485 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the group MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// CreateGroup creates a new group with the given admin and members.
+func (k msgServer) CreateGroup(goCtx context.Context, msg *types.MsgCreateGroup) (*types.MsgCreateGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	totalWeight := sdk.ZeroDec()
+	for _, m := range msg.Members {
+		weight, err := types.ParsePositiveDec(m.Weight)
+		if err != nil {
+			return nil, err
+		}
+		totalWeight = totalWeight.Add(weight)
+	}
+
+	groupID := k.nextSequence(ctx, types.GroupSeqKey)
+	k.setGroupInfo(ctx, types.GroupInfo{
+		GroupId:     groupID,
+		Admin:       msg.Admin,
+		Metadata:    msg.Metadata,
+		Version:     1,
+		TotalWeight: totalWeight.String(),
+	})
+
+	for _, m := range msg.Members {
+		k.setGroupMember(ctx, types.GroupMember{
+			GroupId: groupID,
+			Member:  m,
+		})
+	}
+
+	k.emitGroupEvent(ctx, types.EventTypeCreateGroup, groupID, msg.Admin)
+
+	return &types.MsgCreateGroupResponse{GroupId: groupID}, nil
+}
+
+// UpdateGroupMembers adds, updates or removes members of a group. A member
+// update with a zero weight removes the member from the group. Any change to
+// the members bumps the group version, which aborts its pending proposals.
+func (k msgServer) UpdateGroupMembers(goCtx context.Context, msg *types.MsgUpdateGroupMembers) (*types.MsgUpdateGroupMembersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	g, err := k.getGroupWithAdmin(ctx, msg.GroupId, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	totalWeight, err := types.ParseNonNegativeDec(g.TotalWeight)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, update := range msg.MemberUpdates {
+		newWeight, err := types.ParseNonNegativeDec(update.Weight)
+		if err != nil {
+			return nil, err
+		}
+
+		m := types.GroupMember{GroupId: g.GroupId, Member: update}
+
+		prev, err := k.GetGroupMember(ctx, g.GroupId, update.Address)
+		found := err == nil
+		if found {
+			prevWeight, err := types.ParsePositiveDec(prev.Member.Weight)
+			if err != nil {
+				return nil, err
+			}
+			totalWeight = totalWeight.Sub(prevWeight)
+		}
+
+		if newWeight.IsZero() {
+			if !found {
+				return nil, sdkerrors.Wrapf(types.ErrNotFound, "member %s of group %d", update.Address, g.GroupId)
+			}
+			k.deleteGroupMember(ctx, m)
+			continue
+		}
+
+		totalWeight = totalWeight.Add(newWeight)
+		k.setGroupMember(ctx, m)
+	}
+
+	g.TotalWeight = totalWeight.String()
+	g.Version++
+	k.setGroupInfo(ctx, g)
+
+	k.emitGroupEvent(ctx, types.EventTypeUpdateGroup, g.GroupId, msg.Admin)
+
+	return &types.MsgUpdateGroupMembersResponse{}, nil
+}
+
+// UpdateGroupAdmin transfers the administration of a group to a new admin.
+func (k msgServer) UpdateGroupAdmin(goCtx context.Context, msg *types.MsgUpdateGroupAdmin) (*types.MsgUpdateGroupAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	g, err := k.getGroupWithAdmin(ctx, msg.GroupId, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.GetGroupByAdminKey(g.Admin, g.GroupId))
+	g.Admin = msg.NewAdmin
+	k.setGroupInfo(ctx, g)
+
+	k.emitGroupEvent(ctx, types.EventTypeUpdateGroup, g.GroupId, msg.Admin)
+
+	return &types.MsgUpdateGroupAdminResponse{}, nil
+}
+
+// UpdateGroupMetadata updates the metadata of a group.
+func (k msgServer) UpdateGroupMetadata(goCtx context.Context, msg *types.MsgUpdateGroupMetadata) (*types.MsgUpdateGroupMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	g, err := k.getGroupWithAdmin(ctx, msg.GroupId, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	g.Metadata = msg.Metadata
+	k.setGroupInfo(ctx, g)
+
+	k.emitGroupEvent(ctx, types.EventTypeUpdateGroup, g.GroupId, msg.Admin)
+
+	return &types.MsgUpdateGroupMetadataResponse{}, nil
+}
+
+// CreateGroupAccount creates a new group account for a group. The group
+// account is a module account whose address is derived from a sequence, so
+// it can only act through the proposals accepted by the group.
+func (k msgServer) CreateGroupAccount(goCtx context.Context, msg *types.MsgCreateGroupAccount) (*types.MsgCreateGroupAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.getGroupWithAdmin(ctx, msg.GroupId, msg.Admin); err != nil {
+		return nil, err
+	}
+
+	seq := k.nextSequence(ctx, types.GroupAccountSeqKey)
+	address := types.GroupAccountAddress(seq)
+	if k.accKeeper.GetAccount(ctx, address) != nil {
+		return nil, sdkerrors.Wrapf(types.ErrDuplicate, "account %s already exists", address)
+	}
+
+	acc := authtypes.NewModuleAccount(authtypes.NewBaseAccountWithAddress(address), types.GroupAccountName(seq))
+	k.accKeeper.SetAccount(ctx, k.accKeeper.NewAccount(ctx, acc))
+
+	a, err := types.NewGroupAccountInfo(address, msg.GroupId, msg.Admin, msg.Metadata, 1, msg.GetDecisionPolicy())
+	if err != nil {
+		return nil, err
+	}
+	k.setGroupAccountInfo(ctx, a)
+
+	k.emitGroupAccountEvent(ctx, types.EventTypeCreateGroupAccount, address, msg.Admin)
+
+	return &types.MsgCreateGroupAccountResponse{Address: address}, nil
+}
+
+// UpdateGroupAccountAdmin transfers the administration of a group account to a
+// new admin.
+func (k msgServer) UpdateGroupAccountAdmin(goCtx context.Context, msg *types.MsgUpdateGroupAccountAdmin) (*types.MsgUpdateGroupAccountAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	a, err := k.getGroupAccountWithAdmin(ctx, msg.Address, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.GetGroupAccountByAdminKey(a.Admin, a.Address))
+	a.Admin = msg.NewAdmin
+	k.setGroupAccountInfo(ctx, a)
+
+	k.emitGroupAccountEvent(ctx, types.EventTypeUpdateGroupAccount, a.Address, msg.Admin)
+
+	return &types.MsgUpdateGroupAccountAdminResponse{}, nil
+}
+
+// UpdateGroupAccountDecisionPolicy replaces the decision policy of a group
+// account. It bumps the group account version, which aborts its pending
+// proposals.
+func (k msgServer) UpdateGroupAccountDecisionPolicy(goCtx context.Context, msg *types.MsgUpdateGroupAccountDecisionPolicy) (*types.MsgUpdateGroupAccountDecisionPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	a, err := k.getGroupAccountWithAdmin(ctx, msg.Address, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.SetDecisionPolicy(msg.GetDecisionPolicy()); err != nil {
+		return nil, err
+	}
+	a.Version++
+	k.setGroupAccountInfo(ctx, a)
+
+	k.emitGroupAccountEvent(ctx, types.EventTypeUpdateGroupAccount, a.Address, msg.Admin)
+
+	return &types.MsgUpdateGroupAccountDecisionPolicyResponse{}, nil
+}
+
+// UpdateGroupAccountMetadata updates the metadata of a group account.
+func (k msgServer) UpdateGroupAccountMetadata(goCtx context.Context, msg *types.MsgUpdateGroupAccountMetadata) (*types.MsgUpdateGroupAccountMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	a, err := k.getGroupAccountWithAdmin(ctx, msg.Address, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	a.Metadata = msg.Metadata
+	k.setGroupAccountInfo(ctx, a)
+
+	k.emitGroupAccountEvent(ctx, types.EventTypeUpdateGroupAccount, a.Address, msg.Admin)
+
+	return &types.MsgUpdateGroupAccountMetadataResponse{}, nil
+}
+
+// CreateProposal submits a new proposal to a group account. All the proposers
+// must be members of the group, and all the proposed messages must be signed
+// by the group account only.
+func (k msgServer) CreateProposal(goCtx context.Context, msg *types.MsgCreateProposal) (*types.MsgCreateProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	a, err := k.GetGroupAccountInfo(ctx, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := k.GetGroupInfo(ctx, a.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, proposer := range msg.Proposers {
+		if _, err := k.GetGroupMember(ctx, g.GroupId, proposer); err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "proposer %s is not a member of group %d", proposer, g.GroupId)
+		}
+	}
+
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureMsgAuthZ(msgs, a.Address); err != nil {
+		return nil, err
+	}
+
+	policy := a.GetDecisionPolicy()
+	if policy == nil {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "decision policy")
+	}
+
+	proposalID := k.nextSequence(ctx, types.ProposalSeqKey)
+	k.setProposal(ctx, types.Proposal{
+		ProposalId:          proposalID,
+		Address:             msg.Address,
+		Metadata:            msg.Metadata,
+		Proposers:           msg.Proposers,
+		SubmittedAt:         ctx.BlockTime(),
+		GroupVersion:        g.Version,
+		GroupAccountVersion: a.Version,
+		Status:              types.ProposalStatusSubmitted,
+		Result:              types.ProposalResultUnfinalized,
+		VoteState:           types.DefaultTally(),
+		Timeout:             ctx.BlockTime().Add(policy.GetTimeout()),
+		ExecutorResult:      types.ProposalExecutorResultNotRun,
+		Msgs:                msg.Msgs,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
+		),
+	)
+
+	return &types.MsgCreateProposalResponse{ProposalId: proposalID}, nil
+}
+
+// Vote casts the vote of a group member on a submitted proposal. The proposal
+// is tallied again after each vote, and closed once its result is final.
+func (k msgServer) Vote(goCtx context.Context, msg *types.MsgVote) (*types.MsgVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	p, err := k.GetProposal(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	if p.Status != types.ProposalStatusSubmitted {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "proposal is %s", p.Status)
+	}
+	if !ctx.BlockTime().Before(p.Timeout) {
+		return nil, sdkerrors.Wrap(types.ErrExpired, "voting period has ended")
+	}
+
+	a, err := k.GetGroupAccountInfo(ctx, p.Address)
+	if err != nil {
+		return nil, err
+	}
+	if a.Version != p.GroupAccountVersion {
+		return nil, sdkerrors.Wrap(types.ErrModified, "group account was modified")
+	}
+
+	g, err := k.GetGroupInfo(ctx, a.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	if g.Version != p.GroupVersion {
+		return nil, sdkerrors.Wrap(types.ErrModified, "group was modified")
+	}
+
+	voter, err := k.GetGroupMember(ctx, g.GroupId, msg.Voter)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "voter %s is not a member of group %d", msg.Voter, g.GroupId)
+	}
+
+	if _, err := k.GetVote(ctx, p.ProposalId, msg.Voter); err == nil {
+		return nil, sdkerrors.Wrapf(types.ErrDuplicate, "vote by %s on proposal %d", msg.Voter, p.ProposalId)
+	}
+
+	vote := types.Vote{
+		ProposalId:  p.ProposalId,
+		Voter:       msg.Voter,
+		Choice:      msg.Choice,
+		Metadata:    msg.Metadata,
+		SubmittedAt: ctx.BlockTime(),
+	}
+	if err := p.VoteState.Add(vote, voter.Member.Weight); err != nil {
+		return nil, sdkerrors.Wrap(err, "add new vote")
+	}
+	k.setVote(ctx, vote)
+
+	if err := k.doTally(ctx, &p, g, a); err != nil {
+		return nil, err
+	}
+	k.setProposal(ctx, p)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVote,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", p.ProposalId)),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Voter.String()),
+		),
+	)
+
+	return &types.MsgVoteResponse{}, nil
+}
+
+// Exec tallies a submitted proposal and, if it was accepted, executes its
+// messages on behalf of the group account. A proposal whose group or group
+// account was modified since its submission is aborted instead. The messages
+// are executed atomically: if any of them fails, none of their state changes
+// are kept and the failure is recorded in the proposal.
+func (k msgServer) Exec(goCtx context.Context, msg *types.MsgExec) (*types.MsgExecResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	p, err := k.GetProposal(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	if p.Status != types.ProposalStatusSubmitted && p.Status != types.ProposalStatusClosed {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "proposal is %s", p.Status)
+	}
+
+	a, err := k.GetGroupAccountInfo(ctx, p.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Status == types.ProposalStatusSubmitted {
+		g, err := k.GetGroupInfo(ctx, a.GroupId)
+		if err != nil {
+			return nil, err
+		}
+
+		if a.Version != p.GroupAccountVersion || g.Version != p.GroupVersion {
+			p.Status = types.ProposalStatusAborted
+		} else if err := k.doTally(ctx, &p, g, a); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.Status == types.ProposalStatusClosed && p.Result == types.ProposalResultAccepted &&
+		p.ExecutorResult != types.ProposalExecutorResultSuccess {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.doExecuteMsgs(cacheCtx, p); err != nil {
+			p.ExecutorResult = types.ProposalExecutorResultFailure
+			k.Logger(ctx).Info("proposal execution failed", "proposal", p.ProposalId, "err", err)
+		} else {
+			p.ExecutorResult = types.ProposalExecutorResultSuccess
+			writeCache()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
+	}
+
+	k.setProposal(ctx, p)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExec,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", p.ProposalId)),
+			sdk.NewAttribute(types.AttributeKeyResult, p.ExecutorResult.String()),
+		),
+	)
+
+	return &types.MsgExecResponse{}, nil
+}
+
+// getGroupWithAdmin returns the group with the given ID, checking that it is
+// administered by admin.
+func (k Keeper) getGroupWithAdmin(ctx sdk.Context, groupID uint64, admin sdk.AccAddress) (types.GroupInfo, error) {
+	g, err := k.GetGroupInfo(ctx, groupID)
+	if err != nil {
+		return types.GroupInfo{}, err
+	}
+	if !g.Admin.Equals(admin) {
+		return types.GroupInfo{}, sdkerrors.Wrap(types.ErrUnauthorized, "not group admin")
+	}
+
+	return g, nil
+}
+
+// getGroupAccountWithAdmin returns the group account with the given address,
+// checking that it is administered by admin.
+func (k Keeper) getGroupAccountWithAdmin(ctx sdk.Context, address, admin sdk.AccAddress) (types.GroupAccountInfo, error) {
+	a, err := k.GetGroupAccountInfo(ctx, address)
+	if err != nil {
+		return types.GroupAccountInfo{}, err
+	}
+	if !a.Admin.Equals(admin) {
+		return types.GroupAccountInfo{}, sdkerrors.Wrap(types.ErrUnauthorized, "not group account admin")
+	}
+
+	return a, nil
+}
+
+func (k Keeper) emitGroupEvent(ctx sdk.Context, eventType string, groupID uint64, admin sdk.AccAddress) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", groupID)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, admin.String()),
+		),
+	})
+}
+
+func (k Keeper) emitGroupAccountEvent(ctx sdk.Context, eventType string, address, admin sdk.AccAddress) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, admin.String()),
+		),
+	})
+}
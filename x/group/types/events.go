@@ -0,0 +1,18 @@
+package types
+
+// group module events
+const (
+	EventTypeCreateGroup        = "create_group"
+	EventTypeUpdateGroup        = "update_group"
+	EventTypeCreateGroupAccount = "create_group_account"
+	EventTypeUpdateGroupAccount = "update_group_account"
+	EventTypeCreateProposal     = "create_proposal"
+	EventTypeVote               = "vote"
+	EventTypeExec               = "exec"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyGroupID    = "group_id"
+	AttributeKeyAddress    = "address"
+	AttributeKeyProposalID = "proposal_id"
+	AttributeKeyResult     = "result"
+)
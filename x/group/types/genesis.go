@@ -0,0 +1,105 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates a new genesis state with default values.
+func NewGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// DefaultGenesisState returns a default genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState()
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure. Every object must reference existing groups, group accounts and
+// proposals, and no ID may exceed its sequence.
+func (gs GenesisState) Validate() error {
+	groups := make(map[uint64]GroupInfo, len(gs.Groups))
+	for _, g := range gs.Groups {
+		if err := g.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "group")
+		}
+		if g.GroupId > gs.GroupSeq {
+			return sdkerrors.Wrapf(ErrInvalid, "group id %d exceeds group sequence", g.GroupId)
+		}
+		if _, exists := groups[g.GroupId]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "group id %d", g.GroupId)
+		}
+		groups[g.GroupId] = g
+	}
+
+	for _, m := range gs.GroupMembers {
+		if err := m.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "group member")
+		}
+		if _, exists := groups[m.GroupId]; !exists {
+			return sdkerrors.Wrapf(ErrNotFound, "group %d of member %s", m.GroupId, m.Member.Address)
+		}
+	}
+
+	accounts := make(map[string]GroupAccountInfo, len(gs.GroupAccounts))
+	for _, a := range gs.GroupAccounts {
+		if err := a.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "group account")
+		}
+		if _, exists := groups[a.GroupId]; !exists {
+			return sdkerrors.Wrapf(ErrNotFound, "group %d of group account %s", a.GroupId, a.Address)
+		}
+		if _, exists := accounts[a.Address.String()]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "group account %s", a.Address)
+		}
+		accounts[a.Address.String()] = a
+	}
+
+	proposals := make(map[uint64]struct{}, len(gs.Proposals))
+	for _, p := range gs.Proposals {
+		if err := p.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "proposal")
+		}
+		if p.ProposalId > gs.ProposalSeq {
+			return sdkerrors.Wrapf(ErrInvalid, "proposal id %d exceeds proposal sequence", p.ProposalId)
+		}
+		if _, exists := accounts[p.Address.String()]; !exists {
+			return sdkerrors.Wrapf(ErrNotFound, "group account %s of proposal %d", p.Address, p.ProposalId)
+		}
+		if _, exists := proposals[p.ProposalId]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "proposal id %d", p.ProposalId)
+		}
+		proposals[p.ProposalId] = struct{}{}
+	}
+
+	for _, v := range gs.Votes {
+		if err := v.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "vote")
+		}
+		if _, exists := proposals[v.ProposalId]; !exists {
+			return sdkerrors.Wrapf(ErrNotFound, "proposal %d of vote by %s", v.ProposalId, v.Voter)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, a := range gs.GroupAccounts {
+		if err := a.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	for _, p := range gs.Proposals {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "group"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	GroupSeqKey        = []byte{0x01} // key for the last group ID
+	GroupAccountSeqKey = []byte{0x02} // key for the last group account sequence
+	ProposalSeqKey     = []byte{0x03} // key for the last proposal ID
+
+	GroupKey        = []byte{0x10} // prefix for each key to a group
+	GroupByAdminKey = []byte{0x11} // prefix for the group by admin index
+	GroupMemberKey  = []byte{0x12} // prefix for each key to a group member
+
+	GroupAccountKey        = []byte{0x20} // prefix for each key to a group account
+	GroupAccountByGroupKey = []byte{0x21} // prefix for the group account by group index
+	GroupAccountByAdminKey = []byte{0x22} // prefix for the group account by admin index
+
+	ProposalKey               = []byte{0x30} // prefix for each key to a proposal
+	ProposalByGroupAccountKey = []byte{0x31} // prefix for the proposal by group account index
+
+	VoteKey        = []byte{0x40} // prefix for each key to a vote
+	VoteByVoterKey = []byte{0x41} // prefix for the vote by voter index
+)
+
+// GetGroupKey returns the store key of a group:
+// 0x10 | groupID
+func GetGroupKey(groupID uint64) []byte {
+	return append(GroupKey, sdk.Uint64ToBigEndian(groupID)...)
+}
+
+// GetGroupsByAdminPrefix returns the index prefix of the groups administered
+// by admin: 0x11 | len(admin) | admin
+func GetGroupsByAdminPrefix(admin sdk.AccAddress) []byte {
+	return append(GroupByAdminKey, lengthPrefix(admin)...)
+}
+
+// GetGroupByAdminKey returns the index key of a group administered by admin:
+// 0x11 | len(admin) | admin | groupID
+func GetGroupByAdminKey(admin sdk.AccAddress, groupID uint64) []byte {
+	return append(GetGroupsByAdminPrefix(admin), sdk.Uint64ToBigEndian(groupID)...)
+}
+
+// GetGroupMembersPrefix returns the key prefix of the members of a group:
+// 0x12 | groupID
+func GetGroupMembersPrefix(groupID uint64) []byte {
+	return append(GroupMemberKey, sdk.Uint64ToBigEndian(groupID)...)
+}
+
+// GetGroupMemberKey returns the store key of a group member:
+// 0x12 | groupID | len(member) | member
+func GetGroupMemberKey(groupID uint64, member sdk.AccAddress) []byte {
+	return append(GetGroupMembersPrefix(groupID), lengthPrefix(member)...)
+}
+
+// GetGroupAccountKey returns the store key of a group account:
+// 0x20 | len(address) | address
+func GetGroupAccountKey(address sdk.AccAddress) []byte {
+	return append(GroupAccountKey, lengthPrefix(address)...)
+}
+
+// GetGroupAccountsByGroupPrefix returns the index prefix of the group accounts
+// of a group: 0x21 | groupID
+func GetGroupAccountsByGroupPrefix(groupID uint64) []byte {
+	return append(GroupAccountByGroupKey, sdk.Uint64ToBigEndian(groupID)...)
+}
+
+// GetGroupAccountByGroupKey returns the index key of a group account of a
+// group: 0x21 | groupID | len(address) | address
+func GetGroupAccountByGroupKey(groupID uint64, address sdk.AccAddress) []byte {
+	return append(GetGroupAccountsByGroupPrefix(groupID), lengthPrefix(address)...)
+}
+
+// GetGroupAccountsByAdminPrefix returns the index prefix of the group accounts
+// administered by admin: 0x22 | len(admin) | admin
+func GetGroupAccountsByAdminPrefix(admin sdk.AccAddress) []byte {
+	return append(GroupAccountByAdminKey, lengthPrefix(admin)...)
+}
+
+// GetGroupAccountByAdminKey returns the index key of a group account
+// administered by admin: 0x22 | len(admin) | admin | len(address) | address
+func GetGroupAccountByAdminKey(admin, address sdk.AccAddress) []byte {
+	return append(GetGroupAccountsByAdminPrefix(admin), lengthPrefix(address)...)
+}
+
+// GetProposalKey returns the store key of a proposal:
+// 0x30 | proposalID
+func GetProposalKey(proposalID uint64) []byte {
+	return append(ProposalKey, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// GetProposalsByGroupAccountPrefix returns the index prefix of the proposals
+// of a group account: 0x31 | len(address) | address
+func GetProposalsByGroupAccountPrefix(address sdk.AccAddress) []byte {
+	return append(ProposalByGroupAccountKey, lengthPrefix(address)...)
+}
+
+// GetProposalByGroupAccountKey returns the index key of a proposal of a group
+// account: 0x31 | len(address) | address | proposalID
+func GetProposalByGroupAccountKey(address sdk.AccAddress, proposalID uint64) []byte {
+	return append(GetProposalsByGroupAccountPrefix(address), sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// GetVotesByProposalPrefix returns the key prefix of the votes on a proposal:
+// 0x40 | proposalID
+func GetVotesByProposalPrefix(proposalID uint64) []byte {
+	return append(VoteKey, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// GetVoteKey returns the store key of a vote:
+// 0x40 | proposalID | len(voter) | voter
+func GetVoteKey(proposalID uint64, voter sdk.AccAddress) []byte {
+	return append(GetVotesByProposalPrefix(proposalID), lengthPrefix(voter)...)
+}
+
+// GetVotesByVoterPrefix returns the index prefix of the votes cast by voter:
+// 0x41 | len(voter) | voter
+func GetVotesByVoterPrefix(voter sdk.AccAddress) []byte {
+	return append(VoteByVoterKey, lengthPrefix(voter)...)
+}
+
+// GetVoteByVoterKey returns the index key of a vote cast by voter:
+// 0x41 | len(voter) | voter | proposalID
+func GetVoteByVoterKey(voter sdk.AccAddress, proposalID uint64) []byte {
+	return append(GetVotesByVoterPrefix(voter), sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// ParseLengthPrefixedAddress returns the length prefixed address at the start
+// of the given key.
+func ParseLengthPrefixedAddress(key []byte) sdk.AccAddress {
+	return sdk.AccAddress(key[1 : 1+int(key[0])])
+}
+
+// GroupAccountName returns the module account name of the group account with
+// the given sequence.
+func GroupAccountName(seq uint64) string {
+	return fmt.Sprintf("%s/account/%d", ModuleName, seq)
+}
+
+// GroupAccountAddress derives the address of the group account with the given
+// sequence. Group accounts are module accounts, so no private key exists for
+// them and they can only act through executed proposals.
+func GroupAccountAddress(seq uint64) sdk.AccAddress {
+	return authtypes.NewModuleAddress(GroupAccountName(seq))
+}
+
+func lengthPrefix(addr sdk.AccAddress) []byte {
+	key := make([]byte, 0, 1+len(addr))
+	key = append(key, byte(len(addr)))
+	return append(key, addr...)
+}
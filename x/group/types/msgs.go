@@ -0,0 +1,537 @@
+package types
+
+import (
+	"encoding/json"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// group message types
+const (
+	TypeMsgCreateGroup                      = "create_group"
+	TypeMsgUpdateGroupMembers               = "update_group_members"
+	TypeMsgUpdateGroupAdmin                 = "update_group_admin"
+	TypeMsgUpdateGroupMetadata              = "update_group_metadata"
+	TypeMsgCreateGroupAccount               = "create_group_account"
+	TypeMsgUpdateGroupAccountAdmin          = "update_group_account_admin"
+	TypeMsgUpdateGroupAccountDecisionPolicy = "update_group_account_decision_policy"
+	TypeMsgUpdateGroupAccountMetadata       = "update_group_account_metadata"
+	TypeMsgCreateProposal                   = "create_proposal"
+	TypeMsgVote                             = "vote"
+	TypeMsgExec                             = "exec"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateGroup{}
+	_ sdk.Msg = &MsgUpdateGroupMembers{}
+	_ sdk.Msg = &MsgUpdateGroupAdmin{}
+	_ sdk.Msg = &MsgUpdateGroupMetadata{}
+	_ sdk.Msg = &MsgCreateGroupAccount{}
+	_ sdk.Msg = &MsgUpdateGroupAccountAdmin{}
+	_ sdk.Msg = &MsgUpdateGroupAccountDecisionPolicy{}
+	_ sdk.Msg = &MsgUpdateGroupAccountMetadata{}
+	_ sdk.Msg = &MsgCreateProposal{}
+	_ sdk.Msg = &MsgVote{}
+	_ sdk.Msg = &MsgExec{}
+
+	_ codectypes.UnpackInterfacesMessage = &MsgCreateGroupAccount{}
+	_ codectypes.UnpackInterfacesMessage = &MsgUpdateGroupAccountDecisionPolicy{}
+	_ codectypes.UnpackInterfacesMessage = &MsgCreateProposal{}
+)
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCreateGroup) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCreateGroup) Type() string { return TypeMsgCreateGroup }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCreateGroup) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateGroup) ValidateBasic() error {
+	if msg.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+	if err := (Members{Members: msg.Members}).ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "members")
+	}
+
+	return ValidateMetadata(msg.Metadata, "group")
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgCreateGroup) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateGroupMembers) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateGroupMembers) Type() string { return TypeMsgUpdateGroupMembers }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUpdateGroupMembers) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// ValidateBasic implements the sdk.Msg interface. A member update with a zero
+// weight removes the member from the group.
+func (msg MsgUpdateGroupMembers) ValidateBasic() error {
+	if msg.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+	if msg.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if len(msg.MemberUpdates) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "member updates")
+	}
+
+	addrs := make([]sdk.AccAddress, len(msg.MemberUpdates))
+	for i, m := range msg.MemberUpdates {
+		if _, err := ParseNonNegativeDec(m.Weight); err != nil {
+			return sdkerrors.Wrap(err, "member weight")
+		}
+		if err := ValidateMetadata(m.Metadata, "member"); err != nil {
+			return err
+		}
+		addrs[i] = m.Address
+	}
+
+	return sdkerrors.Wrap(validateAddresses(addrs), "members")
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUpdateGroupMembers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAdmin) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAdmin) Type() string { return TypeMsgUpdateGroupAdmin }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAdmin) ValidateBasic() error {
+	if msg.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+	if msg.NewAdmin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing new admin address")
+	}
+	if msg.Admin.Equals(msg.NewAdmin) {
+		return sdkerrors.Wrap(ErrInvalid, "new and old admin are the same")
+	}
+	if msg.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateGroupMetadata) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateGroupMetadata) Type() string { return TypeMsgUpdateGroupMetadata }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUpdateGroupMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateGroupMetadata) ValidateBasic() error {
+	if msg.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+	if msg.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+
+	return ValidateMetadata(msg.Metadata, "group")
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUpdateGroupMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// NewMsgCreateGroupAccount creates a new MsgCreateGroupAccount.
+func NewMsgCreateGroupAccount(admin sdk.AccAddress, groupID uint64, metadata []byte, decisionPolicy DecisionPolicy) (*MsgCreateGroupAccount, error) {
+	m := &MsgCreateGroupAccount{
+		Admin:    admin,
+		GroupId:  groupID,
+		Metadata: metadata,
+	}
+
+	if err := m.SetDecisionPolicy(decisionPolicy); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// SetDecisionPolicy packs the given decision policy into the message.
+func (msg *MsgCreateGroupAccount) SetDecisionPolicy(decisionPolicy DecisionPolicy) error {
+	any, err := codectypes.NewAnyWithValue(decisionPolicy)
+	if err != nil {
+		return err
+	}
+
+	msg.DecisionPolicy = any
+	return nil
+}
+
+// GetDecisionPolicy returns the cached decision policy of the message, or nil
+// if it is not set or cannot be unpacked.
+func (msg MsgCreateGroupAccount) GetDecisionPolicy() DecisionPolicy {
+	return GroupAccountInfo{DecisionPolicy: msg.DecisionPolicy}.GetDecisionPolicy()
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCreateGroupAccount) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCreateGroupAccount) Type() string { return TypeMsgCreateGroupAccount }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCreateGroupAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateGroupAccount) ValidateBasic() error {
+	if msg.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+	if msg.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if err := validateDecisionPolicy(msg.GetDecisionPolicy()); err != nil {
+		return err
+	}
+
+	return ValidateMetadata(msg.Metadata, "group account")
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgCreateGroupAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgCreateGroupAccount) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(msg.DecisionPolicy, &decisionPolicy)
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAccountAdmin) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAccountAdmin) Type() string { return TypeMsgUpdateGroupAccountAdmin }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAccountAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAccountAdmin) ValidateBasic() error {
+	if msg.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+	if msg.NewAdmin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing new admin address")
+	}
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing group account address")
+	}
+	if msg.Admin.Equals(msg.NewAdmin) {
+		return sdkerrors.Wrap(ErrInvalid, "new and old admin are the same")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAccountAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// NewMsgUpdateGroupAccountDecisionPolicy creates a new
+// MsgUpdateGroupAccountDecisionPolicy.
+func NewMsgUpdateGroupAccountDecisionPolicy(admin, address sdk.AccAddress, decisionPolicy DecisionPolicy) (*MsgUpdateGroupAccountDecisionPolicy, error) {
+	m := &MsgUpdateGroupAccountDecisionPolicy{
+		Admin:   admin,
+		Address: address,
+	}
+
+	if err := m.SetDecisionPolicy(decisionPolicy); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// SetDecisionPolicy packs the given decision policy into the message.
+func (msg *MsgUpdateGroupAccountDecisionPolicy) SetDecisionPolicy(decisionPolicy DecisionPolicy) error {
+	any, err := codectypes.NewAnyWithValue(decisionPolicy)
+	if err != nil {
+		return err
+	}
+
+	msg.DecisionPolicy = any
+	return nil
+}
+
+// GetDecisionPolicy returns the cached decision policy of the message, or nil
+// if it is not set or cannot be unpacked.
+func (msg MsgUpdateGroupAccountDecisionPolicy) GetDecisionPolicy() DecisionPolicy {
+	return GroupAccountInfo{DecisionPolicy: msg.DecisionPolicy}.GetDecisionPolicy()
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAccountDecisionPolicy) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAccountDecisionPolicy) Type() string {
+	return TypeMsgUpdateGroupAccountDecisionPolicy
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAccountDecisionPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAccountDecisionPolicy) ValidateBasic() error {
+	if msg.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing group account address")
+	}
+
+	return validateDecisionPolicy(msg.GetDecisionPolicy())
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAccountDecisionPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgUpdateGroupAccountDecisionPolicy) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(msg.DecisionPolicy, &decisionPolicy)
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAccountMetadata) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAccountMetadata) Type() string { return TypeMsgUpdateGroupAccountMetadata }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAccountMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAccountMetadata) ValidateBasic() error {
+	if msg.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing group account address")
+	}
+
+	return ValidateMetadata(msg.Metadata, "group account")
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAccountMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// NewMsgCreateProposal creates a new MsgCreateProposal.
+func NewMsgCreateProposal(address sdk.AccAddress, proposers []sdk.AccAddress, msgs []sdk.Msg, metadata []byte) (*MsgCreateProposal, error) {
+	anys, err := packMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgCreateProposal{
+		Address:   address,
+		Proposers: proposers,
+		Metadata:  metadata,
+		Msgs:      anys,
+	}, nil
+}
+
+// GetMsgs returns the cached sdk.Msgs of the proposal.
+func (msg MsgCreateProposal) GetMsgs() ([]sdk.Msg, error) {
+	return Proposal{Msgs: msg.Msgs}.GetMsgs()
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCreateProposal) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCreateProposal) Type() string { return TypeMsgCreateProposal }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCreateProposal) GetSigners() []sdk.AccAddress {
+	return msg.Proposers
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateProposal) ValidateBasic() error {
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing group account address")
+	}
+	if len(msg.Proposers) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposers")
+	}
+	if err := validateAddresses(msg.Proposers); err != nil {
+		return sdkerrors.Wrap(err, "proposers")
+	}
+
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return err
+	}
+	for i, m := range msgs {
+		if err := m.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "msg %d", i)
+		}
+	}
+
+	return ValidateMetadata(msg.Metadata, "proposal")
+}
+
+// GetSignBytes implements the sdk.Msg interface. The proposed messages are
+// embedded using their own sign bytes, so that MsgCreateProposal does not need
+// to know the Amino names of every Msg type it may carry.
+func (msg MsgCreateProposal) GetSignBytes() []byte {
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		panic(err)
+	}
+
+	msgsBz := make([]json.RawMessage, len(msgs))
+	for i, m := range msgs {
+		msgsBz[i] = m.GetSignBytes()
+	}
+
+	proposers := make([]string, len(msg.Proposers))
+	for i, p := range msg.Proposers {
+		proposers[i] = p.String()
+	}
+
+	bz, err := json.Marshal(map[string]interface{}{
+		"type": "cosmos-sdk/group/MsgCreateProposal",
+		"value": map[string]interface{}{
+			"address":   msg.Address.String(),
+			"proposers": proposers,
+			"metadata":  msg.Metadata,
+			"msgs":      msgsBz,
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(bz)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgCreateProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackMsgs(unpacker, msg.Msgs)
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgVote) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgVote) Type() string { return TypeMsgVote }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgVote) ValidateBasic() error {
+	if msg.Voter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing voter address")
+	}
+	if msg.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+	if _, ok := Choice_name[int32(msg.Choice)]; !ok || msg.Choice == ChoiceUnspecified {
+		return sdkerrors.Wrap(ErrInvalid, "choice")
+	}
+
+	return ValidateMetadata(msg.Metadata, "vote")
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgExec) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgExec) Type() string { return TypeMsgExec }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgExec) ValidateBasic() error {
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing signer address")
+	}
+	if msg.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgExec) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func validateDecisionPolicy(decisionPolicy DecisionPolicy) error {
+	if decisionPolicy == nil {
+		return sdkerrors.Wrap(ErrEmpty, "decision policy")
+	}
+
+	return sdkerrors.Wrap(decisionPolicy.ValidateBasic(), "decision policy")
+}
@@ -0,0 +1,167 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+var (
+	admin   = sdk.AccAddress("_______admin________")
+	member1 = sdk.AccAddress("_______member1______")
+	member2 = sdk.AccAddress("_______member2______")
+	account = sdk.AccAddress("_______account______")
+)
+
+func TestMsgCreateGroup(t *testing.T) {
+	tests := []struct {
+		title      string
+		msg        types.MsgCreateGroup
+		expectPass bool
+	}{
+		{"valid", types.MsgCreateGroup{Admin: admin, Members: []types.Member{types.NewMember(member1, "1", nil)}}, true},
+		{"no members", types.MsgCreateGroup{Admin: admin}, true},
+		{"missing admin", types.MsgCreateGroup{Members: []types.Member{types.NewMember(member1, "1", nil)}}, false},
+		{"zero weight", types.MsgCreateGroup{Admin: admin, Members: []types.Member{types.NewMember(member1, "0", nil)}}, false},
+		{"invalid weight", types.MsgCreateGroup{Admin: admin, Members: []types.Member{types.NewMember(member1, "x", nil)}}, false},
+		{"missing member address", types.MsgCreateGroup{Admin: admin, Members: []types.Member{types.NewMember(nil, "1", nil)}}, false},
+		{
+			"duplicate members",
+			types.MsgCreateGroup{Admin: admin, Members: []types.Member{types.NewMember(member1, "1", nil), types.NewMember(member1, "2", nil)}},
+			false,
+		},
+		{"metadata too long", types.MsgCreateGroup{Admin: admin, Metadata: []byte(strings.Repeat("a", types.MaxMetadataLength+1))}, false},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.msg.ValidateBasic(), tc.title)
+		} else {
+			require.Error(t, tc.msg.ValidateBasic(), tc.title)
+		}
+	}
+}
+
+func TestMsgUpdateGroupMembers(t *testing.T) {
+	tests := []struct {
+		title      string
+		msg        types.MsgUpdateGroupMembers
+		expectPass bool
+	}{
+		{"valid", types.MsgUpdateGroupMembers{Admin: admin, GroupId: 1, MemberUpdates: []types.Member{types.NewMember(member1, "1", nil)}}, true},
+		{"member removal", types.MsgUpdateGroupMembers{Admin: admin, GroupId: 1, MemberUpdates: []types.Member{types.NewMember(member1, "0", nil)}}, true},
+		{"no updates", types.MsgUpdateGroupMembers{Admin: admin, GroupId: 1}, false},
+		{"missing group id", types.MsgUpdateGroupMembers{Admin: admin, MemberUpdates: []types.Member{types.NewMember(member1, "1", nil)}}, false},
+		{"negative weight", types.MsgUpdateGroupMembers{Admin: admin, GroupId: 1, MemberUpdates: []types.Member{types.NewMember(member1, "-1", nil)}}, false},
+		{
+			"duplicate members",
+			types.MsgUpdateGroupMembers{Admin: admin, GroupId: 1, MemberUpdates: []types.Member{types.NewMember(member1, "1", nil), types.NewMember(member1, "0", nil)}},
+			false,
+		},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.msg.ValidateBasic(), tc.title)
+		} else {
+			require.Error(t, tc.msg.ValidateBasic(), tc.title)
+		}
+	}
+}
+
+func TestMsgCreateGroupAccount(t *testing.T) {
+	tests := []struct {
+		title      string
+		admin      sdk.AccAddress
+		groupID    uint64
+		policy     types.DecisionPolicy
+		expectPass bool
+	}{
+		{"valid", admin, 1, types.NewThresholdDecisionPolicy("1", time.Hour), true},
+		{"missing admin", nil, 1, types.NewThresholdDecisionPolicy("1", time.Hour), false},
+		{"missing group id", admin, 0, types.NewThresholdDecisionPolicy("1", time.Hour), false},
+		{"invalid policy", admin, 1, types.NewPercentageDecisionPolicy("2", time.Hour), false},
+	}
+
+	for _, tc := range tests {
+		msg, err := types.NewMsgCreateGroupAccount(tc.admin, tc.groupID, nil, tc.policy)
+		require.NoError(t, err, tc.title)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), tc.title)
+		} else {
+			require.Error(t, msg.ValidateBasic(), tc.title)
+		}
+	}
+}
+
+func TestMsgCreateProposal(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+
+	tests := []struct {
+		title      string
+		address    sdk.AccAddress
+		proposers  []sdk.AccAddress
+		msgs       []sdk.Msg
+		expectPass bool
+	}{
+		{"valid", account, []sdk.AccAddress{member1, member2}, []sdk.Msg{banktypes.NewMsgSend(account, member1, coins)}, true},
+		{"no msgs", account, []sdk.AccAddress{member1}, nil, true},
+		{"missing address", nil, []sdk.AccAddress{member1}, nil, false},
+		{"no proposers", account, nil, nil, false},
+		{"duplicate proposers", account, []sdk.AccAddress{member1, member1}, nil, false},
+		{"invalid msg", account, []sdk.AccAddress{member1}, []sdk.Msg{banktypes.NewMsgSend(account, member1, nil)}, false},
+	}
+
+	for _, tc := range tests {
+		msg, err := types.NewMsgCreateProposal(tc.address, tc.proposers, tc.msgs, nil)
+		require.NoError(t, err, tc.title)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), tc.title)
+			require.Equal(t, tc.proposers, msg.GetSigners(), tc.title)
+		} else {
+			require.Error(t, msg.ValidateBasic(), tc.title)
+		}
+	}
+}
+
+func TestMsgCreateProposalGetSignBytes(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+	send := banktypes.NewMsgSend(account, member1, coins)
+
+	msg, err := types.NewMsgCreateProposal(account, []sdk.AccAddress{member1}, []sdk.Msg{send}, nil)
+	require.NoError(t, err)
+
+	bz := msg.GetSignBytes()
+	require.Contains(t, string(bz), `"type":"cosmos-sdk/group/MsgCreateProposal"`)
+	require.Contains(t, string(bz), string(send.GetSignBytes()))
+}
+
+func TestMsgVote(t *testing.T) {
+	tests := []struct {
+		title      string
+		msg        types.MsgVote
+		expectPass bool
+	}{
+		{"valid", types.MsgVote{ProposalId: 1, Voter: member1, Choice: types.ChoiceYes}, true},
+		{"missing voter", types.MsgVote{ProposalId: 1, Choice: types.ChoiceYes}, false},
+		{"missing proposal id", types.MsgVote{Voter: member1, Choice: types.ChoiceYes}, false},
+		{"unspecified choice", types.MsgVote{ProposalId: 1, Voter: member1}, false},
+		{"unknown choice", types.MsgVote{ProposalId: 1, Voter: member1, Choice: types.Choice(10)}, false},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.msg.ValidateBasic(), tc.title)
+		} else {
+			require.Error(t, tc.msg.ValidateBasic(), tc.title)
+		}
+	}
+}
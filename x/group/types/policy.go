@@ -0,0 +1,168 @@
+package types
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DecisionPolicyResult is the result of whether a proposal passes or not a
+// decision policy.
+type DecisionPolicyResult struct {
+	// Allow determines if the proposal is allowed to pass.
+	Allow bool
+	// Final determines if the tally result is final or not.
+	Final bool
+}
+
+// DecisionPolicy is the persistent set of rules to determine the result of
+// election on a proposal.
+type DecisionPolicy interface {
+	proto.Message
+
+	// GetTimeout returns the duration after proposal submission where votes are
+	// accepted.
+	GetTimeout() time.Duration
+	// Allow defines policy-specific logic to allow a proposal to pass or not,
+	// based on its tally result, the group's total power and the time since
+	// the proposal was submitted.
+	Allow(tally Tally, totalPower string, votingDuration time.Duration) (DecisionPolicyResult, error)
+
+	ValidateBasic() error
+}
+
+var (
+	_ DecisionPolicy = &ThresholdDecisionPolicy{}
+	_ DecisionPolicy = &PercentageDecisionPolicy{}
+)
+
+// NewThresholdDecisionPolicy creates a threshold DecisionPolicy
+func NewThresholdDecisionPolicy(threshold string, timeout time.Duration) *ThresholdDecisionPolicy {
+	return &ThresholdDecisionPolicy{Threshold: threshold, Timeout: timeout}
+}
+
+// ValidateBasic implements DecisionPolicy.ValidateBasic
+func (p ThresholdDecisionPolicy) ValidateBasic() error {
+	if _, err := ParsePositiveDec(p.Threshold); err != nil {
+		return sdkerrors.Wrap(err, "threshold")
+	}
+	if p.Timeout <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "timeout must be positive")
+	}
+
+	return nil
+}
+
+// Allow implements DecisionPolicy.Allow. The proposal passes once the sum of
+// yes votes reaches the threshold, and is rejected once the threshold cannot
+// be reached anymore or the timeout has passed.
+func (p ThresholdDecisionPolicy) Allow(tally Tally, totalPower string, votingDuration time.Duration) (DecisionPolicyResult, error) {
+	if p.Timeout <= votingDuration {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	threshold, err := ParsePositiveDec(p.Threshold)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "threshold")
+	}
+	yesCount, err := ParseNonNegativeDec(tally.YesCount)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "yes count")
+	}
+	if yesCount.GTE(threshold) {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+
+	undecided, err := undecidedPower(tally, totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if yesCount.Add(undecided).LT(threshold) {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+// NewPercentageDecisionPolicy creates a percentage DecisionPolicy
+func NewPercentageDecisionPolicy(percentage string, timeout time.Duration) *PercentageDecisionPolicy {
+	return &PercentageDecisionPolicy{Percentage: percentage, Timeout: timeout}
+}
+
+// ValidateBasic implements DecisionPolicy.ValidateBasic
+func (p PercentageDecisionPolicy) ValidateBasic() error {
+	percentage, err := ParsePositiveDec(p.Percentage)
+	if err != nil {
+		return sdkerrors.Wrap(err, "percentage")
+	}
+	if percentage.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrInvalid, "percentage must be <= 1")
+	}
+	if p.Timeout <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "timeout must be positive")
+	}
+
+	return nil
+}
+
+// Allow implements DecisionPolicy.Allow. The proposal passes once the yes
+// votes reach the percentage of the group's total power, and is rejected once
+// the percentage cannot be reached anymore or the timeout has passed.
+func (p PercentageDecisionPolicy) Allow(tally Tally, totalPower string, votingDuration time.Duration) (DecisionPolicyResult, error) {
+	if p.Timeout <= votingDuration {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	percentage, err := ParsePositiveDec(p.Percentage)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "percentage")
+	}
+	yesCount, err := ParseNonNegativeDec(tally.YesCount)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "yes count")
+	}
+	total, err := ParseNonNegativeDec(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "total power")
+	}
+	if total.IsZero() {
+		return DecisionPolicyResult{Allow: false, Final: false}, nil
+	}
+
+	if yesCount.Quo(total).GTE(percentage) {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+
+	undecided, err := undecidedPower(tally, totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if yesCount.Add(undecided).Quo(total).LT(percentage) {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+// undecidedPower returns the voting power of the group members that have not
+// voted yet.
+func undecidedPower(tally Tally, totalPower string) (sdk.Dec, error) {
+	total, err := ParseNonNegativeDec(totalPower)
+	if err != nil {
+		return sdk.Dec{}, sdkerrors.Wrap(err, "total power")
+	}
+	totalCounts, err := tally.TotalCounts()
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	undecided := total.Sub(totalCounts)
+	if undecided.IsNegative() {
+		return sdk.ZeroDec(), nil
+	}
+
+	return undecided, nil
+}
@@ -0,0 +1,92 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+func tally(yes, no string) types.Tally {
+	t := types.DefaultTally()
+	t.YesCount = yes
+	t.NoCount = no
+	return t
+}
+
+func TestThresholdDecisionPolicy(t *testing.T) {
+	policy := types.NewThresholdDecisionPolicy("2", time.Hour)
+	require.NoError(t, policy.ValidateBasic())
+
+	tests := []struct {
+		title          string
+		tally          types.Tally
+		totalPower     string
+		votingDuration time.Duration
+		expResult      types.DecisionPolicyResult
+	}{
+		{"threshold reached", tally("2", "0"), "3", time.Second, types.DecisionPolicyResult{Allow: true, Final: true}},
+		{"threshold exceeded", tally("2.5", "0"), "3", time.Second, types.DecisionPolicyResult{Allow: true, Final: true}},
+		{"threshold still reachable", tally("1", "0"), "3", time.Second, types.DecisionPolicyResult{Allow: false, Final: false}},
+		{"threshold unreachable", tally("1", "1.5"), "3", time.Second, types.DecisionPolicyResult{Allow: false, Final: true}},
+		{"timed out", tally("2", "0"), "3", time.Hour, types.DecisionPolicyResult{Allow: false, Final: true}},
+	}
+
+	for _, tc := range tests {
+		res, err := policy.Allow(tc.tally, tc.totalPower, tc.votingDuration)
+		require.NoError(t, err, tc.title)
+		require.Equal(t, tc.expResult, res, tc.title)
+	}
+}
+
+func TestPercentageDecisionPolicy(t *testing.T) {
+	policy := types.NewPercentageDecisionPolicy("0.5", time.Hour)
+	require.NoError(t, policy.ValidateBasic())
+
+	tests := []struct {
+		title          string
+		tally          types.Tally
+		totalPower     string
+		votingDuration time.Duration
+		expResult      types.DecisionPolicyResult
+	}{
+		{"percentage reached", tally("2", "0"), "4", time.Second, types.DecisionPolicyResult{Allow: true, Final: true}},
+		{"percentage still reachable", tally("1", "1"), "4", time.Second, types.DecisionPolicyResult{Allow: false, Final: false}},
+		{"percentage unreachable", tally("1", "2.5"), "4", time.Second, types.DecisionPolicyResult{Allow: false, Final: true}},
+		{"empty group", tally("0", "0"), "0", time.Second, types.DecisionPolicyResult{Allow: false, Final: false}},
+		{"timed out", tally("4", "0"), "4", time.Hour, types.DecisionPolicyResult{Allow: false, Final: true}},
+	}
+
+	for _, tc := range tests {
+		res, err := policy.Allow(tc.tally, tc.totalPower, tc.votingDuration)
+		require.NoError(t, err, tc.title)
+		require.Equal(t, tc.expResult, res, tc.title)
+	}
+}
+
+func TestDecisionPolicyValidateBasic(t *testing.T) {
+	tests := []struct {
+		title      string
+		policy     types.DecisionPolicy
+		expectPass bool
+	}{
+		{"valid threshold", types.NewThresholdDecisionPolicy("1.5", time.Hour), true},
+		{"zero threshold", types.NewThresholdDecisionPolicy("0", time.Hour), false},
+		{"invalid threshold", types.NewThresholdDecisionPolicy("one", time.Hour), false},
+		{"threshold without timeout", types.NewThresholdDecisionPolicy("1", 0), false},
+		{"valid percentage", types.NewPercentageDecisionPolicy("1", time.Hour), true},
+		{"percentage above one", types.NewPercentageDecisionPolicy("1.1", time.Hour), false},
+		{"negative percentage", types.NewPercentageDecisionPolicy("-0.5", time.Hour), false},
+		{"percentage without timeout", types.NewPercentageDecisionPolicy("0.5", 0), false},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.policy.ValidateBasic(), tc.title)
+		} else {
+			require.Error(t, tc.policy.ValidateBasic(), tc.title)
+		}
+	}
+}
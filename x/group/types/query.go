@@ -0,0 +1,60 @@
+package types
+
+import codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+var (
+	_ codectypes.UnpackInterfacesMessage = &QueryGroupAccountInfoResponse{}
+	_ codectypes.UnpackInterfacesMessage = &QueryGroupAccountsByGroupResponse{}
+	_ codectypes.UnpackInterfacesMessage = &QueryGroupAccountsByAdminResponse{}
+	_ codectypes.UnpackInterfacesMessage = &QueryProposalResponse{}
+	_ codectypes.UnpackInterfacesMessage = &QueryProposalsByGroupAccountResponse{}
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryGroupAccountInfoResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.Info == nil {
+		return nil
+	}
+
+	return m.Info.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryGroupAccountsByGroupResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackGroupAccounts(unpacker, m.GroupAccounts)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryGroupAccountsByAdminResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackGroupAccounts(unpacker, m.GroupAccounts)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryProposalResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.Proposal == nil {
+		return nil
+	}
+
+	return m.Proposal.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryProposalsByGroupAccountResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, p := range m.Proposals {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func unpackGroupAccounts(unpacker codectypes.AnyUnpacker, accounts []*GroupAccountInfo) error {
+	for _, a := range accounts {
+		if err := a.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
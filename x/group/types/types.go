@@ -0,0 +1,415 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxMetadataLength is the maximum length of the metadata attached to groups,
+// group accounts, members, proposals and votes.
+const MaxMetadataLength = 255
+
+var (
+	_ codectypes.UnpackInterfacesMessage = &GroupAccountInfo{}
+	_ codectypes.UnpackInterfacesMessage = &Proposal{}
+)
+
+// ParsePositiveDec parses a decimal string, returning an error if it is not
+// strictly positive.
+func ParsePositiveDec(s string) (sdk.Dec, error) {
+	d, err := sdk.NewDecFromStr(s)
+	if err != nil {
+		return sdk.Dec{}, sdkerrors.Wrapf(ErrInvalid, "invalid decimal %q", s)
+	}
+	if !d.IsPositive() {
+		return sdk.Dec{}, sdkerrors.Wrapf(ErrInvalid, "expected a positive decimal, got %s", s)
+	}
+
+	return d, nil
+}
+
+// ParseNonNegativeDec parses a decimal string, returning an error if it is
+// negative.
+func ParseNonNegativeDec(s string) (sdk.Dec, error) {
+	d, err := sdk.NewDecFromStr(s)
+	if err != nil {
+		return sdk.Dec{}, sdkerrors.Wrapf(ErrInvalid, "invalid decimal %q", s)
+	}
+	if d.IsNegative() {
+		return sdk.Dec{}, sdkerrors.Wrapf(ErrInvalid, "expected a non-negative decimal, got %s", s)
+	}
+
+	return d, nil
+}
+
+// ValidateMetadata returns an error if the metadata exceeds MaxMetadataLength.
+func ValidateMetadata(metadata []byte, description string) error {
+	if len(metadata) > MaxMetadataLength {
+		return sdkerrors.Wrapf(ErrMaxLimit, "%s metadata", description)
+	}
+
+	return nil
+}
+
+// NewMember creates a new group member.
+func NewMember(address sdk.AccAddress, weight string, metadata []byte) Member {
+	return Member{
+		Address:  address,
+		Weight:   weight,
+		Metadata: metadata,
+	}
+}
+
+// ValidateBasic performs stateless validation of a member. A member added to a
+// group must have a positive weight.
+func (m Member) ValidateBasic() error {
+	if m.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing member address")
+	}
+	if _, err := ParsePositiveDec(m.Weight); err != nil {
+		return sdkerrors.Wrap(err, "member weight")
+	}
+
+	return ValidateMetadata(m.Metadata, "member")
+}
+
+// ValidateBasic performs stateless validation of a set of members, which must
+// not contain duplicate addresses.
+func (ms Members) ValidateBasic() error {
+	index := make(map[string]struct{}, len(ms.Members))
+	for _, m := range ms.Members {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+
+		addr := m.Address.String()
+		if _, exists := index[addr]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "address: %s", addr)
+		}
+		index[addr] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateBasic performs stateless validation of a group.
+func (g GroupInfo) ValidateBasic() error {
+	if g.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if g.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+	if _, err := ParseNonNegativeDec(g.TotalWeight); err != nil {
+		return sdkerrors.Wrap(err, "total weight")
+	}
+	if g.Version == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "version")
+	}
+
+	return ValidateMetadata(g.Metadata, "group")
+}
+
+// ValidateBasic performs stateless validation of a group member.
+func (gm GroupMember) ValidateBasic() error {
+	if gm.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+
+	return gm.Member.ValidateBasic()
+}
+
+// NewGroupAccountInfo creates a new GroupAccountInfo instance.
+func NewGroupAccountInfo(address sdk.AccAddress, groupID uint64, admin sdk.AccAddress, metadata []byte,
+	version uint64, decisionPolicy DecisionPolicy) (GroupAccountInfo, error) {
+	p := GroupAccountInfo{
+		Address:  address,
+		GroupId:  groupID,
+		Admin:    admin,
+		Metadata: metadata,
+		Version:  version,
+	}
+
+	if err := p.SetDecisionPolicy(decisionPolicy); err != nil {
+		return GroupAccountInfo{}, err
+	}
+
+	return p, nil
+}
+
+// SetDecisionPolicy packs the given decision policy into the group account.
+func (g *GroupAccountInfo) SetDecisionPolicy(decisionPolicy DecisionPolicy) error {
+	any, err := codectypes.NewAnyWithValue(decisionPolicy)
+	if err != nil {
+		return err
+	}
+
+	g.DecisionPolicy = any
+	return nil
+}
+
+// GetDecisionPolicy returns the cached decision policy of the group account,
+// or nil if it is not set or cannot be unpacked.
+func (g GroupAccountInfo) GetDecisionPolicy() DecisionPolicy {
+	if g.DecisionPolicy == nil {
+		return nil
+	}
+
+	decisionPolicy, ok := g.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+
+	return decisionPolicy
+}
+
+// ValidateBasic performs stateless validation of a group account.
+func (g GroupAccountInfo) ValidateBasic() error {
+	if g.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing group account address")
+	}
+	if g.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+	if g.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if g.Version == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "version")
+	}
+
+	policy := g.GetDecisionPolicy()
+	if policy == nil {
+		return sdkerrors.Wrap(ErrEmpty, "decision policy")
+	}
+	if err := policy.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "decision policy")
+	}
+
+	return ValidateMetadata(g.Metadata, "group account")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g GroupAccountInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(g.DecisionPolicy, &decisionPolicy)
+}
+
+// DefaultTally returns a Tally with all counts set to zero.
+func DefaultTally() Tally {
+	return Tally{
+		YesCount:     "0",
+		NoCount:      "0",
+		AbstainCount: "0",
+		VetoCount:    "0",
+	}
+}
+
+// TotalCounts returns the sum of all the weighted votes of the tally.
+func (t Tally) TotalCounts() (sdk.Dec, error) {
+	total := sdk.ZeroDec()
+	for _, count := range []string{t.YesCount, t.NoCount, t.AbstainCount, t.VetoCount} {
+		d, err := ParseNonNegativeDec(count)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		total = total.Add(d)
+	}
+
+	return total, nil
+}
+
+// Add adds the weight of the vote to the count of its choice.
+func (t *Tally) Add(vote Vote, weight string) error {
+	w, err := ParsePositiveDec(weight)
+	if err != nil {
+		return err
+	}
+
+	var count *string
+	switch vote.Choice {
+	case ChoiceYes:
+		count = &t.YesCount
+	case ChoiceNo:
+		count = &t.NoCount
+	case ChoiceAbstain:
+		count = &t.AbstainCount
+	case ChoiceVeto:
+		count = &t.VetoCount
+	default:
+		return sdkerrors.Wrapf(ErrInvalid, "unknown choice %s", vote.Choice)
+	}
+
+	d, err := ParseNonNegativeDec(*count)
+	if err != nil {
+		return err
+	}
+
+	*count = d.Add(w).String()
+	return nil
+}
+
+// ValidateBasic performs stateless validation of a tally.
+func (t Tally) ValidateBasic() error {
+	_, err := t.TotalCounts()
+	return err
+}
+
+// GetMsgs returns the cached sdk.Msgs of the proposal.
+func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(p.Msgs))
+	for i, msgAny := range p.Msgs {
+		msg, ok := msgAny.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "messages contains %T which is not a sdk.Msg", msgAny)
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// SetMsgs packs the given sdk.Msgs into the proposal.
+func (p *Proposal) SetMsgs(msgs []sdk.Msg) error {
+	anys, err := packMsgs(msgs)
+	if err != nil {
+		return err
+	}
+
+	p.Msgs = anys
+	return nil
+}
+
+// ValidateBasic performs stateless validation of a proposal.
+func (p Proposal) ValidateBasic() error {
+	if p.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+	if p.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing group account address")
+	}
+	if len(p.Proposers) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposers")
+	}
+	if err := validateAddresses(p.Proposers); err != nil {
+		return sdkerrors.Wrap(err, "proposers")
+	}
+	if p.SubmittedAt.IsZero() {
+		return sdkerrors.Wrap(ErrEmpty, "submitted at")
+	}
+	if p.GroupVersion == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group version")
+	}
+	if p.GroupAccountVersion == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group account version")
+	}
+	if p.Status == ProposalStatusUnspecified {
+		return sdkerrors.Wrap(ErrEmpty, "status")
+	}
+	if p.Result == ProposalResultUnspecified {
+		return sdkerrors.Wrap(ErrEmpty, "result")
+	}
+	if p.ExecutorResult == ProposalExecutorResultUnspecified {
+		return sdkerrors.Wrap(ErrEmpty, "executor result")
+	}
+	if err := p.VoteState.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "vote state")
+	}
+	if p.Timeout.IsZero() {
+		return sdkerrors.Wrap(ErrEmpty, "timeout")
+	}
+
+	msgs, err := p.GetMsgs()
+	if err != nil {
+		return err
+	}
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "msg %d", i)
+		}
+	}
+
+	return ValidateMetadata(p.Metadata, "proposal")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p Proposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackMsgs(unpacker, p.Msgs)
+}
+
+// ValidateBasic performs stateless validation of a vote.
+func (v Vote) ValidateBasic() error {
+	if v.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+	if v.Voter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing voter address")
+	}
+	if _, ok := Choice_name[int32(v.Choice)]; !ok || v.Choice == ChoiceUnspecified {
+		return sdkerrors.Wrap(ErrInvalid, "choice")
+	}
+	if v.SubmittedAt.IsZero() {
+		return sdkerrors.Wrap(ErrEmpty, "submitted at")
+	}
+
+	return ValidateMetadata(v.Metadata, "vote")
+}
+
+// VotingDuration returns the time elapsed between the submission of the
+// proposal and the given block time.
+func (p Proposal) VotingDuration(blockTime time.Time) time.Duration {
+	return blockTime.Sub(p.SubmittedAt)
+}
+
+func packMsgs(msgs []sdk.Msg) ([]*codectypes.Any, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		pb, ok := msg.(proto.Message)
+		if !ok {
+			return nil, fmt.Errorf("cannot proto marshal %T", msg)
+		}
+
+		any, err := codectypes.NewAnyWithValue(pb)
+		if err != nil {
+			return nil, err
+		}
+
+		anys[i] = any
+	}
+
+	return anys, nil
+}
+
+func unpackMsgs(unpacker codectypes.AnyUnpacker, anys []*codectypes.Any) error {
+	for _, any := range anys {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateAddresses(addrs []sdk.AccAddress) error {
+	index := make(map[string]struct{}, len(addrs))
+	for _, addr := range addrs {
+		if addr.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty address")
+		}
+
+		s := addr.String()
+		if _, exists := index[s]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "address: %s", s)
+		}
+		index[s] = struct{}{}
+	}
+
+	return nil
+}
@@ -4,11 +4,16 @@
 package types
 
 import (
+	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -374,56 +379,178 @@ func (m *Params) GetReceiveEnabled() bool {
 	return false
 }
 
+// MsgTransferResponse defines the Msg/Transfer response type.
+type MsgTransferResponse struct {
+}
+
+func (m *MsgTransferResponse) Reset()         { *m = MsgTransferResponse{} }
+func (m *MsgTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferResponse) ProtoMessage()    {}
+func (*MsgTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08134a70fd29e656, []int{5}
+}
+func (m *MsgTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferResponse.Merge(m, src)
+}
+func (m *MsgTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgTransfer)(nil), "ibc.transfer.MsgTransfer")
 	proto.RegisterType((*FungibleTokenPacketData)(nil), "ibc.transfer.FungibleTokenPacketData")
 	proto.RegisterType((*FungibleTokenPacketAcknowledgement)(nil), "ibc.transfer.FungibleTokenPacketAcknowledgement")
 	proto.RegisterType((*DenomTrace)(nil), "ibc.transfer.DenomTrace")
 	proto.RegisterType((*Params)(nil), "ibc.transfer.Params")
+	proto.RegisterType((*MsgTransferResponse)(nil), "ibc.transfer.MsgTransferResponse")
 }
 
 func init() { proto.RegisterFile("ibc/transfer/transfer.proto", fileDescriptor_08134a70fd29e656) }
 
 var fileDescriptor_08134a70fd29e656 = []byte{
-	// 601 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x6e, 0xb6, 0xae, 0xdb, 0xdc, 0x6d, 0x80, 0x19, 0x5b, 0x56, 0x20, 0xa9, 0x72, 0xea, 0x65,
-	0x89, 0x0a, 0x42, 0x48, 0xbb, 0xc0, 0xba, 0x81, 0x98, 0x10, 0xd2, 0x14, 0xf5, 0xc4, 0xa5, 0x72,
-	0x9c, 0x47, 0x1a, 0xb5, 0xb1, 0x2b, 0xdb, 0x1d, 0x4c, 0xfc, 0x02, 0x6e, 0xfc, 0x17, 0xfe, 0xc4,
-	0x8e, 0x3b, 0x72, 0x8a, 0xd0, 0xf6, 0x0f, 0x7a, 0xe4, 0x84, 0x1c, 0x7b, 0x65, 0x45, 0x13, 0xa7,
-	0xf8, 0xfb, 0xde, 0xfb, 0x5e, 0xbe, 0xf7, 0xfc, 0x8c, 0x1e, 0xe7, 0x09, 0x8d, 0x94, 0x20, 0x4c,
-	0x7e, 0x02, 0x31, 0x3f, 0x84, 0x13, 0xc1, 0x15, 0xc7, 0x1b, 0x79, 0x42, 0xc3, 0x1b, 0xae, 0xb5,
-	0x9d, 0xf1, 0x8c, 0x57, 0x81, 0x48, 0x9f, 0x4c, 0x4e, 0xcb, 0xa3, 0x5c, 0x16, 0x5c, 0x46, 0x09,
-	0x91, 0x10, 0x9d, 0x75, 0x13, 0x50, 0xa4, 0x1b, 0x51, 0x9e, 0x33, 0x13, 0x0f, 0x7e, 0x2c, 0xa3,
-	0xe6, 0x07, 0x99, 0xf5, 0x6d, 0x15, 0xfc, 0x12, 0x35, 0x25, 0x9f, 0x0a, 0x0a, 0x83, 0x09, 0x17,
-	0xca, 0x75, 0xda, 0x4e, 0x67, 0xbd, 0xb7, 0x33, 0x2b, 0x7d, 0x7c, 0x4e, 0x8a, 0xf1, 0x41, 0x70,
-	0x2b, 0x18, 0xc4, 0xc8, 0xa0, 0x53, 0x2e, 0x14, 0x7e, 0x8d, 0xb6, 0x6c, 0x8c, 0x0e, 0x09, 0x63,
-	0x30, 0x76, 0x97, 0x2a, 0xed, 0xde, 0xac, 0xf4, 0x1f, 0x2d, 0x68, 0x6d, 0x3c, 0x88, 0x37, 0x0d,
-	0x71, 0x64, 0x30, 0x7e, 0x81, 0x56, 0x14, 0x1f, 0x01, 0x73, 0x97, 0xdb, 0x4e, 0xa7, 0xf9, 0x6c,
-	0x2f, 0x34, 0xd6, 0x43, 0x6d, 0x3d, 0xb4, 0xd6, 0xc3, 0x23, 0x9e, 0xb3, 0x5e, 0xfd, 0xa2, 0xf4,
-	0x6b, 0xb1, 0xc9, 0xc6, 0x27, 0xa8, 0x21, 0x81, 0xa5, 0x20, 0xdc, 0x7a, 0xdb, 0xe9, 0x6c, 0xf4,
-	0xba, 0xbf, 0x4b, 0x7f, 0x3f, 0xcb, 0xd5, 0x70, 0x9a, 0x84, 0x94, 0x17, 0x91, 0x1d, 0x80, 0xf9,
-	0xec, 0xcb, 0x74, 0x14, 0xa9, 0xf3, 0x09, 0xc8, 0xf0, 0x90, 0xd2, 0xc3, 0x34, 0x15, 0x20, 0x65,
-	0x6c, 0x0b, 0xe0, 0x16, 0x5a, 0x13, 0x40, 0x21, 0x3f, 0x03, 0xe1, 0xae, 0x68, 0xf7, 0xf1, 0x1c,
-	0xeb, 0xfe, 0x54, 0x5e, 0x00, 0x9f, 0xaa, 0xc1, 0x10, 0xf2, 0x6c, 0xa8, 0xdc, 0x46, 0xdb, 0xe9,
-	0xd4, 0x6f, 0xf7, 0xb7, 0x18, 0x0f, 0xe2, 0x4d, 0x4b, 0xbc, 0xab, 0x30, 0x3e, 0x41, 0x0f, 0x6e,
-	0x32, 0xf4, 0x57, 0x2a, 0x52, 0x4c, 0xdc, 0xd5, 0xaa, 0xc8, 0x93, 0x59, 0xe9, 0xbb, 0x8b, 0x45,
-	0xe6, 0x29, 0x41, 0x7c, 0xdf, 0x72, 0xfd, 0x39, 0xf5, 0x15, 0xed, 0xbe, 0x9d, 0xb2, 0x2c, 0x4f,
-	0xc6, 0xd0, 0xd7, 0x43, 0x38, 0x25, 0x74, 0x04, 0xea, 0x98, 0x28, 0x82, 0xb7, 0xd1, 0x4a, 0x0a,
-	0x8c, 0x17, 0xe6, 0xea, 0x62, 0x03, 0xf0, 0x0e, 0x6a, 0x90, 0x82, 0x4f, 0x99, 0xaa, 0x6e, 0xa5,
-	0x1e, 0x5b, 0xa4, 0x79, 0x3b, 0xbc, 0xe5, 0x2a, 0xfd, 0xae, 0x49, 0xd4, 0x17, 0x27, 0x11, 0xf4,
-	0x51, 0x70, 0xc7, 0xcf, 0x0f, 0xe9, 0x88, 0xf1, 0xcf, 0x63, 0x48, 0x33, 0x28, 0x80, 0x29, 0xec,
-	0xa2, 0x55, 0x39, 0xa5, 0x14, 0xa4, 0xac, 0x9c, 0xac, 0xc5, 0x37, 0x50, 0x3b, 0x04, 0x21, 0xb8,
-	0x30, 0x0b, 0x12, 0x1b, 0x10, 0xbc, 0x42, 0xe8, 0x58, 0x5b, 0xed, 0x0b, 0x42, 0x01, 0x63, 0x54,
-	0x9f, 0x10, 0x35, 0xb4, 0x4d, 0x54, 0x67, 0xfc, 0x14, 0x21, 0xbd, 0x0a, 0x03, 0xd3, 0x9e, 0x11,
-	0xaf, 0x6b, 0xa6, 0xd2, 0x05, 0xdf, 0x1c, 0xd4, 0x38, 0x25, 0x82, 0x14, 0x12, 0x1f, 0xa0, 0x0d,
-	0xdd, 0xc7, 0x00, 0x18, 0x49, 0xc6, 0x90, 0x1a, 0x03, 0xbd, 0xdd, 0x59, 0xe9, 0x3f, 0xb4, 0x9b,
-	0x78, 0x2b, 0x1a, 0xc4, 0x4d, 0x0d, 0xdf, 0x18, 0x84, 0x8f, 0xd0, 0x3d, 0xdb, 0xe9, 0x5c, 0xbe,
-	0x54, 0xc9, 0x5b, 0xb3, 0xd2, 0xdf, 0x31, 0xf2, 0x7f, 0x12, 0x82, 0x78, 0xcb, 0x32, 0xb6, 0x48,
-	0xef, 0xfd, 0xc5, 0x95, 0xe7, 0x5c, 0x5e, 0x79, 0xce, 0xaf, 0x2b, 0xcf, 0xf9, 0x7e, 0xed, 0xd5,
-	0x2e, 0xaf, 0xbd, 0xda, 0xcf, 0x6b, 0xaf, 0xf6, 0xb1, 0xfb, 0xdf, 0xcd, 0xfc, 0x12, 0xe5, 0x09,
-	0xdd, 0xff, 0xfb, 0xde, 0xf5, 0xa2, 0x26, 0x8d, 0xea, 0xa5, 0x3e, 0xff, 0x13, 0x00, 0x00, 0xff,
-	0xff, 0x0a, 0x5a, 0x1c, 0xec, 0x0c, 0x04, 0x00, 0x00,
+	// 639 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0x8e, 0x9b, 0x34, 0x6d, 0x27, 0x6d, 0xef, 0xbd, 0xd3, 0x3f, 0x37, 0x17, 0x92, 0xe0, 0x55,
+	0x36, 0xb5, 0x95, 0x22, 0x84, 0xd4, 0x0d, 0x34, 0x2d, 0xa8, 0x15, 0xaa, 0xa8, 0xac, 0xac, 0xd8,
+	0x44, 0xe3, 0xc9, 0xc1, 0xb1, 0x12, 0xcf, 0x44, 0x33, 0x93, 0x42, 0xc5, 0x13, 0xb0, 0xe3, 0x5d,
+	0x78, 0x89, 0x2e, 0xbb, 0x64, 0x65, 0xa1, 0xf6, 0x0d, 0xb2, 0x64, 0x85, 0xc6, 0x33, 0x09, 0x09,
+	0x2a, 0xac, 0x3c, 0xdf, 0xf9, 0xce, 0x77, 0xfc, 0x9d, 0xe3, 0x39, 0x46, 0xff, 0x27, 0x11, 0x0d,
+	0x94, 0x20, 0x4c, 0xbe, 0x07, 0x31, 0x3b, 0xf8, 0x23, 0xc1, 0x15, 0xc7, 0xeb, 0x49, 0x44, 0xfd,
+	0x69, 0xac, 0xba, 0x1d, 0xf3, 0x98, 0xe7, 0x44, 0xa0, 0x4f, 0x26, 0xa7, 0x5a, 0xa3, 0x5c, 0xa6,
+	0x5c, 0x06, 0x11, 0x91, 0x10, 0x5c, 0xb5, 0x22, 0x50, 0xa4, 0x15, 0x50, 0x9e, 0x30, 0xc3, 0x7b,
+	0x5f, 0x8b, 0xa8, 0x72, 0x21, 0xe3, 0x8e, 0xad, 0x82, 0x9f, 0xa3, 0x8a, 0xe4, 0x63, 0x41, 0xa1,
+	0x3b, 0xe2, 0x42, 0xb9, 0x4e, 0xc3, 0x69, 0xae, 0xb5, 0x77, 0x27, 0x59, 0x1d, 0x5f, 0x93, 0x74,
+	0x78, 0xe4, 0xcd, 0x91, 0x5e, 0x88, 0x0c, 0xba, 0xe4, 0x42, 0xe1, 0x97, 0x68, 0xd3, 0x72, 0xb4,
+	0x4f, 0x18, 0x83, 0xa1, 0xbb, 0x94, 0x6b, 0xf7, 0x27, 0x59, 0x7d, 0x67, 0x41, 0x6b, 0x79, 0x2f,
+	0xdc, 0x30, 0x81, 0x13, 0x83, 0xf1, 0x33, 0xb4, 0xac, 0xf8, 0x00, 0x98, 0x5b, 0x6c, 0x38, 0xcd,
+	0xca, 0xe1, 0xbe, 0x6f, 0xac, 0xfb, 0xda, 0xba, 0x6f, 0xad, 0xfb, 0x27, 0x3c, 0x61, 0xed, 0xd2,
+	0x4d, 0x56, 0x2f, 0x84, 0x26, 0x1b, 0x9f, 0xa3, 0xb2, 0x04, 0xd6, 0x03, 0xe1, 0x96, 0x1a, 0x4e,
+	0x73, 0xbd, 0xdd, 0xfa, 0x91, 0xd5, 0x0f, 0xe2, 0x44, 0xf5, 0xc7, 0x91, 0x4f, 0x79, 0x1a, 0xd8,
+	0x01, 0x98, 0xc7, 0x81, 0xec, 0x0d, 0x02, 0x75, 0x3d, 0x02, 0xe9, 0x1f, 0x53, 0x7a, 0xdc, 0xeb,
+	0x09, 0x90, 0x32, 0xb4, 0x05, 0x70, 0x15, 0xad, 0x0a, 0xa0, 0x90, 0x5c, 0x81, 0x70, 0x97, 0xb5,
+	0xfb, 0x70, 0x86, 0x75, 0x7f, 0x2a, 0x49, 0x81, 0x8f, 0x55, 0xb7, 0x0f, 0x49, 0xdc, 0x57, 0x6e,
+	0xb9, 0xe1, 0x34, 0x4b, 0xf3, 0xfd, 0x2d, 0xf2, 0x5e, 0xb8, 0x61, 0x03, 0x67, 0x39, 0xc6, 0xe7,
+	0xe8, 0xbf, 0x69, 0x86, 0x7e, 0x4a, 0x45, 0xd2, 0x91, 0xbb, 0x92, 0x17, 0x79, 0x34, 0xc9, 0xea,
+	0xee, 0x62, 0x91, 0x59, 0x8a, 0x17, 0xfe, 0x6b, 0x63, 0x9d, 0x59, 0xe8, 0x13, 0xda, 0x7b, 0x3d,
+	0x66, 0x71, 0x12, 0x0d, 0xa1, 0xa3, 0x87, 0x70, 0x49, 0xe8, 0x00, 0xd4, 0x29, 0x51, 0x04, 0x6f,
+	0xa3, 0xe5, 0x1e, 0x30, 0x9e, 0x9a, 0x4f, 0x17, 0x1a, 0x80, 0x77, 0x51, 0x99, 0xa4, 0x7c, 0xcc,
+	0x54, 0xfe, 0x55, 0x4a, 0xa1, 0x45, 0x3a, 0x6e, 0x87, 0x57, 0xcc, 0xd3, 0x1f, 0x9a, 0x44, 0x69,
+	0x71, 0x12, 0x5e, 0x07, 0x79, 0x0f, 0xbc, 0xfc, 0x98, 0x0e, 0x18, 0xff, 0x30, 0x84, 0x5e, 0x0c,
+	0x29, 0x30, 0x85, 0x5d, 0xb4, 0x22, 0xc7, 0x94, 0x82, 0x94, 0xb9, 0x93, 0xd5, 0x70, 0x0a, 0xb5,
+	0x43, 0x10, 0x82, 0x0b, 0x73, 0x41, 0x42, 0x03, 0xbc, 0x17, 0x08, 0x9d, 0x6a, 0xab, 0x1d, 0x41,
+	0x28, 0x60, 0x8c, 0x4a, 0x23, 0xa2, 0xfa, 0xb6, 0x89, 0xfc, 0x8c, 0x1f, 0x23, 0xa4, 0xaf, 0x42,
+	0xd7, 0xb4, 0x67, 0xc4, 0x6b, 0x3a, 0x92, 0xeb, 0xbc, 0xcf, 0x0e, 0x2a, 0x5f, 0x12, 0x41, 0x52,
+	0x89, 0x8f, 0xd0, 0xba, 0xee, 0xa3, 0x0b, 0x8c, 0x44, 0x43, 0xe8, 0x19, 0x03, 0xed, 0xbd, 0x49,
+	0x56, 0xdf, 0xb2, 0x37, 0x71, 0x8e, 0xf5, 0xc2, 0x8a, 0x86, 0xaf, 0x0c, 0xc2, 0x27, 0xe8, 0x1f,
+	0xdb, 0xe9, 0x4c, 0xbe, 0x94, 0xcb, 0xab, 0x93, 0xac, 0xbe, 0x6b, 0xe4, 0xbf, 0x25, 0x78, 0xe1,
+	0xa6, 0x8d, 0xd8, 0x22, 0xde, 0x0e, 0xda, 0x9a, 0x5b, 0xaa, 0x10, 0xe4, 0x88, 0x33, 0x09, 0x87,
+	0x6f, 0x51, 0xf1, 0x42, 0xc6, 0xf8, 0x0c, 0xad, 0xce, 0xf6, 0x6d, 0xdf, 0x9f, 0x5f, 0x62, 0x7f,
+	0x4e, 0x55, 0x7d, 0xf2, 0x47, 0x6a, 0x5a, 0xb0, 0xfd, 0xe6, 0xe6, 0xae, 0xe6, 0xdc, 0xde, 0xd5,
+	0x9c, 0xef, 0x77, 0x35, 0xe7, 0xcb, 0x7d, 0xad, 0x70, 0x7b, 0x5f, 0x2b, 0x7c, 0xbb, 0xaf, 0x15,
+	0xde, 0xb5, 0xfe, 0xba, 0x01, 0x1f, 0x83, 0x24, 0xa2, 0x07, 0xbf, 0xfe, 0x2b, 0x7a, 0x21, 0xa2,
+	0x72, 0xfe, 0x47, 0x78, 0xfa, 0x73, 0x00, 0x3d, 0x43, 0x5c, 0x9c, 0x74, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Transfer defines a rpc handler method for MsgTransfer.
+	Transfer(ctx context.Context, in *MsgTransfer, opts ...grpc.CallOption) (*MsgTransferResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Transfer(ctx context.Context, in *MsgTransfer, opts ...grpc.CallOption) (*MsgTransferResponse, error) {
+	out := new(MsgTransferResponse)
+	err := c.cc.Invoke(ctx, "/ibc.transfer.Msg/Transfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Transfer defines a rpc handler method for MsgTransfer.
+	Transfer(context.Context, *MsgTransfer) (*MsgTransferResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Transfer(ctx context.Context, req *MsgTransfer) (*MsgTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.transfer.Msg/Transfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Transfer(ctx, req.(*MsgTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.transfer.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Transfer",
+			Handler:    _Msg_Transfer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/transfer/transfer.proto",
 }
 
 func (m *MsgTransfer) Marshal() (dAtA []byte, err error) {
@@ -666,6 +793,29 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTransfer(v)
 	base := offset
@@ -782,6 +932,15 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *MsgTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTransfer(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1525,6 +1684,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTransfer(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
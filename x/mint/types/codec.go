@@ -2,14 +2,38 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// RegisterCodec registers the necessary x/mint interfaces and concrete types
+// on the provided Amino codec. These types are used for Amino JSON serialization.
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "cosmos-sdk/x/mint/MsgUpdateParams", nil)
+}
+
+// RegisterInterfaces registers the x/mint interfaces types with the interface registry
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+	)
+}
+
 var (
 	amino = codec.New()
+
+	// ModuleCdc references the global x/mint module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding as Amino is
+	// still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/mint and
+	// defined at the application level.
+	ModuleCdc = codec.NewAminoCodec(amino)
 )
 
 func init() {
+	RegisterCodec(amino)
 	cryptocodec.RegisterCrypto(amino)
 	amino.Seal()
 }
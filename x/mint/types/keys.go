@@ -1,7 +1,12 @@
 package types
 
-// MinterKey is the key to use for the keeper store.
-var MinterKey = []byte{0x00}
+var (
+	// MinterKey is the key to use for the keeper store.
+	MinterKey = []byte{0x00}
+
+	// ParamsKey is the key to use for the mint parameters in the keeper store.
+	ParamsKey = []byte{0x01}
+)
 
 const (
 	// module name
@@ -10,6 +15,9 @@ const (
 	// StoreKey is the default store key for mint
 	StoreKey = ModuleName
 
+	// RouterKey is the message route for mint
+	RouterKey = ModuleName
+
 	// QuerierRoute is the querier route for the minting store.
 	QuerierRoute = StoreKey
 
@@ -18,6 +18,7 @@ type Keeper struct {
 	key         sdk.StoreKey
 	tkey        sdk.StoreKey
 	spaces      map[string]*types.Subspace
+	legacy      map[string]bool
 }
 
 // NewKeeper constructs a params keeper
@@ -28,6 +29,7 @@ func NewKeeper(cdc codec.BinaryMarshaler, legacyAmino *codec.LegacyAmino, key, t
 		key:         key,
 		tkey:        tkey,
 		spaces:      make(map[string]*types.Subspace),
+		legacy:      make(map[string]bool),
 	}
 }
 
@@ -53,6 +55,21 @@ func (k Keeper) Subspace(s string) types.Subspace {
 	return space
 }
 
+// Allocate subspace for a module which stores its parameters itself and only
+// reads the subspace to migrate them. Parameter change proposals are rejected
+// for such subspaces as they would not affect the module.
+func (k Keeper) LegacySubspace(s string) types.Subspace {
+	space := k.Subspace(s)
+	k.legacy[s] = true
+
+	return space
+}
+
+// IsLegacySubspace returns true if the subspace was allocated with LegacySubspace
+func (k Keeper) IsLegacySubspace(s string) bool {
+	return k.legacy[s]
+}
+
 // Get existing substore from keeper
 func (k Keeper) GetSubspace(s string) (types.Subspace, bool) {
 	space, ok := k.spaces[s]
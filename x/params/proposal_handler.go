@@ -29,6 +29,9 @@ func handleParameterChangeProposal(ctx sdk.Context, k keeper.Keeper, p *proposal
 		if !ok {
 			return sdkerrors.Wrap(proposal.ErrUnknownSubspace, c.Subspace)
 		}
+		if k.IsLegacySubspace(c.Subspace) {
+			return sdkerrors.Wrapf(proposal.ErrLegacySubspace, "%s: use the module's MsgUpdateParams instead", c.Subspace)
+		}
 
 		k.Logger(ctx).Info(
 			fmt.Sprintf("attempt to set new parameter value; key: %s, value: %s", c.Key, c.Value),
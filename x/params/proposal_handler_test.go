@@ -1,6 +1,7 @@
 package params_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
@@ -111,6 +112,19 @@ func TestProposalHandlerFailed(t *testing.T) {
 	require.False(t, ss.Has(input.ctx, []byte(keyMaxValidators)))
 }
 
+func TestProposalHandlerLegacySubspace(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.LegacySubspace(testSubspace).WithKeyTable(
+		types.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+
+	tp := testProposal(proposal.NewParamChange(testSubspace, keyMaxValidators, "1"))
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+	require.True(t, errors.Is(hdlr(input.ctx, tp), proposal.ErrLegacySubspace))
+
+	require.False(t, ss.Has(input.ctx, []byte(keyMaxValidators)))
+}
+
 func TestProposalHandlerUpdateOmitempty(t *testing.T) {
 	input := newTestInput(t)
 	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
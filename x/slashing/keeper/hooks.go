@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/binary"
 	"time"
 
 	"github.com/tendermint/tendermint/crypto"
@@ -36,6 +37,44 @@ func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, address sdk.ConsAddress)
 	k.deleteAddrPubkeyRelation(ctx, crypto.Address(address))
 }
 
+// When a validator rotates its consensus key, move its signing info and
+// missed blocks to the new consensus address and add the address-pubkey
+// relation of the new key. The relation of the old key is kept so that
+// signatures and evidence produced with it can still be handled.
+func (k Keeper) AfterConsensusPubKeyUpdate(ctx sdk.Context, oldPubKey, newPubKey crypto.PubKey) {
+	k.AddPubkey(ctx, newPubKey)
+
+	oldConsAddr := sdk.GetConsAddress(oldPubKey)
+	newConsAddr := sdk.GetConsAddress(newPubKey)
+
+	// the store is accessed directly as the signing info accessors already
+	// resolve the old consensus address to the new one
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.ValidatorSigningInfoKey(oldConsAddr))
+	if bz == nil {
+		return
+	}
+
+	var signingInfo types.ValidatorSigningInfo
+	k.cdc.MustUnmarshalBinaryBare(bz, &signingInfo)
+
+	signingInfo.Address = newConsAddr
+	store.Set(types.ValidatorSigningInfoKey(newConsAddr), k.cdc.MustMarshalBinaryBare(&signingInfo))
+	store.Delete(types.ValidatorSigningInfoKey(oldConsAddr))
+
+	oldPrefix := types.ValidatorMissedBlockBitArrayPrefixKey(oldConsAddr)
+
+	iter := sdk.KVStorePrefixIterator(store, oldPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		index := int64(binary.LittleEndian.Uint64(iter.Key()[len(oldPrefix):]))
+		store.Set(types.ValidatorMissedBlockBitArrayKey(newConsAddr, index), iter.Value())
+		store.Delete(iter.Key())
+	}
+}
+
 //_________________________________________________________________________________________
 
 // Hooks wrapper struct for slashing keeper
@@ -72,3 +111,8 @@ func (h Hooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _
 func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
 func (h Hooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
 func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec)                {}
+
+// Implements sdk.ValidatorHooks
+func (h Hooks) AfterConsensusPubKeyUpdate(ctx sdk.Context, oldPubKey, newPubKey crypto.PubKey) {
+	h.k.AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey)
+}
@@ -19,10 +19,18 @@ type Keeper struct {
 	cdc        codec.BinaryMarshaler
 	sk         types.StakingKeeper
 	paramspace types.ParamSubspace
+
+	// the address capable of executing a MsgUpdateParams message, typically
+	// the x/gov module account
+	authority sdk.AccAddress
 }
 
-// NewKeeper creates a slashing keeper
-func NewKeeper(cdc codec.BinaryMarshaler, key sdk.StoreKey, sk types.StakingKeeper, paramspace types.ParamSubspace) Keeper {
+// NewKeeper creates a slashing keeper. The param subspace is only used by the
+// store migration moving the slashing parameters out of it.
+func NewKeeper(
+	cdc codec.BinaryMarshaler, key sdk.StoreKey, sk types.StakingKeeper, paramspace types.ParamSubspace,
+	authority sdk.AccAddress,
+) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramspace.HasKeyTable() {
 		paramspace = paramspace.WithKeyTable(types.ParamKeyTable())
@@ -33,9 +41,15 @@ func NewKeeper(cdc codec.BinaryMarshaler, key sdk.StoreKey, sk types.StakingKeep
 		cdc:        cdc,
 		sk:         sk,
 		paramspace: paramspace,
+		authority:  authority,
 	}
 }
 
+// GetAuthority returns the x/slashing module's authority.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
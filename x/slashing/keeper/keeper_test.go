@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -96,6 +97,24 @@ func TestUnJailNotBonded(t *testing.T) {
 // Test a new validator entering the validator set
 // Ensure that SigningInfo.StartHeight is set correctly
 // and that they are not immediately jailed
+func TestMsgUpdateParams(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.SlashingKeeper)
+
+	params := app.SlashingKeeper.GetParams(ctx)
+	params.SignedBlocksWindow = 500
+
+	// only the authority may update the params
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(sdk.AccAddress("other"), params))
+	require.Error(t, err)
+	require.NotEqual(t, int64(500), app.SlashingKeeper.SignedBlocksWindow(ctx))
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(app.SlashingKeeper.GetAuthority(), params))
+	require.NoError(t, err)
+	require.Equal(t, int64(500), app.SlashingKeeper.SignedBlocksWindow(ctx))
+}
+
 func TestHandleNewValidator(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -318,3 +337,67 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	require.Equal(t, sdk.Unbonding, validator.Status)
 
 }
+
+// Test that the signing info and missed blocks of a validator follow its
+// consensus key rotation, and remain reachable from the old key.
+func TestConsPubKeyRotation(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.SlashingKeeper.SetParams(ctx, keeper.TestParams())
+
+	power := int64(100)
+	pks := simapp.CreateTestPubKeys(2)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks[:1], sdk.TokensFromConsensusPower(200))
+
+	valAddr, oldPk, newPk := sdk.ValAddress(pks[0].Address()), pks[0], pks[1]
+	oldConsAddr, newConsAddr := sdk.GetConsAddress(oldPk), sdk.GetConsAddress(newPk)
+
+	sh := staking.NewHandler(app.StakingKeeper)
+	res, err := sh(ctx, keeper.NewTestMsgCreateValidator(valAddr, oldPk, sdk.TokensFromConsensusPower(power)))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	height := int64(0)
+	for ; height < int64(10); height++ {
+		ctx = ctx.WithBlockHeight(height)
+		app.SlashingKeeper.HandleValidatorSignature(ctx, oldPk.Address(), power, height%2 == 0)
+	}
+
+	res, err = sh(ctx, stakingtypes.NewMsgRotateConsPubKey(valAddr, newPk))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	_, err = app.SlashingKeeper.GetPubkey(ctx, newPk.Address())
+	require.NoError(t, err)
+
+	// the signing info is stored under the new consensus address and can be
+	// retrieved from both addresses
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	require.True(t, found)
+	require.Equal(t, newConsAddr, info.Address)
+	require.Equal(t, int64(10), info.IndexOffset)
+	require.Equal(t, int64(5), info.MissedBlocksCounter)
+
+	oldInfo, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, oldConsAddr)
+	require.True(t, found)
+	require.Equal(t, info, oldInfo)
+	require.Equal(t, app.SlashingKeeper.GetValidatorMissedBlocks(ctx, oldConsAddr), app.SlashingKeeper.GetValidatorMissedBlocks(ctx, newConsAddr))
+	require.True(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, newConsAddr, 1))
+
+	// signatures of the old and new keys are accounted on the same signing info
+	ctx = ctx.WithBlockHeight(height)
+	app.SlashingKeeper.HandleValidatorSignature(ctx, oldPk.Address(), power, false)
+	ctx = ctx.WithBlockHeight(height + 1)
+	app.SlashingKeeper.HandleValidatorSignature(ctx, newPk.Address(), power, false)
+
+	info, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	require.True(t, found)
+	require.Equal(t, int64(12), info.IndexOffset)
+	require.Equal(t, int64(7), info.MissedBlocksCounter)
+
+	// evidence of the old key still tombstones the validator
+	app.SlashingKeeper.Tombstone(ctx, oldConsAddr)
+	require.True(t, app.SlashingKeeper.IsTombstoned(ctx, newConsAddr))
+}
@@ -8,47 +8,46 @@ import (
 )
 
 // SignedBlocksWindow - sliding window for downtime slashing
-func (k Keeper) SignedBlocksWindow(ctx sdk.Context) (res int64) {
-	k.paramspace.Get(ctx, types.KeySignedBlocksWindow, &res)
-	return
+func (k Keeper) SignedBlocksWindow(ctx sdk.Context) int64 {
+	return k.GetParams(ctx).SignedBlocksWindow
 }
 
 // MinSignedPerWindow - minimum blocks signed per window
 func (k Keeper) MinSignedPerWindow(ctx sdk.Context) int64 {
-	var minSignedPerWindow sdk.Dec
-	k.paramspace.Get(ctx, types.KeyMinSignedPerWindow, &minSignedPerWindow)
-	signedBlocksWindow := k.SignedBlocksWindow(ctx)
+	params := k.GetParams(ctx)
 
 	// NOTE: RoundInt64 will never panic as minSignedPerWindow is
 	//       less than 1.
-	return minSignedPerWindow.MulInt64(signedBlocksWindow).RoundInt64()
+	return params.MinSignedPerWindow.MulInt64(params.SignedBlocksWindow).RoundInt64()
 }
 
 // DowntimeJailDuration - Downtime unbond duration
-func (k Keeper) DowntimeJailDuration(ctx sdk.Context) (res time.Duration) {
-	k.paramspace.Get(ctx, types.KeyDowntimeJailDuration, &res)
-	return
+func (k Keeper) DowntimeJailDuration(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).DowntimeJailDuration
 }
 
 // SlashFractionDoubleSign - fraction of power slashed in case of double sign
-func (k Keeper) SlashFractionDoubleSign(ctx sdk.Context) (res sdk.Dec) {
-	k.paramspace.Get(ctx, types.KeySlashFractionDoubleSign, &res)
-	return
+func (k Keeper) SlashFractionDoubleSign(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).SlashFractionDoubleSign
 }
 
 // SlashFractionDowntime - fraction of power slashed for downtime
-func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
-	k.paramspace.Get(ctx, types.KeySlashFractionDowntime, &res)
-	return
+func (k Keeper) SlashFractionDowntime(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).SlashFractionDowntime
 }
 
 // GetParams returns the total set of slashing parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramspace.GetParamSet(ctx, &params)
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		panic("stored slashing params should not have been nil")
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &params)
 	return params
 }
 
-// SetParams sets the slashing parameters to the param space.
+// SetParams sets the slashing parameters to the store.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramspace.SetParamSet(ctx, &params)
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, k.cdc.MustMarshalBinaryBare(&params))
 }
@@ -13,7 +13,7 @@ import (
 // ConsAddress
 func (k Keeper) GetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress) (info types.ValidatorSigningInfo, found bool) {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.ValidatorSigningInfoKey(address))
+	bz := store.Get(types.ValidatorSigningInfoKey(k.signingInfoAddress(ctx, address)))
 	if bz == nil {
 		found = false
 		return
@@ -34,7 +34,7 @@ func (k Keeper) HasValidatorSigningInfo(ctx sdk.Context, consAddr sdk.ConsAddres
 func (k Keeper) SetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress, info types.ValidatorSigningInfo) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshalBinaryBare(&info)
-	store.Set(types.ValidatorSigningInfoKey(address), bz)
+	store.Set(types.ValidatorSigningInfoKey(k.signingInfoAddress(ctx, address)), bz)
 }
 
 // IterateValidatorSigningInfos iterates over the stored ValidatorSigningInfo
@@ -57,7 +57,7 @@ func (k Keeper) IterateValidatorSigningInfos(ctx sdk.Context,
 // GetValidatorMissedBlockBitArray gets the bit for the missed blocks array
 func (k Keeper) GetValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress, index int64) bool {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.ValidatorMissedBlockBitArrayKey(address, index))
+	bz := store.Get(types.ValidatorMissedBlockBitArrayKey(k.signingInfoAddress(ctx, address), index))
 	var missed gogotypes.BoolValue
 	if bz == nil {
 		// lazy: treat empty key as not missed
@@ -74,6 +74,7 @@ func (k Keeper) IterateValidatorMissedBlockBitArray(ctx sdk.Context,
 	address sdk.ConsAddress, handler func(index int64, missed bool) (stop bool)) {
 
 	store := ctx.KVStore(k.storeKey)
+	address = k.signingInfoAddress(ctx, address)
 	index := int64(0)
 	// Array may be sparse
 	for ; index < k.SignedBlocksWindow(ctx); index++ {
@@ -144,15 +145,27 @@ func (k Keeper) IsTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
 func (k Keeper) SetValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress, index int64, missed bool) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshalBinaryBare(&gogotypes.BoolValue{Value: missed})
-	store.Set(types.ValidatorMissedBlockBitArrayKey(address, index), bz)
+	store.Set(types.ValidatorMissedBlockBitArrayKey(k.signingInfoAddress(ctx, address), index), bz)
 }
 
 // clearValidatorMissedBlockBitArray deletes every instance of ValidatorMissedBlockBitArray in the store
 func (k Keeper) clearValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress) {
 	store := ctx.KVStore(k.storeKey)
-	iter := sdk.KVStorePrefixIterator(store, types.ValidatorMissedBlockBitArrayPrefixKey(address))
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorMissedBlockBitArrayPrefixKey(k.signingInfoAddress(ctx, address)))
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
 		store.Delete(iter.Key())
 	}
 }
+
+// signingInfoAddress returns the consensus address under which the signing
+// info and missed blocks of a validator are stored. Those of a validator that
+// rotated its consensus key during the last unbonding period are stored under
+// its new consensus address.
+func (k Keeper) signingInfoAddress(ctx sdk.Context, address sdk.ConsAddress) sdk.ConsAddress {
+	if newAddress, found := k.sk.GetRotatedConsAddr(ctx, address); found {
+		return newAddress
+	}
+
+	return address
+}
@@ -3,6 +3,8 @@
 package types
 
 import (
+	"github.com/tendermint/tendermint/crypto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -42,6 +44,10 @@ type StakingKeeper interface {
 	Validator(sdk.Context, sdk.ValAddress) stakingexported.ValidatorI            // get a particular validator by operator address
 	ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingexported.ValidatorI // get a particular validator by consensus address
 
+	// get the current consensus address of a validator from a consensus address
+	// it rotated away from during the last unbonding period
+	GetRotatedConsAddr(sdk.Context, sdk.ConsAddress) (sdk.ConsAddress, bool)
+
 	// slash the validator and delegators of the validator, specifying offence height, offence power, and slash fraction
 	Slash(sdk.Context, sdk.ConsAddress, int64, int64, sdk.Dec)
 	Jail(sdk.Context, sdk.ConsAddress)   // jail a validator
@@ -61,4 +67,6 @@ type StakingHooks interface {
 	AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) // Must be called when a validator is deleted
 
 	AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) // Must be called when a validator is bonded
+
+	AfterConsensusPubKeyUpdate(ctx sdk.Context, oldPubKey, newPubKey crypto.PubKey) // Must be called when a validator's consensus key is rotated
 }
@@ -29,6 +29,7 @@ const (
 //
 // - 0x03<accAddr_Bytes>: crypto.PubKey
 var (
+	ParamsKey                             = []byte{0x00} // Key for the slashing parameters
 	ValidatorSigningInfoKeyPrefix         = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitArrayKeyPrefix = []byte{0x02} // Prefix for missed block bit array
 	AddrPubkeyRelationKeyPrefix           = []byte{0x03} // Prefix for address-pubkey relation
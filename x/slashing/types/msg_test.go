@@ -18,3 +18,13 @@ func TestMsgUnjailGetSignBytes(t *testing.T) {
 		string(bytes),
 	)
 }
+
+func TestMsgUpdateParamsValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority")
+	require.NoError(t, NewMsgUpdateParams(authority, DefaultParams()).ValidateBasic())
+	require.Error(t, NewMsgUpdateParams(sdk.AccAddress{}, DefaultParams()).ValidateBasic())
+
+	params := DefaultParams()
+	params.SignedBlocksWindow = 0
+	require.Error(t, NewMsgUpdateParams(authority, params).ValidateBasic())
+}
@@ -74,6 +74,24 @@ func InitGenesis(
 		}
 	}
 
+	for _, record := range data.TokenizeShareRecords {
+		keeper.SetTokenizeShareRecord(ctx, record)
+
+		// the liquid shares of each validator are the shares delegated by
+		// the tokenize share record accounts
+		if delegation, found := keeper.GetDelegation(ctx, record.GetModuleAddress(), record.Validator); found {
+			liquidShares := keeper.GetValidatorLiquidShares(ctx, record.Validator)
+			keeper.SetValidatorLiquidShares(ctx, record.Validator, liquidShares.Add(delegation.Shares))
+		}
+	}
+
+	keeper.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId)
+
+	for _, rotation := range data.ConsPubKeyRotations {
+		keeper.SetConsPubKeyRotation(ctx, rotation)
+		keeper.InsertConsPubKeyRotationQueue(ctx, rotation)
+	}
+
 	for _, ubd := range data.UnbondingDelegations {
 		keeper.SetUnbondingDelegation(ctx, ubd)
 
@@ -170,14 +188,17 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	})
 
 	return &types.GenesisState{
-		Params:               keeper.GetParams(ctx),
-		LastTotalPower:       keeper.GetLastTotalPower(ctx),
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           keeper.GetAllValidators(ctx),
-		Delegations:          keeper.GetAllDelegations(ctx),
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                    keeper.GetParams(ctx),
+		LastTotalPower:            keeper.GetLastTotalPower(ctx),
+		LastValidatorPowers:       lastValidatorPowers,
+		Validators:                keeper.GetAllValidators(ctx),
+		Delegations:               keeper.GetAllDelegations(ctx),
+		UnbondingDelegations:      unbondingDelegations,
+		Redelegations:             redelegations,
+		Exported:                  true,
+		TokenizeShareRecords:      keeper.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordId: keeper.GetLastTokenizeShareRecordID(ctx),
+		ConsPubKeyRotations:       keeper.GetAllConsPubKeyRotations(ctx),
 	}
 }
 
@@ -204,9 +225,57 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.LastTokenizeShareRecordId); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateConsPubKeyRotations(data.ConsPubKeyRotations); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateConsPubKeyRotations(rotations []types.ConsPubKeyRotation) error {
+	operators := make(map[string]bool, len(rotations))
+
+	for _, rotation := range rotations {
+		if err := rotation.Validate(); err != nil {
+			return err
+		}
+
+		if operators[rotation.OperatorAddress.String()] {
+			return fmt.Errorf("duplicate consensus key rotation in genesis state: validator %s", rotation.OperatorAddress)
+		}
+
+		operators[rotation.OperatorAddress.String()] = true
+	}
+
+	return nil
+}
+
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, lastID uint64) error {
+	ids := make(map[uint64]bool, len(records))
+
+	for _, record := range records {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: id %d", record.Id)
+		}
+
+		if record.Id > lastID {
+			return fmt.Errorf("tokenize share record id %d exceeds the last tokenize share record id %d", record.Id, lastID)
+		}
+
+		ids[record.Id] = true
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) (err error) {
 	addrMap := make(map[string]bool, len(validators))
 
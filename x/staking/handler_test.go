@@ -5,7 +5,7 @@ import (
 	"testing"
 	"time"
 
-	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -116,12 +116,12 @@ func TestValidatorByPowerIndex(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	ts := &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-	finishTime, err := gogotypes.TimestampFromProto(ts)
+	var resData types.MsgUndelegateResponse
+	err = proto.Unmarshal(res.Data, &resData)
 	require.NoError(t, err)
 
+	finishTime := resData.CompletionTime
+
 	ctx = ctx.WithBlockTime(finishTime)
 	staking.EndBlocker(ctx, app.StakingKeeper)
 	staking.EndBlocker(ctx, app.StakingKeeper)
@@ -254,12 +254,12 @@ func TestLegacyValidatorDelegations(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	ts := &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-	finishTime, err := gogotypes.TimestampFromProto(ts)
+	var resData types.MsgUndelegateResponse
+	err = proto.Unmarshal(res.Data, &resData)
 	require.NoError(t, err)
 
+	finishTime := resData.CompletionTime
+
 	ctx = ctx.WithBlockTime(finishTime)
 	staking.EndBlocker(ctx, app.StakingKeeper)
 
@@ -450,6 +450,49 @@ func TestEditValidatorIncreaseMinSelfDelegationBeyondCurrentBond(t *testing.T) {
 	require.Nil(t, res)
 }
 
+func TestMinCommissionRate(t *testing.T) {
+	initPower := int64(100)
+	initBond := sdk.TokensFromConsensusPower(10)
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 1, 1000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	minRate := sdk.NewDecWithPrec(5, 2)
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = minRate
+	app.StakingKeeper.SetParams(ctx, params)
+
+	validatorAddr := valAddrs[0]
+
+	// creating a validator with a commission below the minimum fails
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, PKs[0], initBond)
+	res, err := handler(ctx, msgCreateValidator)
+	require.True(t, types.ErrCommissionLTMinRate.Is(err))
+	require.Nil(t, res)
+
+	msgCreateValidator.Commission = types.NewCommissionRates(minRate, sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2))
+	res, err = handler(ctx, msgCreateValidator)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// editing the commission below the minimum fails
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(48 * time.Hour))
+	newRate := sdk.NewDecWithPrec(4, 2)
+	msgEditValidator := types.NewMsgEditValidator(validatorAddr, types.Description{}, &newRate, nil)
+	res, err = handler(ctx, msgEditValidator)
+	require.True(t, types.ErrCommissionLTMinRate.Is(err))
+	require.Nil(t, res)
+
+	newRate = sdk.NewDecWithPrec(6, 2)
+	msgEditValidator = types.NewMsgEditValidator(validatorAddr, types.Description{}, &newRate, nil)
+	res, err = handler(ctx, msgEditValidator)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, newRate, validator.Commission.Rate)
+}
+
 func TestIncrementsMsgUnbond(t *testing.T) {
 	initPower := int64(1000)
 	initBond := sdk.TokensFromConsensusPower(initPower)
@@ -499,12 +542,12 @@ func TestIncrementsMsgUnbond(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, res)
 
-		ts := &gogotypes.Timestamp{}
-		types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-		finishTime, err := gogotypes.TimestampFromProto(ts)
+		var resData types.MsgUndelegateResponse
+		err = proto.Unmarshal(res.Data, &resData)
 		require.NoError(t, err)
 
+		finishTime := resData.CompletionTime
+
 		ctx = ctx.WithBlockTime(finishTime)
 		staking.EndBlocker(ctx, app.StakingKeeper)
 
@@ -617,10 +660,8 @@ func TestMultipleMsgCreateValidator(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, res)
 
-		ts := &gogotypes.Timestamp{}
-		types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-		_, err = gogotypes.TimestampFromProto(ts)
+		var resData types.MsgUndelegateResponse
+		err = proto.Unmarshal(res.Data, &resData)
 		require.NoError(t, err)
 
 		// adds validator into unbonding queue
@@ -675,12 +716,12 @@ func TestMultipleMsgDelegate(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, res)
 
-		ts := &gogotypes.Timestamp{}
-		types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-		finishTime, err := gogotypes.TimestampFromProto(ts)
+		var resData types.MsgUndelegateResponse
+		err = proto.Unmarshal(res.Data, &resData)
 		require.NoError(t, err)
 
+		finishTime := resData.CompletionTime
+
 		ctx = ctx.WithBlockTime(finishTime)
 		staking.EndBlocker(ctx, app.StakingKeeper)
 
@@ -714,12 +755,12 @@ func TestJailValidator(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	ts := &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-	finishTime, err := gogotypes.TimestampFromProto(ts)
+	var resData types.MsgUndelegateResponse
+	err = proto.Unmarshal(res.Data, &resData)
 	require.NoError(t, err)
 
+	finishTime := resData.CompletionTime
+
 	ctx = ctx.WithBlockTime(finishTime)
 	staking.EndBlocker(ctx, app.StakingKeeper)
 
@@ -734,12 +775,12 @@ func TestJailValidator(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	ts = &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-	finishTime, err = gogotypes.TimestampFromProto(ts)
+	resData = types.MsgUndelegateResponse{}
+	err = proto.Unmarshal(res.Data, &resData)
 	require.NoError(t, err)
 
+	finishTime = resData.CompletionTime
+
 	ctx = ctx.WithBlockTime(finishTime)
 	staking.EndBlocker(ctx, app.StakingKeeper)
 
@@ -782,12 +823,12 @@ func TestValidatorQueue(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	ts := &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-	finishTime, err := gogotypes.TimestampFromProto(ts)
+	var resData types.MsgUndelegateResponse
+	err = proto.Unmarshal(res.Data, &resData)
 	require.NoError(t, err)
 
+	finishTime := resData.CompletionTime
+
 	ctx = ctx.WithBlockTime(finishTime)
 	staking.EndBlocker(ctx, app.StakingKeeper)
 
@@ -863,6 +904,112 @@ func TestUnbondingPeriod(t *testing.T) {
 	require.False(t, found, "should have unbonded")
 }
 
+func TestCancelUnbondingDelegation(t *testing.T) {
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, 1000, 2, 1000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+	validatorAddr, delegatorAddr := valAddrs[0], delAddrs[1]
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.UnbondingTime = 7 * time.Second
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// create the validator and delegate to it
+	valTokens := sdk.TokensFromConsensusPower(10)
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, PKs[0], valTokens)
+	res, err := handler(ctx, msgCreateValidator)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	delTokens := sdk.TokensFromConsensusPower(10)
+	res, err = handler(ctx, NewTestMsgDelegate(delegatorAddr, validatorAddr, delTokens))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// unbond at two different heights
+	ctx = ctx.WithBlockHeight(1)
+	unbondAmt := sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(4))
+	res, err = handler(ctx, types.NewMsgUndelegate(delegatorAddr, validatorAddr, unbondAmt))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	origHeader := ctx.BlockHeader()
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(origHeader.Time.Add(time.Second))
+	res, err = handler(ctx, types.NewMsgUndelegate(delegatorAddr, validatorAddr, unbondAmt))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	bondedPoolBalance := app.BankKeeper.GetBalance(ctx, app.StakingKeeper.GetBondedPool(ctx).GetAddress(), bondDenom)
+	notBondedPoolBalance := app.BankKeeper.GetBalance(ctx, app.StakingKeeper.GetNotBondedPool(ctx).GetAddress(), bondDenom)
+
+	// the entry must exist and hold enough tokens
+	cancelAmt := sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(1))
+	res, err = handler(ctx, types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 3, cancelAmt))
+	require.True(t, types.ErrNoUnbondingDelegationEntry.Is(err))
+	require.Nil(t, res)
+
+	res, err = handler(ctx, types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 1, unbondAmt.Add(cancelAmt)))
+	require.True(t, types.ErrBadCancelUnbondingAmount.Is(err))
+	require.Nil(t, res)
+
+	// partially cancel the first entry
+	res, err = handler(ctx, types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 1, cancelAmt))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 2)
+	require.Equal(t, unbondAmt.Amount.Sub(cancelAmt.Amount), ubd.Entries[0].Balance)
+	require.Equal(t, unbondAmt.Amount.Sub(cancelAmt.Amount), ubd.Entries[0].InitialBalance)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, delTokens.Sub(unbondAmt.Amount.MulRaw(2)).Add(cancelAmt.Amount).ToDec(), delegation.Shares)
+
+	// the tokens moved back to the bonded pool
+	require.Equal(t, bondedPoolBalance.Add(cancelAmt), app.BankKeeper.GetBalance(ctx, app.StakingKeeper.GetBondedPool(ctx).GetAddress(), bondDenom))
+	require.Equal(t, notBondedPoolBalance.Sub(cancelAmt), app.BankKeeper.GetBalance(ctx, app.StakingKeeper.GetNotBondedPool(ctx).GetAddress(), bondDenom))
+
+	// fully cancel the first entry, which is removed from the queue
+	firstCompletionTime := ubd.Entries[0].CompletionTime
+	res, err = handler(ctx, types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 1, sdk.NewCoin(bondDenom, ubd.Entries[0].Balance)))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	ubd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, int64(2), ubd.Entries[0].CreationHeight)
+	require.Empty(t, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, firstCompletionTime))
+	require.Len(t, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, ubd.Entries[0].CompletionTime), 1)
+
+	// mature entries cannot be cancelled
+	matureCtx := ctx.WithBlockTime(ubd.Entries[0].CompletionTime)
+	res, err = handler(matureCtx, types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 2, cancelAmt))
+	require.True(t, types.ErrNoUnbondingDelegationEntry.Is(err))
+	require.Nil(t, res)
+
+	// fully cancel the last entry, which removes the unbonding delegation
+	res, err = handler(ctx, types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 2, unbondAmt))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	require.False(t, found)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, delTokens.ToDec(), delegation.Shares)
+
+	// no unbonding delegation is left
+	res, err = handler(ctx, types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 2, cancelAmt))
+	require.True(t, types.ErrNoUnbondingDelegation.Is(err))
+	require.Nil(t, res)
+}
+
 func TestUnbondingFromUnbondingValidator(t *testing.T) {
 	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, 1000, 2, 1000000000)
 	handler := staking.NewHandler(app.StakingKeeper)
@@ -888,12 +1035,12 @@ func TestUnbondingFromUnbondingValidator(t *testing.T) {
 	require.NotNil(t, res)
 
 	// change the ctx to Block Time one second before the validator would have unbonded
-	ts := &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-	finishTime, err := gogotypes.TimestampFromProto(ts)
+	var resData types.MsgUndelegateResponse
+	err = proto.Unmarshal(res.Data, &resData)
 	require.NoError(t, err)
 
+	finishTime := resData.CompletionTime
+
 	ctx = ctx.WithBlockTime(finishTime.Add(time.Second * -1))
 
 	// unbond the delegator from the validator
@@ -1496,3 +1643,379 @@ func TestInvalidCoinDenom(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 }
+
+func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
+	initPower := int64(1000)
+	initBond := sdk.TokensFromConsensusPower(initPower)
+
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 4, 10000000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	validatorAddr, delegatorAddr, ownerAddr, receiverAddr := valAddrs[0], delAddrs[1], delAddrs[2], delAddrs[3]
+
+	// create validator and delegate to it
+	res, err := handler(ctx, NewTestMsgCreateValidator(validatorAddr, PKs[0], initBond))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	delTokens := sdk.TokensFromConsensusPower(100)
+	res, err = handler(ctx, NewTestMsgDelegate(delegatorAddr, validatorAddr, delTokens))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// only the bond denom can be tokenized
+	_, err = handler(ctx, types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, sdk.NewInt64Coin("atom", 10), ownerAddr))
+	require.Error(t, err)
+
+	// tokenize part of the delegation
+	tokenizeTokens := sdk.TokensFromConsensusPower(40)
+	res, err = handler(ctx, types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, sdk.NewCoin(bondDenom, tokenizeTokens), ownerAddr))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, ownerAddr, record.Owner)
+	require.Equal(t, validatorAddr, record.Validator)
+
+	shareDenom := record.GetShareTokenDenom()
+	require.Equal(t, tokenizeTokens, app.BankKeeper.GetBalance(ctx, delegatorAddr, shareDenom).Amount)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, delTokens.Sub(tokenizeTokens).ToDec(), delegation.Shares)
+
+	recordDelegation, found := app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), validatorAddr)
+	require.True(t, found)
+	require.Equal(t, tokenizeTokens.ToDec(), recordDelegation.Shares)
+	require.Equal(t, tokenizeTokens.ToDec(), app.StakingKeeper.GetValidatorLiquidShares(ctx, validatorAddr))
+	require.Equal(t, tokenizeTokens, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// share tokens are transferable and can be redeemed by any holder
+	redeemTokens := sdk.TokensFromConsensusPower(10)
+	err = app.BankKeeper.SendCoins(ctx, delegatorAddr, receiverAddr, sdk.NewCoins(sdk.NewCoin(shareDenom, redeemTokens)))
+	require.NoError(t, err)
+
+	res, err = handler(ctx, types.NewMsgRedeemTokensForShares(receiverAddr, sdk.NewCoin(shareDenom, redeemTokens)))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, receiverAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, redeemTokens.ToDec(), delegation.Shares)
+	require.True(t, app.BankKeeper.GetBalance(ctx, receiverAddr, shareDenom).IsZero())
+	require.Equal(t, tokenizeTokens.Sub(redeemTokens).ToDec(), app.StakingKeeper.GetValidatorLiquidShares(ctx, validatorAddr))
+
+	// tokens cannot be redeemed beyond the balance
+	_, err = handler(ctx, types.NewMsgRedeemTokensForShares(receiverAddr, sdk.NewCoin(shareDenom, redeemTokens)))
+	require.Error(t, err)
+
+	// redeeming the remaining tokens removes the record
+	res, err = handler(ctx, types.NewMsgRedeemTokensForShares(delegatorAddr, sdk.NewCoin(shareDenom, tokenizeTokens.Sub(redeemTokens))))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, delTokens.Sub(redeemTokens).ToDec(), delegation.Shares)
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), validatorAddr)
+	require.False(t, found)
+
+	_, err = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.Error(t, err)
+	require.True(t, app.BankKeeper.GetSupplyOf(ctx, shareDenom).IsZero())
+	require.True(t, app.StakingKeeper.GetValidatorLiquidShares(ctx, validatorAddr).IsZero())
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+
+	// the validator keeps all of its tokens throughout
+	validator, found := app.StakingKeeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, initBond.Add(delTokens), validator.Tokens)
+}
+
+func TestTokenizeSharesLiquidStakingCaps(t *testing.T) {
+	initPower := int64(1000)
+	initBond := sdk.TokensFromConsensusPower(initPower)
+
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 2, 10000000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	validatorAddr, delegatorAddr := valAddrs[0], delAddrs[1]
+
+	res, err := handler(ctx, NewTestMsgCreateValidator(validatorAddr, PKs[0], initBond))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	delTokens := sdk.TokensFromConsensusPower(1000)
+	res, err = handler(ctx, NewTestMsgDelegate(delegatorAddr, validatorAddr, delTokens))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// at most 10% of the bonded tokens may be tokenized
+	params := app.StakingKeeper.GetParams(ctx)
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(1, 1)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, err = handler(ctx, types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(201)), delegatorAddr))
+	require.True(t, types.ErrGlobalLiquidCapExceeded.Is(err))
+
+	res, err = handler(ctx, types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(150)), delegatorAddr))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// at most 10% of the validator's shares may be tokenized
+	params.GlobalLiquidStakingCap = sdk.OneDec()
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(1, 1)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, err = handler(ctx, types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(51)), delegatorAddr))
+	require.True(t, types.ErrValidatorLiquidCapExceeded.Is(err))
+
+	res, err = handler(ctx, types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(50)), delegatorAddr))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, sdk.TokensFromConsensusPower(200), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+}
+
+func TestTransferTokenizeShareRecord(t *testing.T) {
+	initPower := int64(1000)
+	initBond := sdk.TokensFromConsensusPower(initPower)
+
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 3, 10000000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	validatorAddr, ownerAddr, newOwnerAddr := valAddrs[0], delAddrs[1], delAddrs[2]
+
+	res, err := handler(ctx, NewTestMsgCreateValidator(validatorAddr, PKs[0], initBond))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	res, err = handler(ctx, NewTestMsgDelegate(ownerAddr, validatorAddr, initBond))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	res, err = handler(ctx, types.NewMsgTokenizeShares(ownerAddr, validatorAddr, sdk.NewCoin(bondDenom, initBond), ownerAddr))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Len(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr), 1)
+
+	// only the owner can transfer the record
+	_, err = handler(ctx, types.NewMsgTransferTokenizeShareRecord(1, newOwnerAddr, newOwnerAddr))
+	require.True(t, types.ErrNotTokenizeShareRecordOwner.Is(err))
+
+	_, err = handler(ctx, types.NewMsgTransferTokenizeShareRecord(2, ownerAddr, newOwnerAddr))
+	require.True(t, types.ErrTokenizeShareRecordNotExists.Is(err))
+
+	res, err = handler(ctx, types.NewMsgTransferTokenizeShareRecord(1, ownerAddr, newOwnerAddr))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr))
+	records := app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, newOwnerAddr)
+	require.Len(t, records, 1)
+	require.Equal(t, newOwnerAddr, records[0].Owner)
+
+	// the denom index still resolves to the record
+	record, err := app.StakingKeeper.GetTokenizeShareRecordByDenom(ctx, records[0].GetShareTokenDenom())
+	require.NoError(t, err)
+	require.Equal(t, records[0], record)
+}
+
+func TestTransferTokenizeShareRecordWithdrawsRewards(t *testing.T) {
+	// use the app keeper, which has the distribution hooks set
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	handler := staking.NewHandler(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	balance := sdk.TokensFromConsensusPower(1000)
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 3, balance)
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+	validatorAddr, ownerAddr, newOwnerAddr := valAddrs[0], delAddrs[1], delAddrs[2]
+
+	// fund the distribution module account for the rewards allocated below
+	rewards := sdk.TokensFromConsensusPower(10)
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(bondDenom, rewards))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// the record holds half of the validator's tokens
+	bond := sdk.TokensFromConsensusPower(100)
+	msg := types.NewMsgCreateValidator(
+		validatorAddr, PKs[0], sdk.NewCoin(bondDenom, bond), types.Description{},
+		types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	res, err := handler(ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	res, err = handler(ctx, NewTestMsgDelegate(ownerAddr, validatorAddr, bond))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	res, err = handler(ctx, types.NewMsgTokenizeShares(ownerAddr, validatorAddr, sdk.NewCoin(bondDenom, bond), ownerAddr))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	val := app.StakingKeeper.Validator(ctx, validatorAddr)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(bondDenom, rewards)})
+
+	res, err = handler(ctx, types.NewMsgTransferTokenizeShareRecord(1, ownerAddr, newOwnerAddr))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// the rewards accrued before the transfer were paid out to the previous owner
+	recordRewards := rewards.QuoRaw(2)
+	require.Equal(t, balance.Sub(bond).Add(recordRewards), app.BankKeeper.GetBalance(ctx, ownerAddr, bondDenom).Amount)
+
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+
+	// and the new owner only receives the rewards accruing after the transfer
+	withdrawn, err := app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, newOwnerAddr)
+	require.NoError(t, err)
+	require.True(t, withdrawn.IsZero())
+}
+
+func TestRotateConsPubKey(t *testing.T) {
+	initPower := int64(1000)
+	initBond := sdk.TokensFromConsensusPower(initPower)
+
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 2, 10000000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	validatorAddr, otherValidatorAddr := valAddrs[0], valAddrs[1]
+	oldPk, newPk := PKs[0], PKs[1]
+
+	res, err := handler(ctx, NewTestMsgCreateValidator(validatorAddr, oldPk, initBond))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	res, err = handler(ctx, NewTestMsgCreateValidator(otherValidatorAddr, PKs[2], initBond))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	updates := staking.EndBlocker(ctx, app.StakingKeeper)
+	require.Len(t, updates, 2)
+
+	// the new consensus key cannot be in use by another validator
+	_, err = handler(ctx, types.NewMsgRotateConsPubKey(validatorAddr, PKs[2]))
+	require.True(t, types.ErrValidatorPubKeyExists.Is(err))
+
+	fee := app.StakingKeeper.KeyRotationFee(ctx)
+	balanceBefore := app.BankKeeper.GetBalance(ctx, sdk.AccAddress(validatorAddr), fee.Denom)
+	supplyBefore := app.BankKeeper.GetSupplyOf(ctx, fee.Denom)
+
+	res, err = handler(ctx, types.NewMsgRotateConsPubKey(validatorAddr, newPk))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// the key rotation fee is burned
+	require.Equal(t, balanceBefore.Sub(fee), app.BankKeeper.GetBalance(ctx, sdk.AccAddress(validatorAddr), fee.Denom))
+	require.Equal(t, supplyBefore.Sub(fee), app.BankKeeper.GetSupplyOf(ctx, fee.Denom))
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.True(t, newPk.Equals(validator.GetConsPubKey()))
+
+	// both consensus addresses resolve to the validator
+	oldConsAddr, newConsAddr := sdk.GetConsAddress(oldPk), sdk.GetConsAddress(newPk)
+	for _, consAddr := range []sdk.ConsAddress{oldConsAddr, newConsAddr} {
+		validator, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+		require.True(t, found)
+		require.Equal(t, validatorAddr, validator.OperatorAddress)
+	}
+
+	rotatedConsAddr, found := app.StakingKeeper.GetRotatedConsAddr(ctx, oldConsAddr)
+	require.True(t, found)
+	require.Equal(t, newConsAddr, rotatedConsAddr)
+
+	// only one rotation is allowed per unbonding period
+	_, err = handler(ctx, types.NewMsgRotateConsPubKey(validatorAddr, PKs[3]))
+	require.True(t, types.ErrConsPubKeyRotationInProgress.Is(err))
+
+	// the old key is removed from the validator set and the new one added
+	updates = staking.EndBlocker(ctx, app.StakingKeeper)
+	require.Len(t, updates, 2)
+	require.Equal(t, validator.ABCIValidatorUpdate(), updates[1])
+	require.Equal(t, int64(0), updates[0].Power)
+
+	oldUpdate := types.NewValidator(validatorAddr, oldPk, types.Description{}).ABCIValidatorUpdateZero()
+	require.Equal(t, oldUpdate, updates[0])
+
+	updates = staking.EndBlocker(ctx, app.StakingKeeper)
+	require.Empty(t, updates)
+
+	// the old consensus address no longer resolves once the unbonding period
+	// has elapsed
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(app.StakingKeeper.UnbondingTime(ctx)))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	_, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, oldConsAddr)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetRotatedConsAddr(ctx, oldConsAddr)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetConsPubKeyRotation(ctx, validatorAddr)
+	require.False(t, found)
+
+	res, err = handler(ctx, types.NewMsgRotateConsPubKey(validatorAddr, PKs[3]))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+}
+
+func TestRotateConsPubKeyUnbondingValidator(t *testing.T) {
+	initPower := int64(1000)
+	initBond := sdk.TokensFromConsensusPower(initPower)
+
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 1, 10000000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	validatorAddr := valAddrs[0]
+	oldPk, newPk := PKs[0], PKs[1]
+
+	res, err := handler(ctx, NewTestMsgCreateValidator(validatorAddr, oldPk, initBond))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// the validator leaves the set in the block its key is rotated
+	res, err = handler(ctx, types.NewMsgRotateConsPubKey(validatorAddr, newPk))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	app.StakingKeeper.Jail(ctx, sdk.GetConsAddress(oldPk))
+
+	updates := staking.EndBlocker(ctx, app.StakingKeeper)
+	require.Len(t, updates, 1)
+
+	oldUpdate := types.NewValidator(validatorAddr, oldPk, types.Description{}).ABCIValidatorUpdateZero()
+	require.Equal(t, oldUpdate, updates[0])
+}
+
+func TestUpdateParams(t *testing.T) {
+	app, ctx, delAddrs, _ := bootstrapHandlerGenesisTest(t, 1000, 1, 10000000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MaxValidators = 7
+
+	// only the authority may update the params
+	_, err := handler(ctx, types.NewMsgUpdateParams(delAddrs[0], params))
+	require.Error(t, err)
+	require.NotEqual(t, uint32(7), app.StakingKeeper.MaxValidators(ctx))
+
+	res, err := handler(ctx, types.NewMsgUpdateParams(app.StakingKeeper.GetAuthority(), params))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, params, app.StakingKeeper.GetParams(ctx))
+}
@@ -0,0 +1,264 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetConsPubKeyRotation returns the consensus key rotation of a validator
+// which has not completed yet.
+func (k Keeper) GetConsPubKeyRotation(ctx sdk.Context, valAddr sdk.ValAddress) (rotation types.ConsPubKeyRotation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetConsPubKeyRotationKey(valAddr))
+	if bz == nil {
+		return rotation, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &rotation)
+
+	return rotation, true
+}
+
+// SetConsPubKeyRotation sets the consensus key rotation of a validator along
+// with the mapping of its old consensus address to the validator.
+func (k Keeper) SetConsPubKeyRotation(ctx sdk.Context, rotation types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+
+	oldConsAddr := sdk.GetConsAddress(rotation.GetOldConsPubKey())
+	newConsAddr := sdk.GetConsAddress(rotation.GetNewConsPubKey())
+
+	store.Set(types.GetConsPubKeyRotationKey(rotation.OperatorAddress), k.cdc.MustMarshalBinaryBare(&rotation))
+	store.Set(types.GetOldToNewConsAddrKey(oldConsAddr), newConsAddr)
+	store.Set(types.GetValidatorByConsAddrKey(oldConsAddr), rotation.OperatorAddress)
+}
+
+// RemoveConsPubKeyRotation removes the consensus key rotation of a validator.
+// The old consensus address no longer resolves to the validator afterwards.
+func (k Keeper) RemoveConsPubKeyRotation(ctx sdk.Context, valAddr sdk.ValAddress) {
+	rotation, found := k.GetConsPubKeyRotation(ctx, valAddr)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	oldConsAddr := sdk.GetConsAddress(rotation.GetOldConsPubKey())
+
+	store.Delete(types.GetConsPubKeyRotationKey(valAddr))
+	store.Delete(types.GetOldToNewConsAddrKey(oldConsAddr))
+	store.Delete(types.GetValidatorByConsAddrKey(oldConsAddr))
+}
+
+// IterateConsPubKeyRotations iterates through the consensus key rotations
+// which have not completed yet.
+func (k Keeper) IterateConsPubKeyRotations(ctx sdk.Context, cb func(rotation types.ConsPubKeyRotation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rotation types.ConsPubKeyRotation
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &rotation)
+
+		if cb(rotation) {
+			break
+		}
+	}
+}
+
+// GetAllConsPubKeyRotations returns all the consensus key rotations which have
+// not completed yet.
+func (k Keeper) GetAllConsPubKeyRotations(ctx sdk.Context) (rotations []types.ConsPubKeyRotation) {
+	k.IterateConsPubKeyRotations(ctx, func(rotation types.ConsPubKeyRotation) bool {
+		rotations = append(rotations, rotation)
+		return false
+	})
+
+	return rotations
+}
+
+// GetRotatedConsAddr returns the current consensus address of a validator
+// given a consensus address it has rotated away from during the last
+// unbonding period.
+func (k Keeper) GetRotatedConsAddr(ctx sdk.Context, oldConsAddr sdk.ConsAddress) (newConsAddr sdk.ConsAddress, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetOldToNewConsAddrKey(oldConsAddr))
+	if bz == nil {
+		return nil, false
+	}
+
+	return sdk.ConsAddress(bz), true
+}
+
+// GetConsPubKeyRotationQueueTimeSlice gets a specific consensus key rotation
+// queue timeslice. A timeslice is a slice of validator addresses corresponding
+// to the rotations that complete at a certain time.
+func (k Keeper) GetConsPubKeyRotationQueueTimeSlice(ctx sdk.Context, timestamp time.Time) []sdk.ValAddress {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetConsPubKeyRotationTimeKey(timestamp))
+	if bz == nil {
+		return []sdk.ValAddress{}
+	}
+
+	va := types.ValAddresses{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &va)
+
+	return va.Addresses
+}
+
+// SetConsPubKeyRotationQueueTimeSlice sets a specific consensus key rotation
+// queue timeslice.
+func (k Keeper) SetConsPubKeyRotationQueueTimeSlice(ctx sdk.Context, timestamp time.Time, keys []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&types.ValAddresses{Addresses: keys})
+	store.Set(types.GetConsPubKeyRotationTimeKey(timestamp), bz)
+}
+
+// InsertConsPubKeyRotationQueue inserts a consensus key rotation to the
+// appropriate timeslice in the rotation queue.
+func (k Keeper) InsertConsPubKeyRotationQueue(ctx sdk.Context, rotation types.ConsPubKeyRotation) {
+	timeSlice := k.GetConsPubKeyRotationQueueTimeSlice(ctx, rotation.CompletionTime)
+	timeSlice = append(timeSlice, rotation.OperatorAddress)
+	k.SetConsPubKeyRotationQueueTimeSlice(ctx, rotation.CompletionTime, timeSlice)
+}
+
+// ConsPubKeyRotationQueueIterator returns all the consensus key rotation queue
+// timeslices from time 0 until endTime.
+func (k Keeper) ConsPubKeyRotationQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.ConsPubKeyRotationQueueKey,
+		sdk.InclusiveEndBytes(types.GetConsPubKeyRotationTimeKey(endTime)))
+}
+
+// DequeueAllMatureConsPubKeyRotationQueue returns a concatenated list of all
+// the timeslices inclusively previous to currTime, and deletes the timeslices
+// from the queue.
+func (k Keeper) DequeueAllMatureConsPubKeyRotationQueue(ctx sdk.Context, currTime time.Time) (matureRotations []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := k.ConsPubKeyRotationQueueIterator(ctx, currTime)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		timeslice := types.ValAddresses{}
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &timeslice)
+
+		matureRotations = append(matureRotations, timeslice.Addresses...)
+
+		store.Delete(iterator.Key())
+	}
+
+	return matureRotations
+}
+
+// RotateValidatorConsPubKey replaces the consensus public key of a validator.
+// The validator set updates replacing the old key by the new one are returned
+// to Tendermint at the end of the block, while the old consensus address keeps
+// resolving to the validator for an unbonding period so that signatures and
+// evidence produced with the old key are still attributed to it.
+func (k Keeper) RotateValidatorConsPubKey(ctx sdk.Context, validator types.Validator, newPubKey crypto.PubKey) error {
+	if _, found := k.GetConsPubKeyRotation(ctx, validator.OperatorAddress); found {
+		return types.ErrConsPubKeyRotationInProgress
+	}
+
+	if _, found := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(newPubKey)); found {
+		return types.ErrValidatorPubKeyExists
+	}
+
+	// the key rotation fee is burned
+	fee := k.KeyRotationFee(ctx)
+	if fee.IsPositive() {
+		fees := sdk.NewCoins(fee)
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(validator.OperatorAddress), types.ModuleName, fees); err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, fees); err != nil {
+			return err
+		}
+	}
+
+	oldPubKey := validator.GetConsPubKey()
+	rotation := types.ConsPubKeyRotation{
+		OperatorAddress:    validator.OperatorAddress,
+		OldConsensusPubkey: validator.ConsensusPubkey,
+		NewConsensusPubkey: sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, newPubKey),
+		Height:             ctx.BlockHeight(),
+		CompletionTime:     ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx)),
+	}
+
+	validator.ConsensusPubkey = rotation.NewConsensusPubkey
+	k.SetValidator(ctx, validator)
+	k.SetValidatorByConsAddr(ctx, validator)
+
+	k.SetConsPubKeyRotation(ctx, rotation)
+	k.InsertConsPubKeyRotationQueue(ctx, rotation)
+	k.setBlockConsPubKeyRotation(ctx, validator.OperatorAddress)
+
+	k.AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey)
+
+	return nil
+}
+
+// CompleteConsPubKeyRotations removes all the consensus key rotations whose
+// unbonding period has elapsed.
+func (k Keeper) CompleteConsPubKeyRotations(ctx sdk.Context) {
+	blockTime := ctx.BlockHeader().Time
+
+	for _, valAddr := range k.DequeueAllMatureConsPubKeyRotationQueue(ctx, blockTime) {
+		rotation, found := k.GetConsPubKeyRotation(ctx, valAddr)
+		if !found || rotation.CompletionTime.After(blockTime) {
+			continue
+		}
+
+		k.RemoveConsPubKeyRotation(ctx, valAddr)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteKeyRotation,
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyOldConsPubKey, rotation.OldConsensusPubkey),
+			),
+		)
+	}
+}
+
+// setBlockConsPubKeyRotation marks the consensus key of a validator as rotated
+// in the current block.
+func (k Keeper) setBlockConsPubKeyRotation(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBlockConsPubKeyRotationKey(valAddr), []byte{})
+}
+
+// getAndClearBlockConsPubKeyRotations returns the consensus key rotations of
+// the current block, keyed by validator operator address, and clears the
+// current block marks.
+func (k Keeper) getAndClearBlockConsPubKeyRotations(ctx sdk.Context) map[[sdk.AddrLen]byte]types.ConsPubKeyRotation {
+	store := ctx.KVStore(k.storeKey)
+	rotations := make(map[[sdk.AddrLen]byte]types.ConsPubKeyRotation)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.BlockConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		valAddr := sdk.ValAddress(iterator.Key()[1:])
+
+		if rotation, found := k.GetConsPubKeyRotation(ctx, valAddr); found {
+			var valAddrBytes [sdk.AddrLen]byte
+
+			copy(valAddrBytes[:], valAddr)
+			rotations[valAddrBytes] = rotation
+		}
+
+		store.Delete(iterator.Key())
+	}
+
+	return rotations
+}
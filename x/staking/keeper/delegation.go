@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -259,6 +260,26 @@ func (k Keeper) InsertUBDQueue(ctx sdk.Context, ubd types.UnbondingDelegation,
 	}
 }
 
+// removeUBDQueueEntry removes an unbonding delegation from the given timeslice
+// of the unbonding queue.
+func (k Keeper) removeUBDQueueEntry(ctx sdk.Context, ubd types.UnbondingDelegation, completionTime time.Time) {
+	timeSlice := k.GetUBDQueueTimeSlice(ctx, completionTime)
+
+	for i, dvPair := range timeSlice {
+		if dvPair.DelegatorAddress.Equals(ubd.DelegatorAddress) && dvPair.ValidatorAddress.Equals(ubd.ValidatorAddress) {
+			timeSlice = append(timeSlice[:i], timeSlice[i+1:]...)
+			break
+		}
+	}
+
+	if len(timeSlice) == 0 {
+		ctx.KVStore(k.storeKey).Delete(types.GetUnbondingDelegationTimeKey(completionTime))
+		return
+	}
+
+	k.SetUBDQueueTimeSlice(ctx, completionTime, timeSlice)
+}
+
 // Returns all the unbonding queue timeslices from time 0 until endTime
 func (k Keeper) UBDQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
@@ -734,6 +755,81 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 	return balances, nil
 }
 
+// CancelUnbonding cancels the given amount of the unbonding delegation entry
+// created at creationHeight and delegates it back to the validator. The entry
+// is removed if its whole balance is cancelled.
+func (k Keeper) CancelUnbonding(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, creationHeight int64, amount sdk.Int,
+) error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	if validator.IsJailed() {
+		return types.ErrValidatorJailed
+	}
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.ErrNoUnbondingDelegation
+	}
+
+	entryIndex := -1
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == creationHeight {
+			entryIndex = i
+			break
+		}
+	}
+
+	if entryIndex == -1 {
+		return sdkerrors.Wrapf(types.ErrNoUnbondingDelegationEntry, "creation height %d", creationHeight)
+	}
+
+	entry := ubd.Entries[entryIndex]
+
+	// mature entries are completed at the end of the block
+	if entry.IsMature(ctx.BlockHeader().Time) {
+		return sdkerrors.Wrapf(types.ErrNoUnbondingDelegationEntry, "entry at creation height %d is mature", creationHeight)
+	}
+
+	if entry.Balance.LT(amount) {
+		return sdkerrors.Wrapf(types.ErrBadCancelUnbondingAmount, "got %s, balance %s", amount, entry.Balance)
+	}
+
+	// the unbonding tokens are held by the not bonded pool
+	if _, err := k.Delegate(ctx, delAddr, amount, sdk.Unbonding, validator, false); err != nil {
+		return err
+	}
+
+	if entry.Balance.Equal(amount) {
+		ubd.RemoveEntry(int64(entryIndex))
+	} else {
+		entry.Balance = entry.Balance.Sub(amount)
+		entry.InitialBalance = entry.InitialBalance.Sub(amount)
+		ubd.Entries[entryIndex] = entry
+	}
+
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	// remove the unbonding delegation from the queue timeslice of the entry
+	// unless another entry completes at the same time
+	for _, e := range ubd.Entries {
+		if e.CompletionTime.Equal(entry.CompletionTime) {
+			return nil
+		}
+	}
+
+	k.removeUBDQueueEntry(ctx, ubd, entry.CompletionTime)
+
+	return nil
+}
+
 // begin unbonding / redelegation; create a redelegation record
 func (k Keeper) BeginRedelegation(
 	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec,
@@ -870,3 +966,217 @@ func (k Keeper) ValidateUnbondAmount(
 
 	return shares, nil
 }
+
+// GetDelegatorBonded returns the amount of tokens a delegator has bonded to
+// validators, truncated to an integer per delegation.
+func (k Keeper) GetDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress) sdk.Int {
+	bonded := sdk.ZeroInt()
+
+	for _, delegation := range k.GetDelegatorDelegations(ctx, delegator, math.MaxUint16) {
+		validator, found := k.GetValidator(ctx, delegation.ValidatorAddress)
+		if found {
+			bonded = bonded.Add(validator.TokensFromShares(delegation.Shares).TruncateInt())
+		}
+	}
+
+	return bonded
+}
+
+// GetDelegatorUnbonding returns the amount of tokens a delegator has in
+// unbonding delegation entries.
+func (k Keeper) GetDelegatorUnbonding(ctx sdk.Context, delegator sdk.AccAddress) sdk.Int {
+	unbonding := sdk.ZeroInt()
+
+	for _, ubd := range k.GetUnbondingDelegations(ctx, delegator, math.MaxUint16) {
+		for _, entry := range ubd.Entries {
+			unbonding = unbonding.Add(entry.Balance)
+		}
+	}
+
+	return unbonding
+}
+
+// TransferUnbonding moves up to wantAmt tokens of unbonding delegation entries
+// between the given delegators for a single validator. Entries keep their
+// creation height and completion time so they remain subject to slashing and
+// mature as before. It returns the amount actually transferred, which may be
+// less than requested if the recipient reaches the maximum number of entries.
+func (k Keeper) TransferUnbonding(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt sdk.Int,
+) sdk.Int {
+	transferred := sdk.ZeroInt()
+
+	ubdFrom, found := k.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	modified := false
+	for i := 0; i < len(ubdFrom.Entries) && wantAmt.IsPositive(); i++ {
+		entry := ubdFrom.Entries[i]
+
+		toXfer := sdk.MinInt(entry.Balance, wantAmt)
+		if !toXfer.IsPositive() {
+			continue
+		}
+
+		if k.HasMaxUnbondingDelegationEntries(ctx, toAddr, valAddr) {
+			break
+		}
+
+		ubdTo := k.SetUnbondingDelegationEntry(ctx, toAddr, valAddr, entry.CreationHeight, entry.CompletionTime, toXfer)
+		k.InsertUBDQueue(ctx, ubdTo, entry.CompletionTime)
+
+		transferred = transferred.Add(toXfer)
+		wantAmt = wantAmt.Sub(toXfer)
+		modified = true
+
+		remaining := entry.Balance.Sub(toXfer)
+		if remaining.IsZero() {
+			ubdFrom.RemoveEntry(int64(i))
+			i--
+
+			continue
+		}
+
+		entry.InitialBalance = sdk.MaxInt(entry.InitialBalance.Sub(toXfer), remaining)
+		entry.Balance = remaining
+		ubdFrom.Entries[i] = entry
+	}
+
+	if modified {
+		if len(ubdFrom.Entries) == 0 {
+			k.RemoveUnbondingDelegation(ctx, ubdFrom)
+		} else {
+			k.SetUnbondingDelegation(ctx, ubdFrom)
+		}
+	}
+
+	return transferred
+}
+
+// TransferDelegation moves up to wantShares delegation shares of a single
+// validator between the given delegators. Redelegation entries into the
+// validator that are no longer covered by the shares left behind move along
+// with the delegation so that slashing for the source validator applies to
+// the recipient. It returns the amount of shares actually transferred, which
+// is zero if the transfer would exceed the maximum number of redelegation
+// entries.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantShares sdk.Dec,
+) sdk.Dec {
+	transferred := sdk.ZeroDec()
+
+	if !wantShares.IsPositive() {
+		return transferred
+	}
+
+	if _, found := k.GetValidator(ctx, valAddr); !found {
+		return transferred
+	}
+
+	delFrom, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	// collect the redelegations into the validator up-front and, assuming the
+	// worst case that every entry must move, check the recipient's entry limit
+	// while we can still return without modifying state
+	var redelegations []types.Redelegation
+	for _, red := range k.GetRedelegations(ctx, fromAddr, math.MaxUint16) {
+		if !red.ValidatorDstAddress.Equals(valAddr) {
+			continue
+		}
+
+		redTo, found := k.GetRedelegation(ctx, toAddr, red.ValidatorSrcAddress, red.ValidatorDstAddress)
+		if found && len(red.Entries)+len(redTo.Entries) > int(k.MaxEntries(ctx)) {
+			return transferred
+		}
+
+		redelegations = append(redelegations, red)
+	}
+
+	transferred = sdk.MinDec(delFrom.Shares, wantShares)
+	remaining := delFrom.Shares.Sub(transferred)
+
+	// settle the source delegation before its shares change, also when it is
+	// removed, so that its rewards are withdrawn to the source delegator
+	k.BeforeDelegationSharesModified(ctx, fromAddr, valAddr)
+
+	// update or create the recipient's delegation
+	delTo, found := k.GetDelegation(ctx, toAddr, valAddr)
+	if found {
+		k.BeforeDelegationSharesModified(ctx, toAddr, valAddr)
+	} else {
+		delTo = types.NewDelegation(toAddr, valAddr, sdk.ZeroDec())
+		k.BeforeDelegationCreated(ctx, toAddr, valAddr)
+	}
+
+	delTo.Shares = delTo.Shares.Add(transferred)
+	k.SetDelegation(ctx, delTo)
+	k.AfterDelegationModified(ctx, toAddr, valAddr)
+
+	// update or remove the source delegation
+	if remaining.IsZero() {
+		k.RemoveDelegation(ctx, delFrom)
+	} else {
+		delFrom.Shares = remaining
+		k.SetDelegation(ctx, delFrom)
+		k.AfterDelegationModified(ctx, fromAddr, valAddr)
+	}
+
+	// The shares left behind stay liable for as many redelegation entries as
+	// they cover; the rest of the entries, splitting one if necessary, move to
+	// the recipient.
+	for _, red := range redelegations {
+		modified := false
+
+		for i := 0; i < len(red.Entries); i++ {
+			entry := red.Entries[i]
+
+			sharesToKeep := sdk.MinDec(entry.SharesDst, remaining)
+			sharesToSend := entry.SharesDst.Sub(sharesToKeep)
+			remaining = remaining.Sub(sharesToKeep)
+
+			if sharesToSend.IsZero() {
+				continue
+			}
+
+			balanceToSend := entry.InitialBalance
+			if !sharesToKeep.IsZero() {
+				balanceToSend = sharesToSend.Quo(entry.SharesDst).MulInt(entry.InitialBalance).TruncateInt()
+			}
+
+			redTo := k.SetRedelegationEntry(
+				ctx, toAddr, red.ValidatorSrcAddress, red.ValidatorDstAddress,
+				entry.CreationHeight, entry.CompletionTime, balanceToSend, sdk.ZeroDec(), sharesToSend,
+			)
+			k.InsertRedelegationQueue(ctx, redTo, entry.CompletionTime)
+			modified = true
+
+			if sharesToKeep.IsZero() {
+				// the queue entry left behind for the source is a no-op once
+				// the redelegation no longer exists
+				red.RemoveEntry(int64(i))
+				i--
+
+				continue
+			}
+
+			entry.InitialBalance = entry.InitialBalance.Sub(balanceToSend)
+			entry.SharesDst = sharesToKeep
+			red.Entries[i] = entry
+		}
+
+		if modified {
+			if len(red.Entries) == 0 {
+				k.RemoveRedelegation(ctx, red)
+			} else {
+				k.SetRedelegation(ctx, red)
+			}
+		}
+	}
+
+	return transferred
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -1023,3 +1024,132 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestTransferUnbonding(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(0))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	completionTime := ctx.BlockTime().Add(time.Hour)
+
+	app.StakingKeeper.SetUnbondingDelegationEntry(ctx, addrDels[0], valAddrs[0], 0, completionTime, sdk.NewInt(10))
+	app.StakingKeeper.SetUnbondingDelegationEntry(ctx, addrDels[0], valAddrs[0], 1, completionTime, sdk.NewInt(20))
+
+	// the first entry moves entirely, the second one is split
+	transferred := app.StakingKeeper.TransferUnbonding(ctx, addrDels[0], addrDels[1], valAddrs[0], sdk.NewInt(15))
+	require.Equal(t, sdk.NewInt(15), transferred)
+
+	ubdFrom, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubdFrom.Entries, 1)
+	require.Equal(t, int64(1), ubdFrom.Entries[0].CreationHeight)
+	require.Equal(t, sdk.NewInt(15), ubdFrom.Entries[0].Balance)
+
+	ubdTo, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[1], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubdTo.Entries, 2)
+	require.Equal(t, sdk.NewInt(10), ubdTo.Entries[0].Balance)
+	require.Equal(t, sdk.NewInt(5), ubdTo.Entries[1].Balance)
+	require.Equal(t, sdk.NewInt(15), app.StakingKeeper.GetDelegatorUnbonding(ctx, addrDels[1]))
+
+	// transferring more than what is left removes the unbonding delegation
+	transferred = app.StakingKeeper.TransferUnbonding(ctx, addrDels[0], addrDels[1], valAddrs[0], sdk.NewInt(100))
+	require.Equal(t, sdk.NewInt(15), transferred)
+
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], valAddrs[0])
+	require.False(t, found)
+}
+
+func TestTransferDelegation(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(0))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+
+	validator := types.NewValidator(valAddrs[0], PKs[0], types.Description{})
+	validator, issuedShares := validator.AddTokensFromDel(sdk.NewInt(100))
+	keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(addrDels[0], valAddrs[0], issuedShares))
+
+	// 40 of the delegated shares come from a redelegation
+	completionTime := ctx.BlockTime().Add(time.Hour)
+	app.StakingKeeper.SetRedelegationEntry(ctx, addrDels[0], valAddrs[1], valAddrs[0], 0, completionTime, sdk.NewInt(40), sdk.NewDec(40), sdk.NewDec(40))
+
+	transferred := app.StakingKeeper.TransferDelegation(ctx, addrDels[0], addrDels[1], valAddrs[0], sdk.NewDec(70))
+	require.Equal(t, sdk.NewDec(70), transferred)
+
+	delFrom, found := app.StakingKeeper.GetDelegation(ctx, addrDels[0], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(30), delFrom.Shares)
+
+	delTo, found := app.StakingKeeper.GetDelegation(ctx, addrDels[1], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(70), delTo.Shares)
+
+	// the remaining 30 shares only cover part of the redelegation
+	redFrom, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], valAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, redFrom.Entries, 1)
+	require.Equal(t, sdk.NewDec(30), redFrom.Entries[0].SharesDst)
+	require.Equal(t, sdk.NewInt(30), redFrom.Entries[0].InitialBalance)
+
+	redTo, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[1], valAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, redTo.Entries, 1)
+	require.Equal(t, sdk.NewDec(10), redTo.Entries[0].SharesDst)
+	require.Equal(t, sdk.NewInt(10), redTo.Entries[0].InitialBalance)
+
+	// transferring the rest removes the delegation and the redelegation
+	transferred = app.StakingKeeper.TransferDelegation(ctx, addrDels[0], addrDels[1], valAddrs[0], sdk.NewDec(100))
+	require.Equal(t, sdk.NewDec(30), transferred)
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, addrDels[0], valAddrs[0])
+	require.False(t, found)
+
+	_, found = app.StakingKeeper.GetRedelegation(ctx, addrDels[0], valAddrs[1], valAddrs[0])
+	require.False(t, found)
+	require.Equal(t, sdk.NewInt(100), app.StakingKeeper.GetDelegatorBonded(ctx, addrDels[1]))
+}
+
+func TestTransferDelegationWithdrawsRewards(t *testing.T) {
+	// use the app keeper, which has the distribution hooks set
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	balance := sdk.TokensFromConsensusPower(1000)
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, balance)
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+
+	// fund the distribution module account for the rewards allocated below
+	rewards := sdk.TokensFromConsensusPower(10)
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, rewards))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	valTokens := sdk.TokensFromConsensusPower(100)
+	msg := types.NewMsgCreateValidator(
+		valAddrs[0], PKs[0], sdk.NewCoin(sdk.DefaultBondDenom, valTokens), types.Description{},
+		types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	_, err := keeper.NewMsgServerImpl(app.StakingKeeper).CreateValidator(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, rewards)})
+
+	// transfer the whole self-delegation
+	transferred := app.StakingKeeper.TransferDelegation(ctx, addrDels[0], addrDels[1], valAddrs[0], val.GetDelegatorShares())
+	require.Equal(t, val.GetDelegatorShares(), transferred)
+
+	_, found := app.StakingKeeper.GetDelegation(ctx, addrDels[0], valAddrs[0])
+	require.False(t, found)
+
+	// the rewards accrued before the transfer were withdrawn to the source delegator
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balance.Sub(valTokens).Add(rewards))),
+		app.BankKeeper.GetAllBalances(ctx, addrDels[0]),
+	)
+	require.True(t, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[0]).Rewards.IsZero())
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"github.com/tendermint/tendermint/crypto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -77,3 +79,10 @@ func (k Keeper) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress,
 		k.hooks.BeforeValidatorSlashed(ctx, valAddr, fraction)
 	}
 }
+
+// AfterConsensusPubKeyUpdate - call hook if registered
+func (k Keeper) AfterConsensusPubKeyUpdate(ctx sdk.Context, oldPubKey, newPubKey crypto.PubKey) {
+	if k.hooks != nil {
+		k.hooks.AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey)
+	}
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetValidatorLiquidShares returns the delegator shares of a validator that
+// are currently held by tokenize share records.
+func (k Keeper) GetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorLiquidSharesKey(valAddr))
+
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	dp := sdk.DecProto{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &dp)
+
+	return dp.Dec
+}
+
+// SetValidatorLiquidShares sets the tokenized delegator shares of a validator,
+// removing the entry once no shares are tokenized.
+func (k Keeper) SetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+
+	if !shares.IsPositive() {
+		store.Delete(types.GetValidatorLiquidSharesKey(valAddr))
+		return
+	}
+
+	bz := k.cdc.MustMarshalBinaryBare(&sdk.DecProto{Dec: shares})
+	store.Set(types.GetValidatorLiquidSharesKey(valAddr), bz)
+}
+
+// IterateValidatorLiquidShares iterates through the tokenized delegator shares
+// of all validators.
+func (k Keeper) IterateValidatorLiquidShares(ctx sdk.Context, cb func(valAddr sdk.ValAddress, shares sdk.Dec) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorLiquidSharesPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		valAddr := sdk.ValAddress(iterator.Key()[len(types.ValidatorLiquidSharesPrefix):])
+
+		dp := sdk.DecProto{}
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &dp)
+
+		if cb(valAddr, dp.Dec) {
+			break
+		}
+	}
+}
+
+// GetTotalLiquidStakedTokens returns the amount of tokens currently held by
+// tokenize share records. The amount is derived from the tokenized shares, so
+// it reflects any slashing of the validators.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) sdk.Int {
+	total := sdk.ZeroDec()
+
+	k.IterateValidatorLiquidShares(ctx, func(valAddr sdk.ValAddress, shares sdk.Dec) bool {
+		validator, found := k.GetValidator(ctx, valAddr)
+		if found {
+			total = total.Add(validator.TokensFromShares(shares))
+		}
+
+		return false
+	})
+
+	return total.TruncateInt()
+}
+
+// CheckLiquidStakingCaps returns an error if tokenizing the given delegator
+// shares of a validator would exceed either the global or the validator
+// liquid staking cap. A cap of one disables the corresponding check.
+func (k Keeper) CheckLiquidStakingCaps(ctx sdk.Context, validator types.Validator, shares sdk.Dec) error {
+	if globalCap := k.GlobalLiquidStakingCap(ctx); globalCap.LT(sdk.OneDec()) {
+		liquidTokens := k.GetTotalLiquidStakedTokens(ctx).ToDec().Add(validator.TokensFromShares(shares))
+		maxTokens := globalCap.MulInt(k.TotalBondedTokens(ctx))
+
+		if liquidTokens.GT(maxTokens) {
+			return sdkerrors.Wrapf(
+				types.ErrGlobalLiquidCapExceeded,
+				"liquid staked tokens %s exceed %s", liquidTokens, maxTokens,
+			)
+		}
+	}
+
+	if validatorCap := k.ValidatorLiquidStakingCap(ctx); validatorCap.LT(sdk.OneDec()) {
+		liquidShares := k.GetValidatorLiquidShares(ctx, validator.OperatorAddress).Add(shares)
+		maxShares := validatorCap.Mul(validator.DelegatorShares)
+
+		if liquidShares.GT(maxShares) {
+			return sdkerrors.Wrapf(
+				types.ErrValidatorLiquidCapExceeded,
+				"liquid shares %s exceed %s", liquidShares, maxShares,
+			)
+		}
+	}
+
+	return nil
+}
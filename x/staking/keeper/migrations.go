@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v041staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_41"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper            Keeper
+	minCommissionRate sdk.Dec
+}
+
+// NewMigrator returns a new Migrator. The minCommissionRate is the minimum
+// validator commission rate set when migrating from version 1 to 2.
+func NewMigrator(keeper Keeper, minCommissionRate sdk.Dec) Migrator {
+	return Migrator{keeper: keeper, minCommissionRate: minCommissionRate}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v041staking.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.paramstore, m.minCommissionRate)
+}
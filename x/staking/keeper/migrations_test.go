@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrate1to2MinCommissionRate(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// write the version 1 params to the subspace
+	paramSpace := app.GetSubspace(types.ModuleName)
+	legacyParams := types.DefaultParams()
+	paramSpace.Set(ctx, types.KeyUnbondingTime, legacyParams.UnbondingTime)
+	paramSpace.Set(ctx, types.KeyMaxValidators, legacyParams.MaxValidators)
+	paramSpace.Set(ctx, types.KeyMaxEntries, legacyParams.MaxEntries)
+	paramSpace.Set(ctx, types.KeyBondDenom, legacyParams.BondDenom)
+	paramSpace.Set(ctx, types.KeyHistoricalEntries, legacyParams.HistoricalEntries)
+
+	pk := ed25519.GenPrivKey().PubKey()
+	valAddr := sdk.ValAddress(pk.Address())
+	validator := types.NewValidator(valAddr, pk, types.Description{})
+	validator.Commission = types.NewCommission(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(1, 2))
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	minCommissionRate := sdk.NewDecWithPrec(5, 2)
+	m := keeper.NewMigrator(app.StakingKeeper, minCommissionRate)
+	require.NoError(t, m.Migrate1to2(ctx))
+	require.Equal(t, minCommissionRate, app.StakingKeeper.MinCommissionRate(ctx))
+
+	// the commission rate of the validator is raised to the migrator's rate
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, minCommissionRate, validator.Commission.Rate)
+	require.Equal(t, minCommissionRate, validator.Commission.MaxRate)
+}
@@ -8,49 +8,66 @@ import (
 )
 
 // UnbondingTime
-func (k Keeper) UnbondingTime(ctx sdk.Context) (res time.Duration) {
-	k.paramstore.Get(ctx, types.KeyUnbondingTime, &res)
-	return
+func (k Keeper) UnbondingTime(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).UnbondingTime
 }
 
 // MaxValidators - Maximum number of validators
-func (k Keeper) MaxValidators(ctx sdk.Context) (res uint32) {
-	k.paramstore.Get(ctx, types.KeyMaxValidators, &res)
-	return
+func (k Keeper) MaxValidators(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).MaxValidators
 }
 
 // MaxEntries - Maximum number of simultaneous unbonding
 // delegations or redelegations (per pair/trio)
-func (k Keeper) MaxEntries(ctx sdk.Context) (res uint32) {
-	k.paramstore.Get(ctx, types.KeyMaxEntries, &res)
-	return
+func (k Keeper) MaxEntries(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).MaxEntries
 }
 
 // HistoricalEntries = number of historical info entries
 // to persist in store
-func (k Keeper) HistoricalEntries(ctx sdk.Context) (res uint32) {
-	k.paramstore.Get(ctx, types.KeyHistoricalEntries, &res)
-	return
+func (k Keeper) HistoricalEntries(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).HistoricalEntries
 }
 
 // BondDenom - Bondable coin denomination
-func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
-	k.paramstore.Get(ctx, types.KeyBondDenom, &res)
-	return
+func (k Keeper) BondDenom(ctx sdk.Context) string {
+	return k.GetParams(ctx).BondDenom
+}
+
+// GlobalLiquidStakingCap - Maximum fraction of the total bonded tokens that
+// may be tokenized
+func (k Keeper) GlobalLiquidStakingCap(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).GlobalLiquidStakingCap
+}
+
+// ValidatorLiquidStakingCap - Maximum fraction of a validator's delegator
+// shares that may be tokenized
+func (k Keeper) ValidatorLiquidStakingCap(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).ValidatorLiquidStakingCap
+}
+
+// KeyRotationFee - Fee charged for rotating a validator's consensus public key
+func (k Keeper) KeyRotationFee(ctx sdk.Context) sdk.Coin {
+	return k.GetParams(ctx).KeyRotationFee
+}
+
+// MinCommissionRate - Minimum commission rate a validator may set
+func (k Keeper) MinCommissionRate(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).MinCommissionRate
 }
 
 // Get all parameteras as types.Params
-func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(
-		k.UnbondingTime(ctx),
-		k.MaxValidators(ctx),
-		k.MaxEntries(ctx),
-		k.HistoricalEntries(ctx),
-		k.BondDenom(ctx),
-	)
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		panic("stored staking params should not have been nil")
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &params)
+	return params
 }
 
 // set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramstore.SetParamSet(ctx, &params)
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, k.cdc.MustMarshalBinaryBare(&params))
 }
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the id of the most recently created
+// tokenize share record.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the id of the most recently created
+// tokenize share record.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord returns the tokenize share record with the given id.
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (types.TokenizeShareRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenizeShareRecordByIndexKey(id))
+	if bz == nil {
+		return types.TokenizeShareRecord{}, sdkerrors.Wrapf(types.ErrTokenizeShareRecordNotExists, "id: %d", id)
+	}
+
+	var record types.TokenizeShareRecord
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+
+	return record, nil
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record that issued
+// the given share token denom.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (types.TokenizeShareRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenizeShareRecordIDByDenomKey(denom))
+	if bz == nil {
+		return types.TokenizeShareRecord{}, sdkerrors.Wrapf(types.ErrTokenizeShareRecordNotExists, "denom: %s", denom)
+	}
+
+	return k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// GetTokenizeShareRecordsByOwner returns all tokenize share records owned by
+// the given address.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetTokenizeShareRecordIDsByOwnerPrefix(owner)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Key()[len(prefix):])
+
+		record, err := k.GetTokenizeShareRecord(ctx, id)
+		if err != nil {
+			panic(err)
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// IterateTokenizeShareRecords iterates through all tokenize share records in
+// the order of their ids.
+func (k Keeper) IterateTokenizeShareRecords(ctx sdk.Context, cb func(record types.TokenizeShareRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllTokenizeShareRecords returns all tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (records []types.TokenizeShareRecord) {
+	k.IterateTokenizeShareRecords(ctx, func(record types.TokenizeShareRecord) bool {
+		records = append(records, record)
+		return false
+	})
+
+	return records
+}
+
+// SetTokenizeShareRecord sets a tokenize share record along with its owner
+// and denom indexes.
+func (k Keeper) SetTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	id := sdk.Uint64ToBigEndian(record.Id)
+
+	store.Set(types.GetTokenizeShareRecordByIndexKey(record.Id), k.cdc.MustMarshalBinaryBare(&record))
+	store.Set(types.GetTokenizeShareRecordIDByOwnerAndIDKey(record.Owner, record.Id), []byte{})
+	store.Set(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()), id)
+}
+
+// DeleteTokenizeShareRecord removes a tokenize share record along with its
+// owner and denom indexes.
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, id uint64) error {
+	record, err := k.GetTokenizeShareRecord(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordByIndexKey(record.Id))
+	store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(record.Owner, record.Id))
+	store.Delete(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()))
+
+	return nil
+}
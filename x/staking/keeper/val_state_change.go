@@ -47,6 +47,9 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 		)
 	}
 
+	// Remove all mature consensus key rotations from the rotation queue.
+	k.CompleteConsPubKeyRotations(ctx)
+
 	// Remove all mature redelegations from the red queue.
 	matureRedelegations := k.DequeueAllMatureRedelegationQueue(ctx, ctx.BlockHeader().Time)
 	for _, dvvTriplet := range matureRedelegations {
@@ -96,6 +99,9 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 	// (see LastValidatorPowerKey).
 	last := k.getLastValidatorsByAddr(ctx)
 
+	// Retrieve the validators whose consensus key was rotated in this block.
+	rotations := k.getAndClearBlockConsPubKeyRotations(ctx)
+
 	// Iterate over validators, highest power to lowest.
 	iterator := k.ValidatorsPowerStoreIterator(ctx)
 	defer iterator.Close()
@@ -138,8 +144,16 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		newPower := validator.ConsensusPower()
 		newPowerBytes := k.cdc.MustMarshalBinaryBare(&gogotypes.Int64Value{Value: newPower})
 
-		// update the validator set if power has changed
-		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
+		// a validator whose consensus key was rotated is removed from the
+		// Tendermint validator set under its old key and added back under
+		// its new key
+		rotation, rotated := rotations[valAddrBytes]
+		if found && rotated {
+			updates = append(updates, rotation.OldConsPubKeyUpdateZero())
+		}
+
+		// update the validator set if power or consensus key has changed
+		if !found || rotated || !bytes.Equal(oldPowerBytes, newPowerBytes) {
 			updates = append(updates, validator.ABCIValidatorUpdate())
 
 			k.SetLastValidatorPower(ctx, valAddr, newPower)
@@ -157,7 +171,18 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		validator = k.bondedToUnbonding(ctx, validator)
 		amtFromBondedToNotBonded = amtFromBondedToNotBonded.Add(validator.GetTokens())
 		k.DeleteLastValidatorPower(ctx, validator.GetOperator())
-		updates = append(updates, validator.ABCIValidatorUpdateZero())
+
+		// Tendermint only knows the old consensus key of a validator rotated
+		// in this block
+		var addrBytes [sdk.AddrLen]byte
+
+		copy(addrBytes[:], valAddrBytes)
+
+		if rotation, rotated := rotations[addrBytes]; rotated {
+			updates = append(updates, rotation.OldConsPubKeyUpdateZero())
+		} else {
+			updates = append(updates, validator.ABCIValidatorUpdateZero())
+		}
 	}
 
 	// Update the pools based on the recent updates in the validator set:
@@ -0,0 +1,94 @@
+package v041
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.40 to v0.41. The
+// migration includes:
+//
+// - Set the GlobalLiquidStakingCap, ValidatorLiquidStakingCap and
+// KeyRotationFee params to their defaults.
+// - Set the MinCommissionRate param to minCommissionRate.
+// - Raise the commission rate of every validator below minCommissionRate to
+// minCommissionRate, along with its max rate if it is lower. The commission
+// MaxChangeRate and UpdateTime constraints are bypassed, and the commission
+// UpdateTime is left unchanged.
+// - Move the staking parameters from the x/params subspace to the staking
+// store under types.ParamsKey.
+func MigrateStore(
+	ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler,
+	paramSpace paramtypes.Subspace, minCommissionRate sdk.Dec,
+) error {
+	if minCommissionRate.IsNil() || minCommissionRate.IsNegative() || minCommissionRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid minimum commission rate: %s", minCommissionRate)
+	}
+
+	paramSpace.Set(ctx, types.KeyGlobalLiquidStakingCap, types.DefaultGlobalLiquidStakingCap)
+	paramSpace.Set(ctx, types.KeyValidatorLiquidStakingCap, types.DefaultValidatorLiquidStakingCap)
+	paramSpace.Set(ctx, types.KeyKeyRotationFee, types.DefaultKeyRotationFee)
+	paramSpace.Set(ctx, types.KeyMinCommissionRate, minCommissionRate)
+
+	store := ctx.KVStore(storeKey)
+
+	if err := migrateValidatorCommissions(store, cdc, minCommissionRate); err != nil {
+		return err
+	}
+
+	return migrateParams(ctx, store, cdc, paramSpace)
+}
+
+// migrateParams copies the staking parameters from the x/params subspace to
+// the staking store.
+func migrateParams(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryMarshaler, paramSpace paramtypes.Subspace) error {
+	var params types.Params
+	paramSpace.GetParamSet(ctx, &params)
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.MarshalBinaryBare(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// migrateValidatorCommissions raises the commission rate of the validators
+// below the minimum commission rate.
+func migrateValidatorCommissions(store sdk.KVStore, cdc codec.BinaryMarshaler, minCommissionRate sdk.Dec) error {
+	validatorsStore := prefix.NewStore(store, types.ValidatorsKey)
+
+	iterator := validatorsStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		validator, err := types.UnmarshalValidator(cdc, iterator.Value())
+		if err != nil {
+			return err
+		}
+
+		if validator.Commission.Rate.GTE(minCommissionRate) {
+			continue
+		}
+
+		validator.Commission.Rate = minCommissionRate
+		if validator.Commission.MaxRate.LT(minCommissionRate) {
+			validator.Commission.MaxRate = minCommissionRate
+		}
+
+		validatorsStore.Set(iterator.Key(), types.MustMarshalValidator(cdc, validator))
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package v041_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v041staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_41"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	storeKey := app.GetKey(types.StoreKey)
+	paramSpace := app.GetSubspace(types.ModuleName)
+
+	// write the v0.40 params, which lack the params added in v0.41, to the
+	// subspace
+	legacyParams := types.DefaultParams()
+	legacyParams.MaxValidators = 50
+	paramSpace.Set(ctx, types.KeyUnbondingTime, legacyParams.UnbondingTime)
+	paramSpace.Set(ctx, types.KeyMaxValidators, legacyParams.MaxValidators)
+	paramSpace.Set(ctx, types.KeyMaxEntries, legacyParams.MaxEntries)
+	paramSpace.Set(ctx, types.KeyBondDenom, legacyParams.BondDenom)
+	paramSpace.Set(ctx, types.KeyHistoricalEntries, legacyParams.HistoricalEntries)
+
+	updateTime := time.Unix(100, 0).UTC()
+	commissions := []types.Commission{
+		types.NewCommissionWithTime(sdk.ZeroDec(), sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(1, 2), updateTime),
+		types.NewCommissionWithTime(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2), updateTime),
+		types.NewCommissionWithTime(sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2), updateTime),
+	}
+
+	valAddrs := make([]sdk.ValAddress, len(commissions))
+	for i, commission := range commissions {
+		pk := ed25519.GenPrivKey().PubKey()
+		valAddrs[i] = sdk.ValAddress(pk.Address())
+
+		validator := types.NewValidator(valAddrs[i], pk, types.Description{})
+		validator.Commission = commission
+		app.StakingKeeper.SetValidator(ctx, validator)
+	}
+
+	minCommissionRate := sdk.NewDecWithPrec(5, 2)
+	require.NoError(t, v041staking.MigrateStore(ctx, storeKey, app.AppCodec(), paramSpace, minCommissionRate))
+	require.Equal(t, minCommissionRate, app.StakingKeeper.MinCommissionRate(ctx))
+	require.Equal(t, types.DefaultKeyRotationFee, app.StakingKeeper.KeyRotationFee(ctx))
+	require.Equal(t, legacyParams.MaxValidators, app.StakingKeeper.MaxValidators(ctx))
+
+	expected := []types.Commission{
+		// the max rate is raised along with the rate
+		types.NewCommissionWithTime(minCommissionRate, minCommissionRate, sdk.NewDecWithPrec(1, 2), updateTime),
+		// the max change rate is bypassed
+		types.NewCommissionWithTime(minCommissionRate, sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2), updateTime),
+		// rates above the minimum are unchanged
+		commissions[2],
+	}
+
+	for i, valAddr := range valAddrs {
+		validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+		require.True(t, found)
+		require.Equal(t, expected[i], validator.Commission)
+	}
+
+	// invalid minimum commission rates are rejected
+	require.Error(t, v041staking.MigrateStore(ctx, storeKey, app.AppCodec(), paramSpace, sdk.NewDec(-1)))
+	require.Error(t, v041staking.MigrateStore(ctx, storeKey, app.AppCodec(), paramSpace, sdk.NewDec(2)))
+}
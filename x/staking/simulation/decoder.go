@@ -57,6 +57,34 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &redB)
 
 			return fmt.Sprintf("%v\n%v", redA, redB)
+		case bytes.Equal(kvA.Key[:1], types.TokenizeShareRecordPrefix):
+			var recordA, recordB types.TokenizeShareRecord
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &recordA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &recordB)
+
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+		case bytes.Equal(kvA.Key[:1], types.LastTokenizeShareRecordIDKey),
+			bytes.Equal(kvA.Key[:1], types.TokenizeShareRecordIDByDenomPrefix):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.TokenizeShareRecordIDByOwnerPrefix):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+		case bytes.Equal(kvA.Key[:1], types.ValidatorLiquidSharesPrefix):
+			var sharesA, sharesB sdk.DecProto
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &sharesA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &sharesB)
+
+			return fmt.Sprintf("%v\n%v", sharesA, sharesB)
+		case bytes.Equal(kvA.Key[:1], types.ConsPubKeyRotationKey):
+			var rotationA, rotationB types.ConsPubKeyRotation
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &rotationA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &rotationB)
+
+			return fmt.Sprintf("%v\n%v", rotationA, rotationB)
+		case bytes.Equal(kvA.Key[:1], types.OldToNewConsAddrKey):
+			return fmt.Sprintf("%v\n%v", sdk.ConsAddress(kvA.Value), sdk.ConsAddress(kvB.Value))
 		default:
 			panic(fmt.Sprintf("invalid staking key prefix %X", kvA.Key[:1]))
 		}
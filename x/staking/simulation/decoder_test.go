@@ -41,6 +41,16 @@ func TestDecodeStore(t *testing.T) {
 	del := types.NewDelegation(delAddr1, valAddr1, sdk.OneDec())
 	ubd := types.NewUnbondingDelegation(delAddr1, valAddr1, 15, bondTime, sdk.OneInt())
 	red := types.NewRedelegation(delAddr1, valAddr1, valAddr1, 12, bondTime, sdk.OneInt(), sdk.OneDec())
+	record := types.NewTokenizeShareRecord(1, delAddr1, valAddr1)
+	liquidShares := sdk.DecProto{Dec: sdk.OneDec()}
+	consAddr1 := sdk.ConsAddress(delPk1.Address())
+	rotation := types.ConsPubKeyRotation{
+		OperatorAddress:    valAddr1,
+		OldConsensusPubkey: sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, delPk1),
+		NewConsensusPubkey: sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, ed25519.GenPrivKey().PubKey()),
+		Height:             1,
+		CompletionTime:     bondTime,
+	}
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -50,6 +60,11 @@ func TestDecodeStore(t *testing.T) {
 			{Key: types.GetDelegationKey(delAddr1, valAddr1), Value: cdc.MustMarshalBinaryBare(&del)},
 			{Key: types.GetUBDKey(delAddr1, valAddr1), Value: cdc.MustMarshalBinaryBare(&ubd)},
 			{Key: types.GetREDKey(delAddr1, valAddr1, valAddr1), Value: cdc.MustMarshalBinaryBare(&red)},
+			{Key: types.GetTokenizeShareRecordByIndexKey(1), Value: cdc.MustMarshalBinaryBare(&record)},
+			{Key: types.LastTokenizeShareRecordIDKey, Value: sdk.Uint64ToBigEndian(1)},
+			{Key: types.GetValidatorLiquidSharesKey(valAddr1), Value: cdc.MustMarshalBinaryBare(&liquidShares)},
+			{Key: types.GetConsPubKeyRotationKey(valAddr1), Value: cdc.MustMarshalBinaryBare(&rotation)},
+			{Key: types.GetOldToNewConsAddrKey(consAddr1), Value: consAddr1.Bytes()},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -64,6 +79,11 @@ func TestDecodeStore(t *testing.T) {
 		{"Delegation", fmt.Sprintf("%v\n%v", del, del)},
 		{"UnbondingDelegation", fmt.Sprintf("%v\n%v", ubd, ubd)},
 		{"Redelegation", fmt.Sprintf("%v\n%v", red, red)},
+		{"TokenizeShareRecord", fmt.Sprintf("%v\n%v", record, record)},
+		{"LastTokenizeShareRecordID", "1\n1"},
+		{"ValidatorLiquidShares", fmt.Sprintf("%v\n%v", liquidShares, liquidShares)},
+		{"ConsPubKeyRotation", fmt.Sprintf("%v\n%v", rotation, rotation)},
+		{"OldToNewConsAddr", fmt.Sprintf("%v\n%v", consAddr1, consAddr1)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
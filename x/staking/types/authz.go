@@ -0,0 +1,151 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authzexported "github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+var _ authzexported.Authorization = &StakeAuthorization{}
+
+// NewStakeAuthorization creates a new StakeAuthorization object. Only one of
+// allowed or denied validators may be set.
+func NewStakeAuthorization(allowed []sdk.ValAddress, denied []sdk.ValAddress, authzType AuthorizationType, amount *sdk.Coin) (*StakeAuthorization, error) {
+	if len(allowed) == 0 && len(denied) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "both allowed & deny list cannot be empty")
+	}
+
+	if len(allowed) > 0 && len(denied) > 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot set both allowed & deny list")
+	}
+
+	authorization := StakeAuthorization{
+		MaxTokens:         amount,
+		AuthorizationType: authzType,
+	}
+
+	if len(allowed) > 0 {
+		authorization.Validators = &StakeAuthorization_AllowList{AllowList: &StakeAuthorization_Validators{Address: allowed}}
+	} else {
+		authorization.Validators = &StakeAuthorization_DenyList{DenyList: &StakeAuthorization_Validators{Address: denied}}
+	}
+
+	return &authorization, nil
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a StakeAuthorization) MsgTypeURL() string {
+	authzType, err := normalizeAuthzType(a.AuthorizationType)
+	if err != nil {
+		panic(err)
+	}
+
+	return authzType
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a StakeAuthorization) ValidateBasic() error {
+	if a.MaxTokens != nil && a.MaxTokens.IsNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "negative coin amount: %v", a.MaxTokens)
+	}
+
+	if _, err := normalizeAuthzType(a.AuthorizationType); err != nil {
+		return err
+	}
+
+	switch validators := a.Validators.(type) {
+	case *StakeAuthorization_AllowList:
+		if validators.AllowList == nil || len(validators.AllowList.Address) == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "allow list cannot be empty")
+		}
+	case *StakeAuthorization_DenyList:
+		if validators.DenyList == nil || len(validators.DenyList.Address) == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "deny list cannot be empty")
+		}
+	default:
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "either an allow list or a deny list must be set")
+	}
+
+	return nil
+}
+
+// Accept implements Authorization.Accept. The target validator is checked
+// against the allow or deny list and the amount is deducted from MaxTokens,
+// if set.
+func (a StakeAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authzexported.AcceptResponse, error) {
+	var validatorAddress sdk.ValAddress
+	var amount sdk.Coin
+
+	switch msg := msg.(type) {
+	case *MsgDelegate:
+		validatorAddress = msg.ValidatorAddress
+		amount = msg.Amount
+	case *MsgUndelegate:
+		validatorAddress = msg.ValidatorAddress
+		amount = msg.Amount
+	case *MsgBeginRedelegate:
+		validatorAddress = msg.ValidatorDstAddress
+		amount = msg.Amount
+	default:
+		return authzexported.AcceptResponse{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown msg type")
+	}
+
+	if sdk.MsgTypeURL(msg) != a.MsgTypeURL() {
+		return authzexported.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %s, got %s", a.MsgTypeURL(), sdk.MsgTypeURL(msg))
+	}
+
+	if allowList := a.GetAllowList(); allowList != nil && !containsValidator(allowList.Address, validatorAddress) {
+		return authzexported.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot delegate/undelegate to %s validator", validatorAddress)
+	}
+
+	if denyList := a.GetDenyList(); denyList != nil && containsValidator(denyList.Address, validatorAddress) {
+		return authzexported.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot delegate/undelegate to %s validator", validatorAddress)
+	}
+
+	if a.MaxTokens == nil {
+		return authzexported.AcceptResponse{Accept: true, Delete: false}, nil
+	}
+
+	if a.MaxTokens.Denom != amount.Denom || a.MaxTokens.IsLT(amount) {
+		return authzexported.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "negative coin amount: %v", a.MaxTokens.Amount.Sub(amount.Amount))
+	}
+
+	limitLeft := a.MaxTokens.Sub(amount)
+	if limitLeft.IsZero() {
+		return authzexported.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authzexported.AcceptResponse{
+		Accept: true,
+		Delete: false,
+		Updated: &StakeAuthorization{
+			Validators:        a.GetValidators(),
+			MaxTokens:         &limitLeft,
+			AuthorizationType: a.GetAuthorizationType(),
+		},
+	}, nil
+}
+
+func containsValidator(validators []sdk.ValAddress, addr sdk.ValAddress) bool {
+	for _, v := range validators {
+		if v.Equals(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeAuthzType returns the Msg type URL an AuthorizationType grants.
+func normalizeAuthzType(authzType AuthorizationType) (string, error) {
+	switch authzType {
+	case AuthorizationType_AUTHORIZATION_TYPE_DELEGATE:
+		return sdk.MsgTypeURL(&MsgDelegate{}), nil
+	case AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE:
+		return sdk.MsgTypeURL(&MsgUndelegate{}), nil
+	case AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE:
+		return sdk.MsgTypeURL(&MsgBeginRedelegate{}), nil
+	default:
+		return "", sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "unknown authorization type %s", authzType)
+	}
+}
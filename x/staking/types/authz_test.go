@@ -0,0 +1,178 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestStakeAuthorizationAccept(t *testing.T) {
+	ctx := sdk.Context{}
+	delAddr := sdk.AccAddress(valAddr1)
+	coin100 := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	coin50 := sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)
+	coin150 := sdk.NewInt64Coin(sdk.DefaultBondDenom, 150)
+
+	_, err := NewStakeAuthorization(nil, nil, AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, nil)
+	require.Error(t, err)
+	_, err = NewStakeAuthorization([]sdk.ValAddress{valAddr1}, []sdk.ValAddress{valAddr2}, AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, nil)
+	require.Error(t, err)
+
+	testCases := []struct {
+		msg             string
+		allowed         []sdk.ValAddress
+		denied          []sdk.ValAddress
+		authzType       AuthorizationType
+		limit           *sdk.Coin
+		srvMsg          sdk.Msg
+		expectErr       bool
+		isDelete        bool
+		updatedMaxToken *sdk.Coin
+	}{
+		{
+			"delegate to allowed validator without limit",
+			[]sdk.ValAddress{valAddr1}, nil, AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, nil,
+			NewMsgDelegate(delAddr, valAddr1, coin100), false, false, nil,
+		},
+		{
+			"delegate to a validator not in the allow list",
+			[]sdk.ValAddress{valAddr1}, nil, AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, nil,
+			NewMsgDelegate(delAddr, valAddr2, coin100), true, false, nil,
+		},
+		{
+			"delegate to a denied validator",
+			nil, []sdk.ValAddress{valAddr1}, AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, nil,
+			NewMsgDelegate(delAddr, valAddr1, coin100), true, false, nil,
+		},
+		{
+			"delegate within the limit",
+			nil, []sdk.ValAddress{valAddr2}, AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, &coin100,
+			NewMsgDelegate(delAddr, valAddr1, coin50), false, false, &coin50,
+		},
+		{
+			"delegate the whole limit",
+			[]sdk.ValAddress{valAddr1}, nil, AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, &coin100,
+			NewMsgDelegate(delAddr, valAddr1, coin100), false, true, nil,
+		},
+		{
+			"delegate above the limit",
+			[]sdk.ValAddress{valAddr1}, nil, AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, &coin100,
+			NewMsgDelegate(delAddr, valAddr1, coin150), true, false, nil,
+		},
+		{
+			"undelegate with a delegate authorization",
+			[]sdk.ValAddress{valAddr1}, nil, AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, nil,
+			NewMsgUndelegate(delAddr, valAddr1, coin100), true, false, nil,
+		},
+		{
+			"undelegate from allowed validator",
+			[]sdk.ValAddress{valAddr1}, nil, AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE, &coin100,
+			NewMsgUndelegate(delAddr, valAddr1, coin50), false, false, &coin50,
+		},
+		{
+			"redelegate to allowed destination validator",
+			[]sdk.ValAddress{valAddr2}, nil, AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE, nil,
+			NewMsgBeginRedelegate(delAddr, valAddr1, valAddr2, coin100), false, false, nil,
+		},
+		{
+			"redelegate to denied destination validator",
+			nil, []sdk.ValAddress{valAddr2}, AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE, nil,
+			NewMsgBeginRedelegate(delAddr, valAddr1, valAddr2, coin100), true, false, nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.msg, func(t *testing.T) {
+			authorization, err := NewStakeAuthorization(tc.allowed, tc.denied, tc.authzType, tc.limit)
+			require.NoError(t, err)
+			require.NoError(t, authorization.ValidateBasic())
+
+			resp, err := authorization.Accept(ctx, tc.srvMsg)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, resp.Accept)
+			require.Equal(t, tc.isDelete, resp.Delete)
+			if tc.updatedMaxToken != nil {
+				require.Equal(t, tc.updatedMaxToken, resp.Updated.(*StakeAuthorization).MaxTokens)
+			}
+		})
+	}
+}
+
+func TestStakeAuthorizationValidateBasic(t *testing.T) {
+	coin100 := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	allowList := &StakeAuthorization_AllowList{AllowList: &StakeAuthorization_Validators{Address: []sdk.ValAddress{valAddr1}}}
+	denyList := &StakeAuthorization_DenyList{DenyList: &StakeAuthorization_Validators{Address: []sdk.ValAddress{valAddr1}}}
+
+	testCases := []struct {
+		msg           string
+		authorization StakeAuthorization
+		expectErr     bool
+	}{
+		{
+			"allow list",
+			StakeAuthorization{Validators: allowList, AuthorizationType: AuthorizationType_AUTHORIZATION_TYPE_DELEGATE},
+			false,
+		},
+		{
+			"deny list with max tokens",
+			StakeAuthorization{Validators: denyList, MaxTokens: &coin100, AuthorizationType: AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE},
+			false,
+		},
+		{
+			"negative max tokens",
+			StakeAuthorization{
+				Validators: allowList, MaxTokens: &sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(-1)},
+				AuthorizationType: AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+			},
+			true,
+		},
+		{
+			"unspecified authorization type",
+			StakeAuthorization{Validators: allowList, AuthorizationType: AuthorizationType_AUTHORIZATION_TYPE_UNSPECIFIED},
+			true,
+		},
+		{
+			"unknown authorization type",
+			StakeAuthorization{Validators: allowList, AuthorizationType: AuthorizationType(10)},
+			true,
+		},
+		{
+			"no validator list",
+			StakeAuthorization{AuthorizationType: AuthorizationType_AUTHORIZATION_TYPE_DELEGATE},
+			true,
+		},
+		{
+			"empty allow list",
+			StakeAuthorization{
+				Validators:        &StakeAuthorization_AllowList{AllowList: &StakeAuthorization_Validators{}},
+				AuthorizationType: AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+			},
+			true,
+		},
+		{
+			"nil deny list",
+			StakeAuthorization{Validators: &StakeAuthorization_DenyList{}, AuthorizationType: AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.msg, func(t *testing.T) {
+			err := tc.authorization.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
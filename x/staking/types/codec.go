@@ -5,6 +5,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authzexported "github.com/cosmos/cosmos-sdk/x/authz/exported"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz/types"
 )
 
 // RegisterCodec registers the necessary x/staking interfaces and concrete types
@@ -15,6 +17,16 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDelegate{}, "cosmos-sdk/MsgDelegate", nil)
 	cdc.RegisterConcrete(&MsgUndelegate{}, "cosmos-sdk/MsgUndelegate", nil)
 	cdc.RegisterConcrete(&MsgBeginRedelegate{}, "cosmos-sdk/MsgBeginRedelegate", nil)
+	cdc.RegisterConcrete(&MsgTokenizeShares{}, "cosmos-sdk/MsgTokenizeShares", nil)
+	cdc.RegisterConcrete(&MsgRedeemTokensForShares{}, "cosmos-sdk/MsgRedeemTokensForShares", nil)
+	cdc.RegisterConcrete(&MsgTransferTokenizeShareRecord{}, "cosmos-sdk/MsgTransferTokenizeShareRecord", nil)
+	cdc.RegisterConcrete(&MsgRotateConsPubKey{}, "cosmos-sdk/MsgRotateConsPubKey", nil)
+	cdc.RegisterConcrete(&MsgCancelUnbondingDelegation{}, "cosmos-sdk/MsgCancelUnbondingDelegation", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "cosmos-sdk/x/staking/MsgUpdateParams", nil)
+	cdc.RegisterInterface((*isStakeAuthorization_Validators)(nil), nil)
+	cdc.RegisterConcrete(&StakeAuthorization{}, "cosmos-sdk/StakeAuthorization", nil)
+	cdc.RegisterConcrete(&StakeAuthorization_AllowList{}, "cosmos-sdk/StakeAuthorization/AllowList", nil)
+	cdc.RegisterConcrete(&StakeAuthorization_DenyList{}, "cosmos-sdk/StakeAuthorization/DenyList", nil)
 }
 
 // RegisterInterfaces registers the x/staking interfaces types with the interface registry
@@ -25,6 +37,17 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDelegate{},
 		&MsgUndelegate{},
 		&MsgBeginRedelegate{},
+		&MsgTokenizeShares{},
+		&MsgRedeemTokensForShares{},
+		&MsgTransferTokenizeShareRecord{},
+		&MsgRotateConsPubKey{},
+		&MsgCancelUnbondingDelegation{},
+		&MsgUpdateParams{},
+	)
+
+	registry.RegisterImplementations(
+		(*authzexported.Authorization)(nil),
+		&StakeAuthorization{},
 	)
 }
 
@@ -44,4 +67,11 @@ func init() {
 	RegisterCodec(amino)
 	cryptocodec.RegisterCrypto(amino)
 	amino.Seal()
+
+	// StakeAuthorization is carried inside authz messages, so it must be
+	// known to the authz amino codec as well.
+	authztypes.RegisterAuthorizationInterfaceCodec((*isStakeAuthorization_Validators)(nil))
+	authztypes.RegisterAuthorizationTypeCodec(&StakeAuthorization{}, "cosmos-sdk/StakeAuthorization")
+	authztypes.RegisterAuthorizationTypeCodec(&StakeAuthorization_AllowList{}, "cosmos-sdk/StakeAuthorization/AllowList")
+	authztypes.RegisterAuthorizationTypeCodec(&StakeAuthorization_DenyList{}, "cosmos-sdk/StakeAuthorization/DenyList")
 }
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	cryptoenc "github.com/tendermint/tendermint/crypto/encoding"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetOldConsPubKey returns the consensus public key the validator rotated
+// away from.
+func (r ConsPubKeyRotation) GetOldConsPubKey() crypto.PubKey {
+	return sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, r.OldConsensusPubkey)
+}
+
+// GetNewConsPubKey returns the consensus public key the validator rotated to.
+func (r ConsPubKeyRotation) GetNewConsPubKey() crypto.PubKey {
+	return sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, r.NewConsensusPubkey)
+}
+
+// OldConsPubKeyUpdateZero returns an abci.ValidatorUpdate removing the old
+// consensus public key from the Tendermint validator set.
+func (r ConsPubKeyRotation) OldConsPubKeyUpdateZero() abci.ValidatorUpdate {
+	pk, err := cryptoenc.PubKeyToProto(r.GetOldConsPubKey())
+	if err != nil {
+		panic(err)
+	}
+
+	return abci.ValidatorUpdate{
+		PubKey: pk,
+		Power:  0,
+	}
+}
+
+// Validate performs a stateless validation of the rotation.
+func (r ConsPubKeyRotation) Validate() error {
+	if r.OperatorAddress.Empty() {
+		return ErrEmptyValidatorAddr
+	}
+
+	oldPubKey, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, r.OldConsensusPubkey)
+	if err != nil {
+		return fmt.Errorf("invalid old consensus pubkey of validator %s: %w", r.OperatorAddress, err)
+	}
+
+	newPubKey, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, r.NewConsensusPubkey)
+	if err != nil {
+		return fmt.Errorf("invalid new consensus pubkey of validator %s: %w", r.OperatorAddress, err)
+	}
+
+	if oldPubKey.Equals(newPubKey) {
+		return fmt.Errorf("old and new consensus pubkeys of validator %s are the same", r.OperatorAddress)
+	}
+
+	return nil
+}
@@ -57,4 +57,15 @@ var (
 	ErrInvalidHistoricalInfo           = sdkerrors.Register(ModuleName, 45, "invalid historical info")
 	ErrNoHistoricalInfo                = sdkerrors.Register(ModuleName, 46, "no historical info found")
 	ErrEmptyValidatorPubKey            = sdkerrors.Register(ModuleName, 47, "empty validator public key")
+	ErrTokenizeShareRecordNotExists    = sdkerrors.Register(ModuleName, 48, "tokenize share record does not exist")
+	ErrNotTokenizeShareRecordOwner     = sdkerrors.Register(ModuleName, 49, "sender is not the owner of the tokenize share record")
+	ErrExceedingFreeVestingDelegations = sdkerrors.Register(ModuleName, 50, "trying to exceed vested free delegation for vesting account")
+	ErrRedelegationInProgress          = sdkerrors.Register(ModuleName, 51, "delegator is not allowed to tokenize shares from validator with a redelegation in progress")
+	ErrGlobalLiquidCapExceeded         = sdkerrors.Register(ModuleName, 52, "delegation or tokenization exceeds the global cap")
+	ErrValidatorLiquidCapExceeded      = sdkerrors.Register(ModuleName, 53, "delegation or tokenization exceeds the validator cap")
+	ErrOnlyBondDenomAllowedForTokenize = sdkerrors.Register(ModuleName, 54, "only bond denom is allowed for tokenize")
+	ErrConsPubKeyRotationInProgress    = sdkerrors.Register(ModuleName, 55, "validator consensus key rotation already in progress")
+	ErrCommissionLTMinRate             = sdkerrors.Register(ModuleName, 56, "commission cannot be less than min rate")
+	ErrNoUnbondingDelegationEntry      = sdkerrors.Register(ModuleName, 57, "no unbonding delegation entry found at the creation height")
+	ErrBadCancelUnbondingAmount        = sdkerrors.Register(ModuleName, 58, "amount is greater than the unbonding delegation entry balance")
 )
@@ -9,6 +9,12 @@ const (
 	EventTypeDelegate             = "delegate"
 	EventTypeUnbond               = "unbond"
 	EventTypeRedelegate           = "redelegate"
+	EventTypeTokenizeShares       = "tokenize_shares"
+	EventTypeRedeemShares         = "redeem_tokens_for_shares"
+	EventTypeTransferShareRecord  = "transfer_tokenize_share_record"
+	EventTypeRotateConsPubKey     = "rotate_cons_pubkey"
+	EventTypeCompleteKeyRotation  = "complete_cons_pubkey_rotation"
+	EventTypeCancelUnbonding      = "cancel_unbonding_delegation"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -17,5 +23,11 @@ const (
 	AttributeKeyDstValidator      = "destination_validator"
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyCompletionTime    = "completion_time"
+	AttributeKeyShareOwner        = "share_owner"
+	AttributeKeyShareRecordID     = "share_record_id"
+	AttributeKeyNewOwner          = "new_owner"
+	AttributeKeyOldConsPubKey     = "old_consensus_pubkey"
+	AttributeKeyNewConsPubKey     = "new_consensus_pubkey"
+	AttributeKeyCreationHeight    = "creation_height"
 	AttributeValueCategory        = ModuleName
 )
@@ -43,6 +43,14 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// tokenize_share_records defines the tokenize share records active at genesis.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,9,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records" yaml:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the most recently created
+	// tokenize share record.
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,10,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty" yaml:"last_tokenize_share_record_id"`
+	// cons_pub_key_rotations defines the consensus key rotations which have not
+	// completed yet.
+	ConsPubKeyRotations []ConsPubKeyRotation `protobuf:"bytes,11,rep,name=cons_pub_key_rotations,json=consPubKeyRotations,proto3" json:"cons_pub_key_rotations" yaml:"cons_pub_key_rotations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -127,6 +135,27 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetConsPubKeyRotations() []ConsPubKeyRotation {
+	if m != nil {
+		return m.ConsPubKeyRotations
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -192,38 +221,46 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 493 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x1c, 0x86, 0x6b, 0xba, 0x76, 0x95, 0x5b, 0x10, 0x32, 0x1d, 0x44, 0x15, 0x4a, 0x4a, 0x54, 0xa1,
-	0x0a, 0x58, 0xa2, 0x8e, 0x1b, 0xe2, 0x42, 0x84, 0x98, 0x06, 0x1c, 0xaa, 0x00, 0x3b, 0x70, 0xa9,
-	0xdc, 0xc5, 0x0a, 0xd1, 0xd2, 0x38, 0xca, 0xcf, 0xdd, 0x9f, 0x3b, 0xe2, 0xcc, 0xc7, 0xda, 0x71,
-	0x07, 0x0e, 0x88, 0x43, 0x84, 0xda, 0x6f, 0xb0, 0x23, 0x27, 0x14, 0x3b, 0x0d, 0xa1, 0x5d, 0x10,
-	0xa7, 0xd6, 0xd6, 0xf3, 0x3e, 0xaf, 0xed, 0xd8, 0x78, 0x70, 0xc4, 0x61, 0xc6, 0xc1, 0x06, 0x41,
-	0x8f, 0x83, 0xc8, 0xb7, 0x4f, 0x46, 0x53, 0x26, 0xe8, 0xc8, 0xf6, 0x59, 0xc4, 0x20, 0x00, 0x2b,
-	0x4e, 0xb8, 0xe0, 0xe4, 0xae, 0xa2, 0xac, 0x9c, 0xb2, 0x72, 0xaa, 0xd7, 0xf5, 0xb9, 0xcf, 0x25,
-	0x62, 0x67, 0xff, 0x14, 0xdd, 0xab, 0x72, 0xae, 0xd2, 0x92, 0x32, 0xbf, 0x35, 0x70, 0x67, 0x5f,
-	0xb5, 0xbc, 0x13, 0x54, 0x30, 0xf2, 0x1c, 0x37, 0x63, 0x9a, 0xd0, 0x19, 0x68, 0xa8, 0x8f, 0x86,
-	0xed, 0x3d, 0xdd, 0xba, 0xbe, 0xd5, 0x1a, 0x4b, 0xca, 0xd9, 0xba, 0x48, 0x8d, 0x9a, 0x9b, 0x67,
-	0x08, 0xe0, 0xdb, 0x21, 0x05, 0x31, 0x11, 0x5c, 0xd0, 0x70, 0x12, 0xf3, 0x53, 0x96, 0x68, 0x37,
-	0xfa, 0x68, 0xd8, 0x71, 0x0e, 0x32, 0xee, 0x47, 0x6a, 0x3c, 0xf4, 0x03, 0xf1, 0x69, 0x3e, 0xb5,
-	0x8e, 0xf8, 0xcc, 0xce, 0x57, 0xa8, 0x7e, 0x76, 0xc1, 0x3b, 0xb6, 0xc5, 0x79, 0xcc, 0xc0, 0x3a,
-	0x88, 0xc4, 0x55, 0x6a, 0xdc, 0x3b, 0xa7, 0xb3, 0xf0, 0x99, 0xb9, 0xee, 0x33, 0xdd, 0x5b, 0xd9,
-	0xd4, 0xfb, 0x6c, 0x66, 0x9c, 0x4d, 0x90, 0xcf, 0x08, 0xef, 0x48, 0xea, 0x84, 0x86, 0x81, 0x47,
-	0x05, 0x4f, 0x14, 0x09, 0x5a, 0xbd, 0x5f, 0x1f, 0xb6, 0xf7, 0x1e, 0x55, 0x6d, 0xe1, 0x2d, 0x05,
-	0x71, 0xb8, 0xca, 0x48, 0x97, 0x33, 0xc8, 0x96, 0x79, 0x95, 0x1a, 0xf7, 0x4b, 0xe5, 0xeb, 0x5a,
-	0xd3, 0xbd, 0x13, 0x6e, 0x24, 0x81, 0xec, 0x63, 0x5c, 0x90, 0xa0, 0x6d, 0xc9, 0xea, 0x07, 0x55,
-	0xd5, 0x45, 0x38, 0x3f, 0xc0, 0x52, 0x94, 0xbc, 0xc6, 0x6d, 0x8f, 0x85, 0xcc, 0xa7, 0x22, 0xe0,
-	0x11, 0x68, 0x0d, 0x69, 0x32, 0xab, 0x4c, 0x2f, 0x0b, 0x34, 0x57, 0x95, 0xc3, 0xe4, 0x0b, 0xc2,
-	0x3b, 0xf3, 0x68, 0xca, 0x23, 0x2f, 0x88, 0xfc, 0x49, 0x59, 0xdb, 0x94, 0xda, 0xc7, 0x55, 0xda,
-	0x0f, 0xab, 0x50, 0xc9, 0xbf, 0x76, 0x38, 0xd7, 0x7a, 0x4d, 0xb7, 0x3b, 0xdf, 0x8c, 0x02, 0x19,
-	0xe3, 0x9b, 0x09, 0x2b, 0xf7, 0x6f, 0xcb, 0xfe, 0x41, 0x55, 0xbf, 0x5b, 0x82, 0xf3, 0x8d, 0xfd,
-	0x2d, 0x20, 0x3d, 0xdc, 0x62, 0x67, 0x31, 0x4f, 0x04, 0xf3, 0xb4, 0x56, 0x1f, 0x0d, 0x5b, 0x6e,
-	0x31, 0x36, 0x4f, 0x31, 0xd9, 0xfc, 0xb8, 0xe4, 0x0d, 0xde, 0xa6, 0x9e, 0x97, 0x30, 0x50, 0x97,
-	0xbb, 0xe3, 0x8c, 0x7e, 0xa5, 0xc6, 0xee, 0x7f, 0x5c, 0xc8, 0x43, 0x1a, 0xbe, 0x50, 0x41, 0x77,
-	0x65, 0x20, 0x5d, 0xdc, 0xf8, 0x73, 0xbf, 0xeb, 0xae, 0x1a, 0x38, 0xaf, 0x2e, 0x16, 0x3a, 0xba,
-	0x5c, 0xe8, 0xe8, 0xe7, 0x42, 0x47, 0x5f, 0x97, 0x7a, 0xed, 0x72, 0xa9, 0xd7, 0xbe, 0x2f, 0xf5,
-	0xda, 0xc7, 0x27, 0xff, 0xec, 0x39, 0x2b, 0xde, 0xa9, 0x6c, 0x9c, 0x36, 0xe5, 0xf3, 0x7c, 0xfa,
-	0x3b, 0x00, 0x00, 0xff, 0xff, 0xac, 0x6c, 0x97, 0xa5, 0x1a, 0x04, 0x00, 0x00,
+	// 618 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xbf, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc6, 0xe3, 0x6f, 0x7f, 0xe5, 0x7b, 0x29, 0x08, 0x5d, 0xd3, 0x62, 0x2a, 0x6a, 0x07, 0x2b,
+	0x20, 0x0b, 0xa8, 0xad, 0x96, 0x0d, 0xb1, 0x60, 0x10, 0x55, 0x29, 0x43, 0x74, 0x2d, 0x1d, 0x58,
+	0xac, 0x73, 0x7c, 0x72, 0x4d, 0x1c, 0x9f, 0xe5, 0xbb, 0xb4, 0x0d, 0x33, 0x42, 0x8c, 0xfc, 0x59,
+	0x1d, 0x3b, 0x30, 0x20, 0x06, 0x0b, 0xb5, 0xff, 0x41, 0x47, 0x26, 0xe4, 0xb3, 0x13, 0xdc, 0xd8,
+	0xae, 0x98, 0x92, 0x3b, 0x3d, 0x9f, 0xe7, 0xb9, 0xf7, 0xfc, 0xde, 0x0b, 0xba, 0x7d, 0xca, 0x86,
+	0x94, 0x99, 0x8c, 0xe3, 0x81, 0x1f, 0x7a, 0xe6, 0xf1, 0x96, 0x43, 0x38, 0xde, 0x32, 0x3d, 0x12,
+	0x12, 0xe6, 0x33, 0x23, 0x8a, 0x29, 0xa7, 0x70, 0x2d, 0x53, 0x19, 0xb9, 0xca, 0xc8, 0x55, 0xeb,
+	0x6d, 0x8f, 0x7a, 0x54, 0x48, 0xcc, 0xf4, 0x5f, 0xa6, 0x5e, 0xaf, 0xf3, 0x9c, 0xd0, 0x42, 0xa5,
+	0x7d, 0x6f, 0x82, 0xe5, 0x9d, 0x2c, 0x65, 0x9f, 0x63, 0x4e, 0xe0, 0x0b, 0xb0, 0x18, 0xe1, 0x18,
+	0x0f, 0x99, 0x2c, 0x75, 0x24, 0xbd, 0xb5, 0xad, 0x18, 0xd5, 0xa9, 0x46, 0x4f, 0xa8, 0xac, 0xf9,
+	0xb3, 0x44, 0x6d, 0xa0, 0x9c, 0x81, 0x0c, 0xdc, 0x09, 0x30, 0xe3, 0x36, 0xa7, 0x1c, 0x07, 0x76,
+	0x44, 0x4f, 0x48, 0x2c, 0xff, 0xd7, 0x91, 0xf4, 0x65, 0x6b, 0x37, 0xd5, 0xfd, 0x4c, 0xd4, 0x47,
+	0x9e, 0xcf, 0x8f, 0x46, 0x8e, 0xd1, 0xa7, 0x43, 0x33, 0x3f, 0x61, 0xf6, 0xb3, 0xc9, 0xdc, 0x81,
+	0xc9, 0xc7, 0x11, 0x61, 0xc6, 0x6e, 0xc8, 0xaf, 0x12, 0xf5, 0xee, 0x18, 0x0f, 0x83, 0xe7, 0xda,
+	0xac, 0x9f, 0x86, 0x6e, 0xa7, 0x5b, 0x07, 0xe9, 0x4e, 0x2f, 0xdd, 0x80, 0x9f, 0x25, 0xb0, 0x2a,
+	0x54, 0xc7, 0x38, 0xf0, 0x5d, 0xcc, 0x69, 0x9c, 0x29, 0x99, 0x3c, 0xd7, 0x99, 0xd3, 0x5b, 0xdb,
+	0x8f, 0xeb, 0x4a, 0x78, 0x87, 0x19, 0x3f, 0x9c, 0x30, 0xc2, 0xcb, 0xea, 0xa6, 0xc7, 0xbc, 0x4a,
+	0xd4, 0xfb, 0x85, 0xf0, 0x59, 0x5b, 0x0d, 0xad, 0x04, 0x25, 0x92, 0xc1, 0x1d, 0x00, 0xa6, 0x4a,
+	0x26, 0xcf, 0x8b, 0xe8, 0x07, 0x75, 0xd1, 0x53, 0x38, 0xbf, 0xc0, 0x02, 0x0a, 0xdf, 0x82, 0x96,
+	0x4b, 0x02, 0xe2, 0x61, 0xee, 0xd3, 0x90, 0xc9, 0x0b, 0xc2, 0x49, 0xab, 0x73, 0x7a, 0x3d, 0x95,
+	0xe6, 0x56, 0x45, 0x18, 0x7e, 0x91, 0xc0, 0xea, 0x28, 0x74, 0x68, 0xe8, 0xfa, 0xa1, 0x67, 0x17,
+	0x6d, 0x17, 0x85, 0xed, 0x93, 0x3a, 0xdb, 0xf7, 0x13, 0xa8, 0xe0, 0x3f, 0x73, 0x39, 0x95, 0xbe,
+	0x1a, 0x6a, 0x8f, 0xca, 0x28, 0x83, 0x3d, 0x70, 0x2b, 0x26, 0xc5, 0xfc, 0x25, 0x91, 0xdf, 0xad,
+	0xcb, 0x47, 0xc4, 0x9d, 0x2d, 0xec, 0xba, 0x01, 0x5c, 0x07, 0x4d, 0x72, 0x1a, 0xd1, 0x98, 0x13,
+	0x57, 0x6e, 0x76, 0x24, 0xbd, 0x89, 0xa6, 0x6b, 0xf8, 0x55, 0x02, 0x6b, 0x9c, 0x0e, 0x48, 0xe8,
+	0x7f, 0x22, 0x36, 0x3b, 0xc2, 0x31, 0xb1, 0x63, 0xd2, 0xa7, 0xb1, 0xcb, 0xe4, 0xff, 0x6f, 0xae,
+	0xfb, 0x20, 0xa7, 0xf6, 0x53, 0x08, 0x09, 0xc6, 0x7a, 0x98, 0xd7, 0xbd, 0x91, 0xd5, 0x5d, 0x6d,
+	0xac, 0xa1, 0x36, 0x2f, 0xb3, 0x0c, 0x7e, 0x04, 0x1b, 0x79, 0x0b, 0x57, 0x50, 0xb6, 0xef, 0xca,
+	0xa0, 0x23, 0xe9, 0xf3, 0x96, 0x7e, 0x95, 0xa8, 0xdd, 0x6b, 0x1d, 0x5f, 0x2d, 0xd7, 0xd0, 0xbd,
+	0xac, 0xfd, 0x4b, 0x51, 0xbb, 0x6e, 0xfa, 0xb5, 0xd7, 0xfa, 0x34, 0x64, 0x76, 0x34, 0x72, 0xec,
+	0x01, 0x19, 0xdb, 0x31, 0xe5, 0xf9, 0x75, 0xb7, 0x6e, 0x7e, 0x0a, 0xaf, 0x68, 0xc8, 0x7a, 0x23,
+	0x67, 0x8f, 0x8c, 0x51, 0x8e, 0xcc, 0x56, 0x5d, 0xed, 0xab, 0xa1, 0x95, 0x7e, 0x09, 0x65, 0xda,
+	0x09, 0x80, 0xe5, 0xc7, 0x05, 0xf7, 0xc0, 0x12, 0x76, 0xdd, 0x98, 0xb0, 0x6c, 0xb8, 0x2c, 0x5b,
+	0x5b, 0xbf, 0x13, 0x75, 0xf3, 0x1f, 0x06, 0xc2, 0x21, 0x0e, 0x5e, 0x66, 0x20, 0x9a, 0x38, 0xc0,
+	0x36, 0x58, 0xf8, 0x3b, 0x5f, 0xe6, 0x50, 0xb6, 0xb0, 0xde, 0x9c, 0x5d, 0x28, 0xd2, 0xf9, 0x85,
+	0x22, 0xfd, 0xba, 0x50, 0xa4, 0x6f, 0x97, 0x4a, 0xe3, 0xfc, 0x52, 0x69, 0xfc, 0xb8, 0x54, 0x1a,
+	0x1f, 0x9e, 0xde, 0x98, 0x73, 0x3a, 0x9d, 0x93, 0x22, 0xd1, 0x59, 0x14, 0xe3, 0xf1, 0xd9, 0x9f,
+	0x01, 0x00, 0x68, 0xef, 0x7d, 0x11, 0x9a, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -246,6 +283,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConsPubKeyRotations) > 0 {
+		for iNdEx := len(m.ConsPubKeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsPubKeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -438,6 +508,21 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
+	if len(m.ConsPubKeyRotations) > 0 {
+		for _, e := range m.ConsPubKeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -748,6 +833,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsPubKeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsPubKeyRotations = append(m.ConsPubKeyRotations, ConsPubKeyRotation{})
+			if err := m.ConsPubKeyRotations[len(m.ConsPubKeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
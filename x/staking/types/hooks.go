@@ -1,6 +1,8 @@
 package types
 
 import (
+	"github.com/tendermint/tendermint/crypto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -61,3 +63,8 @@ func (h MultiStakingHooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.V
 		h[i].BeforeValidatorSlashed(ctx, valAddr, fraction)
 	}
 }
+func (h MultiStakingHooks) AfterConsensusPubKeyUpdate(ctx sdk.Context, oldPubKey, newPubKey crypto.PubKey) {
+	for i := range h {
+		h[i].AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey)
+	}
+}
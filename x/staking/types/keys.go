@@ -46,6 +46,18 @@ var (
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+	ParamsKey         = []byte{0x51} // key for the staking parameters
+
+	LastTokenizeShareRecordIDKey       = []byte{0x61} // key for the last tokenize share record id
+	TokenizeShareRecordPrefix          = []byte{0x62} // key for a tokenize share record, by id
+	TokenizeShareRecordIDByOwnerPrefix = []byte{0x63} // prefix for each key for a tokenize share record id, by owner
+	TokenizeShareRecordIDByDenomPrefix = []byte{0x64} // prefix for each key for a tokenize share record id, by share denom
+	ValidatorLiquidSharesPrefix        = []byte{0x65} // prefix for each key for the tokenized shares of a validator
+
+	ConsPubKeyRotationKey      = []byte{0x71} // prefix for each key to the consensus key rotation of a validator
+	OldToNewConsAddrKey        = []byte{0x72} // prefix for each key to the new consensus address of a rotated consensus address
+	ConsPubKeyRotationQueueKey = []byte{0x73} // prefix for the timestamps in consensus key rotation queue
+	BlockConsPubKeyRotationKey = []byte{0x74} // prefix for each key to a validator whose consensus key was rotated in the current block
 )
 
 // gets the key for the validator with address
@@ -325,3 +337,64 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetTokenizeShareRecordByIndexKey returns the key of a tokenize share record.
+func GetTokenizeShareRecordByIndexKey(id uint64) []byte {
+	return append(TokenizeShareRecordPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDsByOwnerPrefix returns the prefix of the tokenize
+// share record ids owned by an address.
+func GetTokenizeShareRecordIDsByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByOwnerPrefix, owner.Bytes()...)
+}
+
+// GetTokenizeShareRecordIDByOwnerAndIDKey returns the owner index key of a
+// tokenize share record.
+// VALUE: none (key rearrangement used)
+func GetTokenizeShareRecordIDByOwnerAndIDKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIDsByOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDByDenomKey returns the denom index key of a
+// tokenize share record.
+// VALUE: record id (uint64)
+func GetTokenizeShareRecordIDByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordIDByDenomPrefix, []byte(denom)...)
+}
+
+// GetValidatorLiquidSharesKey returns the key of the tokenized delegator
+// shares of a validator.
+// VALUE: sdk.Dec
+func GetValidatorLiquidSharesKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesPrefix, valAddr.Bytes()...)
+}
+
+// GetConsPubKeyRotationKey returns the key of the consensus key rotation of a
+// validator.
+// VALUE: staking/ConsPubKeyRotation
+func GetConsPubKeyRotationKey(valAddr sdk.ValAddress) []byte {
+	return append(ConsPubKeyRotationKey, valAddr.Bytes()...)
+}
+
+// GetOldToNewConsAddrKey returns the key of the new consensus address of a
+// rotated consensus address.
+// VALUE: sdk.ConsAddress
+func GetOldToNewConsAddrKey(oldConsAddr sdk.ConsAddress) []byte {
+	return append(OldToNewConsAddrKey, oldConsAddr.Bytes()...)
+}
+
+// GetConsPubKeyRotationTimeKey returns the key prefix of all consensus key
+// rotations completing at a given time.
+// VALUE: staking/ValAddresses
+func GetConsPubKeyRotationTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(ConsPubKeyRotationQueueKey, bz...)
+}
+
+// GetBlockConsPubKeyRotationKey returns the key marking that the consensus key
+// of a validator was rotated in the current block.
+// VALUE: none (key rearrangement used)
+func GetBlockConsPubKeyRotationKey(valAddr sdk.ValAddress) []byte {
+	return append(BlockConsPubKeyRotationKey, valAddr.Bytes()...)
+}
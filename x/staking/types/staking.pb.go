@@ -859,6 +859,17 @@ type Params struct {
 	MaxEntries        uint32        `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty" yaml:"max_entries"`
 	HistoricalEntries uint32        `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty" yaml:"historical_entries"`
 	BondDenom         string        `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty" yaml:"bond_denom"`
+	// global_liquid_staking_cap is the maximum fraction of the total bonded
+	// tokens that may be tokenized into liquid staking shares.
+	GlobalLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"global_liquid_staking_cap" yaml:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of a validator's
+	// delegator shares that may be tokenized into liquid staking shares.
+	ValidatorLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_liquid_staking_cap" yaml:"validator_liquid_staking_cap"`
+	// key_rotation_fee is the fee charged to a validator operator for rotating
+	// the validator's consensus public key. The fee is burned.
+	KeyRotationFee types1.Coin `protobuf:"bytes,8,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee" yaml:"key_rotation_fee"`
+	// min_commission_rate is the minimum commission rate a validator may set.
+	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -928,6 +939,13 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetKeyRotationFee() types1.Coin {
+	if m != nil {
+		return m.KeyRotationFee
+	}
+	return types1.Coin{}
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a balance
 // in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1123,6 +1141,161 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// TokenizeShareRecord represents a delegation that has been tokenized into
+// transferable share tokens. The delegation itself is held by a dedicated
+// module account while the record owner is entitled to its rewards.
+type TokenizeShareRecord struct {
+	Id    uint64                                        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	// module_account is the name of the module account holding the delegation.
+	ModuleAccount string                                        `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty" yaml:"module_account"`
+	Validator     github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,4,opt,name=validator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+// ConsPubKeyRotation represents the rotation of a validator's consensus public
+// key. It is kept for an unbonding period after the rotation, during which the
+// old consensus address keeps resolving to the validator so that evidence and
+// signatures produced with the old key are still attributed to it.
+type ConsPubKeyRotation struct {
+	OperatorAddress    github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"operator_address,omitempty" yaml:"operator_address"`
+	OldConsensusPubkey string                                        `protobuf:"bytes,2,opt,name=old_consensus_pubkey,json=oldConsensusPubkey,proto3" json:"old_consensus_pubkey,omitempty" yaml:"old_consensus_pubkey"`
+	NewConsensusPubkey string                                        `protobuf:"bytes,3,opt,name=new_consensus_pubkey,json=newConsensusPubkey,proto3" json:"new_consensus_pubkey,omitempty" yaml:"new_consensus_pubkey"`
+	// height is the block height at which the rotation happened.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// completion_time is the time at which the old consensus key stops being
+	// mapped to the validator.
+	CompletionTime time.Time `protobuf:"bytes,5,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+}
+
+func (m *ConsPubKeyRotation) Reset()         { *m = ConsPubKeyRotation{} }
+func (m *ConsPubKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*ConsPubKeyRotation) ProtoMessage()    {}
+func (*ConsPubKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
+}
+func (m *ConsPubKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsPubKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsPubKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsPubKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsPubKeyRotation.Merge(m, src)
+}
+func (m *ConsPubKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsPubKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsPubKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsPubKeyRotation proto.InternalMessageInfo
+
+func (m *ConsPubKeyRotation) GetOperatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return nil
+}
+
+func (m *ConsPubKeyRotation) GetOldConsensusPubkey() string {
+	if m != nil {
+		return m.OldConsensusPubkey
+	}
+	return ""
+}
+
+func (m *ConsPubKeyRotation) GetNewConsensusPubkey() string {
+	if m != nil {
+		return m.NewConsensusPubkey
+	}
+	return ""
+}
+
+func (m *ConsPubKeyRotation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ConsPubKeyRotation) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
 	proto.RegisterType((*CommissionRates)(nil), "cosmos.staking.v1beta1.CommissionRates")
@@ -1144,6 +1317,8 @@ func init() {
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
+	proto.RegisterType((*ConsPubKeyRotation)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotation")
 }
 
 func init() {
@@ -1151,112 +1326,130 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1680 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcb, 0x6f, 0x1b, 0xc7,
-	0x19, 0xd7, 0x92, 0x32, 0x45, 0x7d, 0x94, 0x44, 0x71, 0x6c, 0xab, 0x94, 0xea, 0x72, 0xe5, 0xad,
-	0xd1, 0xaa, 0x40, 0x4d, 0x55, 0x6a, 0xd1, 0xa2, 0xba, 0xb4, 0xa6, 0x68, 0x43, 0x42, 0xed, 0x56,
-	0x5e, 0xb9, 0x2a, 0xd0, 0x1a, 0x25, 0x86, 0xbb, 0x23, 0x6a, 0x2b, 0xee, 0x2e, 0xbb, 0x33, 0xb4,
-	0x25, 0xc3, 0x87, 0x5e, 0x0a, 0xb4, 0x05, 0x8a, 0xfa, 0xe8, 0xa3, 0xd0, 0xfe, 0x01, 0xfd, 0x0f,
-	0xda, 0xab, 0x83, 0x5c, 0x9c, 0x4b, 0x10, 0x04, 0x01, 0x13, 0xd8, 0x97, 0x24, 0x97, 0x04, 0x3c,
-	0xe4, 0x90, 0x4b, 0x82, 0x79, 0xec, 0x43, 0x4b, 0xd1, 0x16, 0x95, 0x00, 0x09, 0x10, 0x5f, 0xa4,
-	0x9d, 0x6f, 0xbe, 0xd7, 0xfc, 0xbe, 0xc7, 0x3c, 0x08, 0x57, 0x2c, 0x9f, 0xba, 0x3e, 0x5d, 0xa6,
-	0x0c, 0xef, 0x3b, 0x5e, 0x6b, 0xf9, 0xde, 0x4a, 0x93, 0x30, 0xbc, 0x12, 0x8e, 0xab, 0x9d, 0xc0,
-	0x67, 0x3e, 0x9a, 0x93, 0x5c, 0xd5, 0x90, 0xaa, 0xb8, 0x16, 0x2e, 0xb4, 0xfc, 0x96, 0x2f, 0x58,
-	0x96, 0xf9, 0x97, 0xe4, 0x5e, 0xb8, 0xc4, 0x88, 0x67, 0x93, 0xc0, 0x75, 0x3c, 0xb6, 0xcc, 0x0e,
-	0x3b, 0x84, 0xca, 0xbf, 0x6a, 0x56, 0x6f, 0xf9, 0x7e, 0xab, 0x4d, 0x96, 0xc5, 0xa8, 0xd9, 0xdd,
-	0x5d, 0x66, 0x8e, 0x4b, 0x28, 0xc3, 0x6e, 0x47, 0x31, 0x54, 0xd2, 0x0c, 0x76, 0x37, 0xc0, 0xcc,
-	0xf1, 0xbd, 0x70, 0x5e, 0xb9, 0xdc, 0xc4, 0x94, 0x44, 0xfe, 0x5a, 0xbe, 0xa3, 0xe6, 0x8d, 0xbf,
-	0x6b, 0x30, 0xb3, 0xe1, 0x50, 0xe6, 0x07, 0x8e, 0x85, 0xdb, 0x9b, 0xde, 0xae, 0x8f, 0x7e, 0x0a,
-	0xb9, 0x3d, 0x82, 0x6d, 0x12, 0x94, 0xb5, 0x45, 0x6d, 0xa9, 0xb0, 0x5a, 0xae, 0xc6, 0x2e, 0x56,
-	0xa5, 0x73, 0x1b, 0x62, 0xbe, 0x36, 0xfe, 0xa4, 0xa7, 0x8f, 0x99, 0x8a, 0x1b, 0xfd, 0x02, 0x72,
-	0xf7, 0x70, 0x9b, 0x12, 0x56, 0xce, 0x2c, 0x66, 0x97, 0x0a, 0xab, 0x97, 0xab, 0x27, 0x03, 0x51,
-	0xdd, 0xc1, 0x6d, 0xc7, 0xc6, 0xcc, 0x8f, 0x14, 0x48, 0x31, 0xe3, 0xbf, 0x19, 0x28, 0xae, 0xfb,
-	0xae, 0xeb, 0x50, 0xea, 0xf8, 0x9e, 0x89, 0x19, 0xa1, 0xa8, 0x06, 0xe3, 0x01, 0x66, 0x44, 0xb8,
-	0x32, 0x59, 0xab, 0x72, 0xfe, 0xb7, 0x7b, 0xfa, 0xf7, 0x5a, 0x0e, 0xdb, 0xeb, 0x36, 0xab, 0x96,
-	0xef, 0x2e, 0xab, 0x05, 0xca, 0x7f, 0x57, 0xa9, 0xbd, 0xaf, 0x00, 0xac, 0x13, 0xcb, 0x14, 0xb2,
-	0xe8, 0x2e, 0xe4, 0x5d, 0x7c, 0xd0, 0x10, 0x7a, 0x32, 0x42, 0xcf, 0xb5, 0xd1, 0xf4, 0xf4, 0x7b,
-	0x7a, 0xf1, 0x10, 0xbb, 0xed, 0x35, 0x23, 0xd4, 0x63, 0x98, 0x13, 0x2e, 0x3e, 0xe0, 0x2e, 0xa2,
-	0x0e, 0x14, 0x39, 0xd5, 0xda, 0xc3, 0x5e, 0x8b, 0x48, 0x23, 0x59, 0x61, 0x64, 0x63, 0x64, 0x23,
-	0x73, 0xb1, 0x91, 0x84, 0x3a, 0xc3, 0x9c, 0x76, 0xf1, 0xc1, 0xba, 0x20, 0x70, 0x8b, 0x6b, 0xf9,
-	0xc7, 0x47, 0xfa, 0xd8, 0xfb, 0x47, 0xba, 0x66, 0xbc, 0xa1, 0x01, 0xc4, 0x88, 0xa1, 0xbb, 0x30,
-	0x6b, 0x45, 0x23, 0x21, 0x4b, 0x55, 0x0c, 0xbf, 0x3f, 0x2c, 0x16, 0x29, 0xbc, 0x6b, 0x79, 0xee,
-	0xf4, 0xd3, 0x9e, 0xae, 0x99, 0x45, 0x2b, 0x15, 0x8a, 0x3f, 0x40, 0xa1, 0xdb, 0xb1, 0x31, 0x23,
-	0x0d, 0x9e, 0x84, 0x02, 0xc9, 0xc2, 0xea, 0x42, 0x55, 0x26, 0x60, 0x35, 0x4c, 0xc0, 0xea, 0x9d,
-	0x30, 0x43, 0x6b, 0x15, 0xae, 0xab, 0xdf, 0xd3, 0x91, 0x5c, 0x56, 0x42, 0xd8, 0x78, 0xf4, 0xae,
-	0xae, 0x99, 0x20, 0x29, 0x5c, 0x20, 0xb1, 0xa6, 0xd7, 0x34, 0x28, 0xd4, 0x09, 0xb5, 0x02, 0xa7,
-	0xc3, 0xf3, 0x18, 0x95, 0x61, 0xc2, 0xf5, 0x3d, 0x67, 0x5f, 0xe5, 0xe3, 0xa4, 0x19, 0x0e, 0xd1,
-	0x02, 0xe4, 0x1d, 0x9b, 0x78, 0xcc, 0x61, 0x87, 0x32, 0xae, 0x66, 0x34, 0xe6, 0x52, 0xf7, 0x49,
-	0x93, 0x3a, 0x61, 0x34, 0xcc, 0x70, 0x88, 0x6e, 0xc0, 0x2c, 0x25, 0x56, 0x37, 0x70, 0xd8, 0x61,
-	0xc3, 0xf2, 0x3d, 0x86, 0x2d, 0x56, 0x1e, 0x17, 0x01, 0xfb, 0x76, 0xbf, 0xa7, 0x7f, 0x4b, 0xfa,
-	0x9a, 0xe6, 0x30, 0xcc, 0x62, 0x48, 0x5a, 0x97, 0x14, 0x6e, 0xc1, 0x26, 0x0c, 0x3b, 0x6d, 0x5a,
-	0x3e, 0x27, 0x2d, 0xa8, 0x61, 0x62, 0x2d, 0xff, 0x9b, 0x80, 0xc9, 0x28, 0xdb, 0xd1, 0x7d, 0x98,
-	0xf5, 0x3b, 0x24, 0xe0, 0xdf, 0x0d, 0x6c, 0xdb, 0x01, 0xa1, 0x32, 0x3c, 0x53, 0xb5, 0x9b, 0xb1,
-	0xe5, 0x34, 0x87, 0xf1, 0x69, 0x4f, 0xbf, 0x7a, 0x8a, 0x0c, 0xda, 0xc1, 0xed, 0x6b, 0x52, 0xc2,
-	0x2c, 0x86, 0x3a, 0x14, 0x81, 0x2f, 0xd9, 0xf2, 0x3d, 0x4a, 0x3c, 0xda, 0xa5, 0x8d, 0x4e, 0xb7,
-	0xb9, 0x4f, 0x14, 0x60, 0xc9, 0x25, 0xa7, 0x39, 0x0c, 0x9e, 0x01, 0x8a, 0xb4, 0x25, 0x28, 0x68,
-	0x0e, 0x72, 0x7f, 0xc2, 0x4e, 0x9b, 0xd8, 0x02, 0xd3, 0xbc, 0xa9, 0x46, 0x68, 0x13, 0x72, 0x94,
-	0x61, 0xd6, 0xa5, 0x02, 0xc8, 0x73, 0xb5, 0x95, 0x53, 0xfa, 0x5c, 0xf3, 0x3d, 0x7b, 0x5b, 0x08,
-	0x9a, 0x4a, 0x01, 0xba, 0x01, 0x39, 0xe6, 0xef, 0x13, 0x4f, 0x81, 0x3a, 0x52, 0xc5, 0x6f, 0x7a,
-	0xcc, 0x54, 0xd2, 0x88, 0xc1, 0xac, 0x4d, 0xda, 0xa4, 0x25, 0xa0, 0xa4, 0x7b, 0x38, 0x20, 0xb4,
-	0x9c, 0x13, 0x1a, 0x37, 0x47, 0x2e, 0x4b, 0x05, 0x50, 0x5a, 0x9f, 0x61, 0x16, 0x23, 0xd2, 0xb6,
-	0xa0, 0xa0, 0x5f, 0x41, 0xc1, 0x8e, 0x53, 0xb7, 0x3c, 0x21, 0x4a, 0xe4, 0xbb, 0xc3, 0x6a, 0x2f,
-	0x91, 0xe5, 0xaa, 0x13, 0x26, 0xa5, 0x79, 0xd4, 0xba, 0x5e, 0xd3, 0xf7, 0x6c, 0xc7, 0x6b, 0x35,
-	0xf6, 0x88, 0xd3, 0xda, 0x63, 0xe5, 0xfc, 0xa2, 0xb6, 0x94, 0x4d, 0x46, 0x2d, 0xcd, 0x61, 0x98,
-	0xc5, 0x88, 0xb4, 0x21, 0x28, 0xc8, 0x86, 0x99, 0x98, 0x4b, 0x94, 0xee, 0xe4, 0x4b, 0x4b, 0xf7,
-	0xb2, 0x2a, 0xdd, 0x8b, 0x69, 0x2b, 0x71, 0xf5, 0x4e, 0x47, 0x44, 0x2e, 0x86, 0x36, 0x00, 0xe2,
-	0x86, 0x51, 0x06, 0x61, 0xc1, 0x78, 0x79, 0xd7, 0x51, 0x0b, 0x4f, 0xc8, 0xa2, 0x87, 0x70, 0xde,
-	0x75, 0xbc, 0x06, 0x25, 0xed, 0xdd, 0x86, 0x02, 0x98, 0xab, 0x2c, 0x88, 0xe8, 0xdd, 0x1c, 0x2d,
-	0x1f, 0xfa, 0x3d, 0x7d, 0x41, 0x35, 0xd5, 0x41, 0x95, 0x86, 0x59, 0x72, 0x1d, 0x6f, 0x9b, 0xb4,
-	0x77, 0xeb, 0x11, 0x6d, 0x6d, 0xea, 0x6f, 0x47, 0xfa, 0x58, 0x54, 0xc0, 0x0e, 0x4c, 0xc5, 0x85,
-	0x45, 0x28, 0xfa, 0x0d, 0x4c, 0xe2, 0x70, 0x50, 0xd6, 0x16, 0xb3, 0x4b, 0x53, 0xa7, 0x4e, 0xf6,
-	0x44, 0x81, 0xc6, 0x3a, 0x64, 0xaf, 0xf8, 0xcb, 0x3b, 0x8b, 0x9a, 0xf1, 0x8f, 0x0c, 0xe4, 0xea,
-	0x3b, 0x5b, 0xd8, 0x09, 0xd0, 0x03, 0x28, 0xc5, 0xc9, 0x76, 0xbc, 0x53, 0xdc, 0xea, 0xf7, 0xf4,
-	0x72, 0x3a, 0x1f, 0x47, 0x6c, 0x15, 0xd7, 0x2c, 0x2b, 0xf4, 0x24, 0x2e, 0x92, 0xb0, 0x57, 0x3c,
-	0x80, 0xd2, 0xbd, 0xb0, 0x63, 0x45, 0xb6, 0x33, 0x69, 0xdb, 0x03, 0x2c, 0x67, 0x68, 0x53, 0xb3,
-	0x91, 0x12, 0x45, 0x49, 0x34, 0xce, 0xeb, 0x30, 0x21, 0xb1, 0xa0, 0x68, 0x0d, 0xce, 0x75, 0xf8,
-	0x87, 0x80, 0xbb, 0xb0, 0x5a, 0x19, 0x5a, 0x4d, 0x82, 0x5f, 0xe5, 0x93, 0x14, 0x31, 0xfe, 0x9d,
-	0x05, 0xa8, 0xef, 0xec, 0xdc, 0x09, 0x9c, 0x4e, 0x9b, 0xb0, 0xaf, 0x14, 0xd7, 0xbf, 0x6a, 0x70,
-	0x31, 0x46, 0x8d, 0x06, 0x56, 0x0a, 0xdc, 0xdb, 0xfd, 0x9e, 0x7e, 0x29, 0x0d, 0x6e, 0x82, 0xed,
-	0x0c, 0x00, 0x9f, 0x8f, 0x14, 0x6d, 0x07, 0xd6, 0xc9, 0x7e, 0xd8, 0x94, 0x45, 0x7e, 0x64, 0x87,
-	0xfb, 0x91, 0x60, 0xfb, 0x42, 0x7e, 0xd4, 0x29, 0x1b, 0x8c, 0xf5, 0x36, 0x14, 0xe2, 0x18, 0x51,
-	0x54, 0x87, 0x3c, 0x53, 0xdf, 0x2a, 0xe4, 0xc6, 0xf0, 0x90, 0x87, 0x62, 0x2a, 0xec, 0x91, 0xa4,
-	0xf1, 0x66, 0x06, 0x20, 0xae, 0xea, 0x6f, 0x6a, 0x45, 0xf1, 0xed, 0x54, 0x6d, 0x7e, 0xd9, 0x33,
-	0x1d, 0xa0, 0x95, 0x74, 0x22, 0x5a, 0x1f, 0x64, 0xe0, 0xfc, 0x6f, 0xc3, 0xce, 0xff, 0x0a, 0x61,
-	0xb4, 0x05, 0x13, 0xc4, 0x63, 0x81, 0x23, 0x20, 0xe6, 0xd9, 0xfa, 0xa3, 0x61, 0xd9, 0x7a, 0x02,
-	0x6a, 0xd7, 0x3d, 0x16, 0x1c, 0xaa, 0xdc, 0x0d, 0xd5, 0x24, 0xb0, 0xfe, 0x57, 0x16, 0xca, 0xc3,
-	0xa4, 0xd0, 0x3a, 0x14, 0xad, 0x80, 0x08, 0x42, 0x78, 0x3a, 0xd0, 0xc4, 0xe9, 0x60, 0x21, 0xbe,
-	0x49, 0xa4, 0x18, 0x0c, 0x73, 0x26, 0xa4, 0xa8, 0xb3, 0x41, 0x0b, 0xf8, 0x31, 0x9f, 0x97, 0x0c,
-	0xe7, 0x3a, 0xe5, 0xb9, 0xde, 0x50, 0x87, 0x83, 0xd0, 0xc8, 0x71, 0x05, 0xf2, 0x74, 0x30, 0x13,
-	0x53, 0xc5, 0xf1, 0xe0, 0xcf, 0x50, 0x74, 0x3c, 0x87, 0x39, 0xb8, 0xdd, 0x68, 0xe2, 0x36, 0xf6,
-	0xac, 0xb3, 0xdc, 0x92, 0xe4, 0x86, 0xae, 0xcc, 0xa6, 0xd4, 0x19, 0xe6, 0x8c, 0xa2, 0xd4, 0x24,
-	0x01, 0x6d, 0xc0, 0x44, 0x68, 0x6a, 0xfc, 0x4c, 0x67, 0xc9, 0x50, 0x3c, 0x11, 0x91, 0x7f, 0x66,
-	0xa1, 0x64, 0x12, 0xfb, 0x55, 0x28, 0x46, 0x0b, 0xc5, 0x2d, 0x00, 0xd9, 0x48, 0xf8, 0x4e, 0x72,
-	0x86, 0x68, 0xf0, 0x56, 0x34, 0x29, 0x35, 0xd4, 0x29, 0x4b, 0xc4, 0xe3, 0xa3, 0x2c, 0x4c, 0x25,
-	0xe3, 0xf1, 0x6a, 0x8b, 0xff, 0xfa, 0x6c, 0xf1, 0x68, 0x33, 0x6e, 0x8d, 0xe3, 0xa2, 0x35, 0xfe,
-	0x60, 0x58, 0x6b, 0x1c, 0x28, 0xa9, 0xe1, 0x3d, 0xf1, 0x93, 0x0c, 0xe4, 0xb6, 0x70, 0x80, 0x5d,
-	0x8a, 0xac, 0x81, 0x8b, 0x8d, 0x7c, 0xec, 0x98, 0x1f, 0x28, 0x98, 0xba, 0x7a, 0x14, 0x7b, 0xc9,
-	0xbd, 0xe6, 0xf1, 0x09, 0xf7, 0x9a, 0x5f, 0xc2, 0x8c, 0x8b, 0x0f, 0x1a, 0xd1, 0xfa, 0x64, 0x30,
-	0xa7, 0x6b, 0xf3, 0xb1, 0x96, 0xe3, 0xf3, 0xf2, 0xb9, 0x26, 0xba, 0xf5, 0x53, 0xf4, 0x33, 0x28,
-	0x70, 0x8e, 0x78, 0x97, 0xe0, 0xe2, 0x73, 0xf1, 0xbb, 0x48, 0x62, 0xd2, 0x30, 0xc1, 0xc5, 0x07,
-	0xd7, 0xe5, 0x00, 0xdd, 0x04, 0xb4, 0x17, 0x3d, 0xcd, 0x35, 0x62, 0x28, 0xb9, 0xfc, 0x77, 0xfa,
-	0x3d, 0x7d, 0x5e, 0xca, 0x0f, 0xf2, 0x18, 0x66, 0x29, 0x26, 0x86, 0xda, 0x7e, 0x02, 0xc0, 0xd7,
-	0xd5, 0xb0, 0x89, 0xe7, 0xbb, 0xea, 0x76, 0x7d, 0xb1, 0xdf, 0xd3, 0x4b, 0x52, 0x4b, 0x3c, 0x67,
-	0x98, 0x93, 0x7c, 0x50, 0xe7, 0xdf, 0x09, 0xe0, 0xff, 0xa3, 0x01, 0x8a, 0xf7, 0x20, 0x93, 0xd0,
-	0x8e, 0xef, 0x51, 0x71, 0xef, 0x4b, 0x5c, 0xd2, 0xb4, 0x17, 0xdf, 0xfb, 0x62, 0xf9, 0xf0, 0xde,
-	0x97, 0x28, 0xdd, 0x9f, 0xc7, 0xfd, 0x3a, 0xa3, 0xe2, 0xa8, 0xd4, 0x34, 0x31, 0x25, 0x89, 0xbb,
-	0xa3, 0x13, 0x4a, 0x9f, 0xd0, 0xa0, 0x5f, 0xd7, 0x60, 0x7e, 0x20, 0x9b, 0x22, 0x67, 0xff, 0x08,
-	0x28, 0x48, 0x4c, 0x0a, 0xbc, 0x0e, 0x95, 0xd3, 0x23, 0x27, 0x67, 0x29, 0x18, 0xd8, 0x08, 0xbe,
-	0xbc, 0x2d, 0x67, 0x5c, 0xac, 0xe6, 0xff, 0x1a, 0x5c, 0x48, 0x9a, 0x8f, 0x16, 0xf2, 0x6b, 0x98,
-	0x4a, 0x5a, 0x57, 0x4b, 0xb8, 0x72, 0x9a, 0x25, 0x28, 0xef, 0x8f, 0xc9, 0xa3, 0xdb, 0x71, 0xa9,
-	0xca, 0xc7, 0xdb, 0x95, 0x53, 0xa3, 0x11, 0xfa, 0x94, 0x2e, 0x59, 0xb9, 0x82, 0xcf, 0x34, 0x18,
-	0xdf, 0xf2, 0xfd, 0x36, 0xf2, 0xa1, 0xe4, 0xf9, 0xac, 0xc1, 0x33, 0x8b, 0xd8, 0x0d, 0xf5, 0xc6,
-	0x23, 0x5f, 0x75, 0xd7, 0x47, 0x03, 0xe9, 0xc3, 0x9e, 0x3e, 0xa8, 0xca, 0x2c, 0x7a, 0x3e, 0xab,
-	0x09, 0xca, 0x1d, 0xf9, 0x02, 0xf4, 0x10, 0xa6, 0x8f, 0x1b, 0x93, 0x2f, 0x5e, 0xbf, 0x1b, 0xd9,
-	0xd8, 0x71, 0x35, 0xfd, 0x9e, 0x7e, 0x21, 0xae, 0x98, 0x88, 0x6c, 0x98, 0x53, 0xcd, 0x84, 0xf5,
-	0xb5, 0x3c, 0x5f, 0xfd, 0xc7, 0x47, 0xba, 0x56, 0xbb, 0xf1, 0xe4, 0x59, 0x45, 0x7b, 0xfa, 0xac,
-	0xa2, 0xbd, 0xf7, 0xac, 0xa2, 0x3d, 0x7a, 0x5e, 0x19, 0x7b, 0xfa, 0xbc, 0x32, 0xf6, 0xd6, 0xf3,
-	0xca, 0xd8, 0xef, 0x7f, 0xf8, 0x42, 0x17, 0x0e, 0xa2, 0x9f, 0x19, 0x84, 0x33, 0xcd, 0x9c, 0xe8,
-	0x66, 0x3f, 0xfe, 0x3c, 0x00, 0x00, 0xff, 0xff, 0x70, 0xa3, 0x33, 0x33, 0x85, 0x18, 0x00, 0x00,
+	// 1964 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x19, 0x4d, 0x6f, 0x23, 0x49,
+	0x35, 0x6d, 0x7b, 0x9c, 0xe4, 0x39, 0x13, 0x27, 0x35, 0x99, 0xe0, 0x64, 0x87, 0x74, 0xb6, 0x77,
+	0x05, 0x83, 0xc4, 0x3a, 0x64, 0x40, 0x20, 0x72, 0x81, 0xb1, 0x3d, 0x43, 0xa2, 0x9d, 0x85, 0x4c,
+	0x67, 0x36, 0x48, 0xb0, 0xa2, 0x55, 0xee, 0xae, 0x38, 0x4d, 0xda, 0x5d, 0xde, 0xae, 0xf2, 0x24,
+	0x59, 0xed, 0x01, 0x09, 0x21, 0x3e, 0xa4, 0x15, 0x7b, 0x42, 0x7b, 0x1c, 0xc1, 0x0f, 0xe0, 0xce,
+	0x01, 0xae, 0x8b, 0xb8, 0x0c, 0x17, 0x84, 0x10, 0x32, 0x68, 0xe6, 0x02, 0x5c, 0x40, 0x3e, 0x72,
+	0x01, 0xd5, 0x47, 0x7f, 0xa4, 0x1d, 0xef, 0xc4, 0x01, 0xb4, 0x48, 0x3b, 0x97, 0xa4, 0xeb, 0xd5,
+	0xfb, 0xaa, 0xf7, 0x55, 0xf5, 0x9e, 0xe1, 0x65, 0x97, 0xb2, 0x2e, 0x65, 0x1b, 0x8c, 0xe3, 0x23,
+	0x3f, 0xec, 0x6c, 0x3c, 0xdc, 0x6c, 0x13, 0x8e, 0x37, 0xe3, 0x75, 0xbd, 0x17, 0x51, 0x4e, 0xd1,
+	0xb2, 0xc2, 0xaa, 0xc7, 0x50, 0x8d, 0xb5, 0xba, 0xd4, 0xa1, 0x1d, 0x2a, 0x51, 0x36, 0xc4, 0x97,
+	0xc2, 0x5e, 0xbd, 0xc1, 0x49, 0xe8, 0x91, 0xa8, 0xeb, 0x87, 0x7c, 0x83, 0x9f, 0xf6, 0x08, 0x53,
+	0x7f, 0xf5, 0xae, 0xd9, 0xa1, 0xb4, 0x13, 0x90, 0x0d, 0xb9, 0x6a, 0xf7, 0x0f, 0x36, 0xb8, 0xdf,
+	0x25, 0x8c, 0xe3, 0x6e, 0x4f, 0x23, 0xac, 0xe5, 0x11, 0xbc, 0x7e, 0x84, 0xb9, 0x4f, 0xc3, 0x78,
+	0x5f, 0xab, 0xdc, 0xc6, 0x8c, 0x24, 0xfa, 0xba, 0xd4, 0xd7, 0xfb, 0xd6, 0x0f, 0x0d, 0x98, 0xdf,
+	0xf6, 0x19, 0xa7, 0x91, 0xef, 0xe2, 0x60, 0x27, 0x3c, 0xa0, 0xe8, 0xf3, 0x50, 0x3e, 0x24, 0xd8,
+	0x23, 0x51, 0xcd, 0x58, 0x37, 0x6e, 0x56, 0x6e, 0xd5, 0xea, 0xa9, 0x8a, 0x75, 0xa5, 0xdc, 0xb6,
+	0xdc, 0x6f, 0x94, 0xde, 0x1f, 0x98, 0x53, 0xb6, 0xc6, 0x46, 0x5f, 0x82, 0xf2, 0x43, 0x1c, 0x30,
+	0xc2, 0x6b, 0x85, 0xf5, 0xe2, 0xcd, 0xca, 0xad, 0x17, 0xeb, 0xe7, 0x1b, 0xa2, 0xbe, 0x8f, 0x03,
+	0xdf, 0xc3, 0x9c, 0x26, 0x0c, 0x14, 0x99, 0xf5, 0xf3, 0x02, 0x54, 0x9b, 0xb4, 0xdb, 0xf5, 0x19,
+	0xf3, 0x69, 0x68, 0x63, 0x4e, 0x18, 0x6a, 0x40, 0x29, 0xc2, 0x9c, 0x48, 0x55, 0x66, 0x1b, 0x75,
+	0x81, 0xff, 0x87, 0x81, 0xf9, 0x89, 0x8e, 0xcf, 0x0f, 0xfb, 0xed, 0xba, 0x4b, 0xbb, 0x1b, 0xfa,
+	0x80, 0xea, 0xdf, 0x2b, 0xcc, 0x3b, 0xd2, 0x06, 0x6c, 0x11, 0xd7, 0x96, 0xb4, 0xe8, 0x0d, 0x98,
+	0xe9, 0xe2, 0x13, 0x47, 0xf2, 0x29, 0x48, 0x3e, 0xb7, 0x27, 0xe3, 0x33, 0x1c, 0x98, 0xd5, 0x53,
+	0xdc, 0x0d, 0xb6, 0xac, 0x98, 0x8f, 0x65, 0x4f, 0x77, 0xf1, 0x89, 0x50, 0x11, 0xf5, 0xa0, 0x2a,
+	0xa0, 0xee, 0x21, 0x0e, 0x3b, 0x44, 0x09, 0x29, 0x4a, 0x21, 0xdb, 0x13, 0x0b, 0x59, 0x4e, 0x85,
+	0x64, 0xd8, 0x59, 0xf6, 0xd5, 0x2e, 0x3e, 0x69, 0x4a, 0x80, 0x90, 0xb8, 0x35, 0xf3, 0xde, 0x23,
+	0x73, 0xea, 0x2f, 0x8f, 0x4c, 0xc3, 0xfa, 0xad, 0x01, 0x90, 0x5a, 0x0c, 0xbd, 0x01, 0x0b, 0x6e,
+	0xb2, 0x92, 0xb4, 0x4c, 0xfb, 0xf0, 0x93, 0xe3, 0x7c, 0x91, 0xb3, 0x77, 0x63, 0x46, 0x28, 0xfd,
+	0x78, 0x60, 0x1a, 0x76, 0xd5, 0xcd, 0xb9, 0xe2, 0x9b, 0x50, 0xe9, 0xf7, 0x3c, 0xcc, 0x89, 0x23,
+	0x82, 0x50, 0x5a, 0xb2, 0x72, 0x6b, 0xb5, 0xae, 0x02, 0xb0, 0x1e, 0x07, 0x60, 0xfd, 0x41, 0x1c,
+	0xa1, 0x8d, 0x35, 0xc1, 0x6b, 0x38, 0x30, 0x91, 0x3a, 0x56, 0x86, 0xd8, 0x7a, 0xf7, 0x4f, 0xa6,
+	0x61, 0x83, 0x82, 0x08, 0x82, 0xcc, 0x99, 0x7e, 0x6d, 0x40, 0xa5, 0x45, 0x98, 0x1b, 0xf9, 0x3d,
+	0x11, 0xc7, 0xa8, 0x06, 0xd3, 0x5d, 0x1a, 0xfa, 0x47, 0x3a, 0x1e, 0x67, 0xed, 0x78, 0x89, 0x56,
+	0x61, 0xc6, 0xf7, 0x48, 0xc8, 0x7d, 0x7e, 0xaa, 0xfc, 0x6a, 0x27, 0x6b, 0x41, 0x75, 0x4c, 0xda,
+	0xcc, 0x8f, 0xbd, 0x61, 0xc7, 0x4b, 0x74, 0x17, 0x16, 0x18, 0x71, 0xfb, 0x91, 0xcf, 0x4f, 0x1d,
+	0x97, 0x86, 0x1c, 0xbb, 0xbc, 0x56, 0x92, 0x0e, 0x7b, 0x61, 0x38, 0x30, 0x3f, 0xa6, 0x74, 0xcd,
+	0x63, 0x58, 0x76, 0x35, 0x06, 0x35, 0x15, 0x44, 0x48, 0xf0, 0x08, 0xc7, 0x7e, 0xc0, 0x6a, 0x57,
+	0x94, 0x04, 0xbd, 0xcc, 0x9c, 0xe5, 0x97, 0xd3, 0x30, 0x9b, 0x44, 0x3b, 0x3a, 0x86, 0x05, 0xda,
+	0x23, 0x91, 0xf8, 0x76, 0xb0, 0xe7, 0x45, 0x84, 0x29, 0xf7, 0xcc, 0x35, 0xee, 0xa5, 0x92, 0xf3,
+	0x18, 0xd6, 0x3f, 0x07, 0xe6, 0x2b, 0x17, 0x88, 0xa0, 0x7d, 0x1c, 0xdc, 0x56, 0x14, 0x76, 0x35,
+	0xe6, 0xa1, 0x01, 0xe2, 0xc8, 0x2e, 0x0d, 0x19, 0x09, 0x59, 0x9f, 0x39, 0xbd, 0x7e, 0xfb, 0x88,
+	0x68, 0x83, 0x65, 0x8f, 0x9c, 0xc7, 0xb0, 0xec, 0x6a, 0x02, 0xda, 0x95, 0x10, 0xb4, 0x0c, 0xe5,
+	0x6f, 0x63, 0x3f, 0x20, 0x9e, 0xb4, 0xe9, 0x8c, 0xad, 0x57, 0x68, 0x07, 0xca, 0x8c, 0x63, 0xde,
+	0x67, 0xd2, 0x90, 0x57, 0x1a, 0x9b, 0x17, 0xd4, 0xb9, 0x41, 0x43, 0x6f, 0x4f, 0x12, 0xda, 0x9a,
+	0x01, 0xba, 0x0b, 0x65, 0x4e, 0x8f, 0x48, 0xa8, 0x8d, 0x3a, 0x51, 0xc6, 0xef, 0x84, 0xdc, 0xd6,
+	0xd4, 0x88, 0xc3, 0x82, 0x47, 0x02, 0xd2, 0x91, 0xa6, 0x64, 0x87, 0x38, 0x22, 0xac, 0x56, 0x96,
+	0x1c, 0x77, 0x26, 0x4e, 0x4b, 0x6d, 0xa0, 0x3c, 0x3f, 0xcb, 0xae, 0x26, 0xa0, 0x3d, 0x09, 0x41,
+	0xaf, 0x42, 0xc5, 0x4b, 0x43, 0xb7, 0x36, 0x2d, 0x53, 0xe4, 0xa5, 0x71, 0xb9, 0x97, 0x89, 0x72,
+	0x5d, 0x09, 0xb3, 0xd4, 0xc2, 0x6b, 0xfd, 0xb0, 0x4d, 0x43, 0xcf, 0x0f, 0x3b, 0xce, 0x21, 0xf1,
+	0x3b, 0x87, 0xbc, 0x36, 0xb3, 0x6e, 0xdc, 0x2c, 0x66, 0xbd, 0x96, 0xc7, 0xb0, 0xec, 0x6a, 0x02,
+	0xda, 0x96, 0x10, 0xe4, 0xc1, 0x7c, 0x8a, 0x25, 0x53, 0x77, 0xf6, 0x99, 0xa9, 0xfb, 0xa2, 0x4e,
+	0xdd, 0xeb, 0x79, 0x29, 0x69, 0xf6, 0x5e, 0x4d, 0x80, 0x82, 0x0c, 0x6d, 0x03, 0xa4, 0x05, 0xa3,
+	0x06, 0x52, 0x82, 0xf5, 0xec, 0xaa, 0xa3, 0x0f, 0x9e, 0xa1, 0x45, 0x6f, 0xc3, 0xb5, 0xae, 0x1f,
+	0x3a, 0x8c, 0x04, 0x07, 0x8e, 0x36, 0xb0, 0x60, 0x59, 0x91, 0xde, 0xbb, 0x37, 0x59, 0x3c, 0x0c,
+	0x07, 0xe6, 0xaa, 0x2e, 0xaa, 0xa3, 0x2c, 0x2d, 0x7b, 0xb1, 0xeb, 0x87, 0x7b, 0x24, 0x38, 0x68,
+	0x25, 0xb0, 0xad, 0xb9, 0x1f, 0x3c, 0x32, 0xa7, 0x92, 0x04, 0xf6, 0x61, 0x2e, 0x4d, 0x2c, 0xc2,
+	0xd0, 0xd7, 0x60, 0x16, 0xc7, 0x8b, 0x9a, 0xb1, 0x5e, 0xbc, 0x39, 0x77, 0xe1, 0x60, 0xcf, 0x24,
+	0x68, 0xca, 0x43, 0xd5, 0x8a, 0xef, 0xfc, 0x71, 0xdd, 0xb0, 0x7e, 0x54, 0x80, 0x72, 0x6b, 0x7f,
+	0x17, 0xfb, 0x11, 0x7a, 0x0b, 0x16, 0xd3, 0x60, 0x3b, 0x5b, 0x29, 0x5e, 0x1b, 0x0e, 0xcc, 0x5a,
+	0x3e, 0x1e, 0x27, 0x2c, 0x15, 0xb7, 0x5d, 0x37, 0xd6, 0x24, 0x4d, 0x12, 0x0d, 0x11, 0xb2, 0x1f,
+	0xc6, 0x15, 0x2b, 0x91, 0x5d, 0xc8, 0xcb, 0x1e, 0x41, 0xb9, 0x44, 0x99, 0x5a, 0x48, 0x98, 0x68,
+	0x48, 0xa6, 0x70, 0xde, 0x81, 0x69, 0x65, 0x0b, 0x86, 0xb6, 0xe0, 0x4a, 0x4f, 0x7c, 0x48, 0x73,
+	0x57, 0x6e, 0xad, 0x8d, 0xcd, 0x26, 0x89, 0xaf, 0xe3, 0x49, 0x91, 0x58, 0x3f, 0x2d, 0x02, 0xb4,
+	0xf6, 0xf7, 0x1f, 0x44, 0x7e, 0x2f, 0x20, 0xfc, 0x43, 0xb5, 0xeb, 0xf7, 0x0c, 0xb8, 0x9e, 0x5a,
+	0x8d, 0x45, 0x6e, 0xce, 0xb8, 0xf7, 0x87, 0x03, 0xf3, 0x46, 0xde, 0xb8, 0x19, 0xb4, 0x4b, 0x18,
+	0xf8, 0x5a, 0xc2, 0x68, 0x2f, 0x72, 0xcf, 0xd7, 0xc3, 0x63, 0x3c, 0xd1, 0xa3, 0x38, 0x5e, 0x8f,
+	0x0c, 0xda, 0x7f, 0xa4, 0x47, 0x8b, 0xf1, 0x51, 0x5f, 0xef, 0x41, 0x25, 0xf5, 0x11, 0x43, 0x2d,
+	0x98, 0xe1, 0xfa, 0x5b, 0xbb, 0xdc, 0x1a, 0xef, 0xf2, 0x98, 0x4c, 0xbb, 0x3d, 0xa1, 0xb4, 0x7e,
+	0x57, 0x00, 0x48, 0xb3, 0xfa, 0xa3, 0x9a, 0x51, 0xe2, 0x3a, 0xd5, 0x97, 0x5f, 0xf1, 0x52, 0x0f,
+	0x68, 0x4d, 0x9d, 0xf1, 0xd6, 0x5f, 0x0b, 0x70, 0xed, 0xf5, 0xb8, 0xf2, 0x3f, 0xb7, 0x30, 0xda,
+	0x85, 0x69, 0x12, 0xf2, 0xc8, 0x97, 0x26, 0x16, 0xd1, 0xfa, 0x99, 0x71, 0xd1, 0x7a, 0x8e, 0xd5,
+	0xee, 0x84, 0x3c, 0x3a, 0xd5, 0xb1, 0x1b, 0xb3, 0xc9, 0xd8, 0xfa, 0xc7, 0x45, 0xa8, 0x8d, 0xa3,
+	0x42, 0x4d, 0xa8, 0xba, 0x11, 0x91, 0x80, 0xf8, 0x75, 0x60, 0xc8, 0xd7, 0xc1, 0x6a, 0xda, 0x49,
+	0xe4, 0x10, 0x2c, 0x7b, 0x3e, 0x86, 0xe8, 0xb7, 0x41, 0x07, 0xc4, 0x33, 0x5f, 0xa4, 0x8c, 0xc0,
+	0xba, 0xe0, 0xbb, 0xde, 0xd2, 0x8f, 0x83, 0x58, 0xc8, 0x59, 0x06, 0xea, 0x75, 0x30, 0x9f, 0x42,
+	0x05, 0x21, 0x7a, 0x13, 0xaa, 0x7e, 0xe8, 0x73, 0x1f, 0x07, 0x4e, 0x1b, 0x07, 0x38, 0x74, 0x2f,
+	0xd3, 0x25, 0xa9, 0x0b, 0x5d, 0x8b, 0xcd, 0xb1, 0xb3, 0xec, 0x79, 0x0d, 0x69, 0x28, 0x00, 0xda,
+	0x86, 0xe9, 0x58, 0x54, 0xe9, 0x52, 0x6f, 0xc9, 0x98, 0x3c, 0xe3, 0x91, 0x77, 0x8a, 0xb0, 0x68,
+	0x13, 0xef, 0xb9, 0x2b, 0x26, 0x73, 0xc5, 0x6b, 0x00, 0xaa, 0x90, 0x88, 0x9b, 0xa4, 0x56, 0xba,
+	0x54, 0x29, 0x9a, 0x55, 0x1c, 0x5a, 0x8c, 0x67, 0xfc, 0xf1, 0xf7, 0x22, 0xcc, 0x65, 0xfd, 0xf1,
+	0xfc, 0x8a, 0xff, 0xff, 0xb9, 0xe2, 0xd1, 0x4e, 0x5a, 0x1a, 0x4b, 0xb2, 0x34, 0x7e, 0x6a, 0x5c,
+	0x69, 0x1c, 0x49, 0xa9, 0xf1, 0x35, 0xf1, 0xbb, 0xd3, 0x50, 0xde, 0xc5, 0x11, 0xee, 0x32, 0xe4,
+	0x8e, 0x34, 0x36, 0x6a, 0xd8, 0xb1, 0x32, 0x92, 0x30, 0x2d, 0x3d, 0x14, 0x7b, 0x46, 0x5f, 0xf3,
+	0xde, 0x39, 0x7d, 0xcd, 0x97, 0x61, 0x5e, 0xcc, 0x63, 0x92, 0xf3, 0x29, 0x67, 0x5e, 0x6d, 0xac,
+	0xa4, 0x5c, 0xce, 0xee, 0xab, 0x71, 0x4d, 0xd2, 0xf5, 0x33, 0xf4, 0x05, 0xa8, 0x08, 0x8c, 0xf4,
+	0x96, 0x10, 0xe4, 0xcb, 0xe9, 0x5c, 0x24, 0xb3, 0x69, 0xd9, 0xd0, 0xc5, 0x27, 0x77, 0xd4, 0x02,
+	0xdd, 0x03, 0x74, 0x98, 0x8c, 0xe6, 0x9c, 0xd4, 0x94, 0x82, 0xfe, 0xe3, 0xc3, 0x81, 0xb9, 0xa2,
+	0xe8, 0x47, 0x71, 0x2c, 0x7b, 0x31, 0x05, 0xc6, 0xdc, 0x3e, 0x07, 0x20, 0xce, 0xe5, 0x78, 0x24,
+	0xa4, 0x5d, 0xdd, 0x5d, 0x5f, 0x1f, 0x0e, 0xcc, 0x45, 0xc5, 0x25, 0xdd, 0xb3, 0xec, 0x59, 0xb1,
+	0x68, 0x89, 0x6f, 0xf4, 0x8e, 0x01, 0x2b, 0x9d, 0x80, 0xb6, 0x71, 0xe0, 0x04, 0xfe, 0x9b, 0x7d,
+	0xdf, 0x73, 0xb4, 0xef, 0x1c, 0x17, 0xf7, 0x74, 0x47, 0x6d, 0x4f, 0xdc, 0x51, 0xaf, 0x2b, 0x99,
+	0x63, 0x19, 0x5b, 0xf6, 0xb2, 0xda, 0xbb, 0x27, 0xb7, 0xf6, 0xd4, 0x4e, 0x13, 0xf7, 0xd0, 0x4f,
+	0x0c, 0xb8, 0x91, 0x06, 0xed, 0x39, 0x2a, 0x4d, 0x4b, 0x95, 0x5e, 0x9f, 0x58, 0xa5, 0x97, 0xf2,
+	0x09, 0x71, 0x9e, 0x56, 0x2b, 0xc9, 0xf6, 0x88, 0x62, 0x1e, 0x2c, 0x1c, 0x91, 0x53, 0x27, 0xa2,
+	0x5c, 0x95, 0xf9, 0x03, 0x42, 0x6a, 0x33, 0x3a, 0x1c, 0x75, 0xd4, 0xb7, 0x31, 0x23, 0x99, 0x16,
+	0xd8, 0x0f, 0x1b, 0xa6, 0x0e, 0x47, 0xdd, 0xcc, 0xe7, 0x19, 0x58, 0xf6, 0xfc, 0x11, 0x39, 0xb5,
+	0x35, 0xe4, 0x2e, 0x21, 0x71, 0x6f, 0x9c, 0x9b, 0xf2, 0xd5, 0x66, 0x27, 0xee, 0x8d, 0xd5, 0xa1,
+	0x33, 0xbd, 0x71, 0x8e, 0xa5, 0xea, 0x8d, 0xcf, 0x4e, 0x07, 0x33, 0x59, 0xf8, 0x33, 0x03, 0x50,
+	0xfa, 0x20, 0xb1, 0x09, 0xeb, 0xd1, 0x90, 0xc9, 0x21, 0x40, 0xa6, 0x63, 0x37, 0x3e, 0x78, 0x08,
+	0x90, 0xd2, 0xc7, 0x43, 0x80, 0x94, 0x16, 0x7d, 0x31, 0xbd, 0xbc, 0x0b, 0xcf, 0xb2, 0xa2, 0xae,
+	0x15, 0xa3, 0xb7, 0xf5, 0x6f, 0x0c, 0x58, 0x19, 0x29, 0x2d, 0x89, 0xb2, 0xdf, 0x02, 0x14, 0x65,
+	0x36, 0x65, 0xf2, 0x9c, 0x6a, 0xa5, 0x27, 0xae, 0x54, 0x8b, 0x51, 0x7e, 0xe3, 0xbf, 0xf8, 0xfe,
+	0x28, 0xc9, 0xd3, 0xfc, 0xca, 0x80, 0xa5, 0xac, 0xf8, 0xe4, 0x20, 0x5f, 0x85, 0xb9, 0xac, 0x74,
+	0x7d, 0x84, 0x97, 0x2f, 0x72, 0x04, 0xad, 0xfd, 0x19, 0x7a, 0x74, 0x3f, 0xad, 0xdb, 0x6a, 0x92,
+	0xbf, 0x79, 0x61, 0x6b, 0xc4, 0x3a, 0xe5, 0xeb, 0xb7, 0x3a, 0xc1, 0xbf, 0x0c, 0x28, 0xed, 0x52,
+	0x1a, 0x20, 0x0a, 0x8b, 0x21, 0xe5, 0x8e, 0x28, 0x33, 0xc4, 0x73, 0xf4, 0xc0, 0x4f, 0x8d, 0xf8,
+	0x9b, 0x93, 0x19, 0xe9, 0x6f, 0x03, 0x73, 0x94, 0x95, 0x5d, 0x0d, 0x29, 0x6f, 0x48, 0xc8, 0x03,
+	0x09, 0x40, 0x6f, 0xc3, 0xd5, 0xb3, 0xc2, 0xd4, 0xf8, 0xf3, 0xeb, 0x13, 0x0b, 0x3b, 0xcb, 0x66,
+	0x38, 0x30, 0x97, 0xd2, 0xf2, 0x99, 0x80, 0x2d, 0x7b, 0xae, 0x9d, 0x91, 0xbe, 0x35, 0x23, 0x4e,
+	0xff, 0x0f, 0x61, 0x81, 0xef, 0x17, 0xe0, 0x9a, 0x04, 0xfa, 0x6f, 0x11, 0x39, 0x33, 0xb4, 0x89,
+	0x4b, 0x23, 0x0f, 0xcd, 0x43, 0xc1, 0xf7, 0xa4, 0x05, 0x4a, 0x76, 0xc1, 0xf7, 0xd0, 0x57, 0xe0,
+	0x0a, 0x3d, 0x0e, 0x49, 0xa4, 0x5f, 0x0e, 0x9b, 0x93, 0x3f, 0x4f, 0x14, 0xbd, 0xbc, 0xbe, 0xa8,
+	0xd7, 0x0f, 0x88, 0x83, 0x5d, 0x97, 0xf6, 0x43, 0xae, 0xdf, 0x7a, 0xd9, 0xeb, 0xeb, 0xcc, 0xbe,
+	0xb8, 0xbe, 0x24, 0xe0, 0xb6, 0x5a, 0x8b, 0x91, 0x57, 0x52, 0xf5, 0x6a, 0xa5, 0x89, 0xd4, 0xc9,
+	0x8e, 0xbc, 0x12, 0x1e, 0x3a, 0x16, 0x7e, 0x51, 0x04, 0xd4, 0xa4, 0xa1, 0x18, 0x2d, 0xbf, 0x9a,
+	0xd6, 0xb8, 0x0f, 0x6f, 0x46, 0x7e, 0x1f, 0x96, 0x68, 0xe0, 0x39, 0x63, 0xe6, 0xe4, 0xe6, 0x70,
+	0x60, 0xbe, 0xa0, 0x85, 0x9f, 0x83, 0x65, 0xd9, 0x88, 0x06, 0x5e, 0x33, 0x37, 0x2e, 0xbf, 0x0f,
+	0x4b, 0x21, 0x39, 0x1e, 0x65, 0x59, 0xcc, 0xb3, 0x3c, 0x0f, 0xcb, 0xb2, 0x51, 0x48, 0x8e, 0x9b,
+	0xa3, 0x13, 0x78, 0xdd, 0x60, 0x08, 0x4f, 0x14, 0xed, 0xf2, 0xe1, 0xd8, 0xe6, 0xe1, 0xca, 0xff,
+	0xa2, 0x79, 0x50, 0xce, 0x6b, 0xdc, 0x7d, 0xff, 0xc9, 0x9a, 0xf1, 0xf8, 0xc9, 0x9a, 0xf1, 0xe7,
+	0x27, 0x6b, 0xc6, 0xbb, 0x4f, 0xd7, 0xa6, 0x1e, 0x3f, 0x5d, 0x9b, 0xfa, 0xfd, 0xd3, 0xb5, 0xa9,
+	0x6f, 0x7c, 0xfa, 0x03, 0xdd, 0x70, 0x92, 0xfc, 0x74, 0x2a, 0x1d, 0xd2, 0x2e, 0x4b, 0xad, 0x3e,
+	0xfb, 0xef, 0x01, 0x00, 0x18, 0xef, 0x43, 0x2c, 0x59, 0x1d, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1265,569 +1458,668 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 8985 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x74, 0x1c, 0xd7,
-		0x75, 0x18, 0x67, 0x3f, 0x80, 0xdd, 0xbb, 0xf8, 0x58, 0x3c, 0x80, 0xd0, 0x72, 0x49, 0x02, 0xd4,
-		0x48, 0x96, 0x28, 0x4a, 0x5a, 0x48, 0x14, 0x45, 0x51, 0x4b, 0xdb, 0x32, 0x16, 0x58, 0x82, 0x20,
-		0xf1, 0xa5, 0x01, 0x40, 0xc9, 0x76, 0xd2, 0x3d, 0x83, 0xd9, 0x87, 0xc5, 0x88, 0xb3, 0x33, 0xa3,
-		0x99, 0x59, 0x12, 0xa0, 0xed, 0x73, 0x94, 0xf8, 0xa3, 0xb1, 0xda, 0xd4, 0xce, 0xc7, 0x69, 0x65,
-		0xc7, 0x72, 0x65, 0xbb, 0xad, 0x53, 0xa7, 0x6d, 0x92, 0x3a, 0x4d, 0x9b, 0xa6, 0xa7, 0x75, 0x5a,
-		0x27, 0x71, 0xda, 0xa6, 0x47, 0x3a, 0x75, 0xdb, 0x34, 0xa7, 0xa5, 0x53, 0xd9, 0xa7, 0x75, 0x5c,
-		0xb7, 0x49, 0x59, 0xf5, 0x53, 0x3f, 0x92, 0xf3, 0xbe, 0xe6, 0x63, 0x3f, 0xb0, 0xbb, 0x10, 0x25,
-		0x5b, 0x27, 0xfa, 0x85, 0x7d, 0xf7, 0xdd, 0x7b, 0xdf, 0xbd, 0xf7, 0xdd, 0x7b, 0xdf, 0x7d, 0x6f,
-		0xde, 0x0c, 0xe0, 0xe7, 0xce, 0xc3, 0x89, 0x9a, 0x65, 0xd5, 0x0c, 0x3c, 0x63, 0x3b, 0x96, 0x67,
-		0x6d, 0x35, 0xb6, 0x67, 0xaa, 0xd8, 0xd5, 0x1c, 0xdd, 0xf6, 0x2c, 0xa7, 0x40, 0x61, 0x68, 0x94,
-		0x61, 0x14, 0x04, 0x86, 0xbc, 0x0c, 0x63, 0x17, 0x74, 0x03, 0xcf, 0xfb, 0x88, 0xeb, 0xd8, 0x43,
-		0xe7, 0x20, 0xb1, 0xad, 0x1b, 0x38, 0x27, 0x9d, 0x88, 0x9f, 0xcc, 0x9c, 0xbe, 0xbb, 0xd0, 0x44,
-		0x54, 0x88, 0x52, 0xac, 0x11, 0xb0, 0x42, 0x29, 0xe4, 0xef, 0x24, 0x60, 0xbc, 0x4d, 0x2f, 0x42,
-		0x90, 0x30, 0xd5, 0x3a, 0xe1, 0x28, 0x9d, 0x4c, 0x2b, 0xf4, 0x37, 0xca, 0xc1, 0xa0, 0xad, 0x6a,
-		0x57, 0xd5, 0x1a, 0xce, 0xc5, 0x28, 0x58, 0x34, 0xd1, 0x14, 0x40, 0x15, 0xdb, 0xd8, 0xac, 0x62,
-		0x53, 0xdb, 0xcb, 0xc5, 0x4f, 0xc4, 0x4f, 0xa6, 0x95, 0x10, 0x04, 0xdd, 0x0f, 0x63, 0x76, 0x63,
-		0xcb, 0xd0, 0xb5, 0x4a, 0x08, 0x0d, 0x4e, 0xc4, 0x4f, 0x26, 0x95, 0x2c, 0xeb, 0x98, 0x0f, 0x90,
-		0xef, 0x85, 0xd1, 0xeb, 0x58, 0xbd, 0x1a, 0x46, 0xcd, 0x50, 0xd4, 0x11, 0x02, 0x0e, 0x21, 0xce,
-		0xc1, 0x50, 0x1d, 0xbb, 0xae, 0x5a, 0xc3, 0x15, 0x6f, 0xcf, 0xc6, 0xb9, 0x04, 0xd5, 0xfe, 0x44,
-		0x8b, 0xf6, 0xcd, 0x9a, 0x67, 0x38, 0xd5, 0xc6, 0x9e, 0x8d, 0xd1, 0x2c, 0xa4, 0xb1, 0xd9, 0xa8,
-		0x33, 0x0e, 0xc9, 0x0e, 0xf6, 0x2b, 0x9b, 0x8d, 0x7a, 0x33, 0x97, 0x14, 0x21, 0xe3, 0x2c, 0x06,
-		0x5d, 0xec, 0x5c, 0xd3, 0x35, 0x9c, 0x1b, 0xa0, 0x0c, 0xee, 0x6d, 0x61, 0xb0, 0xce, 0xfa, 0x9b,
-		0x79, 0x08, 0x3a, 0x34, 0x07, 0x69, 0xbc, 0xeb, 0x61, 0xd3, 0xd5, 0x2d, 0x33, 0x37, 0x48, 0x99,
-		0xbc, 0xab, 0xcd, 0x2c, 0x62, 0xa3, 0xda, 0xcc, 0x22, 0xa0, 0x43, 0x67, 0x61, 0xd0, 0xb2, 0x3d,
-		0xdd, 0x32, 0xdd, 0x5c, 0xea, 0x84, 0x74, 0x32, 0x73, 0xfa, 0x58, 0x5b, 0x47, 0x58, 0x65, 0x38,
-		0x8a, 0x40, 0x46, 0x8b, 0x90, 0x75, 0xad, 0x86, 0xa3, 0xe1, 0x8a, 0x66, 0x55, 0x71, 0x45, 0x37,
-		0xb7, 0xad, 0x5c, 0x9a, 0x32, 0x98, 0x6e, 0x55, 0x84, 0x22, 0xce, 0x59, 0x55, 0xbc, 0x68, 0x6e,
-		0x5b, 0xca, 0x88, 0x1b, 0x69, 0xa3, 0x49, 0x18, 0x70, 0xf7, 0x4c, 0x4f, 0xdd, 0xcd, 0x0d, 0x51,
-		0x0f, 0xe1, 0x2d, 0xf9, 0xd7, 0x06, 0x60, 0xb4, 0x17, 0x17, 0x3b, 0x0f, 0xc9, 0x6d, 0xa2, 0x65,
-		0x2e, 0xd6, 0x8f, 0x0d, 0x18, 0x4d, 0xd4, 0x88, 0x03, 0x07, 0x34, 0xe2, 0x2c, 0x64, 0x4c, 0xec,
-		0x7a, 0xb8, 0xca, 0x3c, 0x22, 0xde, 0xa3, 0x4f, 0x01, 0x23, 0x6a, 0x75, 0xa9, 0xc4, 0x81, 0x5c,
-		0xea, 0x69, 0x18, 0xf5, 0x45, 0xaa, 0x38, 0xaa, 0x59, 0x13, 0xbe, 0x39, 0xd3, 0x4d, 0x92, 0x42,
-		0x59, 0xd0, 0x29, 0x84, 0x4c, 0x19, 0xc1, 0x91, 0x36, 0x9a, 0x07, 0xb0, 0x4c, 0x6c, 0x6d, 0x57,
-		0xaa, 0x58, 0x33, 0x72, 0xa9, 0x0e, 0x56, 0x5a, 0x25, 0x28, 0x2d, 0x56, 0xb2, 0x18, 0x54, 0x33,
-		0xd0, 0xe3, 0x81, 0xab, 0x0d, 0x76, 0xf0, 0x94, 0x65, 0x16, 0x64, 0x2d, 0xde, 0xb6, 0x09, 0x23,
-		0x0e, 0x26, 0x7e, 0x8f, 0xab, 0x5c, 0xb3, 0x34, 0x15, 0xa2, 0xd0, 0x55, 0x33, 0x85, 0x93, 0x31,
-		0xc5, 0x86, 0x9d, 0x70, 0x13, 0xdd, 0x05, 0x3e, 0xa0, 0x42, 0xdd, 0x0a, 0x68, 0x16, 0x1a, 0x12,
-		0xc0, 0x15, 0xb5, 0x8e, 0xf3, 0x37, 0x60, 0x24, 0x6a, 0x1e, 0x34, 0x01, 0x49, 0xd7, 0x53, 0x1d,
-		0x8f, 0x7a, 0x61, 0x52, 0x61, 0x0d, 0x94, 0x85, 0x38, 0x36, 0xab, 0x34, 0xcb, 0x25, 0x15, 0xf2,
-		0x13, 0xbd, 0x2f, 0x50, 0x38, 0x4e, 0x15, 0xbe, 0xa7, 0x75, 0x46, 0x23, 0x9c, 0x9b, 0xf5, 0xce,
-		0x3f, 0x06, 0xc3, 0x11, 0x05, 0x7a, 0x1d, 0x5a, 0xfe, 0x30, 0x1c, 0x6e, 0xcb, 0x1a, 0x3d, 0x0d,
-		0x13, 0x0d, 0x53, 0x37, 0x3d, 0xec, 0xd8, 0x0e, 0x26, 0x1e, 0xcb, 0x86, 0xca, 0xfd, 0x97, 0xc1,
-		0x0e, 0x3e, 0xb7, 0x19, 0xc6, 0x66, 0x5c, 0x94, 0xf1, 0x46, 0x2b, 0xf0, 0x54, 0x3a, 0xf5, 0xdd,
-		0xc1, 0xec, 0x73, 0xcf, 0x3d, 0xf7, 0x5c, 0x4c, 0x7e, 0x61, 0x00, 0x26, 0xda, 0xc5, 0x4c, 0xdb,
-		0xf0, 0x9d, 0x84, 0x01, 0xb3, 0x51, 0xdf, 0xc2, 0x0e, 0x35, 0x52, 0x52, 0xe1, 0x2d, 0x34, 0x0b,
-		0x49, 0x43, 0xdd, 0xc2, 0x46, 0x2e, 0x71, 0x42, 0x3a, 0x39, 0x72, 0xfa, 0xfe, 0x9e, 0xa2, 0xb2,
-		0xb0, 0x44, 0x48, 0x14, 0x46, 0x89, 0xde, 0x0b, 0x09, 0x9e, 0xa2, 0x09, 0x87, 0x53, 0xbd, 0x71,
-		0x20, 0xb1, 0xa4, 0x50, 0x3a, 0x74, 0x14, 0xd2, 0xe4, 0x2f, 0xf3, 0x8d, 0x01, 0x2a, 0x73, 0x8a,
-		0x00, 0x88, 0x5f, 0xa0, 0x3c, 0xa4, 0x68, 0x98, 0x54, 0xb1, 0x58, 0xda, 0xfc, 0x36, 0x71, 0xac,
-		0x2a, 0xde, 0x56, 0x1b, 0x86, 0x57, 0xb9, 0xa6, 0x1a, 0x0d, 0x4c, 0x1d, 0x3e, 0xad, 0x0c, 0x71,
-		0xe0, 0x15, 0x02, 0x43, 0xd3, 0x90, 0x61, 0x51, 0xa5, 0x9b, 0x55, 0xbc, 0x4b, 0xb3, 0x67, 0x52,
-		0x61, 0x81, 0xb6, 0x48, 0x20, 0x64, 0xf8, 0x67, 0x5c, 0xcb, 0x14, 0xae, 0x49, 0x87, 0x20, 0x00,
-		0x3a, 0xfc, 0x63, 0xcd, 0x89, 0xfb, 0x78, 0x7b, 0xf5, 0x9a, 0x7d, 0x4a, 0xfe, 0xd5, 0x18, 0x24,
-		0x68, 0xbe, 0x18, 0x85, 0xcc, 0xc6, 0xfb, 0xd7, 0xca, 0x95, 0xf9, 0xd5, 0xcd, 0xd2, 0x52, 0x39,
-		0x2b, 0xa1, 0x11, 0x00, 0x0a, 0xb8, 0xb0, 0xb4, 0x3a, 0xbb, 0x91, 0x8d, 0xf9, 0xed, 0xc5, 0x95,
-		0x8d, 0xb3, 0x67, 0xb2, 0x71, 0x9f, 0x60, 0x93, 0x01, 0x12, 0x61, 0x84, 0x47, 0x4e, 0x67, 0x93,
-		0x28, 0x0b, 0x43, 0x8c, 0xc1, 0xe2, 0xd3, 0xe5, 0xf9, 0xb3, 0x67, 0xb2, 0x03, 0x51, 0xc8, 0x23,
-		0xa7, 0xb3, 0x83, 0x68, 0x18, 0xd2, 0x14, 0x52, 0x5a, 0x5d, 0x5d, 0xca, 0xa6, 0x7c, 0x9e, 0xeb,
-		0x1b, 0xca, 0xe2, 0xca, 0x42, 0x36, 0xed, 0xf3, 0x5c, 0x50, 0x56, 0x37, 0xd7, 0xb2, 0xe0, 0x73,
-		0x58, 0x2e, 0xaf, 0xaf, 0xcf, 0x2e, 0x94, 0xb3, 0x19, 0x1f, 0xa3, 0xf4, 0xfe, 0x8d, 0xf2, 0x7a,
-		0x76, 0x28, 0x22, 0xd6, 0x23, 0xa7, 0xb3, 0xc3, 0xfe, 0x10, 0xe5, 0x95, 0xcd, 0xe5, 0xec, 0x08,
-		0x1a, 0x83, 0x61, 0x36, 0x84, 0x10, 0x62, 0xb4, 0x09, 0x74, 0xf6, 0x4c, 0x36, 0x1b, 0x08, 0xc2,
-		0xb8, 0x8c, 0x45, 0x00, 0x67, 0xcf, 0x64, 0x91, 0x3c, 0x07, 0x49, 0xea, 0x5d, 0x08, 0xc1, 0xc8,
-		0xd2, 0x6c, 0xa9, 0xbc, 0x54, 0x59, 0x5d, 0xdb, 0x58, 0x5c, 0x5d, 0x99, 0x5d, 0xca, 0x4a, 0x01,
-		0x4c, 0x29, 0x3f, 0xb9, 0xb9, 0xa8, 0x94, 0xe7, 0xb3, 0xb1, 0x30, 0x6c, 0xad, 0x3c, 0xbb, 0x51,
-		0x9e, 0xcf, 0xc6, 0x65, 0x0d, 0x26, 0xda, 0xe5, 0xc9, 0xb6, 0x91, 0x11, 0x9a, 0xe2, 0x58, 0x87,
-		0x29, 0xa6, 0xbc, 0x5a, 0xa6, 0xf8, 0xdb, 0x31, 0x18, 0x6f, 0xb3, 0x56, 0xb4, 0x1d, 0xe4, 0x09,
-		0x48, 0x32, 0x17, 0x65, 0xab, 0xe7, 0x7d, 0x6d, 0x17, 0x1d, 0xea, 0xb0, 0x2d, 0x2b, 0x28, 0xa5,
-		0x0b, 0x57, 0x10, 0xf1, 0x0e, 0x15, 0x04, 0x61, 0xd1, 0x92, 0xd3, 0x7f, 0xb4, 0x25, 0xa7, 0xb3,
-		0x65, 0xef, 0x6c, 0x2f, 0xcb, 0x1e, 0x85, 0xf5, 0x97, 0xdb, 0x93, 0x6d, 0x72, 0xfb, 0x79, 0x18,
-		0x6b, 0x61, 0xd4, 0x73, 0x8e, 0xfd, 0xa8, 0x04, 0xb9, 0x4e, 0xc6, 0xe9, 0x92, 0xe9, 0x62, 0x91,
-		0x4c, 0x77, 0xbe, 0xd9, 0x82, 0x77, 0x76, 0x9e, 0x84, 0x96, 0xb9, 0xfe, 0xb2, 0x04, 0x93, 0xed,
-		0x2b, 0xc5, 0xb6, 0x32, 0xbc, 0x17, 0x06, 0xea, 0xd8, 0xdb, 0xb1, 0x44, 0xb5, 0x74, 0x4f, 0x9b,
-		0x35, 0x98, 0x74, 0x37, 0x4f, 0x36, 0xa7, 0x0a, 0x2f, 0xe2, 0xf1, 0x4e, 0xe5, 0x1e, 0x93, 0xa6,
-		0x45, 0xd2, 0x4f, 0xc6, 0xe0, 0x70, 0x5b, 0xe6, 0x6d, 0x05, 0x3d, 0x0e, 0xa0, 0x9b, 0x76, 0xc3,
-		0x63, 0x15, 0x11, 0x4b, 0xb0, 0x69, 0x0a, 0xa1, 0xc9, 0x8b, 0x24, 0xcf, 0x86, 0xe7, 0xf7, 0xc7,
-		0x69, 0x3f, 0x30, 0x10, 0x45, 0x38, 0x17, 0x08, 0x9a, 0xa0, 0x82, 0x4e, 0x75, 0xd0, 0xb4, 0xc5,
-		0x31, 0x1f, 0x82, 0xac, 0x66, 0xe8, 0xd8, 0xf4, 0x2a, 0xae, 0xe7, 0x60, 0xb5, 0xae, 0x9b, 0x35,
-		0xba, 0x82, 0xa4, 0x8a, 0xc9, 0x6d, 0xd5, 0x70, 0xb1, 0x32, 0xca, 0xba, 0xd7, 0x45, 0x2f, 0xa1,
-		0xa0, 0x0e, 0xe4, 0x84, 0x28, 0x06, 0x22, 0x14, 0xac, 0xdb, 0xa7, 0x90, 0x7f, 0x3a, 0x0d, 0x99,
-		0x50, 0x5d, 0x8d, 0xee, 0x84, 0xa1, 0x67, 0xd4, 0x6b, 0x6a, 0x45, 0xec, 0x95, 0x98, 0x25, 0x32,
-		0x04, 0xb6, 0xc6, 0xf7, 0x4b, 0x0f, 0xc1, 0x04, 0x45, 0xb1, 0x1a, 0x1e, 0x76, 0x2a, 0x9a, 0xa1,
-		0xba, 0x2e, 0x35, 0x5a, 0x8a, 0xa2, 0x22, 0xd2, 0xb7, 0x4a, 0xba, 0xe6, 0x44, 0x0f, 0x7a, 0x14,
-		0xc6, 0x29, 0x45, 0xbd, 0x61, 0x78, 0xba, 0x6d, 0xe0, 0x0a, 0xd9, 0xbd, 0xb9, 0x74, 0x25, 0xf1,
-		0x25, 0x1b, 0x23, 0x18, 0xcb, 0x1c, 0x81, 0x48, 0xe4, 0xa2, 0x79, 0x38, 0x4e, 0xc9, 0x6a, 0xd8,
-		0xc4, 0x8e, 0xea, 0xe1, 0x0a, 0x7e, 0xb6, 0xa1, 0x1a, 0x6e, 0x45, 0x35, 0xab, 0x95, 0x1d, 0xd5,
-		0xdd, 0xc9, 0x4d, 0x10, 0x06, 0xa5, 0x58, 0x4e, 0x52, 0x8e, 0x10, 0xc4, 0x05, 0x8e, 0x57, 0xa6,
-		0x68, 0xb3, 0x66, 0xf5, 0xa2, 0xea, 0xee, 0xa0, 0x22, 0x4c, 0x52, 0x2e, 0xae, 0xe7, 0xe8, 0x66,
-		0xad, 0xa2, 0xed, 0x60, 0xed, 0x6a, 0xa5, 0xe1, 0x6d, 0x9f, 0xcb, 0x1d, 0x0d, 0x8f, 0x4f, 0x25,
-		0x5c, 0xa7, 0x38, 0x73, 0x04, 0x65, 0xd3, 0xdb, 0x3e, 0x87, 0xd6, 0x61, 0x88, 0x4c, 0x46, 0x5d,
-		0xbf, 0x81, 0x2b, 0xdb, 0x96, 0x43, 0x97, 0xc6, 0x91, 0x36, 0xa9, 0x29, 0x64, 0xc1, 0xc2, 0x2a,
-		0x27, 0x58, 0xb6, 0xaa, 0xb8, 0x98, 0x5c, 0x5f, 0x2b, 0x97, 0xe7, 0x95, 0x8c, 0xe0, 0x72, 0xc1,
-		0x72, 0x88, 0x43, 0xd5, 0x2c, 0xdf, 0xc0, 0x19, 0xe6, 0x50, 0x35, 0x4b, 0x98, 0xf7, 0x51, 0x18,
-		0xd7, 0x34, 0xa6, 0xb3, 0xae, 0x55, 0xf8, 0x1e, 0xcb, 0xcd, 0x65, 0x23, 0xc6, 0xd2, 0xb4, 0x05,
-		0x86, 0xc0, 0x7d, 0xdc, 0x45, 0x8f, 0xc3, 0xe1, 0xc0, 0x58, 0x61, 0xc2, 0xb1, 0x16, 0x2d, 0x9b,
-		0x49, 0x1f, 0x85, 0x71, 0x7b, 0xaf, 0x95, 0x10, 0x45, 0x46, 0xb4, 0xf7, 0x9a, 0xc9, 0x1e, 0x83,
-		0x09, 0x7b, 0xc7, 0x6e, 0xa5, 0x3b, 0x15, 0xa6, 0x43, 0xf6, 0x8e, 0xdd, 0x4c, 0xf8, 0x2e, 0xba,
-		0xe1, 0x76, 0xb0, 0xa6, 0x7a, 0xb8, 0x9a, 0xbb, 0x23, 0x8c, 0x1e, 0xea, 0x40, 0x33, 0x90, 0xd5,
-		0xb4, 0x0a, 0x36, 0xd5, 0x2d, 0x03, 0x57, 0x54, 0x07, 0x9b, 0xaa, 0x9b, 0x9b, 0x0e, 0x23, 0x8f,
-		0x68, 0x5a, 0x99, 0xf6, 0xce, 0xd2, 0x4e, 0x74, 0x0a, 0xc6, 0xac, 0xad, 0x67, 0x34, 0xe6, 0x92,
-		0x15, 0xdb, 0xc1, 0xdb, 0xfa, 0x6e, 0xee, 0x6e, 0x6a, 0xdf, 0x51, 0xd2, 0x41, 0x1d, 0x72, 0x8d,
-		0x82, 0xd1, 0x7d, 0x90, 0xd5, 0xdc, 0x1d, 0xd5, 0xb1, 0x69, 0x4e, 0x76, 0x6d, 0x55, 0xc3, 0xb9,
-		0x77, 0x31, 0x54, 0x06, 0x5f, 0x11, 0x60, 0x12, 0x12, 0xee, 0x75, 0x7d, 0xdb, 0x13, 0x1c, 0xef,
-		0x65, 0x21, 0x41, 0x61, 0x9c, 0xdb, 0x49, 0xc8, 0x12, 0x53, 0x44, 0x06, 0x3e, 0x49, 0xd1, 0x46,
-		0xec, 0x1d, 0x3b, 0x3c, 0xee, 0x5d, 0x30, 0x4c, 0x30, 0x83, 0x41, 0xef, 0x63, 0x05, 0x99, 0xbd,
-		0x13, 0x1a, 0xf1, 0x0c, 0x4c, 0x12, 0xa4, 0x3a, 0xf6, 0xd4, 0xaa, 0xea, 0xa9, 0x21, 0xec, 0x07,
-		0x28, 0x36, 0xb1, 0xfb, 0x32, 0xef, 0x8c, 0xc8, 0xe9, 0x34, 0xb6, 0xf6, 0x7c, 0xcf, 0x7a, 0x90,
-		0xc9, 0x49, 0x60, 0xc2, 0xb7, 0xde, 0xb4, 0xa2, 0x5b, 0x2e, 0xc2, 0x50, 0xd8, 0xf1, 0x51, 0x1a,
-		0x98, 0xeb, 0x67, 0x25, 0x52, 0x05, 0xcd, 0xad, 0xce, 0x93, 0xfa, 0xe5, 0x03, 0xe5, 0x6c, 0x8c,
-		0xd4, 0x51, 0x4b, 0x8b, 0x1b, 0xe5, 0x8a, 0xb2, 0xb9, 0xb2, 0xb1, 0xb8, 0x5c, 0xce, 0xc6, 0x43,
-		0x05, 0xfb, 0xa5, 0x44, 0xea, 0x9e, 0xec, 0xbd, 0xf2, 0x2b, 0x31, 0x18, 0x89, 0xee, 0xc0, 0xd0,
-		0xbb, 0xe1, 0x0e, 0x71, 0x5c, 0xe2, 0x62, 0xaf, 0x72, 0x5d, 0x77, 0x68, 0x44, 0xd6, 0x55, 0xb6,
-		0x3a, 0xfa, 0x3e, 0x31, 0xc1, 0xb1, 0xd6, 0xb1, 0xf7, 0x94, 0xee, 0x90, 0x78, 0xab, 0xab, 0x1e,
-		0x5a, 0x82, 0x69, 0xd3, 0xaa, 0xb8, 0x9e, 0x6a, 0x56, 0x55, 0xa7, 0x5a, 0x09, 0x0e, 0xaa, 0x2a,
-		0xaa, 0xa6, 0x61, 0xd7, 0xb5, 0xd8, 0x4a, 0xe8, 0x73, 0x39, 0x66, 0x5a, 0xeb, 0x1c, 0x39, 0x58,
-		0x22, 0x66, 0x39, 0x6a, 0x93, 0xff, 0xc6, 0x3b, 0xf9, 0xef, 0x51, 0x48, 0xd7, 0x55, 0xbb, 0x82,
-		0x4d, 0xcf, 0xd9, 0xa3, 0x75, 0x77, 0x4a, 0x49, 0xd5, 0x55, 0xbb, 0x4c, 0xda, 0x6f, 0xc9, 0xf6,
-		0xe7, 0x52, 0x22, 0x95, 0xca, 0xa6, 0x2f, 0x25, 0x52, 0xe9, 0x2c, 0xc8, 0xaf, 0xc6, 0x61, 0x28,
-		0x5c, 0x87, 0x93, 0x6d, 0x8d, 0x46, 0x97, 0x2c, 0x89, 0x26, 0xb5, 0xbb, 0xf6, 0xad, 0xda, 0x0b,
-		0x73, 0x64, 0x2d, 0x2b, 0x0e, 0xb0, 0xea, 0x58, 0x61, 0x94, 0xa4, 0x8e, 0x20, 0xce, 0x86, 0x59,
-		0x35, 0x92, 0x52, 0x78, 0x0b, 0x2d, 0xc0, 0xc0, 0x33, 0x2e, 0xe5, 0x3d, 0x40, 0x79, 0xdf, 0xbd,
-		0x3f, 0xef, 0x4b, 0xeb, 0x94, 0x79, 0xfa, 0xd2, 0x7a, 0x65, 0x65, 0x55, 0x59, 0x9e, 0x5d, 0x52,
-		0x38, 0x39, 0x3a, 0x02, 0x09, 0x43, 0xbd, 0xb1, 0x17, 0x5d, 0xf5, 0x28, 0xa8, 0xd7, 0x49, 0x38,
-		0x02, 0x89, 0xeb, 0x58, 0xbd, 0x1a, 0x5d, 0x6b, 0x28, 0xe8, 0x4d, 0x0c, 0x86, 0x19, 0x48, 0x52,
-		0x7b, 0x21, 0x00, 0x6e, 0xb1, 0xec, 0x21, 0x94, 0x82, 0xc4, 0xdc, 0xaa, 0x42, 0x02, 0x22, 0x0b,
-		0x43, 0x0c, 0x5a, 0x59, 0x5b, 0x2c, 0xcf, 0x95, 0xb3, 0x31, 0xf9, 0x51, 0x18, 0x60, 0x46, 0x20,
-		0xc1, 0xe2, 0x9b, 0x21, 0x7b, 0x88, 0x37, 0x39, 0x0f, 0x49, 0xf4, 0x6e, 0x2e, 0x97, 0xca, 0x4a,
-		0x36, 0x16, 0x9d, 0xea, 0x44, 0x36, 0x29, 0xbb, 0x30, 0x14, 0x2e, 0xc4, 0xdf, 0x9a, 0x4d, 0xf6,
-		0xd7, 0x24, 0xc8, 0x84, 0x0a, 0x6b, 0x52, 0x11, 0xa9, 0x86, 0x61, 0x5d, 0xaf, 0xa8, 0x86, 0xae,
-		0xba, 0xdc, 0x35, 0x80, 0x82, 0x66, 0x09, 0xa4, 0xd7, 0xa9, 0x7b, 0x8b, 0x42, 0x24, 0x99, 0x1d,
-		0x90, 0x3f, 0x2f, 0x41, 0xb6, 0xb9, 0xb2, 0x6d, 0x12, 0x53, 0xfa, 0x41, 0x8a, 0x29, 0x7f, 0x4e,
-		0x82, 0x91, 0x68, 0x39, 0xdb, 0x24, 0xde, 0x9d, 0x3f, 0x50, 0xf1, 0xfe, 0x20, 0x06, 0xc3, 0x91,
-		0x22, 0xb6, 0x57, 0xe9, 0x9e, 0x85, 0x31, 0xbd, 0x8a, 0xeb, 0xb6, 0xe5, 0x61, 0x53, 0xdb, 0xab,
-		0x18, 0xf8, 0x1a, 0x36, 0x72, 0x32, 0x4d, 0x1a, 0x33, 0xfb, 0x97, 0xc9, 0x85, 0xc5, 0x80, 0x6e,
-		0x89, 0x90, 0x15, 0xc7, 0x17, 0xe7, 0xcb, 0xcb, 0x6b, 0xab, 0x1b, 0xe5, 0x95, 0xb9, 0xf7, 0x57,
-		0x36, 0x57, 0x2e, 0xaf, 0xac, 0x3e, 0xb5, 0xa2, 0x64, 0xf5, 0x26, 0xb4, 0x37, 0x31, 0xec, 0xd7,
-		0x20, 0xdb, 0x2c, 0x14, 0xba, 0x03, 0xda, 0x89, 0x95, 0x3d, 0x84, 0xc6, 0x61, 0x74, 0x65, 0xb5,
-		0xb2, 0xbe, 0x38, 0x5f, 0xae, 0x94, 0x2f, 0x5c, 0x28, 0xcf, 0x6d, 0xac, 0xb3, 0x83, 0x0f, 0x1f,
-		0x7b, 0x23, 0x12, 0xe0, 0xf2, 0x67, 0xe3, 0x30, 0xde, 0x46, 0x12, 0x34, 0xcb, 0xb7, 0x2c, 0x6c,
-		0x17, 0xf5, 0x60, 0x2f, 0xd2, 0x17, 0x48, 0xcd, 0xb0, 0xa6, 0x3a, 0x1e, 0xdf, 0xe1, 0xdc, 0x07,
-		0xc4, 0x4a, 0xa6, 0xa7, 0x6f, 0xeb, 0xd8, 0xe1, 0xe7, 0x44, 0x6c, 0x1f, 0x33, 0x1a, 0xc0, 0xd9,
-		0x51, 0xd1, 0x03, 0x80, 0x6c, 0xcb, 0xd5, 0x3d, 0xfd, 0x1a, 0xae, 0xe8, 0xa6, 0x38, 0x54, 0x22,
-		0xfb, 0x9a, 0x84, 0x92, 0x15, 0x3d, 0x8b, 0xa6, 0xe7, 0x63, 0x9b, 0xb8, 0xa6, 0x36, 0x61, 0x93,
-		0x64, 0x1e, 0x57, 0xb2, 0xa2, 0xc7, 0xc7, 0xbe, 0x13, 0x86, 0xaa, 0x56, 0x83, 0x14, 0x7b, 0x0c,
-		0x8f, 0xac, 0x1d, 0x92, 0x92, 0x61, 0x30, 0x1f, 0x85, 0x97, 0xf1, 0xc1, 0x69, 0xd6, 0x90, 0x92,
-		0x61, 0x30, 0x86, 0x72, 0x2f, 0x8c, 0xaa, 0xb5, 0x9a, 0x43, 0x98, 0x0b, 0x46, 0x6c, 0x63, 0x32,
-		0xe2, 0x83, 0x29, 0x62, 0xfe, 0x12, 0xa4, 0x84, 0x1d, 0xc8, 0x52, 0x4d, 0x2c, 0x51, 0xb1, 0xd9,
-		0x6e, 0x3b, 0x76, 0x32, 0xad, 0xa4, 0x4c, 0xd1, 0x79, 0x27, 0x0c, 0xe9, 0x6e, 0x25, 0x38, 0x9c,
-		0x8f, 0x9d, 0x88, 0x9d, 0x4c, 0x29, 0x19, 0xdd, 0xf5, 0x0f, 0x36, 0xe5, 0x2f, 0xc7, 0x60, 0x24,
-		0xfa, 0x70, 0x01, 0xcd, 0x43, 0xca, 0xb0, 0x34, 0x95, 0xba, 0x16, 0x7b, 0xb2, 0x75, 0xb2, 0xcb,
-		0xf3, 0x88, 0xc2, 0x12, 0xc7, 0x57, 0x7c, 0xca, 0xfc, 0xbf, 0x92, 0x20, 0x25, 0xc0, 0x68, 0x12,
-		0x12, 0xb6, 0xea, 0xed, 0x50, 0x76, 0xc9, 0x52, 0x2c, 0x2b, 0x29, 0xb4, 0x4d, 0xe0, 0xae, 0xad,
-		0x9a, 0xd4, 0x05, 0x38, 0x9c, 0xb4, 0xc9, 0xbc, 0x1a, 0x58, 0xad, 0xd2, 0x5d, 0x8f, 0x55, 0xaf,
-		0x63, 0xd3, 0x73, 0xc5, 0xbc, 0x72, 0xf8, 0x1c, 0x07, 0xa3, 0xfb, 0x61, 0xcc, 0x73, 0x54, 0xdd,
-		0x88, 0xe0, 0x26, 0x28, 0x6e, 0x56, 0x74, 0xf8, 0xc8, 0x45, 0x38, 0x22, 0xf8, 0x56, 0xb1, 0xa7,
-		0x6a, 0x3b, 0xb8, 0x1a, 0x10, 0x0d, 0xd0, 0xd3, 0x8d, 0x3b, 0x38, 0xc2, 0x3c, 0xef, 0x17, 0xb4,
-		0xf2, 0x2b, 0x12, 0x8c, 0x89, 0x7d, 0x5a, 0xd5, 0x37, 0xd6, 0x32, 0x80, 0x6a, 0x9a, 0x96, 0x17,
-		0x36, 0x57, 0xab, 0x2b, 0xb7, 0xd0, 0x15, 0x66, 0x7d, 0x22, 0x25, 0xc4, 0x20, 0x5f, 0x07, 0x08,
-		0x7a, 0x3a, 0x9a, 0x6d, 0x1a, 0x32, 0xfc, 0xc9, 0x11, 0x7d, 0xfc, 0xc8, 0x76, 0xf6, 0xc0, 0x40,
-		0x64, 0x43, 0x87, 0x26, 0x20, 0xb9, 0x85, 0x6b, 0xba, 0xc9, 0xcf, 0x83, 0x59, 0x43, 0x9c, 0xbf,
-		0x24, 0xfc, 0xf3, 0x97, 0xd2, 0xa7, 0x24, 0x18, 0xd7, 0xac, 0x7a, 0xb3, 0xbc, 0xa5, 0x6c, 0xd3,
-		0xf1, 0x82, 0x7b, 0x51, 0xfa, 0xc0, 0x7b, 0x6b, 0xba, 0xb7, 0xd3, 0xd8, 0x2a, 0x68, 0x56, 0x7d,
-		0xa6, 0x66, 0x19, 0xaa, 0x59, 0x0b, 0x9e, 0x9f, 0xd2, 0x1f, 0xda, 0x83, 0x35, 0x6c, 0x3e, 0x58,
-		0xb3, 0x42, 0x4f, 0x53, 0xcf, 0x07, 0x3f, 0xff, 0xaf, 0x24, 0x7d, 0x31, 0x16, 0x5f, 0x58, 0x2b,
-		0x7d, 0x25, 0x96, 0x5f, 0x60, 0xc3, 0xad, 0x09, 0xf3, 0x28, 0x78, 0xdb, 0xc0, 0x1a, 0x51, 0x19,
-		0xbe, 0x77, 0x3f, 0x4c, 0xd4, 0xac, 0x9a, 0x45, 0x39, 0xce, 0x90, 0x5f, 0xfc, 0x89, 0x6c, 0xda,
-		0x87, 0xe6, 0xbb, 0x3e, 0xbe, 0x2d, 0xae, 0xc0, 0x38, 0x47, 0xae, 0xd0, 0x47, 0x42, 0x6c, 0x63,
-		0x83, 0xf6, 0x3d, 0x56, 0xcb, 0xfd, 0xf2, 0x77, 0xe8, 0x82, 0xae, 0x8c, 0x71, 0x52, 0xd2, 0xc7,
-		0xf6, 0x3e, 0x45, 0x05, 0x0e, 0x47, 0xf8, 0xb1, 0xb0, 0xc5, 0x4e, 0x17, 0x8e, 0xbf, 0xc9, 0x39,
-		0x8e, 0x87, 0x38, 0xae, 0x73, 0xd2, 0xe2, 0x1c, 0x0c, 0xf7, 0xc3, 0xeb, 0xb7, 0x38, 0xaf, 0x21,
-		0x1c, 0x66, 0xb2, 0x00, 0xa3, 0x94, 0x89, 0xd6, 0x70, 0x3d, 0xab, 0x4e, 0x73, 0xe2, 0xfe, 0x6c,
-		0x7e, 0xfb, 0x3b, 0x2c, 0x8e, 0x46, 0x08, 0xd9, 0x9c, 0x4f, 0x55, 0x2c, 0x02, 0x7d, 0x0a, 0x56,
-		0xc5, 0x9a, 0xd1, 0x85, 0xc3, 0x37, 0xb8, 0x20, 0x3e, 0x7e, 0xf1, 0x0a, 0x4c, 0x90, 0xdf, 0x34,
-		0x65, 0x85, 0x25, 0xe9, 0x7e, 0x06, 0x97, 0x7b, 0xe5, 0xa3, 0x2c, 0x54, 0xc7, 0x7d, 0x06, 0x21,
-		0x99, 0x42, 0xb3, 0x58, 0xc3, 0x9e, 0x87, 0x1d, 0xb7, 0xa2, 0x1a, 0xed, 0xc4, 0x0b, 0x1d, 0x62,
-		0xe4, 0x3e, 0xf3, 0xfd, 0xe8, 0x2c, 0x2e, 0x30, 0xca, 0x59, 0xc3, 0x28, 0x6e, 0xc2, 0x1d, 0x6d,
-		0xbc, 0xa2, 0x07, 0x9e, 0x9f, 0xe5, 0x3c, 0x27, 0x5a, 0x3c, 0x83, 0xb0, 0x5d, 0x03, 0x01, 0xf7,
-		0xe7, 0xb2, 0x07, 0x9e, 0x3f, 0xc7, 0x79, 0x22, 0x4e, 0x2b, 0xa6, 0x94, 0x70, 0xbc, 0x04, 0x63,
-		0xd7, 0xb0, 0xb3, 0x65, 0xb9, 0xfc, 0xe0, 0xa8, 0x07, 0x76, 0x9f, 0xe3, 0xec, 0x46, 0x39, 0x21,
-		0x3d, 0x49, 0x22, 0xbc, 0x1e, 0x87, 0xd4, 0xb6, 0xaa, 0xe1, 0x1e, 0x58, 0xbc, 0xc8, 0x59, 0x0c,
-		0x12, 0x7c, 0x42, 0x3a, 0x0b, 0x43, 0x35, 0x8b, 0xaf, 0x5a, 0xdd, 0xc9, 0x3f, 0xcf, 0xc9, 0x33,
-		0x82, 0x86, 0xb3, 0xb0, 0x2d, 0xbb, 0x61, 0x90, 0x25, 0xad, 0x3b, 0x8b, 0xbf, 0x2a, 0x58, 0x08,
-		0x1a, 0xce, 0xa2, 0x0f, 0xb3, 0xbe, 0x24, 0x58, 0xb8, 0x21, 0x7b, 0x3e, 0x01, 0x19, 0xcb, 0x34,
-		0xf6, 0x2c, 0xb3, 0x17, 0x21, 0xbe, 0xc0, 0x39, 0x00, 0x27, 0x21, 0x0c, 0xce, 0x43, 0xba, 0xd7,
-		0x89, 0xf8, 0xeb, 0xdf, 0x17, 0xe1, 0x21, 0x66, 0x60, 0x01, 0x46, 0x45, 0x82, 0xd2, 0x2d, 0xb3,
-		0x07, 0x16, 0x7f, 0x83, 0xb3, 0x18, 0x09, 0x91, 0x71, 0x35, 0x3c, 0xec, 0x7a, 0x35, 0xdc, 0x0b,
-		0x93, 0x2f, 0x0b, 0x35, 0x38, 0x09, 0x37, 0xe5, 0x16, 0x36, 0xb5, 0x9d, 0xde, 0x38, 0xfc, 0xbc,
-		0x30, 0xa5, 0xa0, 0x21, 0x2c, 0xe6, 0x60, 0xb8, 0xae, 0x3a, 0xee, 0x8e, 0x6a, 0xf4, 0x34, 0x1d,
-		0x7f, 0x93, 0xf3, 0x18, 0xf2, 0x89, 0xb8, 0x45, 0x1a, 0x66, 0x3f, 0x6c, 0xbe, 0x22, 0x2c, 0x12,
-		0x22, 0xe3, 0xa1, 0xe7, 0x7a, 0xf4, 0x94, 0xad, 0x1f, 0x6e, 0xbf, 0x20, 0x42, 0x8f, 0xd1, 0x2e,
-		0x87, 0x39, 0x9e, 0x87, 0xb4, 0xab, 0xdf, 0xe8, 0x89, 0xcd, 0xdf, 0x12, 0x33, 0x4d, 0x09, 0x08,
-		0xf1, 0xfb, 0xe1, 0x48, 0xdb, 0x65, 0xa2, 0x07, 0x66, 0x7f, 0x9b, 0x33, 0x9b, 0x6c, 0xb3, 0x54,
-		0xf0, 0x94, 0xd0, 0x2f, 0xcb, 0xbf, 0x23, 0x52, 0x02, 0x6e, 0xe2, 0xb5, 0x46, 0xf6, 0x11, 0xae,
-		0xba, 0xdd, 0x9f, 0xd5, 0x7e, 0x51, 0x58, 0x8d, 0xd1, 0x46, 0xac, 0xb6, 0x01, 0x93, 0x9c, 0x63,
-		0x7f, 0xf3, 0xfa, 0x4b, 0x22, 0xb1, 0x32, 0xea, 0xcd, 0xe8, 0xec, 0x7e, 0x10, 0xf2, 0xbe, 0x39,
-		0x45, 0xc1, 0xea, 0x56, 0xea, 0xaa, 0xdd, 0x03, 0xe7, 0x5f, 0xe6, 0x9c, 0x45, 0xc6, 0xf7, 0x2b,
-		0x5e, 0x77, 0x59, 0xb5, 0x09, 0xf3, 0xa7, 0x21, 0x27, 0x98, 0x37, 0x4c, 0x07, 0x6b, 0x56, 0xcd,
-		0xd4, 0x6f, 0xe0, 0x6a, 0x0f, 0xac, 0xff, 0x6e, 0xd3, 0x54, 0x6d, 0x86, 0xc8, 0x09, 0xe7, 0x45,
-		0xc8, 0xfa, 0xb5, 0x4a, 0x45, 0xaf, 0xdb, 0x96, 0xe3, 0x75, 0xe1, 0xf8, 0x55, 0x31, 0x53, 0x3e,
-		0xdd, 0x22, 0x25, 0x2b, 0x96, 0x61, 0x84, 0x36, 0x7b, 0x75, 0xc9, 0x5f, 0xe1, 0x8c, 0x86, 0x03,
-		0x2a, 0x9e, 0x38, 0x34, 0xab, 0x6e, 0xab, 0x4e, 0x2f, 0xf9, 0xef, 0xef, 0x89, 0xc4, 0xc1, 0x49,
-		0x78, 0xe2, 0xf0, 0xf6, 0x6c, 0x4c, 0x56, 0xfb, 0x1e, 0x38, 0xfc, 0xaa, 0x48, 0x1c, 0x82, 0x86,
-		0xb3, 0x10, 0x05, 0x43, 0x0f, 0x2c, 0xfe, 0xbe, 0x60, 0x21, 0x68, 0x08, 0x8b, 0x27, 0x83, 0x85,
-		0xd6, 0xc1, 0x35, 0xdd, 0xf5, 0x1c, 0x56, 0x26, 0xef, 0xcf, 0xea, 0x1f, 0x7c, 0x3f, 0x5a, 0x84,
-		0x29, 0x21, 0x52, 0x92, 0x89, 0xf8, 0xb1, 0x2b, 0xdd, 0x45, 0x75, 0x17, 0xec, 0xd7, 0x44, 0x26,
-		0x0a, 0x91, 0x11, 0xd9, 0x42, 0x15, 0x22, 0x31, 0xbb, 0x46, 0xf6, 0x0e, 0x3d, 0xb0, 0xfb, 0x87,
-		0x4d, 0xc2, 0xad, 0x0b, 0x5a, 0xc2, 0x33, 0x54, 0xff, 0x34, 0xcc, 0xab, 0x78, 0xaf, 0x27, 0xef,
-		0xfc, 0xf5, 0xa6, 0xfa, 0x67, 0x93, 0x51, 0xb2, 0x1c, 0x32, 0xda, 0x54, 0x4f, 0xa1, 0x6e, 0xf7,
-		0x87, 0x72, 0x3f, 0xf6, 0x1a, 0xd7, 0x37, 0x5a, 0x4e, 0x15, 0x97, 0x88, 0x93, 0x47, 0x8b, 0x9e,
-		0xee, 0xcc, 0x3e, 0xfa, 0x9a, 0xef, 0xe7, 0x91, 0x9a, 0xa7, 0x78, 0x01, 0x86, 0x23, 0x05, 0x4f,
-		0x77, 0x56, 0x1f, 0xe3, 0xac, 0x86, 0xc2, 0xf5, 0x4e, 0xf1, 0x51, 0x48, 0x90, 0xe2, 0xa5, 0x3b,
-		0xf9, 0xc7, 0x39, 0x39, 0x45, 0x2f, 0xbe, 0x07, 0x52, 0xa2, 0x68, 0xe9, 0x4e, 0xfa, 0x09, 0x4e,
-		0xea, 0x93, 0x10, 0x72, 0x51, 0xb0, 0x74, 0x27, 0xff, 0xf3, 0x82, 0x5c, 0x90, 0x10, 0xf2, 0xde,
-		0x4d, 0xf8, 0xb5, 0xbf, 0x90, 0xe0, 0x8b, 0x8e, 0xb0, 0xdd, 0x79, 0x18, 0xe4, 0x95, 0x4a, 0x77,
-		0xea, 0x4f, 0xf2, 0xc1, 0x05, 0x45, 0xf1, 0x31, 0x48, 0xf6, 0x68, 0xf0, 0x9f, 0xe4, 0xa4, 0x0c,
-		0xbf, 0x38, 0x07, 0x99, 0x50, 0x75, 0xd2, 0x9d, 0xfc, 0x2f, 0x71, 0xf2, 0x30, 0x15, 0x11, 0x9d,
-		0x57, 0x27, 0xdd, 0x19, 0x7c, 0x4a, 0x88, 0xce, 0x29, 0x88, 0xd9, 0x44, 0x61, 0xd2, 0x9d, 0xfa,
-		0xd3, 0xc2, 0xea, 0x82, 0xa4, 0xf8, 0x04, 0xa4, 0xfd, 0xc5, 0xa6, 0x3b, 0xfd, 0x4f, 0x71, 0xfa,
-		0x80, 0x86, 0x58, 0x20, 0xb4, 0xd8, 0x75, 0x67, 0xf1, 0xd3, 0xc2, 0x02, 0x21, 0x2a, 0x12, 0x46,
-		0xcd, 0x05, 0x4c, 0x77, 0x4e, 0x3f, 0x23, 0xc2, 0xa8, 0xa9, 0x7e, 0x21, 0xb3, 0x49, 0x73, 0x7e,
-		0x77, 0x16, 0x3f, 0x2b, 0x66, 0x93, 0xe2, 0x13, 0x31, 0x9a, 0x2b, 0x82, 0xee, 0x3c, 0xfe, 0x8a,
-		0x10, 0xa3, 0xa9, 0x20, 0x28, 0xae, 0x01, 0x6a, 0xad, 0x06, 0xba, 0xf3, 0x7b, 0x81, 0xf3, 0x1b,
-		0x6b, 0x29, 0x06, 0x8a, 0x4f, 0xc1, 0x64, 0xfb, 0x4a, 0xa0, 0x3b, 0xd7, 0xcf, 0xbc, 0xd6, 0xb4,
-		0x77, 0x0b, 0x17, 0x02, 0xc5, 0x8d, 0x60, 0x49, 0x09, 0x57, 0x01, 0xdd, 0xd9, 0x7e, 0xf6, 0xb5,
-		0x68, 0xe2, 0x0e, 0x17, 0x01, 0xc5, 0x59, 0x80, 0x60, 0x01, 0xee, 0xce, 0xeb, 0x73, 0x9c, 0x57,
-		0x88, 0x88, 0x84, 0x06, 0x5f, 0x7f, 0xbb, 0xd3, 0xbf, 0x28, 0x42, 0x83, 0x53, 0x90, 0xd0, 0x10,
-		0x4b, 0x6f, 0x77, 0xea, 0xcf, 0x8b, 0xd0, 0x10, 0x24, 0xc4, 0xb3, 0x43, 0xab, 0x5b, 0x77, 0x0e,
-		0x5f, 0x10, 0x9e, 0x1d, 0xa2, 0x2a, 0xae, 0xc0, 0x58, 0xcb, 0x82, 0xd8, 0x9d, 0xd5, 0x17, 0x39,
-		0xab, 0x6c, 0xf3, 0x7a, 0x18, 0x5e, 0xbc, 0xf8, 0x62, 0xd8, 0x9d, 0xdb, 0x97, 0x9a, 0x16, 0x2f,
-		0xbe, 0x16, 0x16, 0xcf, 0x43, 0xca, 0x6c, 0x18, 0x06, 0x09, 0x1e, 0xb4, 0xff, 0x9d, 0xbf, 0xdc,
-		0x1f, 0xbe, 0xce, 0xad, 0x23, 0x08, 0x8a, 0x8f, 0x42, 0x12, 0xd7, 0xb7, 0x70, 0xb5, 0x1b, 0xe5,
-		0xf7, 0x5e, 0x17, 0x09, 0x93, 0x60, 0x17, 0x9f, 0x00, 0x60, 0x47, 0x23, 0xf4, 0xf1, 0x60, 0x17,
-		0xda, 0xff, 0xfa, 0x3a, 0xbf, 0x8d, 0x13, 0x90, 0x04, 0x0c, 0xd8, 0xdd, 0x9e, 0xfd, 0x19, 0x7c,
-		0x3f, 0xca, 0x80, 0xce, 0xc8, 0xe3, 0x30, 0xf8, 0x8c, 0x6b, 0x99, 0x9e, 0x5a, 0xeb, 0x46, 0xfd,
-		0xdf, 0x38, 0xb5, 0xc0, 0x27, 0x06, 0xab, 0x5b, 0x0e, 0xf6, 0xd4, 0x9a, 0xdb, 0x8d, 0xf6, 0xbf,
-		0x73, 0x5a, 0x9f, 0x80, 0x10, 0x6b, 0xaa, 0xeb, 0xf5, 0xa2, 0xf7, 0x1f, 0x09, 0x62, 0x41, 0x40,
-		0x84, 0x26, 0xbf, 0xaf, 0xe2, 0xbd, 0x6e, 0xb4, 0x7f, 0x2c, 0x84, 0xe6, 0xf8, 0xc5, 0xf7, 0x40,
-		0x9a, 0xfc, 0x64, 0x57, 0xec, 0xba, 0x10, 0xff, 0x0f, 0x4e, 0x1c, 0x50, 0x90, 0x91, 0x5d, 0xaf,
-		0xea, 0xe9, 0xdd, 0x8d, 0x7d, 0x8b, 0xcf, 0xb4, 0xc0, 0x2f, 0xce, 0x42, 0xc6, 0xf5, 0xaa, 0xd5,
-		0x06, 0xaf, 0x4f, 0xbb, 0x90, 0xff, 0xcf, 0xd7, 0xfd, 0x23, 0x0b, 0x9f, 0x86, 0xcc, 0xf6, 0xf5,
-		0xab, 0x9e, 0x6d, 0xd1, 0x47, 0x20, 0xdd, 0x38, 0xbc, 0xc6, 0x39, 0x84, 0x48, 0x8a, 0x73, 0x30,
-		0x44, 0x74, 0x71, 0xb0, 0x8d, 0xe9, 0xf3, 0xaa, 0x2e, 0x2c, 0xfe, 0x17, 0x37, 0x40, 0x84, 0xa8,
-		0xf4, 0xa3, 0xdf, 0x78, 0x75, 0x4a, 0x7a, 0xf9, 0xd5, 0x29, 0xe9, 0x0f, 0x5e, 0x9d, 0x92, 0x3e,
-		0xfd, 0xed, 0xa9, 0x43, 0x2f, 0x7f, 0x7b, 0xea, 0xd0, 0xef, 0x7d, 0x7b, 0xea, 0x50, 0xfb, 0x63,
-		0x63, 0x58, 0xb0, 0x16, 0x2c, 0x76, 0x60, 0xfc, 0x01, 0x39, 0x72, 0x5c, 0x5c, 0xb3, 0x82, 0xd3,
-		0x5a, 0x7f, 0x93, 0x03, 0x1f, 0x8b, 0xc3, 0x94, 0x66, 0xb9, 0x75, 0xcb, 0x9d, 0xd9, 0x52, 0x5d,
-		0x3c, 0x73, 0xed, 0xe1, 0x2d, 0xec, 0xa9, 0x0f, 0xcf, 0x68, 0x96, 0x6e, 0xf2, 0x63, 0xdf, 0x71,
-		0xd6, 0x5f, 0x20, 0xfd, 0x05, 0xde, 0x9f, 0x6f, 0x7b, 0x42, 0x2c, 0x2f, 0x40, 0x62, 0xce, 0xd2,
-		0x4d, 0x34, 0x01, 0xc9, 0x2a, 0x36, 0xad, 0x3a, 0xbf, 0x01, 0xc6, 0x1a, 0xe8, 0x2e, 0x18, 0x50,
-		0xeb, 0x56, 0xc3, 0xf4, 0xd8, 0x71, 0x79, 0x29, 0xf3, 0x8d, 0x9b, 0xd3, 0x87, 0x7e, 0xff, 0xe6,
-		0x74, 0x7c, 0xd1, 0xf4, 0x14, 0xde, 0x55, 0x4c, 0x7c, 0xf7, 0xa5, 0x69, 0x49, 0xbe, 0x04, 0x83,
-		0xf3, 0x58, 0x3b, 0x08, 0xaf, 0x79, 0xac, 0x35, 0xf1, 0xba, 0x0f, 0x52, 0x8b, 0xa6, 0xc7, 0xee,
-		0xe8, 0x1d, 0x87, 0xb8, 0x6e, 0xb2, 0x5b, 0x1f, 0x4d, 0xe3, 0x13, 0x38, 0x41, 0x9d, 0xc7, 0x9a,
-		0x8f, 0x5a, 0xc5, 0x5a, 0x33, 0x2a, 0x61, 0x4f, 0xe0, 0xa5, 0xf9, 0xdf, 0xfb, 0x4f, 0x53, 0x87,
-		0x9e, 0x7b, 0x75, 0xea, 0x50, 0xa7, 0xf9, 0x89, 0x98, 0x9f, 0x9b, 0x98, 0xfd, 0x79, 0xd0, 0xad,
-		0x5e, 0x9d, 0x21, 0xa1, 0xe5, 0x6e, 0x0d, 0x50, 0xbb, 0x3d, 0x02, 0x9f, 0x8e, 0xc1, 0x74, 0xf3,
-		0x91, 0x3a, 0xf1, 0x63, 0xd7, 0x53, 0xeb, 0x76, 0xa7, 0x17, 0xa2, 0xce, 0x43, 0x7a, 0x43, 0xe0,
-		0xa0, 0x1c, 0x0c, 0xba, 0x58, 0xb3, 0xcc, 0xaa, 0x4b, 0x45, 0x8e, 0x2b, 0xa2, 0x49, 0x0c, 0x68,
-		0xaa, 0xa6, 0xe5, 0xf2, 0xfb, 0x9a, 0xac, 0x51, 0xfa, 0xcb, 0x52, 0x7f, 0x8e, 0x35, 0xe2, 0x0f,
-		0x45, 0xcd, 0xb3, 0x26, 0x7d, 0xe0, 0xfe, 0xfd, 0x9e, 0x46, 0x50, 0xf5, 0x02, 0x15, 0x42, 0x8f,
-		0x1e, 0xa6, 0x9a, 0x1f, 0x3d, 0x3c, 0x85, 0x0d, 0xe3, 0xb2, 0x69, 0x5d, 0x37, 0x37, 0x22, 0x26,
-		0xf9, 0x5d, 0x09, 0x4e, 0xd0, 0x8b, 0xe8, 0x4e, 0x5d, 0x37, 0xbd, 0x19, 0x43, 0xdf, 0x72, 0x67,
-		0xb6, 0x74, 0xcf, 0x65, 0x96, 0xe3, 0x36, 0x99, 0x08, 0x30, 0x0a, 0x04, 0xa3, 0x40, 0x30, 0xe4,
-		0x33, 0x90, 0x2a, 0xe9, 0xde, 0xac, 0xe3, 0xa8, 0x7b, 0x08, 0x41, 0x82, 0xc0, 0xb8, 0x51, 0xe8,
-		0x6f, 0x62, 0x11, 0x6c, 0xe0, 0xba, 0x4b, 0x1f, 0x7a, 0x25, 0x14, 0xd6, 0x28, 0x6d, 0x76, 0x9c,
-		0xc9, 0xf3, 0x21, 0x4d, 0x43, 0x22, 0x85, 0x7e, 0xb2, 0x48, 0x68, 0x27, 0xae, 0xaf, 0xcf, 0x57,
-		0x12, 0x70, 0x3c, 0x84, 0xa0, 0x39, 0x7b, 0xb6, 0x47, 0x43, 0xd2, 0xda, 0xe6, 0xca, 0x8c, 0x85,
-		0x94, 0x61, 0xdd, 0x1d, 0xc2, 0x6c, 0x1b, 0x92, 0x6b, 0x84, 0x8e, 0x28, 0xe2, 0x59, 0x9e, 0x6a,
-		0x70, 0xed, 0x58, 0x83, 0x40, 0xd9, 0x65, 0xfc, 0x18, 0x83, 0xea, 0xe2, 0x1e, 0xbe, 0x81, 0xd5,
-		0x6d, 0x76, 0xf9, 0x31, 0x4e, 0x9f, 0x7d, 0xa6, 0x08, 0x80, 0xde, 0x73, 0x9c, 0x80, 0xa4, 0xda,
-		0x60, 0x8f, 0xed, 0xe2, 0x27, 0x87, 0x14, 0xd6, 0x90, 0x2f, 0xc3, 0x20, 0x7f, 0x54, 0x80, 0xb2,
-		0x10, 0xbf, 0x8a, 0xf7, 0xe8, 0x38, 0x43, 0x0a, 0xf9, 0x89, 0x0a, 0x90, 0xa4, 0xc2, 0xf3, 0x5b,
-		0xdd, 0xb9, 0x42, 0x8b, 0xf4, 0x05, 0x2a, 0xa4, 0xc2, 0xd0, 0xe4, 0x4b, 0x90, 0x9a, 0xb7, 0xea,
-		0xba, 0x69, 0x45, 0xb9, 0xa5, 0x19, 0x37, 0x2a, 0xb3, 0xdd, 0xe0, 0xe1, 0xac, 0xb0, 0x06, 0x9a,
-		0x84, 0x01, 0x76, 0x19, 0x96, 0x3f, 0x7a, 0xe4, 0x2d, 0x79, 0x0e, 0x06, 0x29, 0xef, 0x55, 0x9b,
-		0xcc, 0xaf, 0x7f, 0x11, 0x29, 0xcd, 0xdf, 0x78, 0xe0, 0xec, 0x63, 0x81, 0xb0, 0x08, 0x12, 0x55,
-		0xd5, 0x53, 0xb9, 0xde, 0xf4, 0xb7, 0xfc, 0x5e, 0x48, 0x71, 0x26, 0x2e, 0x3a, 0x0d, 0x71, 0xcb,
-		0x76, 0xf9, 0xc3, 0xc3, 0x7c, 0x27, 0x55, 0x56, 0xed, 0x52, 0x82, 0x24, 0x02, 0x85, 0x20, 0x97,
-		0x94, 0x8e, 0xfe, 0x72, 0xae, 0x7f, 0x7f, 0x61, 0xc3, 0xf8, 0xce, 0xf2, 0x85, 0x18, 0x4c, 0x85,
-		0x7a, 0xaf, 0x61, 0x87, 0xd4, 0xcb, 0x11, 0xd7, 0x47, 0x21, 0x21, 0x79, 0x7f, 0x07, 0x77, 0x79,
-		0x0f, 0xc4, 0x67, 0x6d, 0x1b, 0xe5, 0x21, 0xc5, 0x1e, 0x12, 0x5a, 0xcc, 0x5f, 0x12, 0x8a, 0xdf,
-		0x26, 0x7d, 0xae, 0xb5, 0xed, 0x5d, 0x57, 0x1d, 0xff, 0x35, 0x10, 0xd1, 0x96, 0x1f, 0x87, 0xf4,
-		0x9c, 0x65, 0xba, 0xd8, 0x74, 0x1b, 0x34, 0x74, 0xb6, 0x0c, 0x4b, 0xbb, 0xca, 0x39, 0xb0, 0x06,
-		0x31, 0xb8, 0x6a, 0xdb, 0x94, 0x32, 0xa1, 0x90, 0x9f, 0x2c, 0xf5, 0x96, 0xd6, 0x3b, 0x9a, 0xe8,
-		0xf1, 0xfe, 0x4d, 0xc4, 0x95, 0xf4, 0x6d, 0xf4, 0xeb, 0x47, 0xe0, 0x58, 0x98, 0x94, 0x65, 0x9c,
-		0x90, 0x85, 0xb2, 0x21, 0x0b, 0x51, 0x78, 0x7b, 0xfb, 0xe4, 0xbb, 0x65, 0xde, 0x7c, 0xd7, 0x3c,
-		0x94, 0xdf, 0x3f, 0xb2, 0xf3, 0x5d, 0xe6, 0x52, 0x7e, 0x1c, 0x86, 0xd7, 0x54, 0xc7, 0x5b, 0xc7,
-		0xde, 0x45, 0xac, 0x56, 0xb1, 0x13, 0x0d, 0xec, 0x61, 0x11, 0xd8, 0x08, 0x12, 0x34, 0x7a, 0x99,
-		0x63, 0xd3, 0xdf, 0xf2, 0x0e, 0x24, 0xe8, 0x45, 0x03, 0x3f, 0xe8, 0x39, 0x05, 0x0b, 0x7a, 0x32,
-		0x5d, 0x7b, 0x1e, 0x76, 0x39, 0x09, 0x6b, 0xa0, 0x33, 0x22, 0x74, 0xe3, 0xfb, 0x87, 0x2e, 0xf7,
-		0x76, 0x1e, 0xc0, 0x06, 0x0c, 0x96, 0xc8, 0x6c, 0x2f, 0xce, 0xfb, 0x82, 0x48, 0x81, 0x20, 0x68,
-		0x19, 0x46, 0x6d, 0xd5, 0xf1, 0xe8, 0x0d, 0xcb, 0x1d, 0xaa, 0x05, 0xcf, 0x0c, 0xd3, 0x85, 0xe6,
-		0x79, 0x28, 0x44, 0x94, 0xe5, 0xa3, 0x0c, 0xdb, 0x61, 0xa0, 0xfc, 0x9f, 0x13, 0x30, 0xc0, 0x8d,
-		0xf1, 0x1e, 0x18, 0xe4, 0x46, 0xa3, 0x03, 0x66, 0x4e, 0x1f, 0x2f, 0xb4, 0xfa, 0x7e, 0xc1, 0xf7,
-		0x51, 0xce, 0x4f, 0xd0, 0xa0, 0x7b, 0x20, 0xa5, 0xed, 0xa8, 0xba, 0x59, 0xd1, 0xab, 0xa2, 0x58,
-		0x78, 0xf5, 0xe6, 0xf4, 0xe0, 0x1c, 0x81, 0x2d, 0xce, 0x2b, 0x83, 0xb4, 0x73, 0xb1, 0x4a, 0x92,
-		0xcd, 0x0e, 0xd6, 0x6b, 0x3b, 0x2c, 0xd9, 0xc4, 0x15, 0xde, 0x42, 0xe7, 0x20, 0x41, 0x1c, 0x82,
-		0x5f, 0xc0, 0xcf, 0xb7, 0x14, 0x71, 0xfe, 0xc2, 0x58, 0x4a, 0x91, 0x81, 0x3f, 0xfd, 0xad, 0x69,
-		0x49, 0xa1, 0x14, 0x68, 0x0e, 0x86, 0x0d, 0xd5, 0xf5, 0x2a, 0x34, 0x48, 0xc8, 0xf0, 0x49, 0xca,
-		0xe2, 0x48, 0xab, 0x41, 0xb8, 0x61, 0xb9, 0xe8, 0x19, 0x42, 0xc5, 0x40, 0x55, 0x74, 0x12, 0xb2,
-		0x94, 0x89, 0x66, 0xd5, 0xeb, 0xba, 0xc7, 0xd2, 0xf7, 0x00, 0xb5, 0xfb, 0x08, 0x81, 0xcf, 0x51,
-		0x30, 0x4d, 0xe2, 0x47, 0x21, 0x4d, 0x6f, 0xfc, 0x52, 0x14, 0x76, 0xbb, 0x25, 0x45, 0x00, 0xb4,
-		0xf3, 0x5e, 0x18, 0xbd, 0xa6, 0x1a, 0x7a, 0x55, 0xf5, 0x2c, 0xc7, 0x65, 0x28, 0x29, 0xc6, 0x25,
-		0x00, 0x53, 0xc4, 0x87, 0x60, 0xc2, 0xc4, 0xbb, 0xf4, 0xbe, 0x4d, 0x04, 0x3b, 0x4d, 0xb1, 0x11,
-		0xe9, 0xbb, 0x12, 0xa5, 0x78, 0x17, 0x8c, 0x68, 0xc2, 0xf8, 0x0c, 0x17, 0x28, 0xee, 0xb0, 0x0f,
-		0xa5, 0x68, 0x47, 0x20, 0xa5, 0xda, 0x36, 0x43, 0xc8, 0x50, 0x84, 0x41, 0xd5, 0xb6, 0x69, 0xd7,
-		0x29, 0x18, 0xa3, 0x3a, 0x3a, 0xd8, 0x6d, 0x18, 0x1e, 0x67, 0x32, 0x44, 0x71, 0x46, 0x49, 0x87,
-		0xc2, 0xe0, 0x14, 0xf7, 0x2e, 0x18, 0xc6, 0xd7, 0xf4, 0x2a, 0x36, 0x35, 0xcc, 0xf0, 0x86, 0x29,
-		0xde, 0x90, 0x00, 0x52, 0xa4, 0xfb, 0x20, 0x6b, 0x3b, 0x96, 0x6d, 0xb9, 0xd8, 0xa9, 0xa8, 0xd5,
-		0xaa, 0x83, 0x5d, 0x37, 0x37, 0xc2, 0xf8, 0x09, 0xf8, 0x2c, 0x03, 0xcb, 0x0f, 0x40, 0x62, 0x5e,
-		0xf5, 0x54, 0x92, 0xc3, 0xbc, 0x5d, 0xb6, 0x04, 0x0c, 0x29, 0xe4, 0x67, 0xdb, 0x70, 0xfb, 0x6e,
-		0x0c, 0x12, 0x57, 0x2c, 0x0f, 0xa3, 0x47, 0x42, 0xeb, 0xce, 0x48, 0x3b, 0x1f, 0x5f, 0xd7, 0x6b,
-		0x26, 0xae, 0x2e, 0xbb, 0xb5, 0xd0, 0xab, 0x78, 0x81, 0x8b, 0xc5, 0x22, 0x2e, 0x36, 0x01, 0x49,
-		0xc7, 0x6a, 0x98, 0x55, 0x71, 0x59, 0x84, 0x36, 0x50, 0x19, 0x52, 0xbe, 0xe7, 0x24, 0xba, 0x79,
-		0xce, 0x28, 0xf1, 0x1c, 0xe2, 0xd7, 0x1c, 0xa0, 0x0c, 0x6e, 0x71, 0x07, 0x2a, 0x41, 0xda, 0x4f,
-		0x68, 0xdc, 0x03, 0x7b, 0x73, 0xe2, 0x80, 0x0c, 0xdd, 0x0f, 0x63, 0xbe, 0x3f, 0xf8, 0x06, 0x65,
-		0x5e, 0x98, 0xf5, 0x3b, 0xb8, 0x45, 0x23, 0xae, 0xc6, 0x5f, 0x0b, 0x1c, 0xa4, 0x7a, 0x05, 0xae,
-		0xc6, 0x5e, 0x0d, 0x3c, 0x06, 0x69, 0x57, 0xaf, 0x99, 0xaa, 0xd7, 0x70, 0x30, 0xf7, 0xc6, 0x00,
-		0x20, 0xff, 0x54, 0x0c, 0x06, 0x98, 0x77, 0x87, 0xec, 0x26, 0xb5, 0xb7, 0x5b, 0xac, 0x93, 0xdd,
-		0xe2, 0x07, 0xb7, 0xdb, 0x2c, 0x80, 0x2f, 0x8c, 0xcb, 0x5f, 0xeb, 0x3a, 0xda, 0xca, 0x88, 0x89,
-		0xb8, 0xae, 0xd7, 0x78, 0xf0, 0x86, 0x88, 0x7c, 0x0f, 0x4a, 0x86, 0xf2, 0xe4, 0x79, 0x48, 0x6f,
-		0xe9, 0x5e, 0x45, 0x25, 0xd5, 0x29, 0x35, 0x61, 0xe6, 0xf4, 0x54, 0xa1, 0x5d, 0x19, 0x5b, 0x10,
-		0x35, 0xac, 0x92, 0xda, 0xe2, 0xbf, 0xe4, 0xff, 0x28, 0x91, 0xc5, 0x98, 0x0f, 0x88, 0x66, 0x61,
-		0x58, 0x28, 0x5a, 0xd9, 0x36, 0xd4, 0x1a, 0x77, 0xc6, 0xe3, 0x1d, 0xb5, 0xbd, 0x60, 0xa8, 0x35,
-		0x25, 0xc3, 0x15, 0x24, 0x8d, 0xf6, 0x13, 0x1b, 0xeb, 0x30, 0xb1, 0x11, 0x4f, 0x8a, 0x1f, 0xcc,
-		0x93, 0x22, 0x73, 0x9e, 0x68, 0x9e, 0xf3, 0xaf, 0xc6, 0x68, 0x51, 0x66, 0x5b, 0xae, 0x6a, 0xbc,
-		0x15, 0x21, 0x76, 0x14, 0xd2, 0xb6, 0x65, 0x54, 0x58, 0x0f, 0xbb, 0x95, 0x95, 0xb2, 0x2d, 0x43,
-		0x69, 0xf1, 0xa3, 0xe4, 0x6d, 0x8a, 0xbf, 0x81, 0xdb, 0x60, 0xb5, 0xc1, 0x66, 0xab, 0x39, 0x30,
-		0xc4, 0x4c, 0xc1, 0x17, 0xcc, 0x87, 0x88, 0x0d, 0xe8, 0x0a, 0x2c, 0xb5, 0x2e, 0xf0, 0x4c, 0x6c,
-		0x86, 0xa9, 0x70, 0x3c, 0x42, 0xc1, 0xd6, 0x97, 0x76, 0xd5, 0x7c, 0xd8, 0xcf, 0x15, 0x8e, 0x27,
-		0xff, 0x53, 0x09, 0xd2, 0x54, 0xd5, 0x65, 0xec, 0xa9, 0x11, 0x53, 0x49, 0x07, 0x37, 0xd5, 0x71,
-		0x00, 0xc6, 0xc6, 0xd5, 0x6f, 0x60, 0x3e, 0x81, 0x69, 0x0a, 0x59, 0xd7, 0x6f, 0x60, 0x74, 0xd6,
-		0xd7, 0x2b, 0xbe, 0xbf, 0x5e, 0x3c, 0x14, 0x85, 0x76, 0x77, 0xc0, 0x20, 0xfd, 0x2a, 0xc1, 0x2e,
-		0xbb, 0x96, 0x18, 0xa7, 0xef, 0x2c, 0x6e, 0xec, 0xba, 0xf2, 0x33, 0x30, 0xb8, 0xb1, 0xcb, 0xb6,
-		0x52, 0x47, 0x21, 0xed, 0x58, 0x16, 0x5f, 0x5f, 0x59, 0x5d, 0x93, 0x22, 0x00, 0xba, 0x9c, 0x88,
-		0xed, 0x43, 0x2c, 0xd8, 0x3e, 0x04, 0xfb, 0x9f, 0x78, 0x4f, 0xfb, 0x9f, 0x53, 0xff, 0x4e, 0x82,
-		0x4c, 0x28, 0x0c, 0xd1, 0xc3, 0x70, 0xb8, 0xb4, 0xb4, 0x3a, 0x77, 0xb9, 0xb2, 0x38, 0x5f, 0xb9,
-		0xb0, 0x34, 0xbb, 0x10, 0x5c, 0xef, 0xcd, 0x4f, 0x3e, 0xff, 0xe2, 0x09, 0x14, 0xc2, 0xdd, 0x34,
-		0xaf, 0x92, 0xfd, 0x31, 0x9a, 0x81, 0x89, 0x28, 0xc9, 0x6c, 0x69, 0xbd, 0xbc, 0xb2, 0x91, 0x95,
-		0xf2, 0x87, 0x9f, 0x7f, 0xf1, 0xc4, 0x58, 0x88, 0x62, 0x76, 0xcb, 0xc5, 0xa6, 0xd7, 0x4a, 0x30,
-		0xb7, 0xba, 0xbc, 0xbc, 0xb8, 0x91, 0x8d, 0xb5, 0x10, 0xf0, 0x44, 0x7b, 0x1f, 0x8c, 0x45, 0x09,
-		0x56, 0x16, 0x97, 0xb2, 0xf1, 0x3c, 0x7a, 0xfe, 0xc5, 0x13, 0x23, 0x21, 0xec, 0x15, 0xdd, 0xc8,
-		0xa7, 0x7e, 0xe2, 0x4b, 0x53, 0x87, 0x7e, 0xfe, 0xaf, 0x4d, 0x49, 0x44, 0xb3, 0xe1, 0x48, 0x28,
-		0xa2, 0x07, 0xe0, 0x8e, 0xf5, 0xc5, 0x85, 0x95, 0xf2, 0x7c, 0x65, 0x79, 0x7d, 0xa1, 0xc2, 0xde,
-		0x6b, 0xf6, 0xb5, 0x1b, 0x7d, 0xfe, 0xc5, 0x13, 0x19, 0xae, 0x52, 0x27, 0xec, 0x35, 0xa5, 0x7c,
-		0x65, 0x75, 0xa3, 0x9c, 0x95, 0x18, 0xf6, 0x9a, 0x83, 0xaf, 0x59, 0x1e, 0xfb, 0x6c, 0xc9, 0x43,
-		0x70, 0xa4, 0x0d, 0xb6, 0xaf, 0xd8, 0xd8, 0xf3, 0x2f, 0x9e, 0x18, 0x5e, 0x73, 0x30, 0x73, 0x53,
-		0x4a, 0x51, 0x80, 0x5c, 0x2b, 0xc5, 0xea, 0xda, 0xea, 0xfa, 0xec, 0x52, 0xf6, 0x44, 0x3e, 0xfb,
-		0xfc, 0x8b, 0x27, 0x86, 0x44, 0xce, 0x21, 0xf8, 0x81, 0x66, 0xa5, 0x27, 0x3b, 0xee, 0x5f, 0x1e,
-		0xeb, 0x7f, 0xff, 0x12, 0x3d, 0xf1, 0xf9, 0x8b, 0x31, 0x98, 0x6a, 0xb9, 0x44, 0xc9, 0x8f, 0x1e,
-		0x3b, 0x1d, 0xf8, 0x14, 0x21, 0x35, 0x2f, 0x4e, 0x34, 0xfb, 0x3d, 0xef, 0xf9, 0xd9, 0x3e, 0xcf,
-		0x7b, 0x86, 0xc5, 0x48, 0xe2, 0xb8, 0xe7, 0x54, 0xf7, 0xe3, 0x1e, 0x21, 0xff, 0x01, 0x4e, 0x7b,
-		0x3e, 0xfe, 0x30, 0xdc, 0xcd, 0x0f, 0xc9, 0x5c, 0x4f, 0xbd, 0xaa, 0x9b, 0x35, 0xff, 0x28, 0x92,
-		0xb7, 0xb9, 0x51, 0x26, 0xf9, 0x69, 0xa4, 0x80, 0xee, 0x7b, 0x20, 0x99, 0xdf, 0x77, 0x83, 0xd8,
-		0x7d, 0xe3, 0xd7, 0x65, 0x86, 0xf2, 0x5d, 0x8e, 0x4e, 0xe5, 0x4f, 0x4a, 0x30, 0x72, 0x51, 0x77,
-		0x3d, 0xcb, 0xd1, 0x35, 0xd5, 0xa0, 0xb7, 0x95, 0xcf, 0xf6, 0x9a, 0x9b, 0x9b, 0x72, 0xd8, 0x13,
-		0x30, 0x70, 0x4d, 0x35, 0x5c, 0xec, 0xf1, 0xcb, 0xfa, 0x77, 0x16, 0xda, 0x1b, 0xa2, 0xe0, 0x17,
-		0xe7, 0x82, 0x01, 0x23, 0x93, 0x7f, 0x31, 0x06, 0xa3, 0x34, 0xca, 0x5d, 0xf6, 0x39, 0x0d, 0xb2,
-		0x11, 0x2c, 0x41, 0xc2, 0x51, 0x3d, 0x7e, 0x78, 0x52, 0x2a, 0xf0, 0x43, 0xce, 0x7b, 0xba, 0x1f,
-		0x5c, 0x16, 0xe6, 0xb1, 0xa6, 0x50, 0x5a, 0xf4, 0x23, 0x90, 0xaa, 0xab, 0xbb, 0x15, 0xca, 0x87,
-		0x6d, 0xaf, 0x66, 0xfb, 0xe3, 0x73, 0xeb, 0xe6, 0xf4, 0xe8, 0x9e, 0x5a, 0x37, 0x8a, 0xb2, 0xe0,
-		0x23, 0x2b, 0x83, 0x75, 0x75, 0x97, 0x88, 0x88, 0x6c, 0x18, 0x25, 0x50, 0x6d, 0x47, 0x35, 0x6b,
-		0x98, 0x0d, 0x42, 0x8f, 0x82, 0x4a, 0x17, 0xfb, 0x1e, 0x64, 0x32, 0x18, 0x24, 0xc4, 0x4e, 0x56,
-		0x86, 0xeb, 0xea, 0xee, 0x1c, 0x05, 0x90, 0x11, 0x8b, 0xa9, 0x17, 0x5e, 0x9a, 0x3e, 0x44, 0x0f,
-		0x8e, 0x5f, 0x91, 0x00, 0x02, 0x8b, 0xa1, 0x1f, 0x81, 0xac, 0xe6, 0xb7, 0x28, 0xad, 0xcb, 0xe7,
-		0xf0, 0xde, 0x4e, 0x73, 0xd1, 0x64, 0x6f, 0xb6, 0xb6, 0xbf, 0x7c, 0x73, 0x5a, 0x52, 0x46, 0xb5,
-		0xa6, 0xa9, 0xf8, 0x20, 0x64, 0x1a, 0x76, 0x55, 0xf5, 0x70, 0x85, 0x6e, 0x36, 0x63, 0x5d, 0xeb,
-		0x84, 0x29, 0xc2, 0xeb, 0xd6, 0xcd, 0x69, 0xc4, 0xd4, 0x0a, 0x11, 0xcb, 0xb4, 0x7a, 0x00, 0x06,
-		0x21, 0x04, 0x21, 0x9d, 0x7e, 0x47, 0x82, 0xcc, 0x7c, 0xe8, 0xd6, 0x40, 0x0e, 0x06, 0xeb, 0x96,
-		0xa9, 0x5f, 0xe5, 0xfe, 0x98, 0x56, 0x44, 0x13, 0xe5, 0x21, 0xc5, 0x5e, 0xe0, 0xf0, 0xf6, 0xc4,
-		0x91, 0x90, 0x68, 0x13, 0xaa, 0xeb, 0x78, 0xcb, 0xd5, 0xc5, 0x6c, 0x28, 0xa2, 0x89, 0x2e, 0x40,
-		0xd6, 0xc5, 0x5a, 0xc3, 0xd1, 0xbd, 0xbd, 0x8a, 0x66, 0x99, 0x9e, 0xaa, 0x79, 0xec, 0x55, 0x80,
-		0xd2, 0xd1, 0x5b, 0x37, 0xa7, 0xef, 0x60, 0xb2, 0x36, 0x63, 0xc8, 0xca, 0xa8, 0x00, 0xcd, 0x31,
-		0x08, 0x19, 0xa1, 0x8a, 0x3d, 0x55, 0x37, 0x5c, 0x5a, 0x7a, 0xa5, 0x15, 0xd1, 0x0c, 0xe9, 0xf2,
-		0x8f, 0x07, 0x21, 0xed, 0x7b, 0x3b, 0xba, 0x0e, 0x59, 0xcb, 0xc6, 0x4e, 0xa4, 0x90, 0xa5, 0xeb,
-		0x78, 0x69, 0x29, 0x18, 0xb9, 0x19, 0x43, 0xfe, 0xff, 0x37, 0xa7, 0x1f, 0xec, 0xc1, 0x83, 0xae,
-		0xa8, 0x06, 0x2f, 0x82, 0x95, 0x51, 0xc1, 0x43, 0x54, 0xc5, 0x17, 0x88, 0x5f, 0x88, 0xed, 0xaf,
-		0xdd, 0xd8, 0x12, 0x47, 0x8f, 0x11, 0x95, 0x9b, 0x31, 0x64, 0xe2, 0x01, 0x1c, 0xb4, 0x46, 0x21,
-		0xa4, 0x72, 0x7d, 0x46, 0xd5, 0x0d, 0xf1, 0x56, 0x9b, 0xc2, 0x5b, 0x68, 0x11, 0x06, 0x5c, 0x4f,
-		0xf5, 0x1a, 0xac, 0x78, 0x49, 0x96, 0x1e, 0xee, 0x51, 0xe6, 0x92, 0x65, 0x56, 0xd7, 0x29, 0xa1,
-		0xc2, 0x19, 0xa0, 0x0b, 0x30, 0xe0, 0x59, 0x57, 0xb1, 0xc9, 0x8d, 0xda, 0x57, 0xc4, 0xd3, 0x87,
-		0x34, 0x8c, 0x1a, 0x79, 0x90, 0xad, 0x62, 0x03, 0xd7, 0xa8, 0x29, 0xdd, 0x1d, 0x95, 0x6c, 0x90,
-		0xe8, 0x97, 0x65, 0x4a, 0x8b, 0x7d, 0x87, 0x25, 0x37, 0x50, 0x33, 0x3f, 0x59, 0x19, 0xf5, 0x41,
-		0xeb, 0x14, 0x82, 0x2e, 0x47, 0x2e, 0xbc, 0xf0, 0xcf, 0x2f, 0xdd, 0xd5, 0x29, 0xf6, 0x42, 0x5e,
-		0x2e, 0x8e, 0x55, 0xc2, 0xd7, 0x65, 0x2e, 0x40, 0xb6, 0x61, 0x6e, 0x59, 0x26, 0x7d, 0x13, 0x85,
-		0xef, 0x18, 0xc8, 0x16, 0x34, 0x1e, 0x9e, 0xb5, 0x66, 0x0c, 0x59, 0x19, 0xf5, 0x41, 0x17, 0xd9,
-		0xbe, 0xa2, 0x0a, 0x23, 0x01, 0x16, 0x0d, 0xdd, 0x74, 0xd7, 0xd0, 0xbd, 0x93, 0x87, 0xee, 0xe1,
-		0xe6, 0x51, 0x82, 0xe8, 0x1d, 0xf6, 0x81, 0x84, 0x0c, 0x5d, 0x04, 0x08, 0x12, 0x06, 0x3d, 0x5e,
-		0xc9, 0x9c, 0x96, 0xbb, 0x67, 0x1d, 0xb1, 0x25, 0x0d, 0x68, 0xd1, 0x87, 0x61, 0xbc, 0xae, 0x9b,
-		0x15, 0x17, 0x1b, 0xdb, 0x15, 0x6e, 0x60, 0xc2, 0x92, 0x7e, 0x49, 0xa0, 0xb4, 0xd4, 0x9f, 0x3f,
-		0xdc, 0xba, 0x39, 0x9d, 0xe7, 0x49, 0xb5, 0x95, 0xa5, 0xac, 0x8c, 0xd5, 0x75, 0x73, 0x1d, 0x1b,
-		0xdb, 0xf3, 0x3e, 0xac, 0x38, 0xf4, 0x13, 0x2f, 0x4d, 0x1f, 0xf2, 0x03, 0x58, 0x87, 0xa1, 0x20,
-		0xb0, 0xb0, 0x8b, 0x56, 0x21, 0xad, 0x8a, 0x06, 0x3b, 0x88, 0xe9, 0xd9, 0xd9, 0x43, 0x01, 0x1a,
-		0xf0, 0x60, 0xb9, 0xe2, 0xb9, 0xff, 0x70, 0x42, 0x92, 0x9f, 0x8f, 0xc1, 0xc0, 0xfc, 0x95, 0x35,
-		0x55, 0x77, 0xd0, 0x0d, 0x18, 0x0b, 0x9c, 0x2d, 0x9a, 0x29, 0x96, 0x6f, 0xdd, 0x9c, 0xce, 0x35,
-		0xfb, 0x63, 0x9f, 0xa9, 0x62, 0x56, 0xd3, 0x84, 0x24, 0x41, 0x90, 0x88, 0x5c, 0x71, 0xa3, 0xe3,
-		0x76, 0x3b, 0x3c, 0x76, 0x0b, 0xca, 0x01, 0xd2, 0x54, 0xcb, 0xee, 0x3d, 0x94, 0x38, 0xcb, 0x30,
-		0xc8, 0x6c, 0xe1, 0xa2, 0x22, 0x24, 0x6d, 0xf2, 0x83, 0x3f, 0xfa, 0x98, 0xea, 0x18, 0x4d, 0x14,
-		0xdf, 0x3f, 0x10, 0x26, 0x24, 0xf2, 0x17, 0xe3, 0x00, 0xf3, 0x57, 0xae, 0x6c, 0x38, 0xba, 0x6d,
-		0x60, 0xef, 0x07, 0x6a, 0xd7, 0x8f, 0x4b, 0x70, 0x38, 0xb0, 0x9a, 0xeb, 0x68, 0x4d, 0xc6, 0x7d,
-		0xf2, 0xd6, 0xcd, 0xe9, 0x63, 0xcd, 0xc6, 0x0d, 0xa1, 0x1d, 0xc0, 0xc0, 0xe3, 0x3e, 0xa3, 0x75,
-		0x47, 0x6b, 0x2f, 0x47, 0xd5, 0xf5, 0x7c, 0x39, 0xe2, 0x9d, 0xe5, 0x08, 0xa1, 0xbd, 0x21, 0x39,
-		0xe6, 0x5d, 0xaf, 0x75, 0xae, 0xd7, 0x21, 0x13, 0xcc, 0x91, 0x8b, 0xe6, 0x21, 0xe5, 0xf1, 0xdf,
-		0x7c, 0xca, 0xe5, 0xce, 0x53, 0x2e, 0xc8, 0xf8, 0xb4, 0xfb, 0x94, 0xf2, 0xbf, 0x8d, 0x01, 0x04,
-		0x51, 0xfd, 0x67, 0x35, 0xa2, 0xc8, 0x72, 0xca, 0x17, 0xbf, 0xf8, 0x81, 0x0a, 0x68, 0x4e, 0x1d,
-		0x9a, 0xad, 0x3f, 0x8c, 0xc1, 0xf8, 0xa6, 0xc8, 0xfc, 0xef, 0x58, 0x18, 0xad, 0xc1, 0x20, 0x36,
-		0x3d, 0x47, 0xa7, 0x26, 0x26, 0xde, 0xfa, 0x50, 0x27, 0x6f, 0x6d, 0x63, 0x35, 0xfa, 0xb1, 0x0c,
-		0xf1, 0x34, 0x88, 0xb3, 0x09, 0xd9, 0xfa, 0x53, 0x71, 0xc8, 0x75, 0xa2, 0x42, 0x73, 0x30, 0xaa,
-		0x39, 0x98, 0x02, 0x2a, 0xe1, 0xa3, 0xe7, 0x52, 0x3e, 0xd8, 0x49, 0x34, 0x21, 0xc8, 0xca, 0x88,
-		0x80, 0xf0, 0xda, 0xa0, 0x06, 0xa4, 0xcc, 0x27, 0x21, 0x43, 0xb0, 0x7a, 0xac, 0xeb, 0x65, 0x5e,
-		0x1c, 0x88, 0x41, 0xa2, 0x0c, 0x58, 0x75, 0x30, 0x12, 0x40, 0x69, 0x79, 0xf0, 0x2c, 0x8c, 0xea,
-		0xa6, 0xee, 0xe9, 0xaa, 0x51, 0xd9, 0x52, 0x0d, 0xd5, 0xd4, 0x0e, 0xb2, 0x4b, 0x62, 0x0b, 0x3a,
-		0x1f, 0xb6, 0x89, 0x9d, 0xac, 0x8c, 0x70, 0x48, 0x89, 0x01, 0xd0, 0x45, 0x18, 0x14, 0x43, 0x25,
-		0x0e, 0x54, 0x4b, 0x0a, 0xf2, 0xd0, 0x8c, 0xfc, 0x64, 0x1c, 0xc6, 0x14, 0x5c, 0x7d, 0x67, 0x2a,
-		0xfa, 0x9b, 0x8a, 0x65, 0x00, 0x96, 0x48, 0xc8, 0x4a, 0x72, 0x80, 0xd9, 0x20, 0xa9, 0x28, 0xcd,
-		0x38, 0xcc, 0xbb, 0x5e, 0x68, 0x3e, 0xfe, 0x28, 0x0e, 0x43, 0xe1, 0xf9, 0x78, 0x67, 0x89, 0xff,
-		0xe1, 0x59, 0xe2, 0xd1, 0x62, 0x90, 0x1a, 0x13, 0xfc, 0xa3, 0x87, 0x1d, 0x52, 0x63, 0x4b, 0x48,
-		0x75, 0xce, 0x89, 0xff, 0x3b, 0x06, 0x03, 0x6b, 0xaa, 0xa3, 0xd6, 0x5d, 0xa4, 0xb5, 0x6c, 0x6c,
-		0xc4, 0xc1, 0x7e, 0xcb, 0xd7, 0x6a, 0xf9, 0xa1, 0x58, 0x97, 0x7d, 0xcd, 0x0b, 0x6d, 0xf6, 0x35,
-		0xef, 0x83, 0x91, 0xba, 0xba, 0x1b, 0x7a, 0xd6, 0x4c, 0x27, 0x73, 0xb8, 0x74, 0x24, 0xe0, 0x12,
-		0xed, 0x67, 0xc7, 0x35, 0xc1, 0x03, 0x68, 0xf4, 0x18, 0x64, 0x08, 0x46, 0xb0, 0x4a, 0x10, 0xf2,
-		0xc9, 0xe0, 0x5c, 0x24, 0xd4, 0x29, 0x2b, 0x50, 0x57, 0x77, 0xcb, 0xac, 0x81, 0x96, 0x00, 0xed,
-		0xf8, 0x47, 0x73, 0x95, 0xc0, 0x94, 0x84, 0xfe, 0xf8, 0xad, 0x9b, 0xd3, 0x47, 0x18, 0x7d, 0x2b,
-		0x8e, 0xac, 0x8c, 0x05, 0x40, 0xc1, 0xed, 0x0c, 0x00, 0xd1, 0xab, 0xc2, 0xae, 0x2a, 0xb2, 0xdd,
-		0xf5, 0xe1, 0x5b, 0x37, 0xa7, 0xc7, 0x18, 0x97, 0xa0, 0x4f, 0x56, 0xd2, 0xa4, 0x31, 0x4f, 0x7e,
-		0x87, 0x0c, 0xff, 0x25, 0x09, 0x50, 0xb0, 0x06, 0x29, 0xd8, 0xb5, 0x2d, 0xd3, 0xa5, 0xfb, 0xbe,
-		0xd0, 0x26, 0x4d, 0xda, 0x7f, 0xdf, 0x17, 0xd0, 0x8b, 0x7d, 0x5f, 0x28, 0x74, 0x1f, 0x0f, 0xf2,
-		0x75, 0x8c, 0xcf, 0x63, 0x9b, 0x7b, 0x9d, 0x85, 0x39, 0x4b, 0x17, 0xd4, 0x6d, 0x12, 0xf4, 0xbf,
-		0x90, 0xe0, 0x48, 0x8b, 0x37, 0xf9, 0xc2, 0xfe, 0x39, 0x40, 0x4e, 0xa8, 0x93, 0x7f, 0xbc, 0x8a,
-		0x09, 0xdd, 0xb7, 0x73, 0x8e, 0x39, 0x2d, 0x0b, 0xc1, 0xed, 0x5b, 0x72, 0xd8, 0xc5, 0xd0, 0x7f,
-		0x22, 0xc1, 0x44, 0x78, 0x78, 0x5f, 0x91, 0x15, 0x18, 0x0a, 0x8f, 0xce, 0x55, 0xb8, 0xbb, 0x17,
-		0x15, 0xb8, 0xf4, 0x11, 0x7a, 0xf4, 0x64, 0x10, 0xaa, 0xec, 0xf0, 0xf6, 0xe1, 0x9e, 0xad, 0x21,
-		0x64, 0x6a, 0x0e, 0x59, 0xa6, 0xc1, 0x9f, 0x48, 0x90, 0x58, 0xb3, 0x2c, 0x03, 0x59, 0x30, 0x66,
-		0x5a, 0x5e, 0x85, 0x78, 0x16, 0xae, 0x56, 0xf8, 0x19, 0x0f, 0x3b, 0xd5, 0x9d, 0xeb, 0xcf, 0x48,
-		0xdf, 0xbb, 0x39, 0xdd, 0xca, 0x4a, 0x19, 0x35, 0x2d, 0xaf, 0x44, 0x21, 0x1b, 0xec, 0x04, 0xe8,
-		0xc3, 0x30, 0x1c, 0x1d, 0x8c, 0x9d, 0x78, 0x3d, 0xd5, 0xf7, 0x60, 0x51, 0x36, 0xb7, 0x6e, 0x4e,
-		0x4f, 0x04, 0x11, 0xe3, 0x83, 0x65, 0x65, 0x68, 0x2b, 0x34, 0x7a, 0x31, 0x45, 0xb4, 0xff, 0xe3,
-		0x97, 0xa6, 0xa5, 0xd2, 0x85, 0x8e, 0x4f, 0x68, 0x1e, 0xd8, 0x57, 0x84, 0x5d, 0xff, 0x31, 0x43,
-		0xf4, 0xb1, 0xcc, 0x0b, 0xe3, 0x30, 0xdd, 0xe1, 0x39, 0x84, 0xb7, 0x7b, 0xa0, 0x47, 0x10, 0x5d,
-		0x9e, 0x11, 0xe4, 0x7b, 0x7a, 0xec, 0x21, 0xbf, 0x9e, 0x00, 0xb4, 0xec, 0xd6, 0xe6, 0x48, 0x55,
-		0x83, 0x83, 0x43, 0xcf, 0xa6, 0x23, 0x31, 0xe9, 0x0d, 0x1d, 0x89, 0x2d, 0x47, 0x0e, 0x99, 0x62,
-		0xfd, 0x1d, 0x6d, 0xf7, 0x7c, 0xd2, 0x14, 0x7f, 0x4b, 0x4e, 0x9a, 0xda, 0x97, 0x2a, 0x89, 0x1f,
-		0xe0, 0x8e, 0x29, 0xf9, 0xd6, 0xec, 0x98, 0x26, 0x61, 0x80, 0x9f, 0x41, 0xb3, 0x4f, 0x7d, 0xf3,
-		0x16, 0x7a, 0x54, 0x7c, 0x21, 0x79, 0xb0, 0xb7, 0xec, 0xcf, 0xb0, 0x79, 0x9e, 0xf9, 0x5a, 0x1c,
-		0xb2, 0xcb, 0x6e, 0xad, 0x5c, 0xd5, 0xbd, 0x37, 0xc9, 0xf7, 0xec, 0xce, 0x9b, 0xcc, 0xb9, 0x5b,
-		0x37, 0xa7, 0x47, 0x98, 0xc9, 0x6e, 0xa7, 0xa1, 0xea, 0x30, 0xda, 0xf4, 0x38, 0x87, 0xbb, 0xe6,
-		0xfc, 0x41, 0x9e, 0x2a, 0x35, 0xb1, 0x92, 0xe9, 0xbe, 0x20, 0x14, 0x20, 0x68, 0xb7, 0x7d, 0x34,
-		0xb0, 0x85, 0xec, 0xe2, 0x9b, 0x79, 0xe6, 0xca, 0xa6, 0xf0, 0xeb, 0x31, 0xc8, 0x2c, 0xbb, 0x62,
-		0x9f, 0x8b, 0xff, 0xcc, 0x9e, 0x28, 0x3c, 0xe6, 0xbf, 0x38, 0x12, 0xef, 0x2d, 0x10, 0xa2, 0x2f,
-		0x93, 0x7c, 0x2b, 0x4e, 0xf3, 0x70, 0x09, 0xd7, 0x74, 0xd3, 0x5f, 0xac, 0xf1, 0x3b, 0x1b, 0xa3,
-		0x1f, 0xa2, 0x8d, 0x51, 0x30, 0xc3, 0x89, 0x83, 0xcc, 0xf0, 0x6f, 0xc5, 0x60, 0x78, 0xd9, 0xad,
-		0x6d, 0x9a, 0xd5, 0x77, 0x42, 0xe5, 0x8d, 0x84, 0xca, 0x6d, 0x2f, 0xcd, 0xbe, 0x1e, 0x83, 0x53,
-		0xe1, 0x5a, 0xea, 0xd9, 0x06, 0x76, 0xf6, 0xfc, 0x72, 0xc9, 0x56, 0x6b, 0xba, 0x19, 0xbe, 0x3d,
-		0x73, 0x24, 0x2c, 0x2c, 0xc5, 0x15, 0x22, 0xcb, 0x26, 0x64, 0xd6, 0xd4, 0x1a, 0x56, 0xf0, 0xb3,
-		0x0d, 0xec, 0x7a, 0x6d, 0xde, 0x6d, 0x99, 0x84, 0x01, 0x6b, 0x7b, 0x9b, 0x5d, 0xb6, 0x90, 0x4e,
-		0x26, 0x14, 0xde, 0x42, 0x13, 0x90, 0x34, 0xf4, 0xba, 0xce, 0x0c, 0x92, 0x50, 0x58, 0x03, 0x4d,
-		0x43, 0x46, 0x23, 0x7a, 0x57, 0xd8, 0x95, 0xfd, 0x84, 0xf8, 0x76, 0x47, 0xc3, 0xf4, 0x36, 0x08,
-		0x44, 0x7e, 0x02, 0x86, 0xd8, 0x78, 0x7c, 0x7f, 0x71, 0x04, 0x52, 0xf4, 0x8a, 0x75, 0x30, 0xea,
-		0x20, 0x69, 0x5f, 0x66, 0xef, 0xc1, 0x30, 0x2e, 0x6c, 0x60, 0xd6, 0x28, 0x95, 0x3a, 0x9a, 0xf2,
-		0x64, 0xf7, 0x49, 0x66, 0x86, 0xf2, 0xcd, 0xf8, 0x9b, 0x49, 0x38, 0xcc, 0xaf, 0xb5, 0xa8, 0xb6,
-		0x3e, 0xb3, 0xe3, 0x79, 0xe2, 0x05, 0x33, 0xe0, 0x1b, 0x7b, 0xd5, 0xd6, 0xe5, 0x3d, 0x48, 0x5c,
-		0xf4, 0x3c, 0x1b, 0x9d, 0x82, 0xa4, 0xd3, 0x30, 0xb0, 0x78, 0x58, 0x30, 0x51, 0x08, 0x70, 0x0a,
-		0x04, 0x41, 0x69, 0x18, 0x58, 0x61, 0x28, 0xa8, 0x0c, 0xd3, 0xdb, 0x0d, 0xc3, 0xd8, 0xab, 0x54,
-		0x31, 0xfd, 0x77, 0x4a, 0xfe, 0x7f, 0x2e, 0xc0, 0xbb, 0xb6, 0x6a, 0xfa, 0x45, 0x65, 0x4a, 0x39,
-		0x46, 0xd1, 0xe6, 0x29, 0x96, 0xf8, 0xaf, 0x05, 0x65, 0x81, 0x23, 0xff, 0x7e, 0x0c, 0x52, 0x82,
-		0x35, 0x7d, 0x31, 0x05, 0x1b, 0x58, 0xf3, 0x2c, 0x71, 0x41, 0xc1, 0x6f, 0x23, 0x04, 0xf1, 0x1a,
-		0x9f, 0xa2, 0xf4, 0xc5, 0x43, 0x0a, 0x69, 0x10, 0x98, 0xff, 0xba, 0x10, 0x81, 0xd9, 0x0d, 0x32,
-		0x6b, 0x09, 0xdb, 0x12, 0x27, 0x63, 0x17, 0x0f, 0x29, 0xb4, 0x85, 0x72, 0x30, 0x40, 0xa2, 0xcd,
-		0x63, 0xdf, 0x94, 0x24, 0x70, 0xde, 0x46, 0x93, 0x90, 0xb4, 0x55, 0x4f, 0x63, 0xd7, 0xec, 0x49,
-		0x07, 0x6b, 0x92, 0x78, 0x60, 0xef, 0xf2, 0x36, 0xff, 0xaf, 0x12, 0x62, 0x0c, 0xf6, 0xd1, 0x34,
-		0x22, 0xf7, 0x9a, 0xea, 0x79, 0xd8, 0x31, 0x09, 0x43, 0x86, 0x4e, 0xdf, 0x41, 0xb3, 0xaa, 0x7b,
-		0xfc, 0xff, 0xa7, 0xd0, 0xdf, 0xfc, 0x3f, 0x3b, 0x50, 0x7f, 0xa8, 0xd0, 0x4e, 0xf6, 0x6f, 0xa3,
-		0x86, 0x04, 0xb0, 0x44, 0x90, 0xca, 0x30, 0xae, 0x56, 0xab, 0x3a, 0xf1, 0x6a, 0xd5, 0xa8, 0x6c,
-		0xe9, 0xf4, 0xe0, 0xc4, 0xa5, 0xff, 0x14, 0xac, 0xd3, 0x5c, 0xa0, 0x80, 0xa0, 0xc4, 0xf1, 0x4b,
-		0x69, 0x18, 0xb4, 0x99, 0x50, 0xf2, 0x79, 0x18, 0x6b, 0x91, 0x94, 0xc8, 0x77, 0x55, 0x37, 0xab,
-		0xe2, 0x1d, 0x2a, 0xf2, 0x9b, 0xc0, 0xe8, 0x87, 0x0f, 0xd9, 0xd5, 0x0f, 0xfa, 0xbb, 0xf4, 0xe3,
-		0x9d, 0xef, 0x90, 0x8d, 0x84, 0xee, 0x90, 0xa9, 0xb6, 0x5e, 0x4a, 0x53, 0xfe, 0xfc, 0xea, 0xd8,
-		0x2c, 0xef, 0x60, 0xd7, 0xc6, 0x0a, 0x96, 0x53, 0x9b, 0xa9, 0x61, 0x53, 0xec, 0x94, 0x48, 0x97,
-		0x6a, 0xeb, 0x2e, 0x75, 0xc7, 0xe0, 0x43, 0x8c, 0xee, 0xf9, 0xd0, 0x6f, 0x7a, 0xa3, 0x2c, 0xb1,
-		0x30, 0xbb, 0xb6, 0xe8, 0xfb, 0xf1, 0x6f, 0xc4, 0xe0, 0x58, 0xc8, 0x8f, 0x43, 0xc8, 0xad, 0xee,
-		0x9c, 0x6f, 0xef, 0xf1, 0x3d, 0x7c, 0xc6, 0xf0, 0x32, 0x24, 0x08, 0x3e, 0xea, 0xf2, 0x7f, 0x17,
-		0x72, 0xbf, 0xf4, 0xcf, 0xff, 0x91, 0x4c, 0x9d, 0xa2, 0xfd, 0xac, 0x50, 0x26, 0xa5, 0x4f, 0xf4,
-		0x6e, 0xbf, 0x6c, 0xf0, 0x0d, 0x4a, 0xf7, 0xf6, 0x99, 0xb1, 0xd9, 0x86, 0x9f, 0x3f, 0x0f, 0x72,
-		0x87, 0xed, 0x27, 0xcb, 0x98, 0xfb, 0x6f, 0x78, 0xfb, 0x48, 0xc7, 0x9d, 0xee, 0xe7, 0xed, 0x37,
-		0x83, 0x3d, 0x6e, 0x8d, 0x77, 0x61, 0xf2, 0x49, 0x32, 0x76, 0x70, 0x28, 0x28, 0x12, 0xfb, 0xa4,
-		0x7f, 0x73, 0x46, 0xe2, 0xff, 0x93, 0x4d, 0x5c, 0x83, 0x81, 0x40, 0x3e, 0xbe, 0xd1, 0xbd, 0xa7,
-		0xd0, 0x71, 0xbd, 0x28, 0x84, 0x16, 0x0b, 0x25, 0x44, 0x29, 0xff, 0x82, 0x04, 0x77, 0xb4, 0x0c,
-		0xcd, 0x73, 0xfc, 0x02, 0x40, 0xe8, 0x54, 0x53, 0xea, 0xef, 0xce, 0x5e, 0x88, 0x94, 0x30, 0x6a,
-		0x11, 0xf6, 0xde, 0xae, 0xc2, 0x32, 0x29, 0x22, 0xd2, 0x3e, 0x0b, 0x87, 0xa3, 0xc2, 0x0a, 0x33,
-		0x3d, 0x0d, 0x23, 0xd1, 0x02, 0x82, 0x17, 0x37, 0x07, 0xb8, 0x7b, 0x31, 0x1c, 0x29, 0x22, 0xe4,
-		0x4a, 0xf3, 0xd4, 0xf8, 0xe6, 0x29, 0x43, 0xda, 0x47, 0xe5, 0x5b, 0xc7, 0x9e, 0xad, 0x13, 0x50,
-		0xca, 0x5f, 0x97, 0xe0, 0x44, 0x74, 0x84, 0x60, 0xe3, 0xe3, 0xbe, 0xe9, 0xfa, 0xdd, 0x36, 0x47,
-		0xfa, 0xae, 0x04, 0x77, 0xee, 0xa3, 0x06, 0xb7, 0xd9, 0x0d, 0x98, 0x08, 0x9d, 0xae, 0x8a, 0x85,
-		0x42, 0x38, 0xd7, 0xa9, 0xee, 0xc7, 0xc2, 0xfe, 0x61, 0xe2, 0x51, 0x62, 0xc7, 0xaf, 0x7c, 0x6b,
-		0x7a, 0xbc, 0xb5, 0xcf, 0x55, 0xc6, 0x5b, 0x4f, 0x44, 0x6f, 0xa3, 0x17, 0xfe, 0xae, 0x04, 0xf7,
-		0x45, 0x55, 0x6d, 0xf3, 0x0c, 0xf6, 0x6d, 0x34, 0x75, 0xff, 0x5e, 0x82, 0x53, 0xbd, 0xe8, 0xc3,
-		0xe7, 0x70, 0x0b, 0xc6, 0x83, 0xc7, 0x22, 0xcd, 0x53, 0x78, 0x7f, 0x1f, 0x0f, 0xb7, 0x79, 0x2c,
-		0x20, 0x9f, 0xdb, 0x9b, 0x30, 0x57, 0xff, 0x52, 0xe2, 0xf1, 0x1b, 0x76, 0x13, 0x7f, 0x62, 0xa2,
-		0x1b, 0x9e, 0x3e, 0x27, 0x26, 0xb4, 0xe9, 0x19, 0x8e, 0x6c, 0x7a, 0xda, 0x4c, 0x79, 0xec, 0x36,
-		0x65, 0xa3, 0x6b, 0x3c, 0x5b, 0xb7, 0x79, 0xce, 0xf2, 0x41, 0x18, 0x6f, 0x13, 0x5a, 0x3c, 0x31,
-		0xf5, 0x11, 0x59, 0x0a, 0x6a, 0x0d, 0x1e, 0xf9, 0xdf, 0x48, 0x30, 0x4d, 0x07, 0x6e, 0x33, 0x8d,
-		0x6f, 0x67, 0x7b, 0xd6, 0x79, 0xee, 0x6d, 0xab, 0x16, 0x37, 0xec, 0x22, 0x0c, 0x30, 0x0f, 0xe5,
-		0xb6, 0x3c, 0x80, 0x8b, 0x73, 0x06, 0x41, 0xae, 0x9f, 0x17, 0xfa, 0xb5, 0x4f, 0x18, 0x6f, 0x92,
-		0x1d, 0x6f, 0x57, 0xc2, 0x78, 0x45, 0xe4, 0xfa, 0xf6, 0x6a, 0x70, 0xbb, 0x69, 0xb7, 0x2d, 0xd7,
-		0x33, 0x23, 0xbe, 0x45, 0x49, 0xdd, 0xd7, 0xa9, 0x4b, 0x52, 0xff, 0x21, 0x9f, 0x23, 0x3f, 0xa9,
-		0x77, 0xd1, 0xe7, 0xed, 0x98, 0xd4, 0xff, 0x24, 0x06, 0x47, 0xa8, 0x6e, 0xe1, 0x67, 0x8d, 0x6f,
-		0xc1, 0xdc, 0x54, 0x00, 0xb9, 0x8e, 0x56, 0xb9, 0x5d, 0xb9, 0x28, 0xeb, 0x3a, 0xda, 0x95, 0xc8,
-		0x8a, 0x5e, 0x01, 0x54, 0x75, 0xbd, 0xe6, 0x01, 0xe2, 0x07, 0x1e, 0xa0, 0xea, 0x7a, 0x57, 0xf6,
-		0x29, 0x19, 0x12, 0x07, 0xf6, 0xae, 0x97, 0x25, 0xc8, 0xb7, 0x9b, 0x01, 0xee, 0x4d, 0x3a, 0x4c,
-		0x46, 0x1e, 0xa3, 0x37, 0x3b, 0xd4, 0x03, 0xbd, 0x3c, 0x3c, 0x6e, 0x0a, 0xff, 0xc3, 0x0e, 0x7e,
-		0x53, 0x13, 0xc0, 0x3f, 0x13, 0x4b, 0x9c, 0x1f, 0x30, 0xad, 0xbb, 0xb1, 0x1f, 0xfe, 0xb0, 0xff,
-		0x95, 0x96, 0x15, 0xe6, 0x6d, 0xb1, 0xb1, 0xfb, 0xa6, 0x04, 0x53, 0x1d, 0xc4, 0x7e, 0x3b, 0x97,
-		0x17, 0x3b, 0x1d, 0x5d, 0xea, 0x76, 0xef, 0x22, 0xcf, 0xf0, 0x78, 0x8c, 0xbe, 0xaa, 0x17, 0x3a,
-		0x45, 0x68, 0xf7, 0xf1, 0x01, 0xf9, 0xfd, 0x70, 0xb4, 0x2d, 0x15, 0x97, 0xad, 0x08, 0x89, 0x1d,
-		0xdd, 0xf5, 0xb8, 0x58, 0xf7, 0x74, 0x12, 0xab, 0x89, 0x9a, 0xd2, 0xc8, 0x08, 0xb2, 0x94, 0xf5,
-		0x9a, 0x65, 0x19, 0x5c, 0x0c, 0xf9, 0x32, 0x8c, 0x85, 0x60, 0x7c, 0x90, 0xb3, 0x90, 0xb0, 0x2d,
-		0xfe, 0x3d, 0x9f, 0xcc, 0xe9, 0x63, 0x9d, 0x06, 0x21, 0x34, 0x5c, 0x6d, 0x8a, 0x2f, 0x4f, 0x00,
-		0x62, 0xcc, 0xe8, 0x5d, 0x2f, 0x31, 0xc4, 0x3a, 0x8c, 0x47, 0xa0, 0x7c, 0x90, 0x77, 0xc3, 0x80,
-		0x4d, 0x21, 0x7c, 0x98, 0x8e, 0x2f, 0x09, 0x30, 0x3a, 0x51, 0xb6, 0x31, 0x9a, 0xd3, 0xdf, 0x3b,
-		0x0c, 0x49, 0xca, 0x15, 0x7d, 0x46, 0x02, 0x08, 0xdd, 0xdc, 0x2a, 0x74, 0x62, 0xd3, 0xfe, 0x34,
-		0x27, 0x3f, 0xd3, 0x33, 0x3e, 0xaf, 0xbb, 0x4f, 0xfd, 0xf8, 0xbf, 0xfe, 0xce, 0xcf, 0xc4, 0xee,
-		0x46, 0xf2, 0x4c, 0x87, 0x73, 0xa4, 0x50, 0x30, 0x7e, 0x59, 0x0a, 0xbf, 0x4b, 0xf6, 0x60, 0x6f,
-		0x43, 0x09, 0xc9, 0x0a, 0xbd, 0xa2, 0x73, 0xc1, 0xce, 0x53, 0xc1, 0x1e, 0x45, 0x8f, 0x74, 0x17,
-		0x6c, 0xe6, 0x43, 0xd1, 0xe8, 0xfa, 0x08, 0xfa, 0xa6, 0x04, 0x13, 0xed, 0x8e, 0x09, 0xd0, 0xb9,
-		0xde, 0xa4, 0x68, 0x2d, 0xc8, 0xf2, 0x8f, 0x1f, 0x80, 0x92, 0xab, 0xb2, 0x40, 0x55, 0x99, 0x45,
-		0x4f, 0x1c, 0x40, 0x95, 0x99, 0xd0, 0xea, 0x87, 0xfe, 0x9f, 0x04, 0xc7, 0xf7, 0xdd, 0x42, 0xa3,
-		0xd9, 0xde, 0xa4, 0xdc, 0xa7, 0xf2, 0xcc, 0x97, 0xde, 0x08, 0x0b, 0xae, 0xf1, 0x93, 0x54, 0xe3,
-		0xcb, 0x68, 0xf1, 0x20, 0x1a, 0x07, 0x65, 0x62, 0x58, 0xf7, 0xdf, 0x96, 0x22, 0xaf, 0x53, 0xec,
-		0xef, 0x4e, 0x2d, 0x7b, 0xc7, 0x2e, 0x81, 0xd1, 0xba, 0x25, 0x90, 0x9f, 0xa6, 0x2a, 0x28, 0x68,
-		0xed, 0x0d, 0x4e, 0xda, 0xcc, 0x87, 0xa2, 0x8b, 0xca, 0x47, 0xd0, 0xff, 0x91, 0xda, 0xbf, 0xbf,
-		0xf0, 0xd8, 0xbe, 0x22, 0x76, 0xde, 0x17, 0xe7, 0xcf, 0xf5, 0x4f, 0xc8, 0x95, 0xac, 0x53, 0x25,
-		0x6b, 0x08, 0xdf, 0x6e, 0x25, 0xdb, 0x4e, 0x22, 0xfa, 0x1d, 0x09, 0x26, 0xda, 0xed, 0xe8, 0xba,
-		0x84, 0xe5, 0x3e, 0x7b, 0xd9, 0x2e, 0x61, 0xb9, 0xdf, 0xf6, 0x51, 0x7e, 0x37, 0x55, 0xfe, 0x2c,
-		0x3a, 0xd3, 0x49, 0xf9, 0x7d, 0x67, 0x91, 0xc4, 0xe2, 0xbe, 0x3b, 0x9f, 0x2e, 0xb1, 0xd8, 0xcb,
-		0x2e, 0xb0, 0x4b, 0x2c, 0xf6, 0xb4, 0xf1, 0xea, 0x1e, 0x8b, 0xbe, 0x66, 0x3d, 0x4e, 0xa3, 0x8b,
-		0x7e, 0x43, 0x82, 0xe1, 0x48, 0x5d, 0x8e, 0x1e, 0xde, 0x57, 0xd0, 0x76, 0xbb, 0xa8, 0xfc, 0xe9,
-		0x7e, 0x48, 0xb8, 0x2e, 0x8b, 0x54, 0x97, 0x39, 0x34, 0x7b, 0x10, 0x5d, 0x9c, 0x88, 0xc4, 0x2f,
-		0x4b, 0x30, 0xde, 0xa6, 0x84, 0xed, 0x12, 0x85, 0x9d, 0x4b, 0xf7, 0xfc, 0xb9, 0xfe, 0x09, 0xb9,
-		0x56, 0x17, 0xa8, 0x56, 0xef, 0x43, 0xef, 0x3d, 0x88, 0x56, 0xa1, 0xf5, 0xf9, 0x66, 0x70, 0x3f,
-		0x3a, 0x34, 0x0e, 0x3a, 0xdb, 0xa7, 0x60, 0x42, 0xa1, 0xc7, 0xfa, 0xa6, 0xe3, 0xfa, 0x3c, 0x45,
-		0xf5, 0x79, 0x12, 0xad, 0xbe, 0x31, 0x7d, 0x5a, 0x97, 0xf5, 0xaf, 0xb6, 0x7e, 0x2a, 0x62, 0x7f,
-		0x2f, 0x6a, 0x5b, 0xac, 0xe6, 0x1f, 0xe9, 0x8b, 0x86, 0x2b, 0x75, 0x8e, 0x2a, 0x75, 0x1a, 0x3d,
-		0xd4, 0x49, 0xa9, 0xd0, 0x25, 0x78, 0xdd, 0xdc, 0xb6, 0x66, 0x3e, 0xc4, 0x4a, 0xe0, 0x8f, 0xa0,
-		0x1f, 0x13, 0x17, 0x90, 0x4f, 0xee, 0x3b, 0x6e, 0xa8, 0x8e, 0xcd, 0xdf, 0xd7, 0x03, 0x26, 0x97,
-		0xeb, 0x6e, 0x2a, 0xd7, 0x14, 0x3a, 0xd6, 0x49, 0x2e, 0x52, 0xcb, 0xa2, 0x4f, 0x4a, 0xfe, 0x3b,
-		0x0b, 0xa7, 0xf6, 0xe7, 0x1d, 0x2e, 0x76, 0xf3, 0xf7, 0xf7, 0x84, 0xcb, 0x25, 0xb9, 0x87, 0x4a,
-		0x72, 0x02, 0x4d, 0x75, 0x94, 0x84, 0x95, 0xbe, 0xb7, 0xfb, 0xce, 0xcb, 0x67, 0x53, 0x1d, 0x3f,
-		0x8b, 0x52, 0xc3, 0x26, 0x76, 0x75, 0xf7, 0x40, 0x77, 0x92, 0x7b, 0x7b, 0xb0, 0xfa, 0xcd, 0x24,
-		0x0c, 0x2d, 0xb0, 0x51, 0xd6, 0x3d, 0xd5, 0x7b, 0x83, 0x1b, 0x01, 0xe4, 0xf2, 0x0f, 0x19, 0xd2,
-		0x2b, 0x2d, 0x15, 0xdb, 0xba, 0x8e, 0xc5, 0x5e, 0x71, 0xb1, 0xef, 0xcb, 0xc0, 0xfc, 0xfd, 0xfc,
-		0x66, 0x7e, 0x32, 0xfb, 0x26, 0x22, 0xbd, 0x75, 0xb3, 0x46, 0x00, 0xe8, 0x63, 0x12, 0x1c, 0xa6,
-		0x58, 0x41, 0xbc, 0x51, 0x4c, 0xf1, 0x3e, 0x61, 0x47, 0x8f, 0x59, 0x52, 0x43, 0xc7, 0x3f, 0x94,
-		0x57, 0xe9, 0x6e, 0xfe, 0x7a, 0xcb, 0xb1, 0xd0, 0xe0, 0xcd, 0x6c, 0x65, 0x65, 0xdc, 0x68, 0xa1,
-		0x74, 0x9b, 0x0e, 0x0d, 0x12, 0x07, 0x3f, 0x34, 0xb8, 0x04, 0x99, 0x50, 0xa6, 0xcf, 0x25, 0xbb,
-		0xbc, 0xc2, 0xdb, 0x7c, 0xb2, 0x18, 0x26, 0x46, 0x9f, 0x90, 0xe0, 0x70, 0xdb, 0x45, 0x90, 0xfe,
-		0x1f, 0xce, 0x3e, 0x4f, 0x2e, 0x9b, 0x8c, 0xd3, 0x96, 0xaf, 0xac, 0x4c, 0x34, 0xda, 0x55, 0x13,
-		0x6b, 0x30, 0x1c, 0x59, 0xc0, 0x72, 0xe2, 0xbf, 0xe9, 0xf6, 0xfe, 0xc2, 0x45, 0x94, 0x01, 0xca,
-		0x43, 0x0a, 0xef, 0xda, 0x96, 0xe3, 0xe1, 0x2a, 0xbd, 0xac, 0x93, 0x52, 0xfc, 0xb6, 0x7c, 0x1d,
-		0x50, 0xeb, 0xe4, 0xa2, 0xcb, 0x30, 0x18, 0xbd, 0xda, 0x77, 0x80, 0x03, 0x0c, 0xc1, 0x01, 0x4d,
-		0x40, 0x32, 0xf0, 0xef, 0xb8, 0xc2, 0x1a, 0xb7, 0x3b, 0x39, 0xfc, 0x69, 0x00, 0x00, 0x00, 0xff,
-		0xff, 0x75, 0x0a, 0xea, 0x1f, 0x47, 0x91, 0x00, 0x00,
+		// 10566 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6b, 0x78, 0x1c, 0xd7,
+		0x75, 0x18, 0x67, 0x77, 0x01, 0xec, 0x1e, 0xbc, 0x16, 0x17, 0x20, 0xb9, 0x58, 0x92, 0x00, 0x34,
+		0xa2, 0x28, 0x8a, 0x12, 0x17, 0x12, 0x45, 0x51, 0xd4, 0x52, 0x0f, 0x63, 0x81, 0x25, 0x08, 0x11,
+		0x20, 0xa0, 0x01, 0x48, 0x3d, 0xec, 0x74, 0xbf, 0xc1, 0xee, 0xc5, 0x62, 0xc4, 0xd9, 0x99, 0xd5,
+		0xcc, 0x2c, 0x49, 0xc8, 0xd1, 0xf7, 0x29, 0xb1, 0xe3, 0xd8, 0x6a, 0x5d, 0x3b, 0x8f, 0xba, 0xb6,
+		0x62, 0xb9, 0x7e, 0xa4, 0x75, 0xea, 0xb4, 0x4d, 0x52, 0xbb, 0x49, 0xf3, 0xf8, 0x5a, 0xa7, 0x71,
+		0x12, 0x3b, 0x75, 0xfa, 0xd9, 0x8e, 0xeb, 0x26, 0xf9, 0x5a, 0x3a, 0x95, 0xdd, 0xd6, 0x71, 0xdd,
+		0x26, 0x65, 0xdd, 0xaf, 0x0f, 0xff, 0x48, 0xbf, 0xfb, 0x9a, 0xd7, 0xce, 0xbe, 0x40, 0x52, 0xb6,
+		0xbf, 0xe8, 0xd7, 0xee, 0x9c, 0x7b, 0xce, 0xb9, 0xe7, 0x9c, 0x7b, 0xee, 0xbd, 0xe7, 0x9e, 0x7b,
+		0xe7, 0x0e, 0xfc, 0xdc, 0x19, 0x98, 0xa9, 0x9a, 0x66, 0x55, 0xc7, 0xb3, 0x75, 0xcb, 0x74, 0xcc,
+		0xcd, 0xc6, 0xd6, 0x6c, 0x05, 0xdb, 0x65, 0x4b, 0xab, 0x3b, 0xa6, 0x95, 0xa3, 0x30, 0x34, 0xca,
+		0x30, 0x72, 0x02, 0x43, 0x5e, 0x81, 0xb1, 0xb3, 0x9a, 0x8e, 0x17, 0x5c, 0xc4, 0x75, 0xec, 0xa0,
+		0xd3, 0x90, 0xd8, 0xd2, 0x74, 0x9c, 0x91, 0x66, 0xe2, 0x47, 0x07, 0x4f, 0x1c, 0xce, 0x85, 0x88,
+		0x72, 0x41, 0x8a, 0x35, 0x02, 0x56, 0x28, 0x85, 0xfc, 0xcd, 0x04, 0x8c, 0x47, 0x94, 0x22, 0x04,
+		0x09, 0x43, 0xad, 0x11, 0x8e, 0xd2, 0xd1, 0x94, 0x42, 0xff, 0xa3, 0x0c, 0x0c, 0xd4, 0xd5, 0xf2,
+		0x65, 0xb5, 0x8a, 0x33, 0x31, 0x0a, 0x16, 0x8f, 0x68, 0x0a, 0xa0, 0x82, 0xeb, 0xd8, 0xa8, 0x60,
+		0xa3, 0xbc, 0x93, 0x89, 0xcf, 0xc4, 0x8f, 0xa6, 0x14, 0x1f, 0x04, 0xdd, 0x0b, 0x63, 0xf5, 0xc6,
+		0xa6, 0xae, 0x95, 0x4b, 0x3e, 0x34, 0x98, 0x89, 0x1f, 0xed, 0x53, 0xd2, 0xac, 0x60, 0xc1, 0x43,
+		0xbe, 0x1b, 0x46, 0xaf, 0x62, 0xf5, 0xb2, 0x1f, 0x75, 0x90, 0xa2, 0x8e, 0x10, 0xb0, 0x0f, 0x71,
+		0x1e, 0x86, 0x6a, 0xd8, 0xb6, 0xd5, 0x2a, 0x2e, 0x39, 0x3b, 0x75, 0x9c, 0x49, 0x50, 0xed, 0x67,
+		0x9a, 0xb4, 0x0f, 0x6b, 0x3e, 0xc8, 0xa9, 0x36, 0x76, 0xea, 0x18, 0xcd, 0x41, 0x0a, 0x1b, 0x8d,
+		0x1a, 0xe3, 0xd0, 0xd7, 0xc2, 0x7e, 0x45, 0xa3, 0x51, 0x0b, 0x73, 0x49, 0x12, 0x32, 0xce, 0x62,
+		0xc0, 0xc6, 0xd6, 0x15, 0xad, 0x8c, 0x33, 0xfd, 0x94, 0xc1, 0xdd, 0x4d, 0x0c, 0xd6, 0x59, 0x79,
+		0x98, 0x87, 0xa0, 0x43, 0xf3, 0x90, 0xc2, 0xd7, 0x1c, 0x6c, 0xd8, 0x9a, 0x69, 0x64, 0x06, 0x28,
+		0x93, 0xbb, 0x22, 0x5a, 0x11, 0xeb, 0x95, 0x30, 0x0b, 0x8f, 0x0e, 0x9d, 0x82, 0x01, 0xb3, 0xee,
+		0x68, 0xa6, 0x61, 0x67, 0x92, 0x33, 0xd2, 0xd1, 0xc1, 0x13, 0x07, 0x23, 0x1d, 0x61, 0x95, 0xe1,
+		0x28, 0x02, 0x19, 0x2d, 0x41, 0xda, 0x36, 0x1b, 0x56, 0x19, 0x97, 0xca, 0x66, 0x05, 0x97, 0x34,
+		0x63, 0xcb, 0xcc, 0xa4, 0x28, 0x83, 0xe9, 0x66, 0x45, 0x28, 0xe2, 0xbc, 0x59, 0xc1, 0x4b, 0xc6,
+		0x96, 0xa9, 0x8c, 0xd8, 0x81, 0x67, 0xb4, 0x0f, 0xfa, 0xed, 0x1d, 0xc3, 0x51, 0xaf, 0x65, 0x86,
+		0xa8, 0x87, 0xf0, 0x27, 0xf9, 0xd7, 0xfb, 0x61, 0xb4, 0x1b, 0x17, 0x3b, 0x03, 0x7d, 0x5b, 0x44,
+		0xcb, 0x4c, 0xac, 0x17, 0x1b, 0x30, 0x9a, 0xa0, 0x11, 0xfb, 0x77, 0x69, 0xc4, 0x39, 0x18, 0x34,
+		0xb0, 0xed, 0xe0, 0x0a, 0xf3, 0x88, 0x78, 0x97, 0x3e, 0x05, 0x8c, 0xa8, 0xd9, 0xa5, 0x12, 0xbb,
+		0x72, 0xa9, 0x67, 0x60, 0xd4, 0x15, 0xa9, 0x64, 0xa9, 0x46, 0x55, 0xf8, 0xe6, 0x6c, 0x27, 0x49,
+		0x72, 0x45, 0x41, 0xa7, 0x10, 0x32, 0x65, 0x04, 0x07, 0x9e, 0xd1, 0x02, 0x80, 0x69, 0x60, 0x73,
+		0xab, 0x54, 0xc1, 0x65, 0x3d, 0x93, 0x6c, 0x61, 0xa5, 0x55, 0x82, 0xd2, 0x64, 0x25, 0x93, 0x41,
+		0xcb, 0x3a, 0x7a, 0xc4, 0x73, 0xb5, 0x81, 0x16, 0x9e, 0xb2, 0xc2, 0x3a, 0x59, 0x93, 0xb7, 0x5d,
+		0x84, 0x11, 0x0b, 0x13, 0xbf, 0xc7, 0x15, 0xae, 0x59, 0x8a, 0x0a, 0x91, 0xeb, 0xa8, 0x99, 0xc2,
+		0xc9, 0x98, 0x62, 0xc3, 0x96, 0xff, 0x11, 0xdd, 0x09, 0x2e, 0xa0, 0x44, 0xdd, 0x0a, 0xe8, 0x28,
+		0x34, 0x24, 0x80, 0x17, 0xd4, 0x1a, 0xce, 0xbe, 0x08, 0x23, 0x41, 0xf3, 0xa0, 0x09, 0xe8, 0xb3,
+		0x1d, 0xd5, 0x72, 0xa8, 0x17, 0xf6, 0x29, 0xec, 0x01, 0xa5, 0x21, 0x8e, 0x8d, 0x0a, 0x1d, 0xe5,
+		0xfa, 0x14, 0xf2, 0x17, 0xbd, 0xc5, 0x53, 0x38, 0x4e, 0x15, 0x3e, 0xd2, 0xdc, 0xa2, 0x01, 0xce,
+		0x61, 0xbd, 0xb3, 0x0f, 0xc3, 0x70, 0x40, 0x81, 0x6e, 0xab, 0x96, 0x7f, 0x14, 0xf6, 0x46, 0xb2,
+		0x46, 0xcf, 0xc0, 0x44, 0xc3, 0xd0, 0x0c, 0x07, 0x5b, 0x75, 0x0b, 0x13, 0x8f, 0x65, 0x55, 0x65,
+		0xfe, 0xcb, 0x40, 0x0b, 0x9f, 0xbb, 0xe8, 0xc7, 0x66, 0x5c, 0x94, 0xf1, 0x46, 0x33, 0xf0, 0x58,
+		0x2a, 0xf9, 0xad, 0x81, 0xf4, 0xcb, 0x2f, 0xbf, 0xfc, 0x72, 0x4c, 0xfe, 0x60, 0x3f, 0x4c, 0x44,
+		0xf5, 0x99, 0xc8, 0xee, 0xbb, 0x0f, 0xfa, 0x8d, 0x46, 0x6d, 0x13, 0x5b, 0xd4, 0x48, 0x7d, 0x0a,
+		0x7f, 0x42, 0x73, 0xd0, 0xa7, 0xab, 0x9b, 0x58, 0xcf, 0x24, 0x66, 0xa4, 0xa3, 0x23, 0x27, 0xee,
+		0xed, 0xaa, 0x57, 0xe6, 0x96, 0x09, 0x89, 0xc2, 0x28, 0xd1, 0xe3, 0x90, 0xe0, 0x43, 0x34, 0xe1,
+		0x70, 0xac, 0x3b, 0x0e, 0xa4, 0x2f, 0x29, 0x94, 0x0e, 0x1d, 0x80, 0x14, 0xf9, 0x65, 0xbe, 0xd1,
+		0x4f, 0x65, 0x4e, 0x12, 0x00, 0xf1, 0x0b, 0x94, 0x85, 0x24, 0xed, 0x26, 0x15, 0x2c, 0xa6, 0x36,
+		0xf7, 0x99, 0x38, 0x56, 0x05, 0x6f, 0xa9, 0x0d, 0xdd, 0x29, 0x5d, 0x51, 0xf5, 0x06, 0xa6, 0x0e,
+		0x9f, 0x52, 0x86, 0x38, 0xf0, 0x12, 0x81, 0xa1, 0x69, 0x18, 0x64, 0xbd, 0x4a, 0x33, 0x2a, 0xf8,
+		0x1a, 0x1d, 0x3d, 0xfb, 0x14, 0xd6, 0xd1, 0x96, 0x08, 0x84, 0x54, 0xff, 0xbc, 0x6d, 0x1a, 0xc2,
+		0x35, 0x69, 0x15, 0x04, 0x40, 0xab, 0x7f, 0x38, 0x3c, 0x70, 0x1f, 0x8a, 0x56, 0x2f, 0xec, 0x53,
+		0xf2, 0xaf, 0xc6, 0x20, 0x41, 0xc7, 0x8b, 0x51, 0x18, 0xdc, 0x78, 0x76, 0xad, 0x58, 0x5a, 0x58,
+		0xbd, 0x58, 0x58, 0x2e, 0xa6, 0x25, 0x34, 0x02, 0x40, 0x01, 0x67, 0x97, 0x57, 0xe7, 0x36, 0xd2,
+		0x31, 0xf7, 0x79, 0xe9, 0xc2, 0xc6, 0xa9, 0x93, 0xe9, 0xb8, 0x4b, 0x70, 0x91, 0x01, 0x12, 0x7e,
+		0x84, 0x07, 0x4f, 0xa4, 0xfb, 0x50, 0x1a, 0x86, 0x18, 0x83, 0xa5, 0x67, 0x8a, 0x0b, 0xa7, 0x4e,
+		0xa6, 0xfb, 0x83, 0x90, 0x07, 0x4f, 0xa4, 0x07, 0xd0, 0x30, 0xa4, 0x28, 0xa4, 0xb0, 0xba, 0xba,
+		0x9c, 0x4e, 0xba, 0x3c, 0xd7, 0x37, 0x94, 0xa5, 0x0b, 0x8b, 0xe9, 0x94, 0xcb, 0x73, 0x51, 0x59,
+		0xbd, 0xb8, 0x96, 0x06, 0x97, 0xc3, 0x4a, 0x71, 0x7d, 0x7d, 0x6e, 0xb1, 0x98, 0x1e, 0x74, 0x31,
+		0x0a, 0xcf, 0x6e, 0x14, 0xd7, 0xd3, 0x43, 0x01, 0xb1, 0x1e, 0x3c, 0x91, 0x1e, 0x76, 0xab, 0x28,
+		0x5e, 0xb8, 0xb8, 0x92, 0x1e, 0x41, 0x63, 0x30, 0xcc, 0xaa, 0x10, 0x42, 0x8c, 0x86, 0x40, 0xa7,
+		0x4e, 0xa6, 0xd3, 0x9e, 0x20, 0x8c, 0xcb, 0x58, 0x00, 0x70, 0xea, 0x64, 0x1a, 0xc9, 0xf3, 0xd0,
+		0x47, 0xbd, 0x0b, 0x21, 0x18, 0x59, 0x9e, 0x2b, 0x14, 0x97, 0x4b, 0xab, 0x6b, 0x1b, 0x4b, 0xab,
+		0x17, 0xe6, 0x96, 0xd3, 0x92, 0x07, 0x53, 0x8a, 0x4f, 0x5d, 0x5c, 0x52, 0x8a, 0x0b, 0xe9, 0x98,
+		0x1f, 0xb6, 0x56, 0x9c, 0xdb, 0x28, 0x2e, 0xa4, 0xe3, 0x72, 0x19, 0x26, 0xa2, 0xc6, 0xc9, 0xc8,
+		0x9e, 0xe1, 0x6b, 0xe2, 0x58, 0x8b, 0x26, 0xa6, 0xbc, 0x9a, 0x9a, 0xf8, 0x1b, 0x31, 0x18, 0x8f,
+		0x98, 0x2b, 0x22, 0x2b, 0x79, 0x02, 0xfa, 0x98, 0x8b, 0xb2, 0xd9, 0xf3, 0x9e, 0xc8, 0x49, 0x87,
+		0x3a, 0x6c, 0xd3, 0x0c, 0x4a, 0xe9, 0xfc, 0x11, 0x44, 0xbc, 0x45, 0x04, 0x41, 0x58, 0x34, 0x8d,
+		0xe9, 0x3f, 0xd2, 0x34, 0xa6, 0xb3, 0x69, 0xef, 0x54, 0x37, 0xd3, 0x1e, 0x85, 0xf5, 0x36, 0xb6,
+		0xf7, 0x45, 0x8c, 0xed, 0x67, 0x60, 0xac, 0x89, 0x51, 0xd7, 0x63, 0xec, 0x3b, 0x24, 0xc8, 0xb4,
+		0x32, 0x4e, 0x87, 0x91, 0x2e, 0x16, 0x18, 0xe9, 0xce, 0x84, 0x2d, 0x78, 0x47, 0xeb, 0x46, 0x68,
+		0x6a, 0xeb, 0x4f, 0x4a, 0xb0, 0x2f, 0x3a, 0x52, 0x8c, 0x94, 0xe1, 0x71, 0xe8, 0xaf, 0x61, 0x67,
+		0xdb, 0x14, 0xd1, 0xd2, 0x91, 0x88, 0x39, 0x98, 0x14, 0x87, 0x1b, 0x9b, 0x53, 0xa1, 0x47, 0xc2,
+		0xb2, 0x4e, 0xb7, 0x8a, 0x5b, 0x9b, 0x24, 0x7d, 0x4f, 0x0c, 0xf6, 0x46, 0x32, 0x8f, 0x14, 0xf4,
+		0x10, 0x80, 0x66, 0xd4, 0x1b, 0x0e, 0x8b, 0x88, 0xd8, 0x00, 0x9b, 0xa2, 0x10, 0x3a, 0x78, 0x91,
+		0xc1, 0xb3, 0xe1, 0xb8, 0xe5, 0x71, 0x5a, 0x0e, 0x0c, 0x44, 0x11, 0x4e, 0x7b, 0x82, 0x26, 0xa8,
+		0xa0, 0x53, 0x2d, 0x34, 0x6d, 0x72, 0xcc, 0xfb, 0x21, 0x5d, 0xd6, 0x35, 0x6c, 0x38, 0x25, 0xdb,
+		0xb1, 0xb0, 0x5a, 0xd3, 0x8c, 0x2a, 0x9d, 0x41, 0x92, 0xf9, 0xbe, 0x2d, 0x55, 0xb7, 0xb1, 0x32,
+		0xca, 0x8a, 0xd7, 0x45, 0x29, 0xa1, 0xa0, 0x0e, 0x64, 0xf9, 0x28, 0xfa, 0x03, 0x14, 0xac, 0xd8,
+		0xa5, 0x90, 0x7f, 0x3a, 0x05, 0x83, 0xbe, 0xb8, 0x1a, 0xdd, 0x01, 0x43, 0xcf, 0xab, 0x57, 0xd4,
+		0x92, 0x58, 0x2b, 0x31, 0x4b, 0x0c, 0x12, 0xd8, 0x1a, 0x03, 0xa1, 0xfb, 0x61, 0x82, 0xa2, 0x98,
+		0x0d, 0x07, 0x5b, 0xa5, 0xb2, 0xae, 0xda, 0x36, 0x35, 0x5a, 0x92, 0xa2, 0x22, 0x52, 0xb6, 0x4a,
+		0x8a, 0xe6, 0x45, 0x09, 0x7a, 0x08, 0xc6, 0x29, 0x45, 0xad, 0xa1, 0x3b, 0x5a, 0x5d, 0xc7, 0x25,
+		0xb2, 0x7a, 0xb3, 0x33, 0xe0, 0x97, 0x6c, 0x8c, 0x60, 0xac, 0x70, 0x04, 0x22, 0x91, 0x8d, 0x16,
+		0xe0, 0x10, 0x25, 0xab, 0x62, 0x03, 0x5b, 0xaa, 0x83, 0x4b, 0xf8, 0x85, 0x86, 0xaa, 0xdb, 0x25,
+		0xd5, 0xa8, 0x94, 0xb6, 0x55, 0x7b, 0x3b, 0x33, 0x41, 0x18, 0x14, 0x62, 0x19, 0x49, 0x99, 0x24,
+		0x88, 0x8b, 0x1c, 0xaf, 0x48, 0xd1, 0xe6, 0x8c, 0xca, 0x39, 0xd5, 0xde, 0x46, 0x79, 0xd8, 0x47,
+		0xb9, 0xd8, 0x8e, 0xa5, 0x19, 0xd5, 0x52, 0x79, 0x1b, 0x97, 0x2f, 0x97, 0x1a, 0xce, 0xd6, 0xe9,
+		0xcc, 0x01, 0x7f, 0xfd, 0x54, 0xc2, 0x75, 0x8a, 0x33, 0x4f, 0x50, 0x2e, 0x3a, 0x5b, 0xa7, 0xd1,
+		0x3a, 0x0c, 0x91, 0xc6, 0xa8, 0x69, 0x2f, 0xe2, 0xd2, 0x96, 0x69, 0xd1, 0xa9, 0x71, 0x24, 0x62,
+		0x68, 0xf2, 0x59, 0x30, 0xb7, 0xca, 0x09, 0x56, 0xcc, 0x0a, 0xce, 0xf7, 0xad, 0xaf, 0x15, 0x8b,
+		0x0b, 0xca, 0xa0, 0xe0, 0x72, 0xd6, 0xb4, 0x88, 0x43, 0x55, 0x4d, 0xd7, 0xc0, 0x83, 0xcc, 0xa1,
+		0xaa, 0xa6, 0x30, 0xef, 0x43, 0x30, 0x5e, 0x2e, 0x33, 0x9d, 0xb5, 0x72, 0x89, 0xaf, 0xb1, 0xec,
+		0x4c, 0x3a, 0x60, 0xac, 0x72, 0x79, 0x91, 0x21, 0x70, 0x1f, 0xb7, 0xd1, 0x23, 0xb0, 0xd7, 0x33,
+		0x96, 0x9f, 0x70, 0xac, 0x49, 0xcb, 0x30, 0xe9, 0x43, 0x30, 0x5e, 0xdf, 0x69, 0x26, 0x44, 0x81,
+		0x1a, 0xeb, 0x3b, 0x61, 0xb2, 0x87, 0x61, 0xa2, 0xbe, 0x5d, 0x6f, 0xa6, 0x3b, 0xe6, 0xa7, 0x43,
+		0xf5, 0xed, 0x7a, 0x98, 0xf0, 0x2e, 0xba, 0xe0, 0xb6, 0x70, 0x59, 0x75, 0x70, 0x25, 0xb3, 0xdf,
+		0x8f, 0xee, 0x2b, 0x40, 0xb3, 0x90, 0x2e, 0x97, 0x4b, 0xd8, 0x50, 0x37, 0x75, 0x5c, 0x52, 0x2d,
+		0x6c, 0xa8, 0x76, 0x66, 0xda, 0x8f, 0x3c, 0x52, 0x2e, 0x17, 0x69, 0xe9, 0x1c, 0x2d, 0x44, 0xc7,
+		0x60, 0xcc, 0xdc, 0x7c, 0xbe, 0xcc, 0x5c, 0xb2, 0x54, 0xb7, 0xf0, 0x96, 0x76, 0x2d, 0x73, 0x98,
+		0xda, 0x77, 0x94, 0x14, 0x50, 0x87, 0x5c, 0xa3, 0x60, 0x74, 0x0f, 0xa4, 0xcb, 0xf6, 0xb6, 0x6a,
+		0xd5, 0xe9, 0x98, 0x6c, 0xd7, 0xd5, 0x32, 0xce, 0xdc, 0xc5, 0x50, 0x19, 0xfc, 0x82, 0x00, 0x93,
+		0x2e, 0x61, 0x5f, 0xd5, 0xb6, 0x1c, 0xc1, 0xf1, 0x6e, 0xd6, 0x25, 0x28, 0x8c, 0x73, 0x3b, 0x0a,
+		0x69, 0x62, 0x8a, 0x40, 0xc5, 0x47, 0x29, 0xda, 0x48, 0x7d, 0xbb, 0xee, 0xaf, 0xf7, 0x4e, 0x18,
+		0xae, 0x6f, 0xfb, 0x2b, 0xbd, 0x87, 0x05, 0x64, 0xf5, 0x6d, 0x5f, 0x8d, 0x27, 0x61, 0x1f, 0x41,
+		0xaa, 0x61, 0x47, 0xad, 0xa8, 0x8e, 0xea, 0xc3, 0xbe, 0x8f, 0x62, 0x13, 0xbb, 0xaf, 0xf0, 0xc2,
+		0x80, 0x9c, 0x56, 0x63, 0x73, 0xc7, 0xf5, 0xac, 0xe3, 0x4c, 0x4e, 0x02, 0x13, 0xbe, 0x75, 0xdb,
+		0x82, 0x6e, 0x39, 0x0f, 0x43, 0x7e, 0xc7, 0x47, 0x29, 0x60, 0xae, 0x9f, 0x96, 0x48, 0x14, 0x34,
+		0xbf, 0xba, 0x40, 0xe2, 0x97, 0xe7, 0x8a, 0xe9, 0x18, 0x89, 0xa3, 0x96, 0x97, 0x36, 0x8a, 0x25,
+		0xe5, 0xe2, 0x85, 0x8d, 0xa5, 0x95, 0x62, 0x3a, 0xee, 0x0b, 0xd8, 0x9f, 0x4c, 0x24, 0x8f, 0xa4,
+		0xef, 0x96, 0xbf, 0x1c, 0x83, 0x91, 0xe0, 0x0a, 0x0c, 0x3d, 0x0a, 0xfb, 0x45, 0xba, 0xc4, 0xc6,
+		0x4e, 0xe9, 0xaa, 0x66, 0xd1, 0x1e, 0x59, 0x53, 0xd9, 0xec, 0xe8, 0xfa, 0xc4, 0x04, 0xc7, 0x5a,
+		0xc7, 0xce, 0xd3, 0x9a, 0x45, 0xfa, 0x5b, 0x4d, 0x75, 0xd0, 0x32, 0x4c, 0x1b, 0x66, 0xc9, 0x76,
+		0x54, 0xa3, 0xa2, 0x5a, 0x95, 0x92, 0x97, 0xa8, 0x2a, 0xa9, 0xe5, 0x32, 0xb6, 0x6d, 0x93, 0xcd,
+		0x84, 0x2e, 0x97, 0x83, 0x86, 0xb9, 0xce, 0x91, 0xbd, 0x29, 0x62, 0x8e, 0xa3, 0x86, 0xfc, 0x37,
+		0xde, 0xca, 0x7f, 0x0f, 0x40, 0xaa, 0xa6, 0xd6, 0x4b, 0xd8, 0x70, 0xac, 0x1d, 0x1a, 0x77, 0x27,
+		0x95, 0x64, 0x4d, 0xad, 0x17, 0xc9, 0xf3, 0x1b, 0xb2, 0xfc, 0x79, 0x32, 0x91, 0x4c, 0xa6, 0x53,
+		0x4f, 0x26, 0x92, 0xa9, 0x34, 0xc8, 0xaf, 0xc7, 0x61, 0xc8, 0x1f, 0x87, 0x93, 0x65, 0x4d, 0x99,
+		0x4e, 0x59, 0x12, 0x1d, 0xd4, 0xee, 0x6c, 0x1b, 0xb5, 0xe7, 0xe6, 0xc9, 0x5c, 0x96, 0xef, 0x67,
+		0xd1, 0xb1, 0xc2, 0x28, 0x49, 0x1c, 0x41, 0x9c, 0x0d, 0xb3, 0x68, 0x24, 0xa9, 0xf0, 0x27, 0xb4,
+		0x08, 0xfd, 0xcf, 0xdb, 0x94, 0x77, 0x3f, 0xe5, 0x7d, 0xb8, 0x3d, 0xef, 0x27, 0xd7, 0x29, 0xf3,
+		0xd4, 0x93, 0xeb, 0xa5, 0x0b, 0xab, 0xca, 0xca, 0xdc, 0xb2, 0xc2, 0xc9, 0xd1, 0x24, 0x24, 0x74,
+		0xf5, 0xc5, 0x9d, 0xe0, 0xac, 0x47, 0x41, 0xdd, 0x36, 0xc2, 0x24, 0x24, 0x48, 0xe2, 0x2d, 0x38,
+		0xd7, 0x50, 0xd0, 0x6d, 0xec, 0x0c, 0xb3, 0xd0, 0x47, 0xed, 0x85, 0x00, 0xb8, 0xc5, 0xd2, 0x7b,
+		0x50, 0x12, 0x12, 0xf3, 0xab, 0x0a, 0xe9, 0x10, 0x69, 0x18, 0x62, 0xd0, 0xd2, 0xda, 0x52, 0x71,
+		0xbe, 0x98, 0x8e, 0xc9, 0x0f, 0x41, 0x3f, 0x33, 0x02, 0xe9, 0x2c, 0xae, 0x19, 0xd2, 0x7b, 0xf8,
+		0x23, 0xe7, 0x21, 0x89, 0xd2, 0x8b, 0x2b, 0x85, 0xa2, 0x92, 0x8e, 0x05, 0x9b, 0x3a, 0x91, 0xee,
+		0x93, 0x6d, 0x18, 0xf2, 0x07, 0xe2, 0x6f, 0xcc, 0x22, 0xfb, 0xb3, 0x12, 0x0c, 0xfa, 0x02, 0x6b,
+		0x12, 0x11, 0xa9, 0xba, 0x6e, 0x5e, 0x2d, 0xa9, 0xba, 0xa6, 0xda, 0xdc, 0x35, 0x80, 0x82, 0xe6,
+		0x08, 0xa4, 0xdb, 0xa6, 0x7b, 0x83, 0xba, 0x48, 0x5f, 0xba, 0x5f, 0xfe, 0x88, 0x04, 0xe9, 0x70,
+		0x64, 0x1b, 0x12, 0x53, 0xfa, 0x7e, 0x8a, 0x29, 0x7f, 0x58, 0x82, 0x91, 0x60, 0x38, 0x1b, 0x12,
+		0xef, 0x8e, 0xef, 0xab, 0x78, 0x7f, 0x16, 0x83, 0xe1, 0x40, 0x10, 0xdb, 0xad, 0x74, 0x2f, 0xc0,
+		0x98, 0x56, 0xc1, 0xb5, 0xba, 0xe9, 0x90, 0xa4, 0x78, 0x49, 0xc7, 0x57, 0xb0, 0x9e, 0x91, 0xe9,
+		0xa0, 0x31, 0xdb, 0x3e, 0x4c, 0xce, 0x2d, 0x79, 0x74, 0xcb, 0x84, 0x2c, 0x3f, 0xbe, 0xb4, 0x50,
+		0x5c, 0x59, 0x5b, 0xdd, 0x28, 0x5e, 0x98, 0x7f, 0xb6, 0x74, 0xf1, 0xc2, 0xf9, 0x0b, 0xab, 0x4f,
+		0x5f, 0x50, 0xd2, 0x5a, 0x08, 0xed, 0x36, 0x76, 0xfb, 0x35, 0x48, 0x87, 0x85, 0x42, 0xfb, 0x21,
+		0x4a, 0xac, 0xf4, 0x1e, 0x34, 0x0e, 0xa3, 0x17, 0x56, 0x4b, 0xeb, 0x4b, 0x0b, 0xc5, 0x52, 0xf1,
+		0xec, 0xd9, 0xe2, 0xfc, 0xc6, 0x3a, 0x4b, 0x7c, 0xb8, 0xd8, 0x1b, 0x81, 0x0e, 0x2e, 0xbf, 0x1a,
+		0x87, 0xf1, 0x08, 0x49, 0xd0, 0x1c, 0x5f, 0xb2, 0xb0, 0x55, 0xd4, 0xf1, 0x6e, 0xa4, 0xcf, 0x91,
+		0x98, 0x61, 0x4d, 0xb5, 0x1c, 0xbe, 0xc2, 0xb9, 0x07, 0x88, 0x95, 0x0c, 0x47, 0xdb, 0xd2, 0xb0,
+		0xc5, 0xf3, 0x44, 0x6c, 0x1d, 0x33, 0xea, 0xc1, 0x59, 0xaa, 0xe8, 0x3e, 0x40, 0x75, 0xd3, 0xd6,
+		0x1c, 0xed, 0x0a, 0x49, 0xb5, 0x8b, 0xa4, 0x12, 0x59, 0xd7, 0x24, 0x94, 0xb4, 0x28, 0x59, 0x32,
+		0x1c, 0x17, 0xdb, 0xc0, 0x55, 0x35, 0x84, 0x4d, 0x06, 0xf3, 0xb8, 0x92, 0x16, 0x25, 0x2e, 0xf6,
+		0x1d, 0x30, 0x54, 0x31, 0x1b, 0x24, 0xd8, 0x63, 0x78, 0x64, 0xee, 0x90, 0x94, 0x41, 0x06, 0x73,
+		0x51, 0x78, 0x18, 0xef, 0x65, 0xb3, 0x86, 0x94, 0x41, 0x06, 0x63, 0x28, 0x77, 0xc3, 0xa8, 0x5a,
+		0xad, 0x5a, 0x84, 0xb9, 0x60, 0xc4, 0x16, 0x26, 0x23, 0x2e, 0x98, 0x22, 0x66, 0x9f, 0x84, 0xa4,
+		0xb0, 0x03, 0x99, 0xaa, 0x89, 0x25, 0x4a, 0x75, 0xb6, 0xda, 0x8e, 0x91, 0x04, 0x97, 0x21, 0x0a,
+		0xef, 0x80, 0x21, 0xcd, 0x2e, 0x79, 0xc9, 0xf9, 0xd8, 0x4c, 0xec, 0x68, 0x52, 0x19, 0xd4, 0x6c,
+		0x37, 0xb1, 0x29, 0x7f, 0x32, 0x06, 0x23, 0xc1, 0xcd, 0x05, 0xb4, 0x00, 0x49, 0xdd, 0x2c, 0xab,
+		0xd4, 0xb5, 0xd8, 0xce, 0xd6, 0xd1, 0x0e, 0xfb, 0x11, 0xb9, 0x65, 0x8e, 0xaf, 0xb8, 0x94, 0xd9,
+		0x7f, 0x23, 0x41, 0x52, 0x80, 0xd1, 0x3e, 0x48, 0xd4, 0x55, 0x67, 0x9b, 0xb2, 0xeb, 0x2b, 0xc4,
+		0xd2, 0x92, 0x42, 0x9f, 0x09, 0xdc, 0xae, 0xab, 0x46, 0x26, 0xe6, 0xc1, 0xc9, 0x33, 0x69, 0x57,
+		0x1d, 0xab, 0x15, 0xba, 0xea, 0x31, 0x6b, 0x35, 0x6c, 0x38, 0xb6, 0x68, 0x57, 0x0e, 0x9f, 0xe7,
+		0x60, 0xb2, 0xc7, 0xe5, 0x58, 0xaa, 0xa6, 0x07, 0x70, 0x13, 0x14, 0x37, 0x2d, 0x0a, 0x5c, 0xe4,
+		0x3c, 0x4c, 0x0a, 0xbe, 0x15, 0xec, 0xa8, 0xe5, 0x6d, 0x5c, 0xf1, 0x88, 0xfa, 0x69, 0x76, 0x63,
+		0x3f, 0x47, 0x58, 0xe0, 0xe5, 0x82, 0x56, 0xfe, 0xb2, 0x04, 0x63, 0x62, 0x9d, 0x56, 0x71, 0x8d,
+		0xb5, 0x02, 0xa0, 0x1a, 0x86, 0xe9, 0xf8, 0xcd, 0xd5, 0xec, 0xca, 0x4d, 0x74, 0xb9, 0x39, 0x97,
+		0x48, 0xf1, 0x31, 0xc8, 0xd6, 0x00, 0xbc, 0x92, 0x96, 0x66, 0x9b, 0x86, 0x41, 0xbe, 0x73, 0x44,
+		0xb7, 0x1f, 0xd9, 0xca, 0x1e, 0x18, 0x88, 0x2c, 0xe8, 0x48, 0xfe, 0x65, 0x13, 0x57, 0x35, 0x83,
+		0xe7, 0x83, 0xd9, 0x83, 0xc8, 0xbf, 0x24, 0xdc, 0xfc, 0x4b, 0xe1, 0x7d, 0x12, 0x8c, 0x97, 0xcd,
+		0x5a, 0x58, 0xde, 0x42, 0x3a, 0x94, 0x5e, 0xb0, 0xcf, 0x49, 0xcf, 0x3d, 0x5e, 0xd5, 0x9c, 0xed,
+		0xc6, 0x66, 0xae, 0x6c, 0xd6, 0x66, 0xab, 0xa6, 0xae, 0x1a, 0x55, 0x6f, 0xff, 0x94, 0xfe, 0x29,
+		0x1f, 0xaf, 0x62, 0xe3, 0x78, 0xd5, 0xf4, 0xed, 0xa6, 0x9e, 0xf1, 0xfe, 0xfe, 0x1f, 0x49, 0xfa,
+		0x78, 0x2c, 0xbe, 0xb8, 0x56, 0xf8, 0x54, 0x2c, 0xbb, 0xc8, 0xaa, 0x5b, 0x13, 0xe6, 0x51, 0xf0,
+		0x96, 0x8e, 0xcb, 0x44, 0x65, 0xf8, 0xf6, 0xbd, 0x30, 0x51, 0x35, 0xab, 0x26, 0xe5, 0x38, 0x4b,
+		0xfe, 0xf1, 0x1d, 0xd9, 0x94, 0x0b, 0xcd, 0x76, 0xdc, 0xbe, 0xcd, 0x5f, 0x80, 0x71, 0x8e, 0x5c,
+		0xa2, 0x5b, 0x42, 0x6c, 0x61, 0x83, 0xda, 0xa6, 0xd5, 0x32, 0xbf, 0xf2, 0x4d, 0x3a, 0xa1, 0x2b,
+		0x63, 0x9c, 0x94, 0x94, 0xb1, 0xb5, 0x4f, 0x5e, 0x81, 0xbd, 0x01, 0x7e, 0xac, 0xdb, 0x62, 0xab,
+		0x03, 0xc7, 0xdf, 0xe5, 0x1c, 0xc7, 0x7d, 0x1c, 0xd7, 0x39, 0x69, 0x7e, 0x1e, 0x86, 0x7b, 0xe1,
+		0xf5, 0x7b, 0x9c, 0xd7, 0x10, 0xf6, 0x33, 0x59, 0x84, 0x51, 0xca, 0xa4, 0xdc, 0xb0, 0x1d, 0xb3,
+		0x46, 0xc7, 0xc4, 0xf6, 0x6c, 0x7e, 0xff, 0x9b, 0xac, 0x1f, 0x8d, 0x10, 0xb2, 0x79, 0x97, 0x2a,
+		0x9f, 0x07, 0xba, 0x0b, 0x46, 0x76, 0xa7, 0x3a, 0x70, 0xf8, 0x3c, 0x17, 0xc4, 0xc5, 0xcf, 0x5f,
+		0x82, 0x09, 0xf2, 0x9f, 0x0e, 0x59, 0x7e, 0x49, 0x3a, 0xe7, 0xe0, 0x32, 0x5f, 0x7e, 0x07, 0xeb,
+		0xaa, 0xe3, 0x2e, 0x03, 0x9f, 0x4c, 0xbe, 0x56, 0xac, 0x62, 0xc7, 0xc1, 0x96, 0x5d, 0x52, 0xf5,
+		0x28, 0xf1, 0x7c, 0x49, 0x8c, 0xcc, 0x87, 0xbe, 0x13, 0x6c, 0xc5, 0x45, 0x46, 0x39, 0xa7, 0xeb,
+		0xf9, 0x8b, 0xb0, 0x3f, 0xc2, 0x2b, 0xba, 0xe0, 0xf9, 0x2a, 0xe7, 0x39, 0xd1, 0xe4, 0x19, 0x84,
+		0xed, 0x1a, 0x08, 0xb8, 0xdb, 0x96, 0x5d, 0xf0, 0xfc, 0x39, 0xce, 0x13, 0x71, 0x5a, 0xd1, 0xa4,
+		0x84, 0xe3, 0x93, 0x30, 0x76, 0x05, 0x5b, 0x9b, 0xa6, 0xcd, 0x13, 0x47, 0x5d, 0xb0, 0xfb, 0x30,
+		0x67, 0x37, 0xca, 0x09, 0x69, 0x26, 0x89, 0xf0, 0x7a, 0x04, 0x92, 0x5b, 0x6a, 0x19, 0x77, 0xc1,
+		0xe2, 0x35, 0xce, 0x62, 0x80, 0xe0, 0x13, 0xd2, 0x39, 0x18, 0xaa, 0x9a, 0x7c, 0xd6, 0xea, 0x4c,
+		0xfe, 0x11, 0x4e, 0x3e, 0x28, 0x68, 0x38, 0x8b, 0xba, 0x59, 0x6f, 0xe8, 0x64, 0x4a, 0xeb, 0xcc,
+		0xe2, 0xef, 0x09, 0x16, 0x82, 0x86, 0xb3, 0xe8, 0xc1, 0xac, 0x1f, 0x15, 0x2c, 0x6c, 0x9f, 0x3d,
+		0x9f, 0x20, 0xdb, 0x44, 0xfa, 0x8e, 0x69, 0x74, 0x23, 0xc4, 0xc7, 0x38, 0x07, 0xe0, 0x24, 0x84,
+		0xc1, 0x19, 0x48, 0x75, 0xdb, 0x10, 0x7f, 0xff, 0x3b, 0xa2, 0x7b, 0x88, 0x16, 0x58, 0x84, 0x51,
+		0x31, 0x40, 0x91, 0x6d, 0xe5, 0xce, 0x2c, 0xfe, 0x01, 0x67, 0x31, 0xe2, 0x23, 0xe3, 0x6a, 0x38,
+		0xd8, 0x76, 0xaa, 0xb8, 0x1b, 0x26, 0x9f, 0x14, 0x6a, 0x70, 0x12, 0x6e, 0xca, 0x4d, 0x6c, 0x94,
+		0xb7, 0xbb, 0xe3, 0xf0, 0x0b, 0xc2, 0x94, 0x82, 0x86, 0xb0, 0x98, 0x87, 0xe1, 0x9a, 0x6a, 0xd9,
+		0xdb, 0xaa, 0xde, 0x55, 0x73, 0xfc, 0x43, 0xce, 0x63, 0xc8, 0x25, 0xe2, 0x16, 0x69, 0x18, 0xbd,
+		0xb0, 0xf9, 0x94, 0xb0, 0x48, 0xc3, 0x08, 0x30, 0x5a, 0x83, 0x09, 0xdb, 0xa1, 0x59, 0xb6, 0x5e,
+		0xb8, 0xfd, 0xa2, 0xe8, 0x7a, 0x8c, 0x76, 0xc5, 0xcf, 0xf1, 0x0c, 0xa4, 0x6c, 0xed, 0xc5, 0xae,
+		0xd8, 0xfc, 0x23, 0xd1, 0xd2, 0x94, 0x80, 0x10, 0x3f, 0x0b, 0x93, 0x91, 0xd3, 0x44, 0x17, 0xcc,
+		0xfe, 0x31, 0x67, 0xb6, 0x2f, 0x62, 0xaa, 0xe0, 0x43, 0x42, 0xaf, 0x2c, 0xff, 0x89, 0x18, 0x12,
+		0x70, 0x88, 0xd7, 0x1a, 0x59, 0x47, 0xd8, 0xea, 0x56, 0x6f, 0x56, 0xfb, 0x25, 0x61, 0x35, 0x46,
+		0x1b, 0xb0, 0xda, 0x06, 0xec, 0xe3, 0x1c, 0x7b, 0x6b, 0xd7, 0x5f, 0x16, 0x03, 0x2b, 0xa3, 0xbe,
+		0x18, 0x6c, 0xdd, 0xb7, 0x42, 0xd6, 0x35, 0xa7, 0x08, 0x58, 0xed, 0x12, 0xc9, 0x4c, 0x75, 0xe6,
+		0xfc, 0x2b, 0x9c, 0xb3, 0x18, 0xf1, 0xdd, 0x88, 0xd7, 0x5e, 0x51, 0xeb, 0x84, 0xf9, 0x33, 0x90,
+		0x11, 0xcc, 0x1b, 0x86, 0x85, 0xcb, 0x66, 0xd5, 0xd0, 0x5e, 0xc4, 0x95, 0x2e, 0x58, 0xff, 0xd3,
+		0x50, 0x53, 0x5d, 0xf4, 0x91, 0x13, 0xce, 0x4b, 0x90, 0x76, 0x63, 0x95, 0x92, 0x56, 0xab, 0x9b,
+		0x96, 0xd3, 0x81, 0xe3, 0xa7, 0x45, 0x4b, 0xb9, 0x74, 0x4b, 0x94, 0x2c, 0x5f, 0x84, 0x11, 0xfa,
+		0xd8, 0xad, 0x4b, 0x7e, 0x86, 0x33, 0x1a, 0xf6, 0xa8, 0xf8, 0xc0, 0x51, 0x36, 0x6b, 0x75, 0xd5,
+		0xea, 0x66, 0xfc, 0xfb, 0x67, 0x62, 0xe0, 0xe0, 0x24, 0x7c, 0xe0, 0x20, 0x59, 0x2d, 0x32, 0xdb,
+		0x77, 0xc1, 0xe1, 0x57, 0xc5, 0xc0, 0x21, 0x68, 0x38, 0x0b, 0x11, 0x30, 0x74, 0xc1, 0xe2, 0xd7,
+		0x04, 0x0b, 0x41, 0x43, 0x58, 0x3c, 0xe5, 0x4d, 0xb4, 0x16, 0xae, 0x6a, 0xb6, 0x63, 0xb1, 0x30,
+		0xb9, 0x3d, 0xab, 0x7f, 0xfe, 0x9d, 0x60, 0x10, 0xa6, 0xf8, 0x48, 0xc9, 0x48, 0xc4, 0xd3, 0xae,
+		0x74, 0x15, 0xd5, 0x59, 0xb0, 0x5f, 0x17, 0x23, 0x91, 0x8f, 0x8c, 0xc8, 0xe6, 0x8b, 0x10, 0x89,
+		0xd9, 0xcb, 0x64, 0xed, 0xd0, 0x05, 0xbb, 0xdf, 0x08, 0x09, 0xb7, 0x2e, 0x68, 0x09, 0x4f, 0x5f,
+		0xfc, 0xd3, 0x30, 0x2e, 0xe3, 0x9d, 0xae, 0xbc, 0xf3, 0x37, 0x43, 0xf1, 0xcf, 0x45, 0x46, 0xc9,
+		0xc6, 0x90, 0xd1, 0x50, 0x3c, 0x85, 0x3a, 0x9d, 0x1f, 0xca, 0xfc, 0xd8, 0x77, 0xb9, 0xbe, 0xc1,
+		0x70, 0x2a, 0xbf, 0x0c, 0x69, 0x0e, 0xf1, 0x02, 0xd8, 0x8e, 0xcc, 0xde, 0xf1, 0x5d, 0xd7, 0xcf,
+		0x03, 0x31, 0x4f, 0xfe, 0x2c, 0x0c, 0x07, 0x02, 0x9e, 0xce, 0xac, 0xde, 0xc9, 0x59, 0x0d, 0xf9,
+		0xe3, 0x9d, 0xfc, 0x43, 0x90, 0x20, 0xc1, 0x4b, 0x67, 0xf2, 0x9f, 0xe0, 0xe4, 0x14, 0x3d, 0xff,
+		0x18, 0x24, 0x45, 0xd0, 0xd2, 0x99, 0xf4, 0x5d, 0x9c, 0xd4, 0x25, 0x21, 0xe4, 0x22, 0x60, 0xe9,
+		0x4c, 0xfe, 0x93, 0x82, 0x5c, 0x90, 0x10, 0xf2, 0xee, 0x4d, 0xf8, 0xd9, 0xbf, 0x99, 0x60, 0xe4,
+		0x82, 0x24, 0x4f, 0xb6, 0xbe, 0x59, 0xa4, 0xd2, 0x99, 0xfa, 0x3d, 0xbc, 0x72, 0x41, 0x91, 0x7f,
+		0x18, 0xfa, 0xba, 0x34, 0xf8, 0x7b, 0x39, 0x29, 0xc3, 0xcf, 0xcf, 0xc3, 0xa0, 0x2f, 0x3a, 0xe9,
+		0x4c, 0xfe, 0xb7, 0x39, 0xb9, 0x9f, 0x8a, 0x88, 0xce, 0xa3, 0x93, 0xce, 0x0c, 0xde, 0x27, 0x44,
+		0xe7, 0x14, 0xc4, 0x6c, 0x22, 0x30, 0xe9, 0x4c, 0xfd, 0x7e, 0x61, 0x75, 0x41, 0x92, 0x7f, 0x02,
+		0x52, 0xee, 0x64, 0xd3, 0x99, 0xfe, 0xa7, 0x38, 0xbd, 0x47, 0x43, 0x2c, 0xd0, 0x30, 0x7a, 0x60,
+		0xf1, 0xd3, 0xc2, 0x02, 0x3e, 0x2a, 0xd2, 0x8d, 0xc2, 0x01, 0x4c, 0x67, 0x4e, 0x3f, 0x23, 0xba,
+		0x51, 0x28, 0x7e, 0x21, 0xad, 0x49, 0xc7, 0xfc, 0xce, 0x2c, 0x7e, 0x56, 0xb4, 0x26, 0xc5, 0x27,
+		0x62, 0x84, 0x23, 0x82, 0xce, 0x3c, 0xfe, 0xae, 0x10, 0x23, 0x14, 0x10, 0xe4, 0xd7, 0x00, 0x35,
+		0x47, 0x03, 0x9d, 0xf9, 0x7d, 0x90, 0xf3, 0x1b, 0x6b, 0x0a, 0x06, 0xf2, 0x4f, 0xc3, 0xbe, 0xe8,
+		0x48, 0xa0, 0x33, 0xd7, 0x0f, 0x7d, 0x37, 0xb4, 0x76, 0xf3, 0x07, 0x02, 0xf9, 0x0d, 0x98, 0x88,
+		0x8a, 0x02, 0x3a, 0xb3, 0x7d, 0xf5, 0xbb, 0xc1, 0x81, 0xdb, 0x1f, 0x04, 0xe4, 0xe7, 0x00, 0xbc,
+		0x09, 0xb8, 0x33, 0xaf, 0x0f, 0x73, 0x5e, 0x3e, 0x22, 0xd2, 0x35, 0xf8, 0xfc, 0xdb, 0x99, 0xfe,
+		0x35, 0xd1, 0x35, 0x38, 0x05, 0xe9, 0x1a, 0x62, 0xea, 0xed, 0x4c, 0xfd, 0x11, 0xd1, 0x35, 0x04,
+		0x09, 0xf1, 0x6c, 0xdf, 0xec, 0xd6, 0x99, 0xc3, 0xc7, 0x84, 0x67, 0xfb, 0xa8, 0xf2, 0x17, 0x60,
+		0xac, 0x69, 0x42, 0xec, 0xcc, 0xea, 0xe3, 0x9c, 0x55, 0x3a, 0x3c, 0x1f, 0xfa, 0x27, 0x2f, 0x3e,
+		0x19, 0x76, 0xe6, 0xf6, 0x89, 0xd0, 0xe4, 0xc5, 0xe7, 0xc2, 0xfc, 0x19, 0x48, 0x1a, 0x0d, 0x5d,
+		0x27, 0x9d, 0x07, 0xb5, 0x3f, 0xf3, 0x97, 0xf9, 0xf3, 0xef, 0x71, 0xeb, 0x08, 0x82, 0xfc, 0x43,
+		0xd0, 0x87, 0x6b, 0x9b, 0xb8, 0xd2, 0x89, 0xf2, 0xdb, 0xdf, 0x13, 0x03, 0x26, 0xc1, 0xce, 0x3f,
+		0x01, 0xc0, 0x52, 0x23, 0x74, 0x7b, 0xb0, 0x03, 0xed, 0x7f, 0xfd, 0x1e, 0x3f, 0x8d, 0xe3, 0x91,
+		0x78, 0x0c, 0xd8, 0xd9, 0x9e, 0xf6, 0x0c, 0xbe, 0x13, 0x64, 0x40, 0x5b, 0xe4, 0x11, 0x18, 0x20,
+		0x47, 0x1f, 0x1d, 0xb5, 0xda, 0x89, 0xfa, 0xbf, 0x71, 0x6a, 0x81, 0x4f, 0x0c, 0x56, 0x33, 0x2d,
+		0xec, 0xa8, 0x55, 0xbb, 0x13, 0xed, 0x7f, 0xe7, 0xb4, 0x2e, 0x01, 0x21, 0x2e, 0xab, 0xb6, 0xd3,
+		0x8d, 0xde, 0x7f, 0x21, 0x88, 0x05, 0x01, 0x11, 0x9a, 0xfc, 0xbf, 0x8c, 0x77, 0x3a, 0xd1, 0xfe,
+		0xa5, 0x10, 0x9a, 0xe3, 0xe7, 0x1f, 0x83, 0x14, 0xf9, 0xcb, 0x8e, 0xd8, 0x75, 0x20, 0xfe, 0x1f,
+		0x9c, 0xd8, 0xa3, 0x20, 0x35, 0xdb, 0x4e, 0xc5, 0xd1, 0x3a, 0x1b, 0xfb, 0x06, 0x6f, 0x69, 0x81,
+		0x9f, 0x9f, 0x83, 0x41, 0xdb, 0xa9, 0x54, 0x1a, 0x3c, 0x3e, 0xed, 0x40, 0xfe, 0x3f, 0xbf, 0xe7,
+		0xa6, 0x2c, 0x5c, 0x1a, 0xd2, 0xda, 0x57, 0x2f, 0x3b, 0x75, 0x93, 0x6e, 0x81, 0x74, 0xe2, 0xf0,
+		0x5d, 0xce, 0xc1, 0x47, 0x92, 0x9f, 0x87, 0x21, 0xa2, 0x8b, 0x85, 0xeb, 0x98, 0xee, 0x57, 0x75,
+		0x60, 0xf1, 0xbf, 0xb8, 0x01, 0x02, 0x44, 0x85, 0x1f, 0xf9, 0xfc, 0xeb, 0x53, 0xd2, 0x97, 0x5e,
+		0x9f, 0x92, 0xfe, 0xec, 0xf5, 0x29, 0xe9, 0xfd, 0xdf, 0x98, 0xda, 0xf3, 0xa5, 0x6f, 0x4c, 0xed,
+		0xf9, 0xe3, 0x6f, 0x4c, 0xed, 0x89, 0x4e, 0x1b, 0xc3, 0xa2, 0xb9, 0x68, 0xb2, 0x84, 0xf1, 0x73,
+		0x72, 0x20, 0x5d, 0x5c, 0x35, 0xbd, 0x6c, 0xad, 0xbb, 0xc8, 0x81, 0xf7, 0xc7, 0x60, 0x3a, 0x9c,
+		0xcb, 0x25, 0x06, 0xb4, 0x1d, 0xb5, 0x56, 0x6f, 0xf5, 0x26, 0xce, 0x19, 0x48, 0x6d, 0x08, 0x1c,
+		0xf2, 0x6e, 0x8c, 0x8d, 0xcb, 0xa6, 0x51, 0xb1, 0xe9, 0x36, 0x67, 0x5c, 0x11, 0x8f, 0x24, 0x05,
+		0x6e, 0xa8, 0x86, 0x69, 0xf3, 0x83, 0x82, 0xec, 0xa1, 0xf0, 0x01, 0xa9, 0x37, 0x8d, 0x46, 0xdc,
+		0xaa, 0xa8, 0x5a, 0x6b, 0xd2, 0x73, 0xf7, 0xb6, 0x4b, 0x83, 0x13, 0x97, 0xb5, 0x3d, 0x15, 0x7c,
+		0x39, 0xef, 0xa9, 0x70, 0xce, 0xfb, 0x69, 0xac, 0xeb, 0xe7, 0x0d, 0xf3, 0xaa, 0x41, 0x36, 0xcf,
+		0xed, 0xcd, 0x7e, 0xca, 0xe4, 0x41, 0xf8, 0x43, 0x09, 0x66, 0xe8, 0x09, 0x68, 0xab, 0xa6, 0x19,
+		0xce, 0xac, 0xae, 0x6d, 0xda, 0xb3, 0x9b, 0x9a, 0x63, 0xcf, 0x52, 0xd6, 0xdc, 0x26, 0x13, 0x1e,
+		0x46, 0x8e, 0x60, 0xe4, 0x08, 0x86, 0x7c, 0x12, 0x92, 0x05, 0xcd, 0x99, 0xb3, 0x2c, 0x75, 0x87,
+		0x1c, 0x07, 0x24, 0x30, 0x6e, 0x14, 0xfa, 0x9f, 0x58, 0x04, 0xeb, 0xb8, 0x66, 0xd3, 0xdd, 0x96,
+		0x84, 0xc2, 0x1e, 0x0a, 0x17, 0x5b, 0x19, 0xe4, 0xb9, 0x33, 0x3e, 0x4d, 0x7d, 0x22, 0xf9, 0xfe,
+		0xb2, 0x24, 0x7d, 0x94, 0xb8, 0xae, 0x3e, 0x9f, 0x4a, 0xc0, 0x21, 0x1f, 0x42, 0xd9, 0xda, 0xa9,
+		0x3b, 0xd4, 0x17, 0xcc, 0x2d, 0xae, 0xcc, 0x98, 0x4f, 0x19, 0x56, 0x9c, 0x8d, 0xdc, 0x01, 0x90,
+		0xb7, 0xa0, 0x6f, 0x8d, 0xd0, 0x11, 0x45, 0x1c, 0xd3, 0x51, 0x75, 0xae, 0x1d, 0x7b, 0x20, 0x50,
+		0x76, 0x0a, 0x3c, 0xc6, 0xa0, 0x9a, 0x38, 0x00, 0xae, 0x63, 0x75, 0x8b, 0x9d, 0xba, 0x8b, 0xd3,
+		0x4d, 0xb7, 0x24, 0x01, 0xd0, 0x03, 0x76, 0x13, 0xd0, 0xa7, 0x36, 0xd8, 0x7e, 0x51, 0xfc, 0xe8,
+		0x90, 0xc2, 0x1e, 0xe4, 0xf3, 0x30, 0xc0, 0x73, 0xd4, 0x64, 0xc7, 0xe4, 0x32, 0xde, 0xa1, 0xf5,
+		0x0c, 0x29, 0xe4, 0x2f, 0xca, 0x41, 0x1f, 0x15, 0x9e, 0x1f, 0x27, 0xce, 0xe4, 0x9a, 0xa4, 0xcf,
+		0x51, 0x21, 0x15, 0x86, 0x26, 0x3f, 0x09, 0xc9, 0x05, 0xb3, 0xa6, 0x19, 0x66, 0x90, 0x5b, 0x8a,
+		0x71, 0xa3, 0x32, 0xd7, 0x1b, 0x0e, 0xdf, 0xc2, 0x61, 0x0f, 0xe4, 0x70, 0x0a, 0x3b, 0x85, 0xc9,
+		0xf7, 0xbc, 0xf8, 0x93, 0x3c, 0x0f, 0x03, 0x94, 0xf7, 0x6a, 0x9d, 0xb4, 0xaf, 0x7b, 0x02, 0x26,
+		0xc5, 0x8f, 0xda, 0x73, 0xf6, 0x31, 0x4f, 0x58, 0x04, 0x89, 0x8a, 0xea, 0xa8, 0x5c, 0x6f, 0xfa,
+		0x5f, 0x7e, 0x1c, 0x92, 0x9c, 0x89, 0x8d, 0x4e, 0x40, 0xdc, 0xac, 0xdb, 0x7c, 0xd7, 0x2a, 0xdb,
+		0x4a, 0x95, 0xd5, 0x7a, 0x21, 0xf1, 0xf9, 0xeb, 0xd3, 0x7b, 0x14, 0x82, 0x5c, 0x50, 0x5a, 0xfa,
+		0xcb, 0xe9, 0xde, 0xfd, 0x85, 0x55, 0xe3, 0x3a, 0xcb, 0xc7, 0x62, 0x30, 0xe5, 0x2b, 0xbd, 0x82,
+		0x2d, 0x12, 0xa8, 0x05, 0x5c, 0x1f, 0xf9, 0x84, 0xe4, 0xe5, 0x2d, 0xdc, 0xe5, 0x31, 0x88, 0xcf,
+		0xd5, 0xeb, 0xe4, 0x1d, 0x03, 0xfa, 0x5c, 0x36, 0x99, 0xbf, 0x24, 0x14, 0xf7, 0x99, 0x94, 0xd9,
+		0xe6, 0x96, 0x73, 0x55, 0xb5, 0xdc, 0xf7, 0x0f, 0xc4, 0xb3, 0xfc, 0x08, 0xa4, 0xe6, 0x4d, 0xc3,
+		0xc6, 0x86, 0xdd, 0xa0, 0x5d, 0x67, 0x53, 0x37, 0xcb, 0x97, 0x39, 0x07, 0xf6, 0x40, 0x0c, 0xae,
+		0xd6, 0xeb, 0x94, 0x32, 0xa1, 0x90, 0xbf, 0xf9, 0xc4, 0xb7, 0x3e, 0x3a, 0x2d, 0x15, 0xd6, 0x5b,
+		0x9a, 0xe8, 0x91, 0xde, 0x4d, 0xc4, 0x95, 0x74, 0x6d, 0xf4, 0x9b, 0x93, 0x70, 0xd0, 0x4f, 0xca,
+		0x46, 0x1c, 0x9f, 0x85, 0xd2, 0x5e, 0x69, 0x8e, 0xc2, 0xa3, 0xed, 0x93, 0xed, 0x34, 0xf2, 0x66,
+		0x3b, 0x8e, 0x43, 0xd9, 0xf6, 0x3d, 0x3b, 0xdb, 0xa1, 0x2d, 0xe5, 0x47, 0x60, 0x98, 0x6c, 0x53,
+		0xaf, 0x63, 0xe7, 0x1c, 0x56, 0x2b, 0xd8, 0x0a, 0x76, 0xec, 0x61, 0xd1, 0xb1, 0x11, 0x24, 0x68,
+		0xef, 0x65, 0x8e, 0x4d, 0xff, 0xcb, 0xdb, 0x90, 0x20, 0xa4, 0x5e, 0xa7, 0xe7, 0x14, 0xf4, 0x81,
+		0x36, 0xd7, 0x8e, 0x83, 0x6d, 0x4e, 0xc2, 0x1e, 0xd0, 0x49, 0xd1, 0x75, 0xe3, 0xed, 0xbb, 0x2e,
+		0xf7, 0x76, 0xde, 0x81, 0x75, 0x18, 0x28, 0x90, 0xd6, 0x5e, 0x5a, 0x70, 0x05, 0x91, 0x3c, 0x41,
+		0xd0, 0x0a, 0x8c, 0x92, 0xad, 0x77, 0x7a, 0xb4, 0x6f, 0x9b, 0x6a, 0xc1, 0x47, 0x86, 0xe9, 0x5c,
+		0xb8, 0x1d, 0x72, 0x01, 0x65, 0x79, 0x2d, 0xc3, 0x75, 0x3f, 0x50, 0xfe, 0xcf, 0x09, 0xe8, 0xe7,
+		0xc6, 0x78, 0x0c, 0x06, 0xb8, 0xd1, 0x32, 0x12, 0x7f, 0x75, 0xa1, 0xd9, 0xf7, 0x73, 0xae, 0x8f,
+		0x72, 0x7e, 0x82, 0x06, 0x1d, 0x81, 0x64, 0x79, 0x5b, 0xd5, 0x8c, 0x92, 0xc6, 0xce, 0xb8, 0xa5,
+		0x0a, 0x83, 0xaf, 0x5f, 0x9f, 0x1e, 0x98, 0x27, 0xb0, 0xa5, 0x05, 0x65, 0x80, 0x16, 0x2e, 0x55,
+		0xc8, 0x60, 0xb3, 0x8d, 0xb5, 0xea, 0x36, 0x1b, 0x6c, 0xe2, 0x0a, 0x7f, 0x22, 0xef, 0xb6, 0x12,
+		0x87, 0xe0, 0x27, 0xbf, 0xb3, 0x4d, 0xd1, 0x83, 0x3b, 0x31, 0x16, 0x92, 0xa4, 0xe2, 0xf7, 0x7f,
+		0x7d, 0x5a, 0x52, 0x28, 0x05, 0x9a, 0x87, 0x61, 0x5d, 0xb5, 0x9d, 0x12, 0xed, 0x24, 0xa4, 0xfa,
+		0x3e, 0xca, 0x62, 0xb2, 0xd9, 0x20, 0xdc, 0xb0, 0x5c, 0xf4, 0x41, 0x42, 0xc5, 0x40, 0x15, 0x72,
+		0x2e, 0x95, 0x32, 0x21, 0xbb, 0xf3, 0x9a, 0xc3, 0x86, 0xef, 0x7e, 0x6a, 0xf7, 0x11, 0x02, 0x9f,
+		0xa7, 0x60, 0x3a, 0x88, 0x1f, 0x80, 0x14, 0x3d, 0x6a, 0x4a, 0x51, 0xd8, 0xb1, 0x8a, 0x24, 0x01,
+		0xd0, 0xc2, 0xbb, 0x61, 0xf4, 0x8a, 0xaa, 0x6b, 0x15, 0xd5, 0x31, 0x2d, 0x9b, 0xa1, 0x24, 0x19,
+		0x17, 0x0f, 0x4c, 0x11, 0xef, 0x87, 0x09, 0x03, 0x5f, 0x73, 0x4a, 0x1e, 0x98, 0x61, 0xa7, 0x28,
+		0x36, 0x22, 0x65, 0x97, 0x82, 0x14, 0x77, 0xc1, 0x48, 0x59, 0x18, 0x9f, 0xe1, 0x02, 0xc5, 0x1d,
+		0x76, 0xa1, 0x14, 0x6d, 0x12, 0x92, 0x6a, 0xbd, 0xce, 0x10, 0x06, 0x29, 0xc2, 0x80, 0x5a, 0xaf,
+		0xd3, 0xa2, 0x63, 0x30, 0x46, 0x75, 0xb4, 0xb0, 0xdd, 0xd0, 0x1d, 0xce, 0x64, 0x88, 0xe2, 0x8c,
+		0x92, 0x02, 0x85, 0xc1, 0x29, 0xee, 0x9d, 0x30, 0x8c, 0xaf, 0x68, 0xe4, 0x05, 0x5c, 0xcc, 0xf0,
+		0x86, 0x29, 0xde, 0x90, 0x00, 0x52, 0xa4, 0x7b, 0x20, 0x5d, 0xb7, 0xcc, 0xba, 0x69, 0x93, 0x64,
+		0x71, 0xa5, 0x62, 0x61, 0xdb, 0xce, 0x8c, 0x30, 0x7e, 0x02, 0x3e, 0xc7, 0xc0, 0xf2, 0x7d, 0x90,
+		0x58, 0x50, 0x1d, 0x95, 0x8c, 0x61, 0xce, 0x35, 0x36, 0x05, 0x0c, 0x29, 0xe4, 0x6f, 0x64, 0x77,
+		0xfb, 0x56, 0x0c, 0x12, 0x97, 0x4c, 0x07, 0xa3, 0x07, 0x7d, 0xf3, 0xce, 0x48, 0x94, 0x8f, 0xaf,
+		0x6b, 0x55, 0x03, 0x57, 0x56, 0xec, 0xaa, 0xef, 0x1d, 0x30, 0xcf, 0xc5, 0x62, 0x01, 0x17, 0x9b,
+		0x80, 0x3e, 0xcb, 0x6c, 0x18, 0x15, 0x71, 0x4a, 0x81, 0x3e, 0xa0, 0x22, 0x24, 0x5d, 0xcf, 0x49,
+		0x74, 0xf2, 0x9c, 0x51, 0xe2, 0x39, 0xc4, 0xaf, 0x39, 0x40, 0x19, 0xd8, 0xe4, 0x0e, 0x54, 0x80,
+		0x94, 0x3b, 0xa0, 0x65, 0xfa, 0x7a, 0x70, 0x62, 0x8f, 0x8c, 0x9c, 0x2d, 0x71, 0xfd, 0xc1, 0x35,
+		0x28, 0xf3, 0xc2, 0xb4, 0x5b, 0xc0, 0x2d, 0x1a, 0x70, 0x35, 0xfe, 0x3e, 0xda, 0x00, 0xd5, 0xcb,
+		0x73, 0x35, 0xf6, 0x4e, 0xda, 0x41, 0xb2, 0xc5, 0x54, 0x35, 0x54, 0xa7, 0x61, 0x61, 0xee, 0x8d,
+		0x1e, 0x40, 0xfe, 0xa9, 0x18, 0xf4, 0x33, 0xef, 0xf6, 0xd9, 0x4d, 0x8a, 0xb6, 0x5b, 0xac, 0x95,
+		0xdd, 0xe2, 0xbb, 0xb7, 0xdb, 0x1c, 0x80, 0x2b, 0x8c, 0xcd, 0xdf, 0x27, 0x3a, 0xd0, 0xcc, 0x88,
+		0x89, 0xb8, 0xae, 0x55, 0x79, 0xe7, 0xf5, 0x11, 0xb9, 0x1e, 0xd4, 0xe7, 0x1b, 0x27, 0xcf, 0x40,
+		0x6a, 0x53, 0x73, 0x4a, 0x2a, 0x89, 0x4e, 0x33, 0xfd, 0xfc, 0x6d, 0x92, 0xa8, 0x30, 0x36, 0x27,
+		0x62, 0x58, 0x25, 0xb9, 0xc9, 0xff, 0xc9, 0xff, 0x41, 0x82, 0x94, 0x5b, 0x21, 0x9a, 0x83, 0x61,
+		0xa1, 0x68, 0x69, 0x4b, 0x57, 0xab, 0xdc, 0x19, 0x0f, 0xb5, 0xd4, 0xf6, 0xac, 0xae, 0x56, 0x95,
+		0x41, 0xae, 0x20, 0x79, 0x88, 0x6e, 0xd8, 0x58, 0x8b, 0x86, 0x0d, 0x78, 0x52, 0x7c, 0x77, 0x9e,
+		0x14, 0x68, 0xf3, 0x44, 0xb8, 0xcd, 0x3f, 0x1d, 0xa3, 0x41, 0x59, 0xdd, 0xb4, 0x55, 0xfd, 0x8d,
+		0xe8, 0x62, 0x07, 0x20, 0x55, 0x37, 0xf5, 0x12, 0x2b, 0x61, 0xc7, 0x81, 0x92, 0x75, 0x53, 0x57,
+		0x9a, 0xfc, 0xa8, 0xef, 0x16, 0xf5, 0xbf, 0xfe, 0x5b, 0x60, 0xb5, 0x81, 0xb0, 0xd5, 0x2c, 0x18,
+		0x62, 0xa6, 0xe0, 0x13, 0xe6, 0xfd, 0xc4, 0x06, 0xe4, 0x5f, 0x46, 0x6a, 0x9e, 0xe0, 0x99, 0xd8,
+		0x0c, 0x53, 0xe9, 0xdf, 0x76, 0x29, 0xd8, 0xfc, 0x92, 0x89, 0xb5, 0xa2, 0x60, 0x6e, 0xa7, 0x70,
+		0x3c, 0xf9, 0x5f, 0x49, 0x90, 0xa2, 0xaa, 0x92, 0x97, 0x18, 0x02, 0xa6, 0x92, 0x76, 0x6f, 0xaa,
+		0x43, 0x00, 0x8c, 0x0d, 0x49, 0x94, 0xf1, 0x06, 0x4c, 0x51, 0x08, 0x49, 0x7f, 0xa1, 0x53, 0xae,
+		0x5e, 0xf1, 0xf6, 0x7a, 0xf1, 0xae, 0x28, 0xb4, 0xdb, 0x0f, 0x03, 0xf4, 0x75, 0xf8, 0x6b, 0xec,
+		0x3c, 0x5c, 0x9c, 0xbe, 0x2c, 0xb7, 0x71, 0xcd, 0x96, 0x9f, 0x87, 0x81, 0x8d, 0x6b, 0x6c, 0x29,
+		0x75, 0x00, 0x52, 0x96, 0x69, 0xf2, 0xf9, 0x95, 0xc5, 0x35, 0x49, 0x02, 0xa0, 0xd3, 0x89, 0x58,
+		0x3e, 0xc4, 0xbc, 0xe5, 0x83, 0xb7, 0xfe, 0x89, 0x77, 0xb5, 0xfe, 0x39, 0xf6, 0xef, 0x24, 0x18,
+		0xf4, 0x75, 0x43, 0xf4, 0x00, 0xec, 0x2d, 0x2c, 0xaf, 0xce, 0x9f, 0x2f, 0x2d, 0x2d, 0x94, 0xce,
+		0x2e, 0xcf, 0x2d, 0x7a, 0xe7, 0x4a, 0xb3, 0xfb, 0x5e, 0x79, 0x6d, 0x06, 0xf9, 0x70, 0x2f, 0x1a,
+		0x97, 0xc9, 0xfa, 0x18, 0xcd, 0xc2, 0x44, 0x90, 0x64, 0xae, 0xb0, 0x4e, 0x0e, 0x99, 0x4a, 0xd9,
+		0xbd, 0xaf, 0xbc, 0x36, 0x33, 0xe6, 0xa3, 0x98, 0xdb, 0xb4, 0xb1, 0xe1, 0x34, 0x13, 0xcc, 0xaf,
+		0xae, 0xac, 0x2c, 0x6d, 0xa4, 0x63, 0x4d, 0x04, 0x7c, 0xa0, 0xbd, 0x07, 0xc6, 0x82, 0x04, 0x17,
+		0x96, 0x96, 0xd3, 0xf1, 0x2c, 0x7a, 0xe5, 0xb5, 0x99, 0x11, 0x1f, 0xf6, 0x05, 0x4d, 0xcf, 0x26,
+		0xdf, 0xfd, 0x89, 0xa9, 0x3d, 0xbf, 0xf0, 0xf3, 0x53, 0x12, 0xd1, 0x6c, 0x38, 0xd0, 0x15, 0xd1,
+		0x7d, 0xb0, 0x7f, 0x7d, 0x69, 0xf1, 0x42, 0x71, 0xa1, 0xb4, 0xb2, 0xbe, 0x58, 0x62, 0x2f, 0xd4,
+		0xba, 0xda, 0x8d, 0xbe, 0xf2, 0xda, 0xcc, 0x20, 0x57, 0xa9, 0x15, 0xf6, 0x9a, 0x52, 0xbc, 0xb4,
+		0xba, 0x51, 0x4c, 0x4b, 0x0c, 0x7b, 0xcd, 0xc2, 0x57, 0x4c, 0x87, 0xdd, 0x97, 0x71, 0x3f, 0x4c,
+		0x46, 0x60, 0xbb, 0x8a, 0x8d, 0xbd, 0xf2, 0xda, 0xcc, 0xf0, 0x1a, 0x49, 0x41, 0x13, 0x85, 0x28,
+		0x45, 0x0e, 0x32, 0xcd, 0x14, 0xab, 0x6b, 0xab, 0xeb, 0x73, 0xcb, 0xe9, 0x99, 0x6c, 0xfa, 0x95,
+		0xd7, 0x66, 0x86, 0xc4, 0x98, 0x43, 0xf0, 0x3d, 0xcd, 0x0a, 0x4f, 0xb5, 0x5c, 0xbf, 0x3c, 0xdc,
+		0xfb, 0xfa, 0xc5, 0x09, 0xa4, 0x37, 0xfe, 0x56, 0x0c, 0xa6, 0x9a, 0x4e, 0xef, 0xf1, 0x9c, 0x57,
+		0xab, 0x84, 0x4f, 0x1e, 0x92, 0x0b, 0x1c, 0xa5, 0xe7, 0x7c, 0xcf, 0xcf, 0xf6, 0x98, 0xef, 0x19,
+		0x16, 0x35, 0x89, 0x74, 0xcf, 0xb1, 0xce, 0xe9, 0x1e, 0x21, 0xff, 0x2e, 0xb2, 0x3d, 0xef, 0x8c,
+		0xc3, 0x54, 0xd9, 0xb4, 0x6b, 0xa6, 0x3d, 0xbb, 0xa9, 0xda, 0x78, 0xf6, 0xca, 0x03, 0x9b, 0xd8,
+		0x51, 0x1f, 0x98, 0x2d, 0x9b, 0x9a, 0x30, 0xc7, 0x38, 0x2b, 0xcf, 0x91, 0xf2, 0x1c, 0x2f, 0x6f,
+		0xb1, 0xe2, 0x5d, 0x84, 0xc4, 0xbc, 0xa9, 0x19, 0xc4, 0x14, 0x15, 0x6c, 0x98, 0x35, 0x9e, 0x1d,
+		0x60, 0x0f, 0xe8, 0x4e, 0xe8, 0x57, 0x6b, 0x66, 0xc3, 0x70, 0xc4, 0x72, 0x80, 0x0c, 0x16, 0x7f,
+		0x7a, 0x7d, 0x3a, 0xbe, 0x64, 0x38, 0x0a, 0x2f, 0x62, 0x0b, 0x58, 0xf9, 0x49, 0x18, 0x58, 0xc0,
+		0xe5, 0xdd, 0xf0, 0x5a, 0xc0, 0xe5, 0x10, 0xaf, 0x7b, 0x20, 0xb9, 0x64, 0x38, 0xec, 0x25, 0xd5,
+		0x43, 0x10, 0xd7, 0x0c, 0x16, 0xcd, 0x84, 0xea, 0x27, 0x70, 0x82, 0xba, 0x80, 0xcb, 0x2e, 0x6a,
+		0x05, 0x97, 0x33, 0x52, 0x33, 0x7b, 0x02, 0x2f, 0x2c, 0xfc, 0xf1, 0x7f, 0x9c, 0xda, 0xf3, 0xf2,
+		0xeb, 0x53, 0x7b, 0x5a, 0xba, 0xaa, 0x3f, 0xff, 0xc8, 0x4d, 0xcc, 0x7e, 0x8e, 0xdb, 0x95, 0xcb,
+		0x21, 0xaf, 0xfc, 0x93, 0x3c, 0x1c, 0xe6, 0x38, 0xb6, 0xa3, 0x5e, 0xd6, 0x8c, 0xaa, 0xdb, 0x12,
+		0xfc, 0x99, 0x37, 0xc6, 0x3e, 0xde, 0x18, 0x02, 0xda, 0xb6, 0x3d, 0xb2, 0x6d, 0xd7, 0xe9, 0x9d,
+		0xd7, 0xdf, 0x1d, 0x3a, 0x4a, 0xb6, 0x83, 0xe7, 0xc8, 0xef, 0x91, 0x60, 0xe4, 0x9c, 0x66, 0x3b,
+		0xa6, 0xa5, 0x95, 0x55, 0x9d, 0x9e, 0x56, 0x3e, 0xd5, 0xed, 0x14, 0x19, 0x9a, 0x4a, 0x9e, 0x80,
+		0xfe, 0x2b, 0xaa, 0x6e, 0x63, 0x87, 0x1f, 0xd6, 0xbf, 0x23, 0x17, 0x6d, 0x88, 0x9c, 0xbb, 0x46,
+		0x12, 0x0c, 0x18, 0x99, 0xfc, 0x4b, 0x31, 0x18, 0xa5, 0x83, 0xad, 0xcd, 0xae, 0xd3, 0x20, 0xeb,
+		0xf1, 0x02, 0x24, 0x2c, 0xd5, 0xe1, 0x39, 0xac, 0x42, 0x8e, 0xb7, 0xf1, 0x91, 0xce, 0xed, 0x96,
+		0x23, 0x6e, 0x40, 0x69, 0xd1, 0xdb, 0x20, 0x59, 0x53, 0xaf, 0x95, 0x28, 0x1f, 0xe6, 0x8a, 0x73,
+		0xbd, 0xf1, 0xb9, 0x71, 0x7d, 0x7a, 0x74, 0x47, 0xad, 0xe9, 0x79, 0x59, 0xf0, 0x91, 0x95, 0x81,
+		0x9a, 0x7a, 0x8d, 0x88, 0x88, 0xea, 0x30, 0x4a, 0xa0, 0xe5, 0x6d, 0xd5, 0xa8, 0x62, 0x56, 0x09,
+		0xcd, 0xc8, 0x15, 0xce, 0xf5, 0x5c, 0xc9, 0x3e, 0xaf, 0x12, 0x1f, 0x3b, 0x59, 0x19, 0xae, 0xa9,
+		0xd7, 0xe6, 0x29, 0x80, 0xd4, 0x98, 0x4f, 0x7e, 0xf0, 0xa3, 0xd3, 0x7b, 0x68, 0xbf, 0xf9, 0xb2,
+		0x04, 0xe0, 0x59, 0x0c, 0xbd, 0x0d, 0xd2, 0x65, 0xf7, 0x89, 0xd2, 0xda, 0xbc, 0x0d, 0xef, 0x6e,
+		0xd5, 0x16, 0x21, 0x7b, 0xb3, 0x10, 0xeb, 0x4b, 0xd7, 0xa7, 0x25, 0x65, 0xb4, 0x1c, 0x6a, 0x8a,
+		0xb7, 0xc2, 0x60, 0xa3, 0x5e, 0x51, 0x1d, 0x5c, 0xa2, 0x6b, 0xfe, 0x58, 0xc7, 0x70, 0x6d, 0x8a,
+		0xf0, 0xba, 0x71, 0x7d, 0x1a, 0x31, 0xb5, 0x7c, 0xc4, 0x32, 0x0d, 0xe2, 0x80, 0x41, 0x08, 0x81,
+		0x4f, 0xa7, 0x2f, 0x48, 0x30, 0xb8, 0xe0, 0x3b, 0x35, 0x90, 0x81, 0x81, 0x9a, 0x69, 0x68, 0x97,
+		0xb9, 0x3f, 0xa6, 0x14, 0xf1, 0x48, 0x32, 0x73, 0xec, 0x05, 0x0e, 0x67, 0x47, 0x64, 0xe6, 0xc4,
+		0x33, 0xa1, 0xba, 0x8a, 0x37, 0x6d, 0x4d, 0xb4, 0x86, 0x22, 0x1e, 0xd1, 0x59, 0xf2, 0x12, 0x79,
+		0xb9, 0x61, 0x69, 0xce, 0x4e, 0xa9, 0x6c, 0x1a, 0x8e, 0x5a, 0x76, 0xd8, 0xab, 0x00, 0x85, 0x03,
+		0x37, 0xae, 0x4f, 0xef, 0x67, 0xb2, 0x86, 0x31, 0x64, 0x65, 0x54, 0x80, 0xe6, 0x19, 0x84, 0xd4,
+		0x50, 0xc1, 0x8e, 0xaa, 0xe9, 0x36, 0x8d, 0x80, 0x53, 0x8a, 0x78, 0xf4, 0xe9, 0xf2, 0x2f, 0x06,
+		0x20, 0xe5, 0x7a, 0x3b, 0xba, 0x0a, 0x69, 0xb3, 0x8e, 0xad, 0xc0, 0x7a, 0x82, 0x86, 0x53, 0x85,
+		0x65, 0xaf, 0xe6, 0x30, 0x86, 0xfc, 0xff, 0xae, 0x4f, 0x1f, 0xef, 0xc2, 0x83, 0x2e, 0xa9, 0x3a,
+		0x5f, 0x8b, 0x28, 0xa3, 0x82, 0x07, 0x07, 0x10, 0x95, 0xbd, 0x2c, 0x44, 0xbd, 0xb1, 0x29, 0x32,
+		0xc0, 0x01, 0x95, 0xc3, 0x18, 0xb2, 0x32, 0xea, 0x82, 0xd6, 0x28, 0x84, 0x2c, 0x20, 0x9e, 0x57,
+		0x35, 0x5d, 0xbc, 0xd5, 0xa6, 0xf0, 0x27, 0xb4, 0x04, 0xfd, 0xb6, 0xa3, 0x3a, 0x0d, 0x16, 0x43,
+		0xf6, 0x15, 0x1e, 0xe8, 0x52, 0xe6, 0x82, 0x69, 0x54, 0xd6, 0x29, 0xa1, 0xc2, 0x19, 0xa0, 0xb3,
+		0xd0, 0xef, 0x98, 0x97, 0xb1, 0xc1, 0x8d, 0xda, 0x53, 0x8f, 0xa7, 0x73, 0x14, 0xa3, 0x46, 0x0e,
+		0xa4, 0x2b, 0x58, 0xc7, 0x55, 0x6a, 0x4a, 0xf2, 0xc6, 0x33, 0x66, 0x8b, 0xf2, 0x54, 0x61, 0xa9,
+		0xe7, 0x6e, 0xc9, 0x0d, 0x14, 0xe6, 0x27, 0x2b, 0xa3, 0x2e, 0x68, 0x9d, 0x42, 0xd0, 0xf9, 0xc0,
+		0x81, 0x17, 0x7e, 0xfd, 0xd2, 0x9d, 0xad, 0xfa, 0x9e, 0xcf, 0xcb, 0x45, 0x76, 0xcb, 0x47, 0x4d,
+		0x5a, 0xad, 0x61, 0x6c, 0x9a, 0x06, 0x7d, 0x13, 0x85, 0x2f, 0xdc, 0x48, 0x26, 0x20, 0xee, 0x6f,
+		0xb5, 0x30, 0x86, 0xac, 0x8c, 0xba, 0xa0, 0x73, 0x14, 0x82, 0x2a, 0x30, 0xe2, 0x61, 0xd1, 0xae,
+		0x9b, 0xea, 0xd8, 0x75, 0xef, 0xe0, 0x5d, 0x77, 0x6f, 0xb8, 0x16, 0xaf, 0xf7, 0x0e, 0xbb, 0x40,
+		0x42, 0x86, 0xce, 0x01, 0x78, 0x03, 0x06, 0xcd, 0x72, 0x0d, 0x9e, 0x90, 0x3b, 0x8f, 0x3a, 0x22,
+		0x33, 0xe0, 0xd1, 0xa2, 0x1f, 0x85, 0xf1, 0x9a, 0x66, 0x94, 0x6c, 0xac, 0x6f, 0x95, 0xb8, 0x81,
+		0x09, 0x4b, 0x7a, 0x93, 0x40, 0x61, 0xb9, 0x37, 0x7f, 0xb8, 0x71, 0x7d, 0x3a, 0xcb, 0x07, 0xd5,
+		0x66, 0x96, 0xb2, 0x32, 0x56, 0xd3, 0x8c, 0x75, 0xac, 0x6f, 0x2d, 0xb8, 0xb0, 0xfc, 0xd0, 0xbb,
+		0x3f, 0x3a, 0xbd, 0xc7, 0xed, 0xc0, 0x1a, 0x0c, 0x79, 0x1d, 0x0b, 0xdb, 0x68, 0x15, 0x52, 0xaa,
+		0x78, 0x60, 0xf9, 0xb0, 0xae, 0x9d, 0xdd, 0xd7, 0x41, 0x3d, 0x1e, 0x6c, 0xac, 0x78, 0xf9, 0xdf,
+		0xcf, 0x48, 0xf2, 0x2b, 0x31, 0xe8, 0x5f, 0xb8, 0xb4, 0xa6, 0x6a, 0x16, 0x7a, 0x11, 0xc6, 0x3c,
+		0x67, 0x0b, 0x8e, 0x14, 0x2b, 0x37, 0xae, 0x4f, 0x67, 0xc2, 0xfe, 0xd8, 0xe3, 0x50, 0x31, 0x57,
+		0x2e, 0x0b, 0x49, 0xbc, 0x4e, 0xc2, 0x21, 0xa4, 0xee, 0x16, 0x59, 0x0f, 0x7f, 0xdd, 0x4d, 0x28,
+		0xbb, 0x18, 0xa6, 0x9a, 0x92, 0x28, 0xbe, 0x81, 0xb3, 0x08, 0x03, 0xcc, 0x16, 0xe4, 0x75, 0xac,
+		0xbe, 0x3a, 0xf9, 0xc3, 0x77, 0xa0, 0xa6, 0x5a, 0xf6, 0x26, 0x8a, 0xef, 0xe6, 0xe5, 0x09, 0x89,
+		0xfc, 0xf1, 0x38, 0xc0, 0xc2, 0xa5, 0x4b, 0x1b, 0x96, 0x56, 0xd7, 0xb1, 0xf3, 0x7d, 0xb5, 0xeb,
+		0x4f, 0x48, 0xb0, 0xd7, 0xb3, 0x9a, 0x6d, 0x95, 0x43, 0xc6, 0x7d, 0xea, 0xc6, 0xf5, 0xe9, 0x83,
+		0x61, 0xe3, 0xfa, 0xd0, 0x76, 0x61, 0xe0, 0x71, 0x97, 0xd1, 0xba, 0x55, 0x8e, 0x96, 0xa3, 0x62,
+		0x3b, 0xae, 0x1c, 0xf1, 0xd6, 0x72, 0xf8, 0xd0, 0x6e, 0x4a, 0x8e, 0x05, 0xdb, 0x69, 0x6e, 0xeb,
+		0x75, 0x18, 0xf4, 0xda, 0x88, 0x5c, 0x8b, 0x92, 0x74, 0xf8, 0x7f, 0xde, 0xe4, 0x72, 0xeb, 0x26,
+		0x17, 0x64, 0xbc, 0xd9, 0x5d, 0x4a, 0xf9, 0x6b, 0x31, 0x00, 0xaf, 0x57, 0xff, 0x75, 0xed, 0x51,
+		0x64, 0x3a, 0xe5, 0x93, 0x5f, 0x7c, 0x57, 0x01, 0x34, 0xa7, 0xf6, 0xb5, 0xd6, 0x9f, 0xc7, 0xc8,
+		0x8b, 0xba, 0x7c, 0xe4, 0x7f, 0xd3, 0xc2, 0x68, 0x0d, 0x06, 0xb0, 0xe1, 0x58, 0x1a, 0x35, 0x31,
+		0xf1, 0xd6, 0xfb, 0x5b, 0x79, 0x6b, 0x84, 0xd5, 0xe8, 0x65, 0x19, 0x62, 0x53, 0x8e, 0xb3, 0xf1,
+		0xd9, 0xfa, 0x7d, 0x71, 0xc8, 0xb4, 0xa2, 0x42, 0xf3, 0x30, 0x5a, 0xb6, 0x30, 0x05, 0x94, 0xfc,
+		0x3b, 0x00, 0x85, 0xac, 0xb7, 0x92, 0x08, 0x21, 0xc8, 0xca, 0x88, 0x80, 0xf0, 0xd8, 0xa0, 0x0a,
+		0x24, 0xcc, 0x27, 0x5d, 0x86, 0x60, 0x75, 0x19, 0xd7, 0xcb, 0x3c, 0x38, 0x10, 0x95, 0x04, 0x19,
+		0xb0, 0xe8, 0x60, 0xc4, 0x83, 0x12, 0x42, 0xf4, 0x02, 0x8c, 0x6a, 0x86, 0xe6, 0x68, 0xaa, 0x5e,
+		0xda, 0x54, 0x75, 0xd5, 0x28, 0xef, 0x66, 0x95, 0xc4, 0x26, 0x74, 0x5e, 0x6d, 0x88, 0x9d, 0xac,
+		0x8c, 0x70, 0x48, 0x81, 0x01, 0xd0, 0x39, 0x18, 0x10, 0x55, 0x25, 0x76, 0x15, 0x4b, 0x0a, 0x72,
+		0x5f, 0x8b, 0xbc, 0x37, 0x0e, 0x63, 0x0a, 0xae, 0xbc, 0xd9, 0x14, 0xbd, 0x35, 0xc5, 0x0a, 0x00,
+		0x1b, 0x48, 0xc8, 0x4c, 0x92, 0x49, 0xec, 0x6a, 0x28, 0x4a, 0x31, 0x0e, 0x0b, 0xb6, 0xe3, 0x6b,
+		0x8f, 0xbf, 0x88, 0xc3, 0x90, 0xbf, 0x3d, 0xde, 0x9c, 0xe2, 0x7f, 0x70, 0xa6, 0x78, 0xb4, 0xe4,
+		0x0d, 0x8d, 0x09, 0x7e, 0xe9, 0x61, 0x8b, 0xa1, 0xb1, 0xa9, 0x4b, 0xb5, 0x1e, 0x13, 0xdf, 0x31,
+		0x00, 0xfd, 0x6b, 0xaa, 0xa5, 0xd6, 0x6c, 0x54, 0x6e, 0x5a, 0xd8, 0x88, 0xfd, 0x95, 0xa6, 0xdb,
+		0x6a, 0x79, 0x52, 0xac, 0xc3, 0xba, 0xe6, 0x83, 0x11, 0xeb, 0x9a, 0xb7, 0xc0, 0x08, 0xc9, 0xc7,
+		0xb8, 0xfa, 0xb1, 0xc6, 0x1c, 0x2e, 0x4c, 0x7a, 0x5c, 0x82, 0xe5, 0x2c, 0x5d, 0xe3, 0x9d, 0x03,
+		0x40, 0x0f, 0xc3, 0x20, 0xc1, 0xf0, 0x66, 0x09, 0x42, 0xbe, 0xcf, 0xcb, 0x8b, 0xf8, 0x0a, 0x65,
+		0x05, 0x6a, 0xea, 0xb5, 0x22, 0x7b, 0x40, 0xcb, 0x80, 0xb6, 0xdd, 0xd4, 0x5c, 0xc9, 0x33, 0x25,
+		0xa1, 0x3f, 0x74, 0xe3, 0xfa, 0xf4, 0x24, 0xa3, 0x6f, 0xc6, 0x91, 0x95, 0x31, 0x0f, 0x28, 0xb8,
+		0x9d, 0x04, 0x20, 0x7a, 0x95, 0x58, 0xa6, 0x96, 0xad, 0xae, 0xf7, 0xde, 0xb8, 0x3e, 0x3d, 0xc6,
+		0xb8, 0x78, 0x65, 0xb2, 0x92, 0x22, 0x0f, 0x0b, 0xe4, 0x3f, 0x7a, 0xaf, 0x04, 0x93, 0x55, 0xdd,
+		0xdc, 0x54, 0xf5, 0x92, 0xae, 0xbd, 0xd0, 0xd0, 0x2a, 0x25, 0xde, 0x76, 0xa5, 0xb2, 0x5a, 0xe7,
+		0x2b, 0x6a, 0xa5, 0xe7, 0x15, 0xf5, 0x0c, 0xab, 0xb3, 0x25, 0x63, 0x59, 0xd9, 0xc7, 0xca, 0x96,
+		0x69, 0xd1, 0x3a, 0x2b, 0x99, 0x57, 0xeb, 0xe8, 0x03, 0x12, 0x1c, 0xf4, 0x9c, 0x36, 0x42, 0x24,
+		0x7a, 0x03, 0x6c, 0xe1, 0x62, 0xcf, 0x22, 0xdd, 0x19, 0xee, 0x10, 0x51, 0x52, 0x4d, 0xba, 0xc5,
+		0x4d, 0x82, 0x55, 0x20, 0x7d, 0x19, 0xef, 0x94, 0x2c, 0x7e, 0x2d, 0x43, 0x69, 0x0b, 0x63, 0x7e,
+		0x61, 0xec, 0x64, 0x2e, 0x22, 0x3b, 0x9f, 0x23, 0x89, 0xf3, 0xc2, 0x34, 0x77, 0x47, 0xbe, 0x98,
+		0x0f, 0x33, 0x90, 0x95, 0x91, 0xcb, 0x78, 0x47, 0xe1, 0x90, 0xb3, 0x18, 0x8b, 0xb5, 0x71, 0x28,
+		0xcb, 0x97, 0x49, 0xf5, 0xbc, 0x36, 0x66, 0x4a, 0xfb, 0xd6, 0xc6, 0x21, 0x96, 0x6c, 0x6d, 0x1c,
+		0xcc, 0x0e, 0xfa, 0x7a, 0xe1, 0x27, 0x24, 0x40, 0x5e, 0x40, 0xa2, 0x60, 0xbb, 0x6e, 0x1a, 0x36,
+		0x4d, 0x02, 0xf8, 0x56, 0xec, 0x52, 0xfb, 0x24, 0x80, 0x47, 0x2f, 0x92, 0x00, 0x1e, 0x2d, 0xb9,
+		0xff, 0x52, 0x4c, 0x4e, 0xb1, 0x4e, 0x56, 0xe4, 0x63, 0x45, 0xf3, 0x6c, 0xfd, 0xaf, 0x25, 0x98,
+		0x6c, 0x1a, 0x5a, 0x5c, 0x61, 0xff, 0x06, 0x20, 0xcb, 0x57, 0xc8, 0x6f, 0x32, 0x63, 0x42, 0xf7,
+		0x3c, 0x52, 0x8d, 0x59, 0xe1, 0x82, 0x5b, 0x18, 0x7f, 0xb0, 0x4d, 0x92, 0x7f, 0x29, 0xc1, 0x84,
+		0xbf, 0x7a, 0x57, 0x91, 0x0b, 0x30, 0xe4, 0xaf, 0x9d, 0xab, 0x70, 0xb8, 0x1b, 0x15, 0xb8, 0xf4,
+		0x01, 0x7a, 0xf4, 0x94, 0x37, 0x6e, 0xb3, 0x4c, 0xfe, 0x03, 0x5d, 0x5b, 0x43, 0xc8, 0x14, 0x1e,
+		0xbf, 0x99, 0x06, 0x7f, 0x25, 0x41, 0x62, 0xcd, 0x34, 0x75, 0x64, 0xc2, 0x98, 0x61, 0x3a, 0x25,
+		0x32, 0xcc, 0xe0, 0x4a, 0x89, 0x27, 0xfc, 0x58, 0x8a, 0x7f, 0xbe, 0x37, 0x23, 0x7d, 0xfb, 0xfa,
+		0x74, 0x33, 0x2b, 0x65, 0xd4, 0x30, 0x9d, 0x02, 0x85, 0x6c, 0x50, 0x00, 0xfa, 0x51, 0x18, 0x0e,
+		0x56, 0xc6, 0xd2, 0x9f, 0x4f, 0xf7, 0x5c, 0x59, 0x90, 0xcd, 0x8d, 0xeb, 0xd3, 0x13, 0xde, 0xf0,
+		0xe9, 0x82, 0x65, 0x65, 0x68, 0xd3, 0x57, 0x7b, 0x3e, 0x49, 0xb4, 0xff, 0x4b, 0x62, 0x81, 0x9f,
+		0x8c, 0xc1, 0x38, 0x05, 0x6a, 0x2f, 0x62, 0x9a, 0x33, 0x54, 0x70, 0xd9, 0xb4, 0x2a, 0x68, 0x04,
+		0x62, 0xfc, 0x78, 0x40, 0x42, 0x89, 0x69, 0xe4, 0x8a, 0xb9, 0x3e, 0xf3, 0xaa, 0xc1, 0xcf, 0x09,
+		0x76, 0x9f, 0x63, 0xf2, 0x85, 0x27, 0x8c, 0x9e, 0x4e, 0x5f, 0x66, 0xa5, 0xa1, 0x63, 0x72, 0x15,
+		0x20, 0xdd, 0x8c, 0x63, 0xb1, 0x9e, 0x7f, 0xfa, 0x0a, 0x94, 0x93, 0xe9, 0x8b, 0x02, 0xe6, 0xd8,
+		0x33, 0x49, 0x79, 0xb9, 0xa3, 0x5e, 0x26, 0xd1, 0x93, 0x38, 0xfe, 0x94, 0x97, 0xcb, 0x83, 0xfb,
+		0xc2, 0x6f, 0xc4, 0x01, 0x91, 0x73, 0x89, 0x6b, 0x8d, 0xcd, 0xf3, 0xde, 0x18, 0xf7, 0xfd, 0xcb,
+		0x91, 0x3f, 0x05, 0x13, 0xa6, 0x5e, 0x29, 0xb9, 0x29, 0xef, 0x60, 0x9e, 0x7c, 0xfa, 0xc6, 0xf5,
+		0xe9, 0x03, 0xbc, 0xf2, 0x08, 0x2c, 0x59, 0x41, 0xa6, 0x5e, 0x99, 0x0f, 0xa5, 0xcb, 0x9f, 0x22,
+		0xc7, 0x05, 0xaf, 0x36, 0xb3, 0x8c, 0x87, 0x59, 0x46, 0x61, 0xc9, 0xe4, 0x3c, 0xe1, 0xd5, 0xf9,
+		0xe6, 0x0c, 0x3c, 0x5f, 0x60, 0x24, 0x02, 0x47, 0x78, 0x22, 0x16, 0x0f, 0x7d, 0xb7, 0x63, 0xf1,
+		0xc0, 0x0f, 0x2f, 0x9f, 0x6d, 0xb9, 0xa3, 0x7a, 0x5f, 0xdb, 0x66, 0xb8, 0xe6, 0x6e, 0x9d, 0x06,
+		0xf7, 0x56, 0x5f, 0x1d, 0x6c, 0xb9, 0xb7, 0x5a, 0xc5, 0x06, 0xb6, 0x35, 0x7b, 0x57, 0x7b, 0xab,
+		0x5d, 0xed, 0xd7, 0xca, 0x5f, 0x4d, 0xc2, 0xd0, 0x22, 0xab, 0x85, 0x6c, 0x46, 0x60, 0xf4, 0x28,
+		0xb9, 0xf7, 0x91, 0x44, 0x98, 0x7c, 0x24, 0x6d, 0x99, 0x72, 0x64, 0x71, 0xa8, 0xd8, 0xc5, 0x64,
+		0x34, 0xc8, 0xe6, 0x87, 0x52, 0xe9, 0xb9, 0xe4, 0x52, 0xdd, 0xbc, 0xea, 0xf6, 0xe2, 0xa5, 0x9e,
+		0xd7, 0x57, 0xdc, 0xdf, 0xc3, 0xfc, 0x64, 0x76, 0xbe, 0x75, 0x83, 0x40, 0xd6, 0x08, 0x00, 0xbd,
+		0x53, 0x82, 0xbd, 0x14, 0xcb, 0x8b, 0x5f, 0x28, 0xa6, 0x48, 0x4a, 0x1c, 0x6b, 0xa5, 0xc2, 0xb2,
+		0x6a, 0x7b, 0x67, 0x56, 0x29, 0xaf, 0xc2, 0x61, 0xee, 0x16, 0x07, 0x7d, 0x95, 0x87, 0xd9, 0xca,
+		0xca, 0xb8, 0xde, 0x44, 0x69, 0xa3, 0x45, 0x00, 0x5f, 0xa0, 0x9c, 0xe8, 0x6d, 0x1b, 0xd8, 0x47,
+		0x8a, 0x9e, 0x84, 0x41, 0x6f, 0x66, 0xb1, 0xf9, 0xf7, 0x35, 0xba, 0x8f, 0x24, 0xfc, 0xc4, 0xe8,
+		0x5d, 0x12, 0xec, 0xf5, 0x02, 0x7d, 0x3f, 0x5b, 0xf6, 0x1d, 0x92, 0x7b, 0x7b, 0x48, 0xd8, 0x84,
+		0x8d, 0x13, 0xc9, 0x57, 0x56, 0x26, 0x5c, 0xf8, 0x82, 0x4f, 0x90, 0x35, 0x72, 0x55, 0xba, 0xbf,
+		0x7e, 0x71, 0x25, 0x5f, 0xf7, 0x13, 0x75, 0x90, 0x01, 0xfb, 0x36, 0x42, 0xdd, 0xb4, 0x1c, 0x5c,
+		0xc9, 0x24, 0xf9, 0x8d, 0x32, 0xfc, 0x19, 0xbd, 0x5b, 0x82, 0x7d, 0x0e, 0x9f, 0x6a, 0xd8, 0x8e,
+		0x55, 0xc9, 0xa2, 0x93, 0x8d, 0x9d, 0x49, 0xb5, 0xd7, 0x3b, 0x62, 0x82, 0x2a, 0xdc, 0xc5, 0xf5,
+		0x3e, 0xc4, 0xf4, 0x8e, 0x66, 0x2c, 0x2b, 0x13, 0x4e, 0x33, 0xad, 0x8d, 0x9e, 0x87, 0x43, 0xdc,
+		0x85, 0x23, 0xa8, 0xc8, 0xb9, 0x38, 0xb2, 0x5d, 0x94, 0x28, 0x1c, 0xbd, 0x71, 0x7d, 0xfa, 0x70,
+		0xc0, 0xe3, 0xa3, 0xd1, 0x65, 0x65, 0x92, 0xb9, 0x7f, 0x53, 0x55, 0x4b, 0x15, 0xd2, 0xda, 0xfb,
+		0xc8, 0x58, 0x4a, 0x86, 0xd1, 0x92, 0x3f, 0xa0, 0xb6, 0x33, 0x83, 0xed, 0xbb, 0x42, 0xf3, 0x6c,
+		0x14, 0xd6, 0x3a, 0x9a, 0xaf, 0xac, 0x8c, 0x97, 0x9b, 0x48, 0x6d, 0xf9, 0x2a, 0xa0, 0xe6, 0xce,
+		0x85, 0xce, 0xc3, 0x40, 0x70, 0x5a, 0xdb, 0xc5, 0x5c, 0x2a, 0x38, 0x90, 0x73, 0x37, 0xde, 0xf8,
+		0x12, 0x57, 0xd8, 0xc3, 0x2d, 0x1f, 0x9c, 0x7f, 0xfe, 0x7e, 0x98, 0x6e, 0x31, 0x90, 0x3a, 0xd7,
+		0x76, 0x35, 0x2e, 0x77, 0x38, 0x94, 0xd2, 0xdd, 0xb8, 0xdd, 0xf1, 0x6c, 0x8c, 0xfc, 0xbd, 0x04,
+		0xa0, 0x15, 0xbb, 0x3a, 0x6f, 0x61, 0x76, 0x2b, 0x22, 0xdf, 0x86, 0x0f, 0x6d, 0xd2, 0x4a, 0x37,
+		0xb5, 0x49, 0xbb, 0x12, 0xd8, 0xf6, 0x8c, 0xf5, 0x76, 0xd8, 0xa2, 0xeb, 0xbd, 0xcf, 0xf8, 0x1b,
+		0xb2, 0xf7, 0x19, 0x9d, 0x3c, 0x4b, 0x7c, 0x1f, 0x73, 0xf8, 0x7d, 0x6f, 0x4c, 0x0e, 0x9f, 0x5c,
+		0xf4, 0xcc, 0x42, 0x33, 0xf6, 0xf1, 0x19, 0xfe, 0x84, 0x1e, 0x12, 0xdf, 0xec, 0x18, 0xe8, 0x6e,
+		0x09, 0xca, 0xb0, 0x79, 0x80, 0xfb, 0xd9, 0x38, 0xa4, 0x57, 0xec, 0x6a, 0xb1, 0xa2, 0x39, 0xb7,
+		0xc9, 0xf7, 0xea, 0xad, 0xb7, 0x3d, 0xe6, 0x6f, 0x5c, 0x9f, 0x1e, 0x61, 0x26, 0xbb, 0x95, 0x86,
+		0xaa, 0xc1, 0x68, 0x38, 0xf5, 0xc0, 0x5c, 0x73, 0x61, 0x37, 0xe7, 0x9c, 0x9a, 0x52, 0x0e, 0x23,
+		0xc1, 0x23, 0x47, 0xe8, 0x5a, 0x74, 0x6f, 0x60, 0xab, 0xe9, 0x73, 0xb7, 0xf3, 0x14, 0x00, 0x6b,
+		0xc2, 0xcf, 0xc5, 0x60, 0x70, 0xc5, 0x16, 0x73, 0x38, 0xfe, 0x6b, 0xbb, 0xc7, 0xf5, 0xb0, 0x7b,
+		0x92, 0x33, 0xde, 0x5d, 0x47, 0x08, 0x9e, 0xee, 0xfc, 0x7a, 0x9c, 0x8e, 0xc3, 0x05, 0x5c, 0xd5,
+		0x0c, 0x37, 0xa6, 0xc1, 0x6f, 0xa6, 0xea, 0x7f, 0x80, 0x52, 0xf5, 0x5e, 0x0b, 0x27, 0x76, 0xd3,
+		0xc2, 0xbf, 0x47, 0x2e, 0xc8, 0xb6, 0xab, 0x17, 0x8d, 0xca, 0x9b, 0x5d, 0xe5, 0xa6, 0xba, 0xca,
+		0x57, 0xe2, 0x30, 0x46, 0xde, 0x14, 0xf0, 0x87, 0xb6, 0xf6, 0x9b, 0xc6, 0xec, 0xd1, 0x98, 0xb4,
+		0x5b, 0x89, 0xd5, 0x43, 0x85, 0x2f, 0x1f, 0x58, 0x3e, 0x2d, 0x11, 0xee, 0x56, 0x91, 0x68, 0xbb,
+		0xb0, 0xdc, 0xb8, 0xcb, 0x88, 0x36, 0xda, 0x2a, 0x61, 0xc3, 0x1b, 0xf5, 0xdf, 0x4a, 0x90, 0x59,
+		0xb1, 0xab, 0x64, 0xe8, 0xc3, 0x35, 0xda, 0xb4, 0xf6, 0x59, 0xd3, 0xfa, 0x01, 0x68, 0xdb, 0x87,
+		0x03, 0x27, 0xf4, 0x7b, 0x76, 0xd6, 0x4f, 0xc7, 0x60, 0x8a, 0x38, 0xab, 0xa5, 0x1a, 0xf6, 0x16,
+		0xb6, 0xa2, 0xf2, 0x9a, 0x6f, 0x83, 0x4c, 0xcb, 0x45, 0x1f, 0xcd, 0x76, 0x16, 0xee, 0xbc, 0x71,
+		0x7d, 0x7a, 0xba, 0xcd, 0xa2, 0x92, 0xae, 0xf7, 0xf6, 0x3a, 0x91, 0x6b, 0x3d, 0x72, 0xee, 0x94,
+		0x1e, 0x4d, 0xdf, 0x7d, 0x9a, 0x94, 0x33, 0x40, 0x9b, 0x90, 0x22, 0x59, 0x38, 0xe6, 0x24, 0x6c,
+		0xec, 0x2d, 0xde, 0xb8, 0x3e, 0x9d, 0xf6, 0x12, 0x74, 0xbb, 0x75, 0x8c, 0xa4, 0x81, 0xaf, 0xfa,
+		0xbd, 0xe1, 0x77, 0x24, 0x18, 0x27, 0xde, 0x60, 0x3a, 0xaa, 0x83, 0xbd, 0x35, 0x67, 0x74, 0x34,
+		0x27, 0xdd, 0xce, 0x68, 0xee, 0x24, 0x00, 0x51, 0x2c, 0x90, 0xe8, 0xf4, 0xed, 0x08, 0x7a, 0x65,
+		0xb2, 0x42, 0x8c, 0xc3, 0x52, 0x90, 0x5c, 0x8b, 0x83, 0x90, 0x6d, 0x5e, 0x5a, 0x89, 0xec, 0xbf,
+		0x9c, 0x85, 0x4c, 0x38, 0xf4, 0x75, 0xcb, 0xf6, 0xc2, 0xb8, 0x2f, 0xa6, 0x72, 0xc1, 0x97, 0x21,
+		0xdb, 0x1c, 0x23, 0x88, 0x52, 0xf2, 0x06, 0x7d, 0x38, 0xbf, 0x29, 0xf5, 0xf0, 0xba, 0x60, 0x28,
+		0x8b, 0x29, 0x6f, 0xc1, 0xde, 0xc0, 0x74, 0x75, 0xbb, 0xea, 0xd9, 0x80, 0xc9, 0xa6, 0xd1, 0xdc,
+		0xad, 0xcb, 0xeb, 0x7d, 0x52, 0x4f, 0xbd, 0x4f, 0x7e, 0x2b, 0xcc, 0xb4, 0x1a, 0x4e, 0x6e, 0x9e,
+		0xf9, 0x51, 0x38, 0xd2, 0xbe, 0x4f, 0xbb, 0x2d, 0x76, 0x08, 0x0e, 0x44, 0xf8, 0xb1, 0x5b, 0xfc,
+		0x6a, 0x1c, 0x0e, 0x12, 0x17, 0x21, 0x3b, 0x58, 0xfa, 0x9b, 0x27, 0xc6, 0x6e, 0xc1, 0xac, 0x16,
+		0x71, 0xcc, 0x28, 0xd1, 0xeb, 0x31, 0x23, 0xde, 0x7d, 0x8f, 0xc0, 0xe1, 0x76, 0x6d, 0xe3, 0x36,
+		0xe2, 0x47, 0x25, 0x18, 0x25, 0x3d, 0x85, 0xbe, 0xa8, 0xc1, 0x8f, 0x5d, 0x90, 0x33, 0xd0, 0x0d,
+		0x67, 0xdb, 0x24, 0x2f, 0x43, 0xf4, 0x98, 0xc4, 0xf2, 0xb5, 0x89, 0xc7, 0xc3, 0x97, 0x6f, 0x8f,
+		0xf5, 0x9e, 0x6f, 0x97, 0x27, 0x61, 0x7f, 0x48, 0x42, 0x21, 0xfd, 0x89, 0x57, 0x01, 0xe2, 0x2b,
+		0x76, 0x95, 0x9c, 0x78, 0x0a, 0x27, 0x81, 0x5a, 0x66, 0x01, 0x9b, 0x47, 0xb5, 0xec, 0x89, 0xee,
+		0x71, 0xdd, 0xfe, 0x77, 0x19, 0x86, 0x83, 0x2b, 0xff, 0xa3, 0x6d, 0x98, 0x04, 0x30, 0xb3, 0xf7,
+		0x77, 0x8b, 0xe9, 0x56, 0xf6, 0x36, 0x48, 0xf2, 0xb6, 0xc3, 0xe8, 0xce, 0x36, 0xd4, 0x02, 0x29,
+		0x7b, 0x6f, 0x17, 0x48, 0x2e, 0xf7, 0x17, 0x60, 0x34, 0xbc, 0x74, 0x6b, 0x67, 0xbd, 0x10, 0x6e,
+		0xf6, 0x44, 0xf7, 0xb8, 0x6e, 0x95, 0x9b, 0x00, 0xbe, 0xb5, 0xc4, 0x5d, 0x6d, 0x38, 0x78, 0x68,
+		0xd9, 0xe3, 0x5d, 0xa1, 0xb9, 0x75, 0x18, 0x30, 0x12, 0x0a, 0xb3, 0xef, 0x69, 0xc3, 0x20, 0x88,
+		0x9a, 0x7d, 0xa0, 0x6b, 0x54, 0xb7, 0xbe, 0x77, 0x48, 0xb0, 0x37, 0x3a, 0x04, 0x6c, 0xd7, 0xe0,
+		0x91, 0x14, 0xd9, 0xd3, 0xbd, 0x52, 0xb8, 0x52, 0xfc, 0x1d, 0x09, 0x0e, 0xb4, 0x0b, 0xd8, 0x4e,
+		0xb5, 0x53, 0xac, 0x35, 0x5d, 0xf6, 0xf1, 0xdd, 0xd1, 0xb9, 0x72, 0x39, 0x90, 0x6e, 0x8a, 0x88,
+		0xda, 0x79, 0x69, 0x18, 0x39, 0xfb, 0x60, 0x0f, 0xc8, 0x6e, 0xad, 0xef, 0x93, 0x60, 0xb2, 0xf5,
+		0x04, 0x75, 0xb2, 0x5d, 0xbf, 0x6f, 0x45, 0x95, 0x7d, 0x74, 0x37, 0x54, 0xae, 0x44, 0xdb, 0x30,
+		0x14, 0x18, 0x6c, 0xef, 0x6e, 0xe7, 0xd4, 0x3e, 0xc4, 0xec, 0x6c, 0x97, 0x88, 0xa2, 0xa6, 0x5b,
+		0xbe, 0x4d, 0xf0, 0xb9, 0x18, 0x1c, 0xf3, 0xe7, 0xf5, 0x5f, 0x68, 0x60, 0x6b, 0xc7, 0x4d, 0xdd,
+		0xd7, 0xd5, 0xaa, 0x66, 0xf8, 0xdf, 0xe0, 0x9e, 0xf4, 0x4f, 0x7a, 0x14, 0x57, 0x48, 0x2c, 0x1b,
+		0x30, 0xb8, 0xa6, 0x56, 0xb1, 0x82, 0x5f, 0x68, 0x60, 0xdb, 0x89, 0xb8, 0x5f, 0x8d, 0xdc, 0x7d,
+		0xb6, 0xb5, 0xc5, 0xde, 0x34, 0x25, 0x27, 0x26, 0xf8, 0x13, 0xd9, 0x0f, 0xd1, 0xb5, 0x9a, 0xc6,
+		0x26, 0xd6, 0x84, 0xc2, 0x1e, 0xc8, 0x87, 0x70, 0xe8, 0x49, 0x06, 0xb6, 0x83, 0x9a, 0x49, 0x88,
+		0x8b, 0xcb, 0x1b, 0x06, 0xdb, 0x41, 0x95, 0x9f, 0x80, 0x21, 0x56, 0x1f, 0x6f, 0x83, 0x49, 0x48,
+		0xd2, 0x6b, 0x7e, 0xbc, 0x5a, 0x07, 0xc8, 0xf3, 0x79, 0x76, 0x17, 0x1b, 0xe3, 0xc2, 0x2a, 0x66,
+		0x0f, 0x85, 0x42, 0x4b, 0x53, 0x1e, 0xed, 0x3c, 0x29, 0x32, 0x43, 0xb9, 0x66, 0xfc, 0xdd, 0x3e,
+		0xd8, 0xcb, 0x42, 0xcd, 0x59, 0xb5, 0xae, 0xcd, 0x6e, 0x3b, 0x8e, 0xb8, 0xe4, 0x10, 0x18, 0x38,
+		0xa7, 0xd6, 0x35, 0x79, 0x07, 0x12, 0xe7, 0x1c, 0xa7, 0x8e, 0x8e, 0x41, 0x9f, 0xd5, 0xd0, 0xb1,
+		0x78, 0x53, 0x62, 0x22, 0xe7, 0xe1, 0xe4, 0x08, 0x82, 0xd2, 0xd0, 0xb1, 0xc2, 0x50, 0x50, 0x11,
+		0xa6, 0xb7, 0x1a, 0xba, 0xbe, 0x53, 0xaa, 0xe0, 0xb2, 0x59, 0x21, 0xeb, 0x2b, 0xfe, 0xd9, 0x66,
+		0x7c, 0xad, 0xae, 0x1a, 0xee, 0xfe, 0x45, 0x52, 0x39, 0x48, 0xd1, 0x16, 0x28, 0x96, 0xf8, 0x64,
+		0x73, 0x51, 0xe0, 0xc8, 0x7f, 0x1a, 0x83, 0xa4, 0x60, 0x4d, 0x2f, 0x47, 0xc3, 0x3a, 0x2e, 0x3b,
+		0xa6, 0x78, 0x3b, 0xd3, 0x7d, 0x46, 0x08, 0xe2, 0x55, 0xde, 0x44, 0xa9, 0x73, 0x7b, 0x14, 0xf2,
+		0x40, 0x60, 0xee, 0x95, 0x75, 0x04, 0x46, 0x6e, 0xb2, 0x9b, 0x80, 0x44, 0xdd, 0x14, 0xc7, 0x82,
+		0xcf, 0xed, 0x51, 0xe8, 0x13, 0xca, 0x40, 0x3f, 0x19, 0xb2, 0x1d, 0x76, 0x90, 0x81, 0xc0, 0xf9,
+		0x33, 0xda, 0x47, 0x5e, 0x08, 0x72, 0xca, 0xec, 0xaa, 0x27, 0x52, 0xc0, 0x1e, 0x49, 0x5c, 0xc5,
+		0x2e, 0x32, 0x0d, 0x7f, 0xa8, 0x9d, 0x18, 0x83, 0x7d, 0x31, 0x86, 0xc8, 0xbd, 0xa6, 0x3a, 0x0e,
+		0xb6, 0x0c, 0xc2, 0x90, 0xa1, 0xd3, 0x7b, 0x10, 0xcd, 0xca, 0x0e, 0xff, 0x78, 0x3c, 0xfd, 0xcf,
+		0x3f, 0x6b, 0x4d, 0xfd, 0xa1, 0x44, 0x0b, 0x87, 0x66, 0x24, 0xfe, 0x59, 0x6b, 0xd6, 0x7d, 0x08,
+		0x52, 0x11, 0xc6, 0xd5, 0x4a, 0x45, 0x23, 0x5e, 0x4d, 0x4e, 0x3f, 0x6b, 0xb4, 0x53, 0x8b, 0x7d,
+		0xc6, 0xe8, 0xb6, 0x40, 0x1e, 0x41, 0x81, 0xe3, 0x17, 0x52, 0x30, 0x50, 0x67, 0x42, 0xc9, 0x67,
+		0x60, 0xac, 0x49, 0x52, 0x22, 0xdf, 0x65, 0xcd, 0xa8, 0x88, 0x7b, 0xfc, 0xc8, 0x7f, 0x02, 0xa3,
+		0x5f, 0x7d, 0x62, 0xef, 0xbd, 0xd2, 0xff, 0x85, 0x1f, 0x6f, 0x7d, 0x8f, 0xc1, 0x88, 0xef, 0x1e,
+		0x03, 0xb5, 0xae, 0x15, 0x52, 0x94, 0x3f, 0xbf, 0xbe, 0x60, 0x8e, 0x17, 0xb0, 0xab, 0x0b, 0x72,
+		0xa6, 0x55, 0x25, 0xa7, 0x2e, 0xc4, 0xae, 0x1d, 0x29, 0x52, 0xeb, 0x9a, 0x4d, 0xdd, 0xd1, 0xfb,
+		0x0a, 0x95, 0x7d, 0xc6, 0xf7, 0x9f, 0xde, 0x6a, 0x90, 0x58, 0x9c, 0x5b, 0x5b, 0x72, 0xfd, 0xf8,
+		0xb7, 0x63, 0x70, 0xd0, 0xe7, 0xc7, 0x3e, 0xe4, 0x66, 0x77, 0xce, 0x46, 0x7b, 0x7c, 0x17, 0xdf,
+		0x70, 0x3a, 0x0f, 0x09, 0x82, 0x8f, 0x3a, 0x7c, 0x74, 0x3a, 0xf3, 0xcb, 0x7f, 0xf0, 0x5b, 0xf2,
+		0x8c, 0xd4, 0xb2, 0x55, 0x28, 0x93, 0xc2, 0xbb, 0xba, 0xb7, 0x5f, 0xda, 0xfb, 0x00, 0x97, 0x7d,
+		0xeb, 0xcc, 0x18, 0xb6, 0xe1, 0x17, 0xcf, 0x83, 0xdc, 0x62, 0x2b, 0x94, 0x8d, 0x98, 0xed, 0x37,
+		0x5f, 0x7b, 0x18, 0x8e, 0x5b, 0x5d, 0x4e, 0xd0, 0xae, 0x05, 0xbb, 0x3c, 0x5e, 0x73, 0x0d, 0xf6,
+		0x3d, 0x45, 0xea, 0xf6, 0x4e, 0x44, 0x8b, 0x81, 0x7d, 0x9f, 0xfb, 0xda, 0x30, 0xf3, 0x6c, 0xef,
+		0x1d, 0x60, 0xf0, 0xe4, 0xe3, 0x6b, 0x82, 0x23, 0xb9, 0x96, 0xf3, 0x45, 0xce, 0x37, 0x59, 0x28,
+		0x3e, 0x4a, 0xf9, 0x17, 0x25, 0xd8, 0xdf, 0x54, 0x35, 0x1f, 0xe3, 0x83, 0x27, 0x55, 0xa4, 0xdd,
+		0x9f, 0x54, 0x59, 0x8c, 0x10, 0xf6, 0xee, 0x8e, 0xc2, 0x32, 0x29, 0x02, 0xd2, 0xbe, 0x00, 0x7b,
+		0x83, 0xc2, 0x0a, 0x33, 0x3d, 0x03, 0x23, 0xc1, 0x85, 0xe8, 0xee, 0x4f, 0x0e, 0x0c, 0x07, 0x16,
+		0xa3, 0x72, 0x29, 0xdc, 0x34, 0xae, 0x79, 0x8a, 0xfe, 0x43, 0x7f, 0x12, 0xff, 0x58, 0x7e, 0x97,
+		0xd6, 0xf1, 0x28, 0xe5, 0xcf, 0x49, 0x30, 0x13, 0xac, 0xc1, 0x77, 0x1e, 0xe6, 0xb6, 0xeb, 0x77,
+		0xcb, 0x1c, 0xe9, 0x5b, 0x12, 0xdc, 0xd1, 0x46, 0x0d, 0x6e, 0xb3, 0x17, 0x61, 0xc2, 0x77, 0x9a,
+		0x58, 0x4c, 0x14, 0xc2, 0xb9, 0x8e, 0x75, 0x3e, 0xbc, 0xe4, 0x86, 0x66, 0x07, 0x88, 0x1d, 0x3f,
+		0xf5, 0xf5, 0xe9, 0xf1, 0xe6, 0x32, 0x5b, 0x19, 0x6f, 0x3e, 0x01, 0x7c, 0x0b, 0xbd, 0xf0, 0x0f,
+		0x25, 0xb8, 0x27, 0xa8, 0x6a, 0x44, 0xb4, 0xfa, 0x43, 0xd4, 0x74, 0x7f, 0x22, 0xc1, 0xb1, 0x6e,
+		0xf4, 0x71, 0x17, 0x9e, 0xe3, 0xde, 0x91, 0xae, 0x70, 0x13, 0xf6, 0x74, 0x50, 0x8c, 0xf5, 0x05,
+		0xe4, 0x72, 0xbb, 0x0d, 0x6d, 0xf5, 0x45, 0x89, 0xf7, 0x5f, 0xbf, 0x9b, 0xb8, 0x0d, 0x13, 0x4c,
+		0x9c, 0xed, 0x3e, 0x51, 0x33, 0x1c, 0x48, 0x9e, 0x45, 0x34, 0x79, 0xec, 0x16, 0x8d, 0x46, 0x57,
+		0x60, 0x7f, 0x93, 0x36, 0xbc, 0x59, 0xde, 0x0a, 0xe3, 0x11, 0x5d, 0x8b, 0x0f, 0x4c, 0x3d, 0xf4,
+		0x2c, 0x05, 0x35, 0x77, 0x1e, 0xb2, 0x3d, 0x33, 0x4d, 0x2b, 0x8e, 0x5c, 0x97, 0xfd, 0xf0, 0xda,
+		0xb3, 0x06, 0x33, 0xad, 0xd5, 0xe2, 0x86, 0x5d, 0x82, 0x7e, 0xe6, 0xa1, 0xdc, 0x96, 0xbb, 0x70,
+		0x71, 0xce, 0xc0, 0x1b, 0xeb, 0x17, 0x84, 0x7e, 0xd1, 0x03, 0xc6, 0x6d, 0xb2, 0xe3, 0xad, 0x1a,
+		0x30, 0xbe, 0x2c, 0xc6, 0xfa, 0x68, 0x35, 0xb8, 0xdd, 0xca, 0xb7, 0x6c, 0xac, 0x67, 0x46, 0x7c,
+		0x83, 0x06, 0x75, 0x57, 0xa7, 0x0e, 0x83, 0xfa, 0x0f, 0x78, 0x1b, 0xb9, 0x83, 0x7a, 0x07, 0x7d,
+		0x7e, 0x18, 0x07, 0xf5, 0xbf, 0x8a, 0xc1, 0x24, 0xd5, 0xcd, 0x7f, 0xfa, 0xf7, 0x0d, 0x68, 0x9b,
+		0x12, 0x20, 0x72, 0xbe, 0xe5, 0x56, 0x8d, 0x45, 0x69, 0xdb, 0x2a, 0x5f, 0x0a, 0xcc, 0xe8, 0x25,
+		0x40, 0x15, 0xdb, 0x09, 0x57, 0x10, 0xdf, 0x75, 0x05, 0x15, 0xdf, 0xd9, 0xda, 0x08, 0xef, 0x4a,
+		0xec, 0xda, 0xbb, 0xbe, 0x24, 0x41, 0x36, 0xaa, 0x05, 0xb8, 0x37, 0x69, 0xb0, 0x2f, 0xf0, 0xda,
+		0x58, 0xd8, 0xa1, 0xee, 0xeb, 0xe6, 0x38, 0x77, 0xa8, 0xfb, 0xef, 0xb5, 0xf0, 0x6d, 0x1d, 0x00,
+		0x7e, 0x47, 0x4c, 0x71, 0x6e, 0x87, 0x69, 0x5e, 0x8d, 0xfd, 0xe0, 0x77, 0xfb, 0xcf, 0x34, 0xcd,
+		0x30, 0x3f, 0x14, 0x0b, 0xbb, 0xaf, 0x4a, 0x30, 0xd5, 0x42, 0xec, 0x1f, 0xe6, 0xf0, 0x62, 0xbb,
+		0xa5, 0x4b, 0xdd, 0xea, 0x55, 0xe4, 0x49, 0xde, 0x1f, 0x83, 0xf7, 0x14, 0xfa, 0xb2, 0x08, 0x51,
+		0x17, 0x60, 0xcb, 0xcf, 0xc2, 0x81, 0x48, 0x2a, 0x2e, 0x5b, 0x1e, 0x12, 0xe4, 0x1d, 0xe9, 0x8c,
+		0x14, 0x74, 0xc7, 0xb0, 0x58, 0x21, 0x6a, 0x4a, 0x23, 0x23, 0x48, 0x53, 0xd6, 0xe4, 0x5d, 0x46,
+		0x2e, 0x86, 0x7c, 0x1e, 0xc6, 0x7c, 0x30, 0x5e, 0xc9, 0x29, 0x92, 0xda, 0xe4, 0xdf, 0x94, 0x18,
+		0x3c, 0x71, 0xb0, 0xe5, 0xbe, 0xa6, 0x69, 0xea, 0x5c, 0x6d, 0x8a, 0x2f, 0x4f, 0x00, 0x62, 0xcc,
+		0x78, 0xca, 0x9e, 0x55, 0xb1, 0x0e, 0xe3, 0x01, 0x28, 0xaf, 0xe4, 0xa6, 0x5e, 0x57, 0x92, 0x1f,
+		0x82, 0x3b, 0x29, 0xd3, 0xa8, 0x97, 0x3e, 0x76, 0x96, 0x2a, 0xc2, 0xca, 0xa1, 0x17, 0x14, 0xe5,
+		0x17, 0xe0, 0x70, 0x7b, 0x32, 0x2f, 0xc0, 0x64, 0xe7, 0x78, 0x3a, 0x05, 0x98, 0x51, 0x8c, 0xb8,
+		0xa4, 0x8c, 0x81, 0xfc, 0x38, 0x1c, 0x69, 0x5d, 0x25, 0x7d, 0x5b, 0x5d, 0x08, 0x1b, 0x79, 0x1f,
+		0xa9, 0xec, 0xc0, 0xdd, 0x1d, 0xe9, 0x6f, 0xbd, 0xd4, 0x75, 0xb8, 0xab, 0x55, 0xad, 0x36, 0x39,
+		0x16, 0xe4, 0x5a, 0xd8, 0x7d, 0xe5, 0x53, 0xba, 0xb9, 0x57, 0x3e, 0xe5, 0x06, 0x1c, 0xe9, 0x54,
+		0x23, 0x57, 0xf3, 0x3c, 0x0c, 0x88, 0x57, 0x82, 0xa4, 0x99, 0xf8, 0xee, 0xf4, 0x14, 0x1c, 0xe4,
+		0x1a, 0x77, 0xa4, 0x39, 0x5d, 0x8f, 0xaa, 0x59, 0xa8, 0x19, 0x9c, 0x0c, 0xa4, 0x5d, 0x4f, 0x06,
+		0xbf, 0x25, 0xc1, 0xe1, 0xf6, 0xf5, 0xdd, 0x06, 0x25, 0x6f, 0xdd, 0xa4, 0x30, 0x0d, 0x87, 0x78,
+		0x23, 0x39, 0xfe, 0x5b, 0x0e, 0x5c, 0x77, 0x90, 0xb7, 0x61, 0xaa, 0x15, 0x02, 0x57, 0xcc, 0xbb,
+		0x2a, 0x51, 0xba, 0x99, 0xab, 0x12, 0x4f, 0x7c, 0x60, 0x0a, 0xfa, 0x68, 0x55, 0xe8, 0x43, 0x12,
+		0x80, 0xef, 0xe2, 0x8a, 0x5c, 0x2b, 0x43, 0x45, 0xe7, 0x73, 0xb3, 0xb3, 0x5d, 0xe3, 0xf3, 0x95,
+		0xf7, 0xb1, 0x1f, 0xff, 0xa3, 0x6f, 0xfe, 0x4c, 0xec, 0x30, 0x92, 0x67, 0x5b, 0x64, 0x92, 0x7d,
+		0xd3, 0xf1, 0x27, 0x25, 0xff, 0x55, 0x9a, 0xc7, 0xbb, 0xab, 0x4a, 0x48, 0x96, 0xeb, 0x16, 0x9d,
+		0x0b, 0x76, 0x86, 0x0a, 0xf6, 0x10, 0x7a, 0xb0, 0xb3, 0x60, 0xb3, 0x6f, 0x0f, 0xce, 0xaf, 0x2f,
+		0xa1, 0xaf, 0x4a, 0x30, 0x11, 0x95, 0x28, 0x44, 0xa7, 0xbb, 0x93, 0xa2, 0x79, 0x49, 0x96, 0x7d,
+		0x64, 0x17, 0x94, 0x5c, 0x95, 0x45, 0xaa, 0xca, 0x1c, 0x7a, 0x62, 0x17, 0xaa, 0xcc, 0xfa, 0xdf,
+		0x35, 0xfc, 0xbf, 0x12, 0x1c, 0x6a, 0x9b, 0x44, 0x43, 0x73, 0xdd, 0x49, 0xd9, 0x66, 0xed, 0x99,
+		0x2d, 0xdc, 0x0c, 0x0b, 0xae, 0xf1, 0x53, 0x54, 0xe3, 0xf3, 0x68, 0x69, 0x37, 0x1a, 0x47, 0xbe,
+		0xd0, 0x89, 0x7e, 0x5f, 0x0a, 0xdc, 0x26, 0xd7, 0xde, 0x9d, 0x9a, 0xb2, 0x47, 0xd9, 0xd9, 0xae,
+		0xf1, 0xb9, 0x0a, 0xcf, 0x50, 0x15, 0x14, 0xb4, 0x76, 0x93, 0x8d, 0x36, 0xfb, 0xf6, 0x60, 0x58,
+		0xf9, 0x12, 0xfa, 0xdf, 0x52, 0xf4, 0xf5, 0x6d, 0x0f, 0xb7, 0x15, 0xb1, 0x75, 0x66, 0x2c, 0x7b,
+		0xba, 0x77, 0x42, 0xae, 0x64, 0x8d, 0x2a, 0x59, 0x45, 0xf8, 0x56, 0x2b, 0x19, 0xd9, 0x88, 0xe8,
+		0x0b, 0x12, 0x4c, 0x44, 0xe5, 0x74, 0x3a, 0x74, 0xcb, 0x36, 0xd9, 0xac, 0x0e, 0xdd, 0xb2, 0x5d,
+		0x02, 0x49, 0x7e, 0x94, 0x2a, 0x7f, 0x0a, 0x9d, 0x6c, 0xa5, 0x7c, 0xdb, 0x56, 0x24, 0x7d, 0xb1,
+		0x6d, 0xee, 0xa3, 0x43, 0x5f, 0xec, 0x26, 0x0f, 0xd4, 0xa1, 0x2f, 0x76, 0x95, 0x7a, 0xe9, 0xdc,
+		0x17, 0x5d, 0xcd, 0xba, 0x6c, 0x46, 0x1b, 0xfd, 0xb6, 0x04, 0xc3, 0x81, 0x95, 0x39, 0x7a, 0xa0,
+		0xad, 0xa0, 0x51, 0x79, 0x94, 0xec, 0x89, 0x5e, 0x48, 0xb8, 0x2e, 0x4b, 0x54, 0x97, 0x79, 0x34,
+		0xb7, 0x1b, 0x5d, 0x82, 0xef, 0x6d, 0x7f, 0x49, 0x82, 0xf1, 0x88, 0x45, 0x6c, 0x87, 0x5e, 0xd8,
+		0x7a, 0xf1, 0x9e, 0x3d, 0xdd, 0x3b, 0x21, 0xd7, 0xea, 0x2c, 0xd5, 0xea, 0x2d, 0xe8, 0xf1, 0xdd,
+		0x68, 0xe5, 0x9b, 0x9f, 0xaf, 0x7b, 0x37, 0x02, 0xf9, 0xea, 0x41, 0xa7, 0x7a, 0x14, 0x4c, 0x28,
+		0xf4, 0x70, 0xcf, 0x74, 0x5c, 0x9f, 0xa7, 0xa9, 0x3e, 0x4f, 0xa1, 0xd5, 0x9b, 0xd3, 0xa7, 0x79,
+		0x5a, 0xff, 0x74, 0xf3, 0x4d, 0xf9, 0xed, 0xbd, 0x28, 0x72, 0xb9, 0x9a, 0x7d, 0xb0, 0x27, 0x1a,
+		0xae, 0xd4, 0x69, 0xaa, 0xd4, 0x09, 0x74, 0x7f, 0x2b, 0xa5, 0x7c, 0x77, 0x80, 0x69, 0xc6, 0x96,
+		0x39, 0xfb, 0x76, 0xb6, 0x08, 0x7e, 0x09, 0xfd, 0x98, 0xb8, 0x72, 0xe7, 0x68, 0xdb, 0x7a, 0x7d,
+		0x2b, 0xd9, 0xec, 0x3d, 0x5d, 0x60, 0x72, 0xb9, 0x0e, 0x53, 0xb9, 0xa6, 0xd0, 0xc1, 0x56, 0x72,
+		0x91, 0xd5, 0x2c, 0x7a, 0x8f, 0xe4, 0x5e, 0xd9, 0x76, 0xac, 0x3d, 0x6f, 0xff, 0x72, 0x37, 0x7b,
+		0x6f, 0x57, 0xb8, 0x5c, 0x92, 0x23, 0x54, 0x92, 0x19, 0x34, 0xd5, 0x52, 0x12, 0x26, 0xc0, 0xd7,
+		0x24, 0xd8, 0xdf, 0x62, 0xcd, 0x8a, 0xce, 0xb4, 0xad, 0xb0, 0xfd, 0x02, 0x39, 0xfb, 0xe8, 0xee,
+		0x88, 0xb9, 0xf8, 0x6f, 0xa1, 0xe2, 0xe7, 0xd1, 0xe9, 0x56, 0xe2, 0x47, 0xbf, 0x1b, 0xb3, 0xb9,
+		0x53, 0xd2, 0x2a, 0xb3, 0x6f, 0xd7, 0x2a, 0x2f, 0xa1, 0xff, 0x24, 0x41, 0xb6, 0xf5, 0xca, 0x16,
+		0x3d, 0xde, 0xbb, 0x78, 0xfe, 0x25, 0x75, 0xf6, 0x89, 0x5d, 0xd3, 0x77, 0x3b, 0xce, 0xb4, 0xd4,
+		0x90, 0xae, 0xde, 0x49, 0x5f, 0x35, 0xcc, 0xda, 0x4b, 0xe8, 0xeb, 0x12, 0x4c, 0xb6, 0x5c, 0xd9,
+		0xa2, 0xc7, 0x7a, 0x15, 0x33, 0xb0, 0x06, 0xcf, 0x3e, 0xbe, 0x5b, 0x72, 0xae, 0xe4, 0x3c, 0x55,
+		0xf2, 0x31, 0x74, 0xa6, 0x37, 0x25, 0xc9, 0xba, 0xbd, 0x32, 0xfb, 0x76, 0xf2, 0x63, 0xbd, 0x84,
+		0xbe, 0x28, 0xc1, 0xfe, 0x16, 0x8b, 0xda, 0x0e, 0x2e, 0xda, 0x7e, 0xe9, 0x9d, 0x7d, 0x74, 0x77,
+		0xc4, 0x5c, 0xb7, 0x53, 0x54, 0xb7, 0xfb, 0x51, 0xae, 0x27, 0xdd, 0x6c, 0xf4, 0x6b, 0x12, 0x8c,
+		0x35, 0x2d, 0x62, 0xd1, 0x43, 0x1d, 0x2c, 0x1d, 0xbd, 0x2a, 0xce, 0x9e, 0xea, 0x95, 0x8c, 0x0b,
+		0xff, 0x20, 0x15, 0xfe, 0x38, 0xba, 0xb7, 0xb5, 0xf0, 0x4e, 0xf0, 0x26, 0x42, 0x5c, 0xb9, 0xe5,
+		0x27, 0x64, 0x7f, 0x3c, 0x0e, 0xfc, 0xd4, 0x6b, 0x89, 0x42, 0x38, 0x0d, 0x3f, 0xc5, 0x35, 0xe4,
+		0x2f, 0xea, 0xe2, 0xe8, 0xdb, 0x39, 0x18, 0xa1, 0x1f, 0xf1, 0xde, 0x52, 0xcb, 0xb8, 0x44, 0x2a,
+		0xea, 0xfc, 0xa9, 0xfb, 0x2f, 0x7c, 0x8d, 0x7d, 0xa9, 0x62, 0xd8, 0x25, 0x24, 0x5f, 0x1a, 0xca,
+		0x6f, 0xc0, 0x84, 0x56, 0xab, 0xeb, 0xb8, 0x86, 0x0d, 0xc7, 0x2e, 0xb9, 0x65, 0x9d, 0xf9, 0xfd,
+		0x01, 0xe7, 0x37, 0xee, 0x91, 0x2f, 0x09, 0xea, 0xfc, 0x32, 0x8c, 0xa9, 0xe5, 0x32, 0xae, 0x07,
+		0x58, 0x76, 0xf8, 0xc0, 0xb8, 0x10, 0x30, 0xcd, 0x29, 0x5d, 0x6e, 0x85, 0x27, 0x5a, 0xb6, 0xc5,
+		0x5d, 0xbe, 0xb6, 0xb0, 0x70, 0x15, 0x1b, 0xc7, 0x0d, 0xec, 0x5c, 0x35, 0xad, 0xcb, 0xa2, 0x49,
+		0x58, 0x55, 0xa2, 0x11, 0xfe, 0xa8, 0xbf, 0xe5, 0x99, 0x3a, 0xf2, 0x46, 0xca, 0x8b, 0xbb, 0xba,
+		0xd0, 0xa4, 0x75, 0xb3, 0x76, 0xfc, 0x00, 0xcf, 0x57, 0xe2, 0x80, 0xa8, 0xb3, 0xce, 0xb1, 0xf7,
+		0x61, 0x5e, 0x64, 0xab, 0x95, 0xd3, 0x40, 0x6e, 0x02, 0xf5, 0x5f, 0x8d, 0xd7, 0xee, 0x45, 0x21,
+		0x25, 0x55, 0x53, 0xaf, 0xf1, 0xab, 0xee, 0x2e, 0x01, 0xa8, 0xba, 0x6e, 0x5e, 0x2d, 0xe9, 0x24,
+		0xbd, 0xcd, 0x52, 0x54, 0x2d, 0x7b, 0x5b, 0x73, 0xcd, 0x39, 0xdf, 0x17, 0x4c, 0xf7, 0x28, 0x29,
+		0xca, 0x6a, 0x59, 0xb3, 0x1d, 0xb4, 0x01, 0xa9, 0x0a, 0x36, 0x76, 0x18, 0xdb, 0xf8, 0xcd, 0xb1,
+		0x4d, 0x12, 0x4e, 0x94, 0xeb, 0x33, 0x80, 0x54, 0x3f, 0x1e, 0xf5, 0x64, 0xba, 0x79, 0x37, 0xd2,
+		0xfa, 0x12, 0xc6, 0x00, 0x67, 0xfa, 0x81, 0xc3, 0x31, 0x35, 0x0c, 0xca, 0x3e, 0x1b, 0xc8, 0x65,
+		0x05, 0xee, 0xdd, 0x89, 0xdf, 0xdc, 0xbd, 0x3b, 0xf9, 0xb1, 0xaf, 0x7c, 0xe6, 0xf8, 0x70, 0x40,
+		0x88, 0xc2, 0x90, 0x7f, 0xdb, 0xe9, 0xd8, 0x47, 0x24, 0x18, 0x6b, 0x12, 0x12, 0xc9, 0x30, 0x35,
+		0x77, 0x71, 0xe3, 0xdc, 0xaa, 0xb2, 0xf4, 0xdc, 0xdc, 0xc6, 0xd2, 0xea, 0x05, 0xf1, 0xf5, 0xb7,
+		0xf5, 0xb5, 0xe2, 0xfc, 0xd2, 0xd9, 0xa5, 0xe2, 0x42, 0x7a, 0x0f, 0x9a, 0x86, 0x03, 0x11, 0x38,
+		0x0b, 0xc5, 0xe5, 0xe2, 0xe2, 0x1c, 0xf9, 0xe8, 0x1b, 0xba, 0x03, 0x0e, 0x45, 0x32, 0x71, 0x51,
+		0x62, 0x2d, 0x50, 0x94, 0xa2, 0x8b, 0x12, 0xbf, 0xd5, 0x43, 0xdb, 0xff, 0x1f, 0x00, 0x50, 0x4f,
+		0x3f, 0x58, 0x30, 0xb3, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2250,6 +2542,18 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.BondDenom != that1.BondDenom {
 		return false
 	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
+		return false
+	}
+	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
+		return false
+	}
 	return true
 }
 func (this *DelegationResponse) Equal(that interface{}) bool {
@@ -2365,6 +2669,75 @@ func (this *Pool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecord)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if !bytes.Equal(this.Owner, that1.Owner) {
+		return false
+	}
+	if this.ModuleAccount != that1.ModuleAccount {
+		return false
+	}
+	if !bytes.Equal(this.Validator, that1.Validator) {
+		return false
+	}
+	return true
+}
+func (this *ConsPubKeyRotation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConsPubKeyRotation)
+	if !ok {
+		that2, ok := that.(ConsPubKeyRotation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.OperatorAddress, that1.OperatorAddress) {
+		return false
+	}
+	if this.OldConsensusPubkey != that1.OldConsensusPubkey {
+		return false
+	}
+	if this.NewConsensusPubkey != that1.NewConsensusPubkey {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.CompletionTime.Equal(that1.CompletionTime) {
+		return false
+	}
+	return true
+}
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3154,6 +3527,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.BondDenom) > 0 {
 		i -= len(m.BondDenom)
 		copy(dAtA[i:], m.BondDenom)
@@ -3176,12 +3589,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err9 != nil {
-		return 0, err9
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err10 != nil {
+		return 0, err10
 	}
-	i -= n9
-	i = encodeVarintStaking(dAtA, i, uint64(n9))
+	i -= n10
+	i = encodeVarintStaking(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -3363,55 +3776,161 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *HistoricalInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Header.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Valset) > 0 {
-		for _, e := range m.Valset {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CommissionRates) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsPubKeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Rate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxChangeRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Commission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *ConsPubKeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsPubKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintStaking(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewConsensusPubkey) > 0 {
+		i -= len(m.NewConsensusPubkey)
+		copy(dAtA[i:], m.NewConsensusPubkey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.NewConsensusPubkey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldConsensusPubkey) > 0 {
+		i -= len(m.OldConsensusPubkey)
+		copy(dAtA[i:], m.OldConsensusPubkey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OldConsensusPubkey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HistoricalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Valset) > 0 {
+		for _, e := range m.Valset {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommissionRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *Commission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = m.CommissionRates.Size()
 	n += 1 + l + sovStaking(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime)
@@ -3695,6 +4214,14 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.KeyRotationFee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -3754,6 +4281,56 @@ func (m *Pool) Size() (n int) {
 	return n
 }
 
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsPubKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.OldConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.NewConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6294,6 +6871,141 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeyRotationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6798,6 +7510,381 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsPubKeyRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsPubKeyRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsPubKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = append(m.OperatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OperatorAddress == nil {
+				m.OperatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldConsensusPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldConsensusPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsensusPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewConsensusPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
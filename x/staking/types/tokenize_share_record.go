@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// TokenizeShareModuleAccountName returns the name of the module account that
+// holds the delegation of the tokenize share record with the given id.
+func TokenizeShareModuleAccountName(id uint64) string {
+	return fmt.Sprintf("tokenizeshare_%d", id)
+}
+
+// NewTokenizeShareRecord creates a new TokenizeShareRecord instance.
+func NewTokenizeShareRecord(id uint64, owner sdk.AccAddress, valAddr sdk.ValAddress) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner,
+		ModuleAccount: TokenizeShareModuleAccountName(id),
+		Validator:     valAddr,
+	}
+}
+
+// GetModuleAddress returns the address of the module account holding the
+// tokenized delegation.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(r.ModuleAccount)
+}
+
+// GetShareTokenDenom returns the denomination of the share tokens issued for
+// the record.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", strings.ToLower(r.Validator.String()), r.Id)
+}
+
+// Validate performs a stateless validation of the record.
+func (r TokenizeShareRecord) Validate() error {
+	if r.Id == 0 {
+		return fmt.Errorf("tokenize share record id cannot be zero")
+	}
+
+	if r.Owner.Empty() {
+		return fmt.Errorf("tokenize share record %d has an empty owner", r.Id)
+	}
+
+	if r.Validator.Empty() {
+		return fmt.Errorf("tokenize share record %d has an empty validator", r.Id)
+	}
+
+	if r.ModuleAccount != TokenizeShareModuleAccountName(r.Id) {
+		return fmt.Errorf("tokenize share record %d has an invalid module account %s", r.Id, r.ModuleAccount)
+	}
+
+	return nil
+}
@@ -40,6 +40,28 @@ func BeginBlocker(k keeper.Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
 			return
 		}
 
+		// If not enough of the bonded stake signalled readiness, postpone the upgrade
+		if plan.ReadinessThreshold != nil {
+			readiness := k.GetReadiness(ctx, plan.Name)
+			if readiness.LT(*plan.ReadinessThreshold) {
+				plan = k.PostponeUpgrade(ctx, plan)
+				ctx.Logger().Info(fmt.Sprintf(
+					"UPGRADE \"%s\" POSTPONED to %d: readiness %s is below threshold %s",
+					plan.Name, plan.Height, readiness, plan.ReadinessThreshold,
+				))
+
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeUpgradePostponed,
+						sdk.NewAttribute(types.AttributeKeyPlanName, plan.Name),
+						sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", plan.Height)),
+						sdk.NewAttribute(types.AttributeKeyReadiness, readiness.String()),
+					),
+				)
+				return
+			}
+		}
+
 		if !k.HasHandler(plan.Name) {
 			upgradeMsg := BuildUpgradeNeededMsg(plan)
 			// We don't have an upgrade handler for this upgrade name, meaning this software is out of date so shutdown
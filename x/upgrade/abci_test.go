@@ -21,6 +21,8 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
@@ -60,6 +62,36 @@ func setupTest(height int64, skip map[int64]bool) TestSuite {
 	return s
 }
 
+// setupReadinessTest sets up a chain with one bonded validator per power and
+// returns their operator addresses.
+func setupReadinessTest(t *testing.T, height int64, powers []int64) (TestSuite, []sdk.ValAddress) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: height, Time: time.Now()})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, len(powers), sdk.TokensFromConsensusPower(100))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	pks := simapp.CreateTestPubKeys(len(powers))
+
+	stakingHandler := staking.NewHandler(app.StakingKeeper)
+	for i, power := range powers {
+		msg := stakingtypes.NewMsgCreateValidator(
+			valAddrs[i], pks[i], sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(power)),
+			stakingtypes.NewDescription("moniker", "", "", "", ""),
+			stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+		)
+		_, err := stakingHandler(ctx, msg)
+		require.NoError(t, err)
+	}
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	s.keeper = app.UpgradeKeeper
+	s.ctx = ctx
+	s.module = upgrade.NewAppModule(s.keeper)
+	s.querier = s.module.LegacyQuerierHandler(codec.NewAminoCodec(app.LegacyAmino()))
+	s.handler = upgrade.NewSoftwareUpgradeProposalHandler(s.keeper)
+	return s, valAddrs
+}
+
 func TestRequireName(t *testing.T) {
 	s := setupTest(10, map[int64]bool{})
 
@@ -128,7 +160,9 @@ func VerifyDoUpgrade(t *testing.T) {
 	})
 
 	t.Log("Verify that the upgrade can be successfully applied with a handler")
-	s.keeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan types.Plan) {})
+	s.keeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
 	require.NotPanics(t, func() {
 		s.module.BeginBlock(newCtx, req)
 	})
@@ -144,7 +178,9 @@ func VerifyDoUpgradeWithCtx(t *testing.T, newCtx sdk.Context, proposalName strin
 	})
 
 	t.Log("Verify that the upgrade can be successfully applied with a handler")
-	s.keeper.SetUpgradeHandler(proposalName, func(ctx sdk.Context, plan types.Plan) {})
+	s.keeper.SetUpgradeHandler(proposalName, func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
 	require.NotPanics(t, func() {
 		s.module.BeginBlock(newCtx, req)
 	})
@@ -156,7 +192,10 @@ func TestHaltIfTooNew(t *testing.T) {
 	s := setupTest(10, map[int64]bool{})
 	t.Log("Verify that we don't panic with registered plan not in database at all")
 	var called int
-	s.keeper.SetUpgradeHandler("future", func(ctx sdk.Context, plan types.Plan) { called++ })
+	s.keeper.SetUpgradeHandler("future", func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		called++
+		return vm, nil
+	})
 
 	newCtx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(time.Now())
 	req := abci.RequestBeginBlock{Header: newCtx.BlockHeader()}
@@ -427,3 +466,48 @@ func TestDumpUpgradeInfoToFile(t *testing.T) {
 	err = os.Remove(upgradeInfoFilePath)
 	require.Nil(t, err)
 }
+
+func TestPostponeUpgradeUntilReady(t *testing.T) {
+	s, valAddrs := setupReadinessTest(t, 10, []int64{10, 10, 10})
+	msgHandler := upgrade.NewHandler(s.keeper)
+	threshold := sdk.NewDecWithPrec(5, 1)
+	plan := types.Plan{Name: "test", Height: s.ctx.BlockHeight() + 1, ReadinessThreshold: &threshold, PostponeBlocks: 10}
+
+	t.Log("Verify readiness can't be signalled without a plan")
+	_, err := msgHandler(s.ctx, types.NewMsgSignalReadiness(valAddrs[0], "test"))
+	require.True(t, errors.Is(sdkerrors.ErrInvalidRequest, err), err)
+
+	err = s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: plan})
+	require.NoError(t, err)
+
+	t.Log("Verify readiness can only be signalled by validators for the scheduled plan")
+	_, err = msgHandler(s.ctx, types.NewMsgSignalReadiness(valAddrs[0], "other"))
+	require.True(t, errors.Is(sdkerrors.ErrInvalidRequest, err), err)
+	_, err = msgHandler(s.ctx, types.NewMsgSignalReadiness(sdk.ValAddress("not-a-validator"), "test"))
+	require.True(t, errors.Is(sdkerrors.ErrInvalidRequest, err), err)
+
+	_, err = msgHandler(s.ctx, types.NewMsgSignalReadiness(valAddrs[0], "test"))
+	require.NoError(t, err)
+	require.Equal(t, "0.333333333333333333", s.keeper.GetReadiness(s.ctx, "test").String())
+
+	t.Log("Verify the upgrade is postponed when readiness is below the threshold")
+	newCtx := s.ctx.WithBlockHeight(plan.Height).WithEventManager(sdk.NewEventManager())
+	require.NotPanics(t, func() {
+		s.module.BeginBlock(newCtx, abci.RequestBeginBlock{Header: newCtx.BlockHeader()})
+	})
+	postponed, found := s.keeper.GetUpgradePlan(newCtx)
+	require.True(t, found)
+	require.Equal(t, plan.Height+plan.PostponeBlocks, postponed.Height)
+	require.Len(t, newCtx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypeUpgradePostponed, newCtx.EventManager().Events()[0].Type)
+	VerifyNotDone(t, newCtx, "test")
+
+	_, err = msgHandler(newCtx, types.NewMsgSignalReadiness(valAddrs[1], "test"))
+	require.NoError(t, err)
+
+	t.Log("Verify the upgrade happens once readiness meets the threshold")
+	newCtx = newCtx.WithBlockHeight(postponed.Height)
+	VerifyDoUpgradeWithCtx(t, newCtx, "test")
+	VerifyDone(t, newCtx, "test")
+	require.True(t, s.keeper.GetReadiness(newCtx, "test").IsZero())
+}
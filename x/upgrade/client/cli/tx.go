@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
@@ -21,6 +22,9 @@ const (
 	FlagUpgradeHeight = "upgrade-height"
 	FlagUpgradeTime   = "time"
 	FlagUpgradeInfo   = "info"
+
+	FlagReadinessThreshold = "readiness-threshold"
+	FlagPostponeBlocks     = "postpone-blocks"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -30,6 +34,40 @@ func GetTxCmd() *cobra.Command {
 		Short: "Upgrade transaction subcommands",
 	}
 
+	cmd.AddCommand(NewCmdSignalReadiness())
+
+	return cmd
+}
+
+// NewCmdSignalReadiness implements a command handler for signalling that a validator
+// is ready for the scheduled software upgrade.
+func NewCmdSignalReadiness() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signal-readiness [plan-name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Signal that the validator operated by the sender is ready for the scheduled upgrade",
+		Long: "Signal that the validator operated by the sender runs a binary which can perform the scheduled upgrade.\n" +
+			"If the upgrade plan has a readiness threshold, it is only applied once the validators which signalled\n" +
+			"hold enough of the bonded stake.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			valAddr := sdk.ValAddress(clientCtx.GetFromAddress())
+			msg := types.NewMsgSignalReadiness(valAddr, args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
 	return cmd
 }
 
@@ -85,6 +123,8 @@ func NewCmdSubmitUpgradeProposal() *cobra.Command {
 	cmd.Flags().Int64(FlagUpgradeHeight, 0, "The height at which the upgrade must happen (not to be used together with --upgrade-time)")
 	cmd.Flags().String(FlagUpgradeTime, "", fmt.Sprintf("The time at which the upgrade must happen (ex. %s) (not to be used together with --upgrade-height)", TimeFormat))
 	cmd.Flags().String(FlagUpgradeInfo, "", "Optional info for the planned upgrade such as commit hash, etc.")
+	cmd.Flags().String(FlagReadinessThreshold, "", "Optional fraction of the bonded stake which must signal readiness before the upgrade is applied (only with --upgrade-height)")
+	cmd.Flags().Int64(FlagPostponeBlocks, 0, "The number of blocks the upgrade is postponed by when the readiness threshold is not met")
 
 	return cmd
 }
@@ -188,6 +228,25 @@ func parseArgsToContent(cmd *cobra.Command, name string) (gov.Content, error) {
 	}
 
 	plan := types.Plan{Name: name, Time: upgradeTime, Height: height, Info: info}
+
+	thresholdStr, err := cmd.Flags().GetString(FlagReadinessThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(thresholdStr) != 0 {
+		threshold, err := sdk.NewDecFromStr(thresholdStr)
+		if err != nil {
+			return nil, err
+		}
+		plan.ReadinessThreshold = &threshold
+	}
+
+	plan.PostponeBlocks, err = cmd.Flags().GetInt64(FlagPostponeBlocks)
+	if err != nil {
+		return nil, err
+	}
+
 	content := types.NewSoftwareUpgradeProposal(title, description, plan)
 	return content, nil
 }
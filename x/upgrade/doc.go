@@ -59,8 +59,9 @@ existing software will cease to process ABCI messages and a new version with cod
 All upgrades are coordinated by a unique upgrade name that cannot be reused on the same blockchain. In order for the upgrade
 module to know that the upgrade has been safely applied, a handler with the name of the upgrade must be installed.
 Here is an example handler for an upgrade named "my-fancy-upgrade":
-	app.upgradeKeeper.SetUpgradeHandler("my-fancy-upgrade", func(ctx sdk.Context, plan upgrade.Plan) {
+	app.upgradeKeeper.SetUpgradeHandler("my-fancy-upgrade", func(ctx sdk.Context, plan upgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
 		// Perform any migrations of the state store needed for this upgrade
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
 	})
 
 This upgrade handler performs the dual function of alerting the upgrade module that the named upgrade has been applied,
@@ -71,8 +72,9 @@ switching the binaries is an ops task and not handled inside the sdk / abci app.
 Here is a sample code to set store migrations with an upgrade:
 
 	// this configures a no-op upgrade handler for the "my-fancy-upgrade" upgrade
-	app.UpgradeKeeper.SetUpgradeHandler("my-fancy-upgrade",  func(ctx sdk.Context, plan upgrade.Plan) {
+	app.UpgradeKeeper.SetUpgradeHandler("my-fancy-upgrade", func(ctx sdk.Context, plan upgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
 		// upgrade changes here
+		return fromVM, nil
 	})
 
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
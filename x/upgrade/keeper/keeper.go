@@ -17,6 +17,7 @@ import (
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
@@ -29,16 +30,21 @@ type Keeper struct {
 	storeKey           sdk.StoreKey
 	cdc                codec.BinaryMarshaler
 	upgradeHandlers    map[string]types.UpgradeHandler
+	stakingKeeper      types.StakingKeeper
 }
 
 // NewKeeper constructs an upgrade Keeper
-func NewKeeper(skipUpgradeHeights map[int64]bool, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, homePath string) Keeper {
+func NewKeeper(
+	skipUpgradeHeights map[int64]bool, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, homePath string,
+	stakingKeeper types.StakingKeeper,
+) Keeper {
 	return Keeper{
 		homePath:           homePath,
 		skipUpgradeHeights: skipUpgradeHeights,
 		storeKey:           storeKey,
 		cdc:                cdc,
 		upgradeHandlers:    map[string]types.UpgradeHandler{},
+		stakingKeeper:      stakingKeeper,
 	}
 }
 
@@ -49,6 +55,36 @@ func (k Keeper) SetUpgradeHandler(name string, upgradeHandler types.UpgradeHandl
 	k.upgradeHandlers[name] = upgradeHandler
 }
 
+// SetModuleVersionMap saves a given version map to state
+func (k Keeper) SetModuleVersionMap(ctx sdk.Context, vm module.VersionMap) {
+	if len(vm) > 0 {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.VersionMapByte})
+		for modName, ver := range vm {
+			nameBytes := []byte(modName)
+			verBytes := make([]byte, 8)
+			binary.BigEndian.PutUint64(verBytes, ver)
+			store.Set(nameBytes, verBytes)
+		}
+	}
+}
+
+// GetModuleVersionMap returns a map of key module name and value module consensus version
+func (k Keeper) GetModuleVersionMap(ctx sdk.Context) module.VersionMap {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.VersionMapByte})
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	vm := make(module.VersionMap)
+	for ; it.Valid(); it.Next() {
+		moduleBytes := it.Key()
+		name := string(moduleBytes)
+		moduleVersion := binary.BigEndian.Uint64(it.Value())
+		vm[name] = moduleVersion
+	}
+
+	return vm
+}
+
 // ScheduleUpgrade schedules an upgrade based on the specified plan.
 // If there is another Plan already scheduled, it will overwrite it
 // (implicitly cancelling the current plan)
@@ -87,10 +123,86 @@ func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) int64 {
 	return int64(binary.BigEndian.Uint64(bz))
 }
 
-// ClearUpgradePlan clears any schedule upgrade
+// ClearUpgradePlan clears any schedule upgrade and the readiness signals of
+// the validators
 func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.PlanKey())
+
+	readinessStore := prefix.NewStore(store, []byte{types.ReadinessByte})
+	it := readinessStore.Iterator(nil, nil)
+	defer it.Close()
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+
+	for _, key := range keys {
+		readinessStore.Delete(key)
+	}
+}
+
+// SignalReadiness records that the given validator runs a binary which can
+// perform the scheduled upgrade with the given name.
+func (k Keeper) SignalReadiness(ctx sdk.Context, valAddr sdk.ValAddress, planName string) error {
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no upgrade plan is scheduled")
+	}
+	if plan.Name != planName {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "scheduled upgrade plan is %s, not %s", plan.Name, planName)
+	}
+
+	if k.stakingKeeper.Validator(ctx, valAddr) == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "validator %s does not exist", valAddr)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ReadinessKey(valAddr), []byte(planName))
+
+	return nil
+}
+
+// GetReadiness returns the fraction of the bonded tokens whose validators
+// signalled readiness for the upgrade with the given name.
+func (k Keeper) GetReadiness(ctx sdk.Context, planName string) sdk.Dec {
+	totalBonded := k.stakingKeeper.TotalBondedTokens(ctx)
+	if !totalBonded.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	readinessStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ReadinessByte})
+	it := readinessStore.Iterator(nil, nil)
+	defer it.Close()
+
+	readyBonded := sdk.ZeroInt()
+	for ; it.Valid(); it.Next() {
+		if string(it.Value()) != planName {
+			continue
+		}
+
+		validator := k.stakingKeeper.Validator(ctx, sdk.ValAddress(it.Key()))
+		if validator == nil || !validator.IsBonded() {
+			continue
+		}
+
+		readyBonded = readyBonded.Add(validator.GetBondedTokens())
+	}
+
+	return readyBonded.ToDec().QuoInt(totalBonded)
+}
+
+// PostponeUpgrade moves the height of the scheduled plan PostponeBlocks after
+// the current height and returns the postponed plan.
+func (k Keeper) PostponeUpgrade(ctx sdk.Context, plan types.Plan) types.Plan {
+	plan.Height = ctx.BlockHeight() + plan.PostponeBlocks
+
+	bz := k.cdc.MustMarshalBinaryBare(&plan)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PlanKey(), bz)
+
+	return plan
 }
 
 // Logger returns a module-specific logger.
@@ -125,14 +237,20 @@ func (k Keeper) HasHandler(name string) bool {
 	return ok
 }
 
-// ApplyUpgrade will execute the handler associated with the Plan and mark the plan as done.
+// ApplyUpgrade will execute the handler associated with the Plan, persist the
+// module version map it returns and mark the plan as done.
 func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) {
 	handler := k.upgradeHandlers[plan.Name]
 	if handler == nil {
 		panic("ApplyUpgrade should never be called without first checking HasHandler")
 	}
 
-	handler(ctx, plan)
+	updatedVM, err := handler(ctx, plan, k.GetModuleVersionMap(ctx))
+	if err != nil {
+		panic(err)
+	}
+
+	k.SetModuleVersionMap(ctx, updatedVM)
 
 	k.ClearUpgradePlan(ctx)
 	k.setDone(ctx, plan.Name)
@@ -1,14 +1,19 @@
 package keeper_test
 
 import (
+	"fmt"
 	"io/ioutil"
 	"os"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	store "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
@@ -28,7 +33,7 @@ func (s *KeeperTestSuite) SetupTest() {
 
 	// recreate keeper in order to use a custom home path
 	app.UpgradeKeeper = keeper.NewKeeper(
-		make(map[int64]bool), app.GetKey(types.StoreKey), app.AppCodec(), homeDir,
+		make(map[int64]bool), app.GetKey(types.StoreKey), app.AppCodec(), homeDir, app.StakingKeeper,
 	)
 
 	s.homeDir = homeDir
@@ -53,6 +58,43 @@ func (s *KeeperTestSuite) TestReadUpgradeInfoFromDisk() {
 	s.Require().Equal(expected, ui)
 }
 
+func (s *KeeperTestSuite) TestModuleVersionMap() {
+	ctx := s.app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// the version map is set at genesis
+	vm := s.app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	s.Require().Equal(uint64(2), vm[banktypes.ModuleName])
+	s.Require().Equal(uint64(1), vm[types.ModuleName])
+
+	vm[banktypes.ModuleName] = 3
+	s.app.UpgradeKeeper.SetModuleVersionMap(ctx, vm)
+	s.Require().Equal(vm, s.app.UpgradeKeeper.GetModuleVersionMap(ctx))
+}
+
+func (s *KeeperTestSuite) TestApplyUpgradeSetsModuleVersionMap() {
+	ctx := s.app.BaseApp.NewContext(false, tmproto.Header{})
+	fromVM := s.app.UpgradeKeeper.GetModuleVersionMap(ctx)
+
+	plan := types.Plan{Name: "test_upgrade", Height: 10}
+	s.app.UpgradeKeeper.SetUpgradeHandler(plan.Name, func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		s.Require().Equal(fromVM, vm)
+		vm[banktypes.ModuleName]++
+		return vm, nil
+	})
+	s.app.UpgradeKeeper.ApplyUpgrade(ctx, plan)
+
+	vm := s.app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	s.Require().Equal(fromVM[banktypes.ModuleName]+1, vm[banktypes.ModuleName])
+
+	// a failing upgrade handler halts the chain
+	s.app.UpgradeKeeper.SetUpgradeHandler("failing", func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return nil, fmt.Errorf("migration failed")
+	})
+	s.Require().Panics(func() {
+		s.app.UpgradeKeeper.ApplyUpgrade(ctx, types.Plan{Name: "failing", Height: 10})
+	})
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
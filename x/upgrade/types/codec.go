@@ -3,6 +3,8 @@ package types
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -11,6 +13,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(Plan{}, "cosmos-sdk/Plan", nil)
 	cdc.RegisterConcrete(&SoftwareUpgradeProposal{}, "cosmos-sdk/SoftwareUpgradeProposal", nil)
 	cdc.RegisterConcrete(&CancelSoftwareUpgradeProposal{}, "cosmos-sdk/CancelSoftwareUpgradeProposal", nil)
+	cdc.RegisterConcrete(&MsgSignalReadiness{}, "cosmos-sdk/MsgSignalReadiness", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -19,4 +22,26 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&SoftwareUpgradeProposal{},
 		&CancelSoftwareUpgradeProposal{},
 	)
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgSignalReadiness{},
+	)
+}
+
+var (
+	amino = codec.New()
+
+	// ModuleCdc references the global x/upgrade module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding as
+	// Amino is still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/upgrade
+	// and defined at the application level.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
 }
@@ -0,0 +1,10 @@
+package types
+
+// upgrade module event types
+const (
+	EventTypeUpgradePostponed = "upgrade_postponed"
+
+	AttributeKeyPlanName  = "plan_name"
+	AttributeKeyHeight    = "height"
+	AttributeKeyReadiness = "readiness"
+)
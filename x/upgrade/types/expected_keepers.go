@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+)
+
+// StakingKeeper defines the expected staking keeper, used to weight the
+// readiness signals of the validators
+type StakingKeeper interface {
+	Validator(ctx sdk.Context, address sdk.ValAddress) stakingexported.ValidatorI
+	TotalBondedTokens(ctx sdk.Context) sdk.Int
+}
@@ -1,5 +1,7 @@
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 const (
 	// ModuleName is the name of this module
 	ModuleName = "upgrade"
@@ -19,6 +21,13 @@ const (
 	PlanByte = 0x0
 	// DoneByte is a prefix for to look up completed upgrade plan by name
 	DoneByte = 0x1
+
+	// VersionMapByte is a prefix to look up module names (key) and versions (value)
+	VersionMapByte = 0x2
+
+	// ReadinessByte is a prefix to look up the upgrade plan name (value) a
+	// validator (key) signalled readiness for
+	ReadinessByte = 0x3
 )
 
 // PlanKey is the key under which the current plan is saved
@@ -26,3 +35,8 @@ const (
 func PlanKey() []byte {
 	return []byte{PlanByte}
 }
+
+// ReadinessKey is the key under which the readiness signal of a validator is saved
+func ReadinessKey(valAddr sdk.ValAddress) []byte {
+	return append([]byte{ReadinessByte}, valAddr.Bytes()...)
+}
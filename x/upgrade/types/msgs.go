@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// upgrade message types
+const (
+	TypeMsgSignalReadiness = "signal_readiness"
+)
+
+var _ sdk.Msg = &MsgSignalReadiness{}
+
+// NewMsgSignalReadiness creates a new MsgSignalReadiness instance
+func NewMsgSignalReadiness(valAddr sdk.ValAddress, planName string) *MsgSignalReadiness {
+	return &MsgSignalReadiness{
+		ValidatorAddress: valAddr,
+		PlanName:         planName,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSignalReadiness) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSignalReadiness) Type() string { return TypeMsgSignalReadiness }
+
+// GetSigners implements the sdk.Msg interface. The readiness is signalled by
+// the validator operator.
+func (msg MsgSignalReadiness) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddress)}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgSignalReadiness) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSignalReadiness) ValidateBasic() error {
+	if msg.ValidatorAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty validator address")
+	}
+	if len(msg.PlanName) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "plan name cannot be empty")
+	}
+
+	return nil
+}
@@ -12,10 +12,18 @@ import (
 func (p Plan) String() string {
 	due := p.DueAt()
 	dueUp := strings.ToUpper(due[0:1]) + due[1:]
-	return fmt.Sprintf(`Upgrade Plan
+	out := fmt.Sprintf(`Upgrade Plan
   Name: %s
   %s
   Info: %s`, p.Name, dueUp, p.Info)
+
+	if p.ReadinessThreshold != nil {
+		out += fmt.Sprintf(`
+  Readiness Threshold: %s
+  Postpone Blocks: %d`, p.ReadinessThreshold, p.PostponeBlocks)
+	}
+
+	return out
 }
 
 // ValidateBasic does basic validation of a Plan
@@ -32,6 +40,21 @@ func (p Plan) ValidateBasic() error {
 	if !p.Time.IsZero() && p.Height != 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot set both time and height")
 	}
+	if p.ReadinessThreshold == nil {
+		if p.PostponeBlocks != 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot set postpone blocks without a readiness threshold")
+		}
+		return nil
+	}
+	if !p.Time.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot set a readiness threshold with time")
+	}
+	if !p.ReadinessThreshold.IsPositive() || p.ReadinessThreshold.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "readiness threshold must be positive and at most one: %s", p.ReadinessThreshold)
+	}
+	if p.PostponeBlocks <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "postpone blocks must be positive")
+	}
 
 	return nil
 }
@@ -20,6 +20,10 @@ func mustParseTime(s string) time.Time {
 	return t
 }
 
+func decPtr(d sdk.Dec) *sdk.Dec {
+	return &d
+}
+
 func TestPlanString(t *testing.T) {
 	cases := map[string]struct {
 		p      Plan
@@ -47,6 +51,15 @@ func TestPlanString(t *testing.T) {
 			},
 			expect: "Upgrade Plan\n  Name: almost-empty\n  Height: 0\n  Info: ",
 		},
+		"with readiness threshold": {
+			p: Plan{
+				Name:               "ready",
+				Height:             7890,
+				ReadinessThreshold: decPtr(sdk.NewDecWithPrec(67, 2)),
+				PostponeBlocks:     100,
+			},
+			expect: "Upgrade Plan\n  Name: ready\n  Height: 7890\n  Info: \n  Readiness Threshold: 0.670000000000000000\n  Postpone Blocks: 100",
+		},
 	}
 
 	for name, tc := range cases {
@@ -95,6 +108,53 @@ func TestPlanValid(t *testing.T) {
 				Height: -12345,
 			},
 		},
+		"proper with readiness threshold": {
+			p: Plan{
+				Name:               "all-good",
+				Height:             123450000,
+				ReadinessThreshold: decPtr(sdk.NewDecWithPrec(67, 2)),
+				PostponeBlocks:     100,
+			},
+			valid: true,
+		},
+		"postpone blocks without readiness threshold": {
+			p: Plan{
+				Name:           "postpone",
+				Height:         123450000,
+				PostponeBlocks: 100,
+			},
+		},
+		"readiness threshold with time": {
+			p: Plan{
+				Name:               "timed",
+				Time:               mustParseTime("2019-07-08T11:33:55Z"),
+				ReadinessThreshold: decPtr(sdk.NewDecWithPrec(67, 2)),
+				PostponeBlocks:     100,
+			},
+		},
+		"zero readiness threshold": {
+			p: Plan{
+				Name:               "zero",
+				Height:             123450000,
+				ReadinessThreshold: decPtr(sdk.ZeroDec()),
+				PostponeBlocks:     100,
+			},
+		},
+		"readiness threshold above one": {
+			p: Plan{
+				Name:               "above",
+				Height:             123450000,
+				ReadinessThreshold: decPtr(sdk.NewDecWithPrec(11, 1)),
+				PostponeBlocks:     100,
+			},
+		},
+		"readiness threshold without postpone blocks": {
+			p: Plan{
+				Name:               "no-postpone",
+				Height:             123450000,
+				ReadinessThreshold: decPtr(sdk.OneDec()),
+			},
+		},
 	}
 
 	for name, tc := range cases {
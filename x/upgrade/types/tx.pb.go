@@ -0,0 +1,627 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/upgrade/v1beta1/tx.proto
+
+package types
+
+import (
+	bytes "bytes"
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgSignalReadiness is the Msg/SignalReadiness request type. It is signed by
+// the validator operator.
+type MsgSignalReadiness struct {
+	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+	// plan_name is the name of the scheduled upgrade plan the validator is
+	// ready for.
+	PlanName string `protobuf:"bytes,2,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty" yaml:"plan_name"`
+}
+
+func (m *MsgSignalReadiness) Reset()         { *m = MsgSignalReadiness{} }
+func (m *MsgSignalReadiness) String() string { return proto.CompactTextString(m) }
+func (*MsgSignalReadiness) ProtoMessage()    {}
+func (*MsgSignalReadiness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{0}
+}
+func (m *MsgSignalReadiness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignalReadiness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignalReadiness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSignalReadiness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignalReadiness.Merge(m, src)
+}
+func (m *MsgSignalReadiness) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignalReadiness) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignalReadiness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSignalReadiness proto.InternalMessageInfo
+
+func (m *MsgSignalReadiness) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *MsgSignalReadiness) GetPlanName() string {
+	if m != nil {
+		return m.PlanName
+	}
+	return ""
+}
+
+// MsgSignalReadinessResponse defines the Msg/SignalReadiness response type.
+type MsgSignalReadinessResponse struct {
+}
+
+func (m *MsgSignalReadinessResponse) Reset()         { *m = MsgSignalReadinessResponse{} }
+func (m *MsgSignalReadinessResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSignalReadinessResponse) ProtoMessage()    {}
+func (*MsgSignalReadinessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{1}
+}
+func (m *MsgSignalReadinessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignalReadinessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignalReadinessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSignalReadinessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignalReadinessResponse.Merge(m, src)
+}
+func (m *MsgSignalReadinessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignalReadinessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignalReadinessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSignalReadinessResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSignalReadiness)(nil), "cosmos.upgrade.v1beta1.MsgSignalReadiness")
+	proto.RegisterType((*MsgSignalReadinessResponse)(nil), "cosmos.upgrade.v1beta1.MsgSignalReadinessResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/upgrade/v1beta1/tx.proto", fileDescriptor_2852c16e3ab79fef) }
+
+var fileDescriptor_2852c16e3ab79fef = []byte{
+	// 320 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xb1, 0x4e, 0x3a, 0x41,
+	0x10, 0xc6, 0xd9, 0xff, 0xdf, 0x18, 0xd9, 0x98, 0x88, 0x17, 0x62, 0x08, 0x31, 0x7b, 0xe4, 0x2a,
+	0x62, 0x64, 0x37, 0x60, 0x47, 0x27, 0x85, 0x1d, 0x16, 0x67, 0x62, 0x61, 0x43, 0x06, 0x76, 0xb3,
+	0x5e, 0xbc, 0xbb, 0x3d, 0x6f, 0x16, 0x02, 0x3e, 0x85, 0x8f, 0xe0, 0xe3, 0x68, 0x47, 0x69, 0x45,
+	0x0c, 0x34, 0xd6, 0x94, 0x56, 0x06, 0xee, 0xa4, 0x10, 0x63, 0xac, 0x76, 0xb2, 0xfb, 0xdb, 0x99,
+	0xf9, 0xbe, 0x8f, 0xba, 0x03, 0x83, 0x91, 0x41, 0x31, 0x4c, 0x74, 0x0a, 0x52, 0x89, 0x51, 0xb3,
+	0xaf, 0x2c, 0x34, 0x85, 0x1d, 0xf3, 0x24, 0x35, 0xd6, 0x38, 0x47, 0x19, 0xc0, 0x73, 0x80, 0xe7,
+	0x40, 0xb5, 0xac, 0x8d, 0x36, 0x6b, 0x44, 0xac, 0xaa, 0x8c, 0xf6, 0x5e, 0x08, 0x75, 0xba, 0xa8,
+	0xaf, 0x02, 0x1d, 0x43, 0xe8, 0x2b, 0x90, 0x41, 0xac, 0x10, 0x9d, 0x07, 0x7a, 0x38, 0x82, 0x30,
+	0x90, 0x60, 0x4d, 0xda, 0x03, 0x29, 0x53, 0x85, 0x58, 0x21, 0x35, 0x52, 0xdf, 0xef, 0x74, 0x97,
+	0x33, 0xb7, 0x32, 0x81, 0x28, 0x6c, 0x7b, 0x5b, 0x88, 0xf7, 0x31, 0x73, 0x1b, 0x3a, 0xb0, 0xb7,
+	0xc3, 0x3e, 0x1f, 0x98, 0x48, 0xe4, 0xbb, 0x66, 0x47, 0x03, 0xe5, 0x9d, 0xb0, 0x93, 0x44, 0x21,
+	0xbf, 0x86, 0xf0, 0x3c, 0xfb, 0xe1, 0x97, 0x36, 0x4d, 0xf2, 0x1b, 0xa7, 0x49, 0x8b, 0x49, 0x08,
+	0x71, 0x2f, 0x86, 0x48, 0x55, 0xfe, 0xd5, 0x48, 0xbd, 0xd8, 0x29, 0x2f, 0x67, 0x6e, 0x29, 0x9b,
+	0xb9, 0x79, 0xf2, 0xfc, 0xbd, 0x55, 0x7d, 0x09, 0x91, 0x6a, 0xef, 0xbc, 0x3f, 0xb9, 0xc4, 0x3b,
+	0xa6, 0xd5, 0x6d, 0x29, 0xbe, 0xc2, 0xc4, 0xc4, 0xa8, 0x5a, 0x63, 0xfa, 0xbf, 0x8b, 0xda, 0xb9,
+	0xa7, 0x07, 0xdf, 0xc5, 0x9e, 0xf0, 0x9f, 0x2d, 0xe3, 0xdb, 0xdd, 0xaa, 0xad, 0xbf, 0xb3, 0x5f,
+	0x93, 0x3b, 0x17, 0xcf, 0x73, 0x46, 0xa6, 0x73, 0x46, 0xde, 0xe6, 0x8c, 0x3c, 0x2e, 0x58, 0x61,
+	0xba, 0x60, 0x85, 0xd7, 0x05, 0x2b, 0xdc, 0x9c, 0xfe, 0xea, 0xd5, 0x78, 0x13, 0xf2, 0xda, 0xb5,
+	0xfe, 0xee, 0x3a, 0xb2, 0xb3, 0xcf, 0x01, 0x00, 0x60, 0x94, 0x57, 0x74, 0x03, 0x02, 0x00, 0x00,
+}
+
+func (this *MsgSignalReadiness) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSignalReadiness)
+	if !ok {
+		that2, ok := that.(MsgSignalReadiness)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
+		return false
+	}
+	if this.PlanName != that1.PlanName {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SignalReadiness defines a method for a validator to signal it runs a
+	// binary which can perform the scheduled upgrade.
+	SignalReadiness(ctx context.Context, in *MsgSignalReadiness, opts ...grpc.CallOption) (*MsgSignalReadinessResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SignalReadiness(ctx context.Context, in *MsgSignalReadiness, opts ...grpc.CallOption) (*MsgSignalReadinessResponse, error) {
+	out := new(MsgSignalReadinessResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Msg/SignalReadiness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SignalReadiness defines a method for a validator to signal it runs a
+	// binary which can perform the scheduled upgrade.
+	SignalReadiness(context.Context, *MsgSignalReadiness) (*MsgSignalReadinessResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SignalReadiness(ctx context.Context, req *MsgSignalReadiness) (*MsgSignalReadinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalReadiness not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SignalReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSignalReadiness)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SignalReadiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Msg/SignalReadiness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SignalReadiness(ctx, req.(*MsgSignalReadiness))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.upgrade.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SignalReadiness",
+			Handler:    _Msg_SignalReadiness_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/upgrade/v1beta1/tx.proto",
+}
+
+func (m *MsgSignalReadiness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignalReadiness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignalReadiness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PlanName) > 0 {
+		i -= len(m.PlanName)
+		copy(dAtA[i:], m.PlanName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PlanName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSignalReadinessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignalReadinessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignalReadinessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSignalReadiness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PlanName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSignalReadinessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSignalReadiness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignalReadiness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignalReadiness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSignalReadinessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignalReadinessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignalReadinessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
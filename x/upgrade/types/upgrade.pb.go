@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -44,6 +45,15 @@ type Plan struct {
 	// Any application specific upgrade info to be included on-chain
 	// such as a git commit that validators could automatically upgrade to
 	Info string `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
+	// The fraction of the bonded tokens whose validators must have signalled
+	// they are ready for the upgrade when it is due. If it is not reached, the
+	// upgrade is postponed by PostponeBlocks instead of halting the chain.
+	// Leave unset to always perform the upgrade when it is due.
+	// Only used with a Height.
+	ReadinessThreshold *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=readiness_threshold,json=readinessThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"readiness_threshold,omitempty" yaml:"readiness_threshold"`
+	// The number of blocks the upgrade is postponed by when the readiness
+	// threshold is not reached.
+	PostponeBlocks int64 `protobuf:"varint,6,opt,name=postpone_blocks,json=postponeBlocks,proto3" json:"postpone_blocks,omitempty" yaml:"postpone_blocks"`
 }
 
 func (m *Plan) Reset()      { *m = Plan{} }
@@ -166,30 +176,36 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xbf, 0x4e, 0xf3, 0x30,
-	0x14, 0xc5, 0xe3, 0xaf, 0xf9, 0x2a, 0xea, 0x6e, 0x56, 0x55, 0xa2, 0x0a, 0x9c, 0xa8, 0x62, 0xe8,
-	0x00, 0x8e, 0x5a, 0x24, 0x84, 0x18, 0xcb, 0x8e, 0xaa, 0x00, 0x0b, 0x12, 0x83, 0x93, 0xba, 0x69,
-	0x44, 0x12, 0x47, 0xb1, 0xcb, 0x9f, 0x17, 0x60, 0xa5, 0x8f, 0xc0, 0xe3, 0x74, 0xec, 0xd8, 0x09,
-	0x68, 0xbb, 0xf0, 0x18, 0x28, 0x76, 0x82, 0x18, 0x18, 0x99, 0x72, 0xcf, 0xd5, 0x2f, 0xe7, 0x9e,
-	0x9b, 0x5c, 0x78, 0x10, 0x70, 0x91, 0x70, 0xe1, 0xce, 0xb2, 0x30, 0xa7, 0x63, 0xe6, 0xde, 0xf7,
-	0x7d, 0x26, 0x69, 0xbf, 0xd2, 0x24, 0xcb, 0xb9, 0xe4, 0xa8, 0xad, 0x29, 0x52, 0x75, 0x4b, 0xaa,
-	0xd3, 0x0a, 0x79, 0xc8, 0x15, 0xe2, 0x16, 0x95, 0xa6, 0x3b, 0x76, 0xc8, 0x79, 0x18, 0x33, 0x57,
-	0x29, 0x7f, 0x36, 0x71, 0x65, 0x94, 0x30, 0x21, 0x69, 0x92, 0x69, 0xa0, 0xfb, 0x0c, 0xa0, 0x39,
-	0x8a, 0x69, 0x8a, 0x10, 0x34, 0x53, 0x9a, 0x30, 0x0b, 0x38, 0xa0, 0xd7, 0xf0, 0x54, 0x8d, 0x4e,
-	0xa1, 0x59, 0xf0, 0xd6, 0x3f, 0x07, 0xf4, 0x9a, 0x83, 0x0e, 0xd1, 0x66, 0xa4, 0x32, 0x23, 0x57,
-	0x95, 0xd9, 0x70, 0x67, 0xf1, 0x66, 0x1b, 0xf3, 0x77, 0x1b, 0x78, 0xea, 0x0d, 0xd4, 0x86, 0xf5,
-	0x29, 0x8b, 0xc2, 0xa9, 0xb4, 0x6a, 0x0e, 0xe8, 0xd5, 0xbc, 0x52, 0x15, 0x53, 0xa2, 0x74, 0xc2,
-	0x2d, 0x53, 0x4f, 0x29, 0xea, 0x33, 0xf3, 0xf3, 0xd5, 0x06, 0xdd, 0x17, 0x00, 0x77, 0x2f, 0xf9,
-	0x44, 0x3e, 0xd0, 0x9c, 0x5d, 0xeb, 0xdd, 0x46, 0x39, 0xcf, 0xb8, 0xa0, 0x31, 0x6a, 0xc1, 0xff,
-	0x32, 0x92, 0x71, 0x15, 0x4e, 0x0b, 0xe4, 0xc0, 0xe6, 0x98, 0x89, 0x20, 0x8f, 0x32, 0x19, 0xf1,
-	0x54, 0x85, 0x6c, 0x78, 0x3f, 0x5b, 0xe8, 0x04, 0x9a, 0x59, 0x4c, 0x53, 0x95, 0xa1, 0x39, 0xd8,
-	0x23, 0xbf, 0x7f, 0x3a, 0x52, 0xec, 0x3f, 0x34, 0x8b, 0x0d, 0x3c, 0xc5, 0x97, 0x89, 0x6e, 0xe1,
-	0xfe, 0x39, 0x4d, 0x03, 0x16, 0xff, 0x71, 0x2c, 0x6d, 0x3f, 0xbc, 0x58, 0xac, 0xb1, 0xb1, 0x5a,
-	0x63, 0x63, 0xb1, 0xc1, 0x60, 0xb9, 0xc1, 0xe0, 0x63, 0x83, 0xc1, 0x7c, 0x8b, 0x8d, 0xe5, 0x16,
-	0x1b, 0xab, 0x2d, 0x36, 0x6e, 0x0e, 0xc3, 0x48, 0x4e, 0x67, 0x3e, 0x09, 0x78, 0xe2, 0x96, 0xb7,
-	0xa1, 0x1f, 0x47, 0x62, 0x7c, 0xe7, 0x3e, 0x7e, 0x1f, 0x8a, 0x7c, 0xca, 0x98, 0xf0, 0xeb, 0xea,
-	0xb7, 0x1c, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xdc, 0xda, 0x23, 0xdb, 0x47, 0x02, 0x00, 0x00,
+	// 460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x33, 0x6d, 0xba, 0xd8, 0x59, 0x51, 0x18, 0xcb, 0x1a, 0x82, 0x26, 0x21, 0x88, 0xec,
+	0x41, 0x13, 0x5a, 0x41, 0xa4, 0xc7, 0xd4, 0x83, 0x27, 0x29, 0xb1, 0x5e, 0x04, 0x29, 0x93, 0x64,
+	0x36, 0x09, 0x4d, 0xf2, 0x86, 0xcc, 0xac, 0xba, 0x9f, 0xc2, 0x7e, 0x04, 0x3f, 0x8d, 0xec, 0xb1,
+	0xc7, 0xe2, 0x61, 0xb5, 0xbb, 0x17, 0xcf, 0xfd, 0x04, 0x92, 0x99, 0x4d, 0x11, 0x59, 0x3c, 0xf5,
+	0x94, 0xf7, 0xfe, 0xfc, 0xf2, 0x9f, 0xc7, 0xfb, 0x3f, 0xfc, 0x24, 0x05, 0x51, 0x83, 0x08, 0xa7,
+	0x3c, 0x6f, 0x69, 0xc6, 0xc2, 0x4f, 0xfb, 0x09, 0x93, 0x74, 0xbf, 0xef, 0x03, 0xde, 0x82, 0x04,
+	0x32, 0xd2, 0x54, 0xd0, 0xab, 0x6b, 0xca, 0xde, 0xcb, 0x21, 0x07, 0x85, 0x84, 0x5d, 0xa5, 0x69,
+	0xdb, 0xcd, 0x01, 0xf2, 0x8a, 0x85, 0xaa, 0x4b, 0xa6, 0x93, 0x50, 0x96, 0x35, 0x13, 0x92, 0xd6,
+	0x5c, 0x03, 0xfe, 0xf7, 0x2d, 0x6c, 0x1e, 0x57, 0xb4, 0x21, 0x04, 0x9b, 0x0d, 0xad, 0x99, 0x85,
+	0x3c, 0x34, 0xde, 0x8d, 0x55, 0x4d, 0x5e, 0x61, 0xb3, 0xe3, 0xad, 0x2d, 0x0f, 0x8d, 0x87, 0x07,
+	0x76, 0xa0, 0xcd, 0x82, 0xde, 0x2c, 0x38, 0xe9, 0xcd, 0xa2, 0x3b, 0xf3, 0x85, 0x6b, 0x9c, 0xff,
+	0x74, 0x51, 0xac, 0xfe, 0x20, 0x23, 0x3c, 0x28, 0x58, 0x99, 0x17, 0xd2, 0xda, 0xf6, 0xd0, 0x78,
+	0x3b, 0x5e, 0x77, 0xdd, 0x2b, 0x65, 0x33, 0x01, 0xcb, 0xd4, 0xaf, 0x74, 0x35, 0x99, 0xe1, 0x07,
+	0x2d, 0xa3, 0x59, 0xd9, 0x30, 0x21, 0x4e, 0x65, 0xd1, 0x32, 0x51, 0x40, 0x95, 0x59, 0x3b, 0x1e,
+	0x1a, 0xdf, 0x8d, 0xde, 0xfc, 0x58, 0xb8, 0x4f, 0xf3, 0x52, 0x16, 0xd3, 0x24, 0x48, 0xa1, 0x0e,
+	0xd7, 0x3b, 0xd2, 0x9f, 0xe7, 0x22, 0x3b, 0x0b, 0xe5, 0x8c, 0x33, 0x11, 0xbc, 0x66, 0xe9, 0xf5,
+	0xc2, 0xb5, 0x67, 0xb4, 0xae, 0x0e, 0xfd, 0x0d, 0x76, 0x7e, 0x4c, 0x6e, 0xd4, 0x93, 0x5e, 0x24,
+	0x47, 0xf8, 0x3e, 0x07, 0x21, 0x39, 0x34, 0xec, 0x34, 0xa9, 0x20, 0x3d, 0x13, 0xd6, 0xa0, 0x9b,
+	0x37, 0xb2, 0xaf, 0x17, 0xee, 0x48, 0x9b, 0xfd, 0x03, 0xf8, 0xf1, 0xbd, 0x5e, 0x89, 0x94, 0x70,
+	0x68, 0xfe, 0xfe, 0xe6, 0x22, 0xff, 0x2b, 0xc2, 0x0f, 0xdf, 0xc1, 0x44, 0x7e, 0xa6, 0x2d, 0x7b,
+	0xaf, 0xb3, 0x39, 0x6e, 0x81, 0x83, 0xa0, 0x15, 0xd9, 0xc3, 0x3b, 0xb2, 0x94, 0x55, 0xbf, 0x5c,
+	0xdd, 0x10, 0x0f, 0x0f, 0x33, 0x26, 0xd2, 0xb6, 0xe4, 0xb2, 0x84, 0x46, 0x2d, 0x79, 0x37, 0xfe,
+	0x5b, 0x22, 0x2f, 0xb1, 0xc9, 0x2b, 0xda, 0xa8, 0x1d, 0x0e, 0x0f, 0x1e, 0x05, 0x9b, 0xa3, 0x0f,
+	0xba, 0xfc, 0x22, 0xb3, 0x4b, 0x20, 0x56, 0xfc, 0x7a, 0xa2, 0x8f, 0xf8, 0xf1, 0x11, 0x6d, 0x52,
+	0x56, 0xdd, 0xf2, 0x58, 0xda, 0x3e, 0x7a, 0x3b, 0xbf, 0x72, 0x8c, 0xcb, 0x2b, 0xc7, 0x98, 0x2f,
+	0x1d, 0x74, 0xb1, 0x74, 0xd0, 0xaf, 0xa5, 0x83, 0xce, 0x57, 0x8e, 0x71, 0xb1, 0x72, 0x8c, 0xcb,
+	0x95, 0x63, 0x7c, 0x78, 0xf6, 0xdf, 0xdc, 0xbe, 0xdc, 0x1c, 0xba, 0x4a, 0x30, 0x19, 0xa8, 0xb3,
+	0x7a, 0xf1, 0x67, 0x00, 0x62, 0xd2, 0x08, 0xfe, 0x07, 0x03, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -223,6 +239,16 @@ func (this *Plan) Equal(that interface{}) bool {
 	if this.Info != that1.Info {
 		return false
 	}
+	if that1.ReadinessThreshold == nil {
+		if this.ReadinessThreshold != nil {
+			return false
+		}
+	} else if !this.ReadinessThreshold.Equal(*that1.ReadinessThreshold) {
+		return false
+	}
+	if this.PostponeBlocks != that1.PostponeBlocks {
+		return false
+	}
 	return true
 }
 func (this *SoftwareUpgradeProposal) Equal(that interface{}) bool {
@@ -302,6 +328,23 @@ func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PostponeBlocks != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.PostponeBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ReadinessThreshold != nil {
+		{
+			size := m.ReadinessThreshold.Size()
+			i -= size
+			if _, err := m.ReadinessThreshold.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintUpgrade(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Info) > 0 {
 		i -= len(m.Info)
 		copy(dAtA[i:], m.Info)
@@ -446,6 +489,13 @@ func (m *Plan) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovUpgrade(uint64(l))
 	}
+	if m.ReadinessThreshold != nil {
+		l = m.ReadinessThreshold.Size()
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.PostponeBlocks != 0 {
+		n += 1 + sovUpgrade(uint64(m.PostponeBlocks))
+	}
 	return n
 }
 
@@ -636,6 +686,60 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			}
 			m.Info = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadinessThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.ReadinessThreshold = &v
+			if err := m.ReadinessThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostponeBlocks", wireType)
+			}
+			m.PostponeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostponeBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUpgrade(dAtA[iNdEx:])